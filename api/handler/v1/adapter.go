@@ -449,6 +449,146 @@ func (adapt *Adapter) ToReplayExecutionTreeNode(res *tree.TreeNode) (*pb.ReplayE
 	return response, nil
 }
 
+func (adapt *Adapter) ToJobGraphProto(graph models.JobGraph) *pb.GetJobGraphResponse {
+	response := &pb.GetJobGraphResponse{}
+	for _, node := range graph.Nodes {
+		response.Nodes = append(response.Nodes, &pb.JobGraphNode{
+			Id:          node.ID,
+			Name:        node.Name,
+			ProjectName: node.Project,
+			Type:        node.Type.String(),
+		})
+	}
+	for _, edge := range graph.Edges {
+		response.Edges = append(response.Edges, &pb.JobGraphEdge{
+			Upstream:   edge.Upstream,
+			Downstream: edge.Downstream,
+			Type:       edge.Type.String(),
+		})
+	}
+	return response
+}
+
+func (adapt *Adapter) ToJobDeploymentPlanProto(plan models.JobDeploymentPlan) *pb.PlanDeploymentResponse {
+	response := &pb.PlanDeploymentResponse{
+		AddedJobs:   plan.Added,
+		RemovedJobs: plan.Removed,
+	}
+	for _, change := range plan.Changed {
+		response.ChangedJobs = append(response.ChangedJobs, &pb.JobSpecChange{
+			Name:           change.Name,
+			Fields:         change.Fields,
+			OldDestination: change.OldDestination,
+			NewDestination: change.NewDestination,
+		})
+	}
+	for _, change := range plan.DependencyChanges {
+		changeProto := &pb.JobDependencyChange{
+			JobId:       change.Job.ID,
+			ProjectName: change.Job.Project,
+			JobName:     change.Job.Name,
+			Added:       change.Added,
+			Removed:     change.Removed,
+			Unknown:     change.Unknown,
+		}
+		if change.Err != nil {
+			changeProto.Error = change.Err.Error()
+		}
+		response.DependencyChanges = append(response.DependencyChanges, changeProto)
+	}
+	for _, node := range plan.Affected {
+		response.AffectedJobs = append(response.AffectedJobs, &pb.JobGraphNode{
+			Id:          node.ID,
+			Name:        node.Name,
+			ProjectName: node.Project,
+			Type:        node.Type.String(),
+		})
+	}
+	return response
+}
+
+func (adapt *Adapter) ToResourceDriftProto(drift models.ResourceDrift) *pb.ResourceDrift {
+	driftProto := &pb.ResourceDrift{
+		ResourceName: drift.Spec.Name,
+		Type:         drift.Spec.Type.String(),
+		Drifted:      drift.Drifted(),
+		Missing:      drift.Missing,
+	}
+	for _, field := range drift.Fields {
+		driftProto.Fields = append(driftProto.Fields, &pb.ResourceFieldDrift{
+			Field:    field.Field,
+			Expected: field.Expected,
+			Actual:   field.Actual,
+		})
+	}
+	if drift.Err != nil {
+		driftProto.Error = drift.Err.Error()
+	}
+	return driftProto
+}
+
+func (adapt *Adapter) ToAuditEventProto(event models.AuditEvent) *pb.AuditEvent {
+	return &pb.AuditEvent{
+		Id:            event.ID.String(),
+		Actor:         event.Actor,
+		ProjectName:   event.ProjectName,
+		Namespace:     event.NamespaceName,
+		Operation:     event.Operation,
+		Targets:       event.Targets,
+		RequestDigest: event.RequestDigest,
+		Outcome:       event.Outcome,
+		Error:         event.Error,
+		CreatedAt:     timestamppb.New(event.CreatedAt),
+	}
+}
+
+func (adapt *Adapter) ToDeploymentProto(deployment models.Deployment) *pb.Deployment {
+	deploymentProto := &pb.Deployment{
+		Id:          deployment.ID.String(),
+		ProjectName: deployment.Namespace.ProjectSpec.Name,
+		Namespace:   deployment.Namespace.Name,
+		Status:      deployment.Status,
+		Message:     deployment.Message,
+		CreatedAt:   timestamppb.New(deployment.CreatedAt),
+		UpdatedAt:   timestamppb.New(deployment.UpdatedAt),
+	}
+	for _, result := range deployment.Jobs {
+		deploymentProto.Jobs = append(deploymentProto.Jobs, &pb.DeploymentJobResult{
+			JobName: result.Name,
+			Success: result.Success,
+			Message: result.Message,
+		})
+	}
+	return deploymentProto
+}
+
+func (adapt *Adapter) ToResourcePlanProto(plan models.ResourcePlan) *pb.ResourcePlan {
+	planProto := &pb.ResourcePlan{
+		ResourceName: plan.Spec.Name,
+		Type:         plan.Spec.Type.String(),
+		Action:       string(plan.Action),
+	}
+	for _, field := range plan.Fields {
+		planProto.Fields = append(planProto.Fields, &pb.ResourceFieldDrift{
+			Field:    field.Field,
+			Expected: field.Expected,
+			Actual:   field.Actual,
+		})
+	}
+	for _, change := range plan.SchemaChanges {
+		planProto.SchemaChanges = append(planProto.SchemaChanges, &pb.ResourceSchemaChange{
+			Field: change.Field,
+			Kind:  string(change.Kind),
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+	if plan.Err != nil {
+		planProto.Error = plan.Err.Error()
+	}
+	return planProto
+}
+
 func NewAdapter(pluginRepo models.PluginRepository, datastoreRepo models.DatastoreRepo) *Adapter {
 	return &Adapter{
 		pluginRepo:             pluginRepo,
@@ -0,0 +1,156 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/datastore"
+	"github.com/odpf/optimus/job"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (sv *RuntimeServiceServer) DeleteNamespace(ctx context.Context, req *pb.DeleteNamespaceRequest) (*pb.DeleteNamespaceResponse, error) {
+	projSpec, err := sv.projectRepoFactory.New().GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+	namespaceSpec, err := sv.namespaceRepoFactory.New(projSpec).GetByName(req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	obs := &deletionObserver{}
+	restorableUntil, err := sv.archiveManager.ArchiveNamespace(ctx, namespaceSpec, job.ArchiveOptions{
+		Force:         req.GetForce(),
+		DropResources: req.GetDropResources(),
+	}, obs)
+	if err != nil {
+		return nil, archiveStatus(err, "failed to delete namespace %s", namespaceSpec.Name)
+	}
+
+	return &pb.DeleteNamespaceResponse{
+		Success:          true,
+		Message:          fmt.Sprintf("namespace %s archived", namespaceSpec.Name),
+		DeletedJobs:      obs.jobNames(),
+		DeletedResources: obs.resourceNames(),
+		RestorableUntil:  timestamppb.New(restorableUntil),
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) RestoreNamespace(ctx context.Context, req *pb.RestoreNamespaceRequest) (*pb.RestoreNamespaceResponse, error) {
+	projSpec, err := sv.projectRepoFactory.New().GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+	deployment, err := sv.archiveManager.RestoreNamespace(ctx, projSpec, req.GetNamespace())
+	if err != nil {
+		return nil, archiveStatus(err, "failed to restore namespace %s", req.GetNamespace())
+	}
+
+	return &pb.RestoreNamespaceResponse{
+		Success: true,
+		Message: fmt.Sprintf("namespace %s restored, its jobs are deployed with deployment %s",
+			req.GetNamespace(), deployment.ID),
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) ArchiveProject(ctx context.Context, req *pb.ArchiveProjectRequest) (*pb.ArchiveProjectResponse, error) {
+	projSpec, err := sv.projectRepoFactory.New().GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	obs := &deletionObserver{}
+	restorableUntil, err := sv.archiveManager.ArchiveProject(ctx, projSpec, job.ArchiveOptions{
+		Force:         req.GetForce(),
+		DropResources: req.GetDropResources(),
+	}, obs)
+	if err != nil {
+		return nil, archiveStatus(err, "failed to archive project %s", projSpec.Name)
+	}
+
+	return &pb.ArchiveProjectResponse{
+		Success:          true,
+		Message:          fmt.Sprintf("project %s archived", projSpec.Name),
+		DeletedJobs:      obs.jobNames(),
+		DeletedResources: obs.resourceNames(),
+		RestorableUntil:  timestamppb.New(restorableUntil),
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) RestoreProject(ctx context.Context, req *pb.RestoreProjectRequest) (*pb.RestoreProjectResponse, error) {
+	deployments, err := sv.archiveManager.RestoreProject(ctx, req.GetProjectName())
+	if err != nil {
+		return nil, archiveStatus(err, "failed to restore project %s", req.GetProjectName())
+	}
+
+	var deploymentIDs []string
+	for _, deployment := range deployments {
+		deploymentIDs = append(deploymentIDs, deployment.ID.String())
+	}
+	message := fmt.Sprintf("project %s restored", req.GetProjectName())
+	if len(deploymentIDs) > 0 {
+		message += fmt.Sprintf(", its jobs are deployed with deployments %s", strings.Join(deploymentIDs, ", "))
+	}
+	return &pb.RestoreProjectResponse{
+		Success: true,
+		Message: message,
+	}, nil
+}
+
+// archiveStatus converts errors of archive manager to grpc status
+func archiveStatus(err error, format string, args ...interface{}) error {
+	code := codes.Internal
+	switch {
+	case errors.Is(err, job.ErrNotArchived):
+		code = codes.NotFound
+	case errors.Is(err, job.ErrArchiveExpired), errors.Is(err, job.ErrArchiveRetained), errors.Is(err, job.ErrJobsInUse):
+		code = codes.FailedPrecondition
+	case errors.Is(err, job.ErrDeployQueueFull), errors.Is(err, job.ErrDeployManagerClosed):
+		code = codes.Unavailable
+	}
+	return status.Errorf(code, "%s: %s", err.Error(), fmt.Sprintf(format, args...))
+}
+
+// deletionObserver collects names of jobs and resources deleted while
+// archiving
+type deletionObserver struct {
+	mu        sync.Mutex
+	jobs      []string
+	resources []string
+}
+
+func (obs *deletionObserver) Notify(e progress.Event) {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	switch evt := e.(type) {
+	case *job.EventJobRemoteDelete:
+		obs.jobs = append(obs.jobs, evt.Name)
+	case *datastore.EventResourceDeleted:
+		if !evt.Skipped && evt.Err == nil {
+			obs.resources = append(obs.resources, evt.Spec.Name)
+		}
+	}
+}
+
+func (obs *deletionObserver) jobNames() []string {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	sort.Strings(obs.jobs)
+	return obs.jobs
+}
+
+func (obs *deletionObserver) resourceNames() []string {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	sort.Strings(obs.resources)
+	return obs.resources
+}
@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"encoding/base64"
+	"strings"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// parsePage reads page size and token of a list request, page limit is one
+// more than page size to tell whether a next page exists
+func parsePage(pageSize int32, pageToken string) (models.Page, int, error) {
+	size := int(pageSize)
+	switch {
+	case size < 0:
+		return models.Page{}, 0, errors.Errorf("page size %d can't be negative", pageSize)
+	case size == 0:
+		size = models.PageDefaultSize
+	case size > models.PageMaxSize:
+		size = models.PageMaxSize
+	}
+
+	page := models.Page{Limit: size + 1}
+	if pageToken != "" {
+		after, err := base64.RawURLEncoding.DecodeString(pageToken)
+		if err != nil || len(after) == 0 {
+			return models.Page{}, 0, errors.Errorf("invalid page token %s", pageToken)
+		}
+		page.After = string(after)
+	}
+	return page, size, nil
+}
+
+// nextPageToken returns the token listing items after the named one
+func nextPageToken(lastName string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(lastName))
+}
+
+// parseLabelSelector reads labels from comma separated key=value pairs
+func parseLabelSelector(selector string) (map[string]string, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+	labels := map[string]string{}
+	for _, pair := range strings.Split(selector, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return nil, errors.Errorf("invalid label selector %q, expected key=value", pair)
+		}
+		labels[key] = strings.TrimSpace(parts[1])
+	}
+	return labels, nil
+}
+
+// validateReadMask checks that mask only selects top level fields of msg
+func validateReadMask(mask *fieldmaskpb.FieldMask, msg proto.Message) error {
+	for _, path := range mask.GetPaths() {
+		if strings.Contains(path, ".") {
+			return errors.Errorf("only top level fields can be selected, found %s", path)
+		}
+	}
+	if !mask.IsValid(msg) {
+		return errors.Errorf("unknown fields in %s", strings.Join(mask.GetPaths(), ","))
+	}
+	return nil
+}
+
+// applyReadMask clears fields of msg not selected by mask, nothing is
+// cleared if mask is empty
+func applyReadMask(mask *fieldmaskpb.FieldMask, msg proto.Message) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+	selected := map[string]bool{}
+	for _, path := range mask.GetPaths() {
+		selected[path] = true
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if field := fields.Get(i); !selected[string(field.Name())] {
+			m.Clear(field)
+		}
+	}
+}
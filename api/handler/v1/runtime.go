@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -16,6 +17,7 @@ import (
 	"github.com/odpf/optimus/datastore"
 
 	"github.com/golang/protobuf/ptypes"
+	"github.com/google/uuid"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
 	"github.com/odpf/optimus/core/logger"
 	log "github.com/odpf/optimus/core/logger"
@@ -63,6 +65,13 @@ type ProtoAdapter interface {
 	ToResourceProto(res models.ResourceSpec) (*pb.ResourceSpecification, error)
 
 	ToReplayExecutionTreeNode(res *tree.TreeNode) (*pb.ReplayExecutionTreeNode, error)
+
+	ToJobGraphProto(graph models.JobGraph) *pb.GetJobGraphResponse
+	ToJobDeploymentPlanProto(plan models.JobDeploymentPlan) *pb.PlanDeploymentResponse
+	ToResourceDriftProto(drift models.ResourceDrift) *pb.ResourceDrift
+	ToResourcePlanProto(plan models.ResourcePlan) *pb.ResourcePlan
+	ToAuditEventProto(event models.AuditEvent) *pb.AuditEvent
+	ToDeploymentProto(deployment models.Deployment) *pb.Deployment
 }
 
 type RuntimeServiceServer struct {
@@ -76,9 +85,11 @@ type RuntimeServiceServer struct {
 	secretRepoFactory    SecretRepoFactory
 	instSvc              models.InstanceService
 	scheduler            models.SchedulerUnit
+	auditEventRepo       store.AuditEventRepository
+	deployManager        job.DeployManager
+	archiveManager       job.ArchiveManager
 
 	progressObserver progress.Observer
-	Now              func() time.Time
 
 	pb.UnimplementedRuntimeServiceServer
 }
@@ -92,8 +103,6 @@ func (sv *RuntimeServiceServer) Version(ctx context.Context, version *pb.Version
 }
 
 func (sv *RuntimeServiceServer) DeployJobSpecification(req *pb.DeployJobSpecificationRequest, respStream pb.RuntimeService_DeployJobSpecificationServer) error {
-	startTime := time.Now()
-
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(req.GetProjectName())
 	if err != nil {
@@ -106,41 +115,75 @@ func (sv *RuntimeServiceServer) DeployJobSpecification(req *pb.DeployJobSpecific
 		return status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
 	}
 
-	var jobsToKeep []models.JobSpec
+	var jobSpecs []models.JobSpec
 	for _, reqJob := range req.GetJobs() {
 		adaptJob, err := sv.adapter.FromJobProto(reqJob)
 		if err != nil {
 			return status.Errorf(codes.Internal, "%s: cannot adapt job %s", err.Error(), reqJob.GetName())
 		}
-
-		err = sv.jobSvc.Create(namespaceSpec, adaptJob)
-		if err != nil {
-			return status.Errorf(codes.Internal, "%s: failed to save %s", err.Error(), adaptJob.Name)
-		}
-		jobsToKeep = append(jobsToKeep, adaptJob)
+		jobSpecs = append(jobSpecs, adaptJob)
 	}
 
+	// deployment is carried out by the server even if client goes away,
+	// progress is streamed till then
+	streamObserver := &jobSyncObserver{
+		stream:   respStream,
+		log:      logrus.New(),
+		finished: make(chan models.Deployment, 1),
+	}
+	defer streamObserver.detach()
 	observers := new(progress.ObserverChain)
 	observers.Join(sv.progressObserver)
-	observers.Join(&jobSyncObserver{
-		stream: respStream,
-		log:    logrus.New(),
-	})
-
-	// delete specs not sent for deployment from internal repository
-	if err := sv.jobSvc.KeepOnly(namespaceSpec, jobsToKeep, observers); err != nil {
-		return status.Errorf(codes.Internal, "%s: failed to delete jobs", err.Error())
+	if !req.GetDetach() {
+		observers.Join(streamObserver)
 	}
 
-	if err := sv.jobSvc.Sync(respStream.Context(), namespaceSpec, observers); err != nil {
-		return status.Errorf(codes.Internal, "%s\nfailed to sync jobs", err.Error())
+	deployment, err := sv.deployManager.Deploy(respStream.Context(), namespaceSpec, jobSpecs, observers)
+	if err != nil {
+		if errors.Is(err, job.ErrDeployQueueFull) || errors.Is(err, job.ErrDeployManagerClosed) {
+			return status.Errorf(codes.Unavailable, "%s: failed to queue deployment", err.Error())
+		}
+		return status.Errorf(codes.Internal, "%s: failed to queue deployment", err.Error())
+	}
+	err = streamObserver.start(&pb.DeployJobSpecificationResponse{
+		DeploymentId: deployment.ID.String(),
+		Message:      fmt.Sprintf("queued deployment %s", deployment.ID),
+	})
+	if err != nil || req.GetDetach() {
+		return err
 	}
 
-	logger.I("finished job deployment in", time.Since(startTime))
+	select {
+	case deployment = <-streamObserver.finished:
+	case <-respStream.Context().Done():
+		return status.FromContextError(respStream.Context().Err()).Err()
+	}
+	if err := respStream.Send(&pb.DeployJobSpecificationResponse{
+		Success:    deployment.Status == models.DeploymentStatusSucceeded,
+		Message:    deployment.Message,
+		Deployment: sv.adapter.ToDeploymentProto(deployment),
+	}); err != nil {
+		return err
+	}
+	if deployment.Status == models.DeploymentStatusFailed {
+		return status.Errorf(codes.Internal, "%s\nfailed to deploy jobs", deployment.Message)
+	}
 	return nil
 }
 
 func (sv *RuntimeServiceServer) ListJobSpecification(ctx context.Context, req *pb.ListJobSpecificationRequest) (*pb.ListJobSpecificationResponse, error) {
+	page, pageSize, err := parsePage(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	labels, err := parseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateReadMask(req.GetReadMask(), &pb.JobSpecification{}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(req.GetProjectName())
 	if err != nil {
@@ -153,22 +196,35 @@ func (sv *RuntimeServiceServer) ListJobSpecification(ctx context.Context, req *p
 		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
 	}
 
-	jobSpecs, err := sv.jobSvc.GetAll(namespaceSpec)
+	jobSpecs, err := sv.jobSvc.GetByFilter(namespaceSpec, models.JobSpecFilter{
+		NamePrefix:  req.GetNamePrefix(),
+		Owner:       req.GetOwner(),
+		TaskName:    req.GetTaskName(),
+		Destination: req.GetDestination(),
+		Labels:      labels,
+		Fields:      req.GetReadMask().GetPaths(),
+		Page:        page,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to retrieve jobs for project %s", err.Error(), req.GetProjectName())
 	}
 
-	jobProtos := []*pb.JobSpecification{}
+	response := &pb.ListJobSpecificationResponse{
+		Jobs: []*pb.JobSpecification{},
+	}
+	if len(jobSpecs) > pageSize {
+		jobSpecs = jobSpecs[:pageSize]
+		response.NextPageToken = nextPageToken(jobSpecs[pageSize-1].Name)
+	}
 	for _, jobSpec := range jobSpecs {
 		jobProto, err := sv.adapter.ToJobProto(jobSpec)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "%s: failed to parse job spec %s", err.Error(), jobSpec.Name)
 		}
-		jobProtos = append(jobProtos, jobProto)
+		applyReadMask(req.GetReadMask(), jobProto)
+		response.Jobs = append(response.Jobs, jobProto)
 	}
-	return &pb.ListJobSpecificationResponse{
-		Jobs: jobProtos,
-	}, nil
+	return response, nil
 }
 
 func (sv *RuntimeServiceServer) DumpJobSpecification(ctx context.Context, req *pb.DumpJobSpecificationRequest) (*pb.DumpJobSpecificationResponse, error) {
@@ -189,12 +245,17 @@ func (sv *RuntimeServiceServer) DumpJobSpecification(ctx context.Context, req *p
 		return nil, status.Errorf(codes.Internal, "%s: job %s not found", err.Error(), req.GetJobName())
 	}
 
-	compiledJob, err := sv.jobSvc.Dump(namespaceSpec, reqJobSpec)
+	compiledJob, err := sv.jobSvc.Dump(ctx, namespaceSpec, reqJobSpec)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to compile %s", err.Error(), reqJobSpec.Name)
 	}
 
-	return &pb.DumpJobSpecificationResponse{Success: true, Content: string(compiledJob.Contents)}, nil
+	return &pb.DumpJobSpecificationResponse{
+		Success:        true,
+		Content:        string(compiledJob.Contents),
+		PriorityWeight: int32(compiledJob.Priority),
+		PriorityReason: compiledJob.PriorityReason,
+	}, nil
 }
 
 func (sv *RuntimeServiceServer) CheckJobSpecification(ctx context.Context, req *pb.CheckJobSpecificationRequest) (*pb.CheckJobSpecificationResponse, error) {
@@ -216,7 +277,7 @@ func (sv *RuntimeServiceServer) CheckJobSpecification(ctx context.Context, req *
 	}
 	reqJobs := []models.JobSpec{j}
 
-	if err = sv.jobSvc.Check(namespaceSpec, reqJobs, nil); err != nil {
+	if err = sv.jobSvc.Check(ctx, namespaceSpec, reqJobs, nil); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to compile jobs\n%s", err.Error())
 	}
 	return &pb.CheckJobSpecificationResponse{Success: true}, nil
@@ -251,7 +312,7 @@ func (sv *RuntimeServiceServer) CheckJobSpecifications(req *pb.CheckJobSpecifica
 		reqJobs = append(reqJobs, j)
 	}
 
-	if err = sv.jobSvc.Check(namespaceSpec, reqJobs, observers); err != nil {
+	if err = sv.jobSvc.Check(respStream.Context(), namespaceSpec, reqJobs, observers); err != nil {
 		return status.Errorf(codes.Internal, "failed to compile jobs\n%s", err.Error())
 	}
 	return nil
@@ -261,6 +322,9 @@ func (sv *RuntimeServiceServer) RegisterProject(ctx context.Context, req *pb.Reg
 	projectRepo := sv.projectRepoFactory.New()
 	projectSpec := sv.adapter.FromProjectProto(req.GetProject())
 
+	if err := sv.archiveManager.PurgeExpiredProject(projectSpec.Name); err != nil {
+		return nil, archiveStatus(err, "failed to save project %s", projectSpec.Name)
+	}
 	if err := projectRepo.Save(projectSpec); err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to save project %s", err.Error(), req.GetProject().GetName())
 	}
@@ -274,6 +338,9 @@ func (sv *RuntimeServiceServer) RegisterProject(ctx context.Context, req *pb.Reg
 
 		namespaceRepo := sv.namespaceRepoFactory.New(savedProjectSpec)
 		namespaceSpec := sv.adapter.FromNamespaceProto(req.GetNamespace())
+		if err = sv.archiveManager.PurgeExpiredNamespace(savedProjectSpec, namespaceSpec.Name); err != nil {
+			return nil, archiveStatus(err, "failed to save namespace %s", namespaceSpec.Name)
+		}
 		if err = namespaceRepo.Save(namespaceSpec); err != nil {
 			return nil, status.Errorf(codes.Internal, "%s: failed to save project %s with namespace %s",
 				err.Error(), req.GetProject().GetName(), req.GetNamespace().GetName())
@@ -295,6 +362,9 @@ func (sv *RuntimeServiceServer) RegisterProjectNamespace(ctx context.Context, re
 
 	namespaceSpec := sv.adapter.FromNamespaceProto(req.GetNamespace())
 	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	if err = sv.archiveManager.PurgeExpiredNamespace(projSpec, namespaceSpec.Name); err != nil {
+		return nil, archiveStatus(err, "failed to save namespace %s", namespaceSpec.Name)
+	}
 	if err = namespaceRepo.Save(namespaceSpec); err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to save namespace %s for project %s", err.Error(), namespaceSpec.Name, projSpec.Name)
 	}
@@ -324,7 +394,7 @@ func (sv *RuntimeServiceServer) CreateJobSpecification(ctx context.Context, req
 	}
 
 	// validate job spec
-	if err = sv.jobSvc.Check(namespaceSpec, []models.JobSpec{jobSpec}, sv.progressObserver); err != nil {
+	if err = sv.jobSvc.Check(ctx, namespaceSpec, []models.JobSpec{jobSpec}, sv.progressObserver); err != nil {
 		return nil, status.Errorf(codes.Internal, "spec validation failed\n%s", err.Error())
 	}
 
@@ -400,23 +470,39 @@ func (sv *RuntimeServiceServer) DeleteJobSpecification(ctx context.Context, req
 }
 
 func (sv *RuntimeServiceServer) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	page, pageSize, err := parsePage(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	projectRepo := sv.projectRepoFactory.New()
-	projects, err := projectRepo.GetAll()
+	projects, err := projectRepo.GetByFilter(models.NameFilter{
+		NamePrefix: req.GetNamePrefix(),
+		Page:       page,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "%s: failed to retrieve saved projects", err.Error())
 	}
 
-	projSpecsProto := []*pb.ProjectSpecification{}
+	response := &pb.ListProjectsResponse{
+		Projects: []*pb.ProjectSpecification{},
+	}
+	if len(projects) > pageSize {
+		projects = projects[:pageSize]
+		response.NextPageToken = nextPageToken(projects[pageSize-1].Name)
+	}
 	for _, project := range projects {
-		projSpecsProto = append(projSpecsProto, sv.adapter.ToProjectProto(project))
+		response.Projects = append(response.Projects, sv.adapter.ToProjectProto(project))
 	}
-
-	return &pb.ListProjectsResponse{
-		Projects: projSpecsProto,
-	}, nil
+	return response, nil
 }
 
 func (sv *RuntimeServiceServer) ListProjectNamespaces(ctx context.Context, req *pb.ListProjectNamespacesRequest) (*pb.ListProjectNamespacesResponse, error) {
+	page, pageSize, err := parsePage(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(req.GetProjectName())
 	if err != nil {
@@ -424,19 +510,25 @@ func (sv *RuntimeServiceServer) ListProjectNamespaces(ctx context.Context, req *
 	}
 
 	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
-	namespaceSpecs, err := namespaceRepo.GetAll()
+	namespaceSpecs, err := namespaceRepo.GetByFilter(models.NameFilter{
+		NamePrefix: req.GetNamePrefix(),
+		Page:       page,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: error while fetching namespaces", err.Error())
 	}
 
-	namespaceSpecsProto := []*pb.NamespaceSpecification{}
+	response := &pb.ListProjectNamespacesResponse{
+		Namespaces: []*pb.NamespaceSpecification{},
+	}
+	if len(namespaceSpecs) > pageSize {
+		namespaceSpecs = namespaceSpecs[:pageSize]
+		response.NextPageToken = nextPageToken(namespaceSpecs[pageSize-1].Name)
+	}
 	for _, namespace := range namespaceSpecs {
-		namespaceSpecsProto = append(namespaceSpecsProto, sv.adapter.ToNamespaceProto(namespace))
+		response.Namespaces = append(response.Namespaces, sv.adapter.ToNamespaceProto(namespace))
 	}
-
-	return &pb.ListProjectNamespacesResponse{
-		Namespaces: namespaceSpecsProto,
-	}, nil
+	return response, nil
 }
 
 func (sv *RuntimeServiceServer) RegisterInstance(ctx context.Context, req *pb.RegisterInstanceRequest) (*pb.RegisterInstanceResponse, error) {
@@ -464,11 +556,11 @@ func (sv *RuntimeServiceServer) RegisterInstance(ctx context.Context, req *pb.Re
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "%s: instance type %s not found", err.Error(), req.InstanceType.String())
 	}
-	instance, err := sv.instSvc.Register(jobSpec, jobScheduledTime, instanceType)
+	instance, err := sv.instSvc.Register(ctx, jobSpec, jobScheduledTime, instanceType)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to register instance of job %s", err.Error(), req.GetJobName())
 	}
-	envMap, fileMap, err := sv.instSvc.Compile(namespaceSpec, jobSpec, instance, instanceType, req.InstanceName)
+	envMap, fileMap, err := sv.instSvc.Compile(ctx, namespaceSpec, jobSpec, instance, instanceType, req.InstanceName)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to compile instance of job %s", err.Error(), req.GetJobName())
 	}
@@ -655,7 +747,11 @@ func (sv *RuntimeServiceServer) UpdateResource(ctx context.Context, req *pb.Upda
 		return nil, status.Errorf(codes.Internal, "%s: failed to parse resource %s", err.Error(), req.Resource.GetName())
 	}
 
-	if err := sv.resourceSvc.UpdateResource(ctx, namespaceSpec, []models.ResourceSpec{optResource}, sv.progressObserver); err != nil {
+	if err := sv.resourceSvc.UpdateResource(ctx, namespaceSpec, []models.ResourceSpec{optResource}, req.GetAllowBreakingChanges(),
+		sv.progressObserver); err != nil {
+		if errors.Is(err, models.ErrBreakingSchemaChange) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s: failed to update resource %s", err.Error(), req.Resource.GetName())
+		}
 		return nil, status.Errorf(codes.Internal, "%s: failed to create resource %s", err.Error(), req.Resource.GetName())
 	}
 	return &pb.UpdateResourceResponse{
@@ -692,6 +788,60 @@ func (sv *RuntimeServiceServer) ReadResource(ctx context.Context, req *pb.ReadRe
 	}, nil
 }
 
+func (sv *RuntimeServiceServer) DeleteResource(ctx context.Context, req *pb.DeleteResourceRequest) (*pb.DeleteResourceResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	if err := sv.resourceSvc.DeleteResource(ctx, namespaceSpec, req.GetDatastoreName(), req.GetResourceName()); err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: resource %s not found", err.Error(), req.GetResourceName())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to delete resource %s", err.Error(), req.GetResourceName())
+	}
+	return &pb.DeleteResourceResponse{
+		Success: true,
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) DetectResourceDrift(ctx context.Context, req *pb.DetectResourceDriftRequest) (*pb.DetectResourceDriftResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	drifts, err := sv.resourceSvc.DetectDrift(ctx, namespaceSpec, req.GetDatastoreName(), req.GetResourceNames())
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: failed to detect drift", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to detect drift", err.Error())
+	}
+
+	var resources []*pb.ResourceDrift
+	for _, drift := range drifts {
+		resources = append(resources, sv.adapter.ToResourceDriftProto(drift))
+	}
+	return &pb.DetectResourceDriftResponse{
+		Resources: resources,
+	}, nil
+}
+
 func (sv *RuntimeServiceServer) DeployResourceSpecification(req *pb.DeployResourceSpecificationRequest, respStream pb.RuntimeService_DeployResourceSpecificationServer) error {
 	startTime := time.Now()
 
@@ -716,6 +866,28 @@ func (sv *RuntimeServiceServer) DeployResourceSpecification(req *pb.DeployResour
 		resourceSpecs = append(resourceSpecs, adapted)
 	}
 
+	if req.GetPlan() {
+		plans, err := sv.resourceSvc.PlanResource(respStream.Context(), namespaceSpec, req.GetDatastoreName(), resourceSpecs,
+			req.GetPrune())
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to plan resources:\n%s", err.Error())
+		}
+		for _, plan := range plans {
+			resp := &pb.DeployResourceSpecificationResponse{
+				Success:      plan.Err == nil,
+				Ack:          true,
+				ResourceName: plan.Spec.Name,
+				Message:      string(plan.Action),
+				Plan:         sv.adapter.ToResourcePlanProto(plan),
+			}
+			if err := respStream.Send(resp); err != nil {
+				return status.Errorf(codes.Internal, "%s: failed to send plan of %s", err.Error(), plan.Spec.Name)
+			}
+		}
+		logger.I("finished resource plan in", time.Since(startTime))
+		return nil
+	}
+
 	observers := new(progress.ObserverChain)
 	observers.Join(sv.progressObserver)
 	observers.Join(&resourceObserver{
@@ -723,14 +895,33 @@ func (sv *RuntimeServiceServer) DeployResourceSpecification(req *pb.DeployResour
 		log:    logrus.New(),
 	})
 
-	if err := sv.resourceSvc.UpdateResource(respStream.Context(), namespaceSpec, resourceSpecs, observers); err != nil {
+	if err := sv.resourceSvc.UpdateResource(respStream.Context(), namespaceSpec, resourceSpecs, req.GetAllowBreakingChanges(),
+		observers); err != nil {
 		return status.Errorf(codes.Internal, "failed to update resources:\n%s", err.Error())
 	}
+	if req.GetPrune() {
+		if err := sv.resourceSvc.KeepOnly(respStream.Context(), namespaceSpec, req.GetDatastoreName(), resourceSpecs,
+			req.GetPruneConfirmed(), observers); err != nil {
+			return status.Errorf(codes.Internal, "failed to prune resources:\n%s", err.Error())
+		}
+	}
 	logger.I("finished resource deployment in", time.Since(startTime))
 	return nil
 }
 
 func (sv *RuntimeServiceServer) ListResourceSpecification(ctx context.Context, req *pb.ListResourceSpecificationRequest) (*pb.ListResourceSpecificationResponse, error) {
+	page, pageSize, err := parsePage(req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	labels, err := parseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validateReadMask(req.GetReadMask(), &pb.ResourceSpecification{}); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(req.GetProjectName())
 	if err != nil {
@@ -743,22 +934,33 @@ func (sv *RuntimeServiceServer) ListResourceSpecification(ctx context.Context, r
 		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
 	}
 
-	resourceSpecs, err := sv.resourceSvc.GetAll(namespaceSpec, req.DatastoreName)
+	resourceSpecs, err := sv.resourceSvc.GetByFilter(namespaceSpec, req.GetDatastoreName(), models.ResourceSpecFilter{
+		NamePrefix: req.GetNamePrefix(),
+		Type:       models.ResourceType(req.GetType()),
+		Labels:     labels,
+		Fields:     req.GetReadMask().GetPaths(),
+		Page:       page,
+	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "%s: failed to retrieve jobs for project %s", err.Error(), req.GetProjectName())
 	}
 
-	resourceProtos := []*pb.ResourceSpecification{}
+	response := &pb.ListResourceSpecificationResponse{
+		Resources: []*pb.ResourceSpecification{},
+	}
+	if len(resourceSpecs) > pageSize {
+		resourceSpecs = resourceSpecs[:pageSize]
+		response.NextPageToken = nextPageToken(resourceSpecs[pageSize-1].Name)
+	}
 	for _, resourceSpec := range resourceSpecs {
 		resourceProto, err := sv.adapter.ToResourceProto(resourceSpec)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "%s: failed to parse job spec %s", err.Error(), resourceSpec.Name)
 		}
-		resourceProtos = append(resourceProtos, resourceProto)
+		applyReadMask(req.GetReadMask(), resourceProto)
+		response.Resources = append(response.Resources, resourceProto)
 	}
-	return &pb.ListResourceSpecificationResponse{
-		Resources: resourceProtos,
-	}, nil
+	return response, nil
 }
 
 func (sv *RuntimeServiceServer) ReplayDryRun(ctx context.Context, req *pb.ReplayRequest) (*pb.ReplayDryRunResponse, error) {
@@ -767,7 +969,7 @@ func (sv *RuntimeServiceServer) ReplayDryRun(ctx context.Context, req *pb.Replay
 		return nil, err
 	}
 
-	rootNode, err := sv.jobSvc.ReplayDryRun(replayWorkerRequest)
+	rootNode, err := sv.jobSvc.ReplayDryRun(ctx, replayWorkerRequest)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "error while processing replay dry run: %v", err)
 	}
@@ -803,6 +1005,64 @@ func (sv *RuntimeServiceServer) Replay(ctx context.Context, req *pb.ReplayReques
 	}, nil
 }
 
+func (sv *RuntimeServiceServer) GetJobGraph(ctx context.Context, req *pb.GetJobGraphRequest) (*pb.GetJobGraphResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	// downstream consumers can be in any of the registered projects
+	projects, err := projectRepo.GetAll()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to retrieve projects", err.Error())
+	}
+
+	graph, err := sv.jobSvc.GetJobGraph(ctx, projSpec, projects, req.GetJobName(), int(req.GetDepth()))
+	if err != nil {
+		if errors.Is(err, job.ErrJobSpecNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: job %s not found", err.Error(), req.GetJobName())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to build job graph for project %s", err.Error(), req.GetProjectName())
+	}
+	return sv.adapter.ToJobGraphProto(graph), nil
+}
+
+func (sv *RuntimeServiceServer) PlanDeployment(ctx context.Context, req *pb.PlanDeploymentRequest) (*pb.PlanDeploymentResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+	namespaceSpec, err := namespaceRepo.GetByName(req.GetNamespace())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+	}
+
+	reqJobs := []models.JobSpec{}
+	for _, jobProto := range req.GetJobs() {
+		j, err := sv.adapter.FromJobProto(jobProto)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to adapt job %s\n%s", jobProto.Name, err.Error())
+		}
+		reqJobs = append(reqJobs, j)
+	}
+
+	// downstream consumers can be in any of the registered projects
+	projects, err := projectRepo.GetAll()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to retrieve projects", err.Error())
+	}
+
+	plan, err := sv.jobSvc.PlanDeployment(ctx, namespaceSpec, reqJobs, projects)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to plan deployment for namespace %s", err.Error(), req.GetNamespace())
+	}
+	return sv.adapter.ToJobDeploymentPlanProto(plan), nil
+}
+
 func (sv *RuntimeServiceServer) parseReplayRequest(req *pb.ReplayRequest) (*models.ReplayWorkerRequest, error) {
 	projectRepo := sv.projectRepoFactory.New()
 	projSpec, err := projectRepo.GetByName(req.GetProjectName())
@@ -846,6 +1106,92 @@ func (sv *RuntimeServiceServer) parseReplayRequest(req *pb.ReplayRequest) (*mode
 	return &replayRequest, nil
 }
 
+func (sv *RuntimeServiceServer) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	if req.GetProjectName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project name is required")
+	}
+	filter := models.AuditFilter{
+		ProjectName:   req.GetProjectName(),
+		NamespaceName: req.GetNamespace(),
+		Actor:         req.GetActor(),
+		Operation:     req.GetOperation(),
+		Limit:         int(req.GetLimit()),
+	}
+	if req.GetSince() != nil {
+		filter.Since = req.GetSince().AsTime()
+	}
+	if req.GetUntil() != nil {
+		filter.Until = req.GetUntil().AsTime()
+	}
+
+	events, err := sv.auditEventRepo.GetAll(filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list audit events of project %s", err.Error(), req.GetProjectName())
+	}
+	response := &pb.ListAuditEventsResponse{}
+	for _, event := range events {
+		response.Events = append(response.Events, sv.adapter.ToAuditEventProto(event))
+	}
+	return response, nil
+}
+
+func (sv *RuntimeServiceServer) GetDeployment(ctx context.Context, req *pb.GetDeploymentRequest) (*pb.GetDeploymentResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	deploymentID, err := uuid.Parse(req.GetDeploymentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: invalid deployment id %s", err.Error(), req.GetDeploymentId())
+	}
+	deployment, err := sv.deployManager.GetDeployment(ctx, deploymentID)
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s: deployment %s not found", err.Error(), req.GetDeploymentId())
+		}
+		return nil, status.Errorf(codes.Internal, "%s: failed to get deployment %s", err.Error(), req.GetDeploymentId())
+	}
+	if deployment.Namespace.ProjectSpec.ID != projSpec.ID {
+		return nil, status.Errorf(codes.NotFound, "deployment %s not found in project %s", req.GetDeploymentId(), req.GetProjectName())
+	}
+	return &pb.GetDeploymentResponse{
+		Deployment: sv.adapter.ToDeploymentProto(deployment),
+	}, nil
+}
+
+func (sv *RuntimeServiceServer) ListDeployments(ctx context.Context, req *pb.ListDeploymentsRequest) (*pb.ListDeploymentsResponse, error) {
+	projectRepo := sv.projectRepoFactory.New()
+	projSpec, err := projectRepo.GetByName(req.GetProjectName())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "%s: project %s not found", err.Error(), req.GetProjectName())
+	}
+
+	filter := models.DeploymentFilter{
+		ProjectID: projSpec.ID,
+		Limit:     int(req.GetLimit()),
+	}
+	if req.GetNamespace() != "" {
+		namespaceRepo := sv.namespaceRepoFactory.New(projSpec)
+		namespaceSpec, err := namespaceRepo.GetByName(req.GetNamespace())
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "%s: namespace %s not found", err.Error(), req.GetNamespace())
+		}
+		filter.NamespaceID = namespaceSpec.ID
+	}
+
+	deployments, err := sv.deployManager.GetDeployments(ctx, filter)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "%s: failed to list deployments of project %s", err.Error(), req.GetProjectName())
+	}
+	response := &pb.ListDeploymentsResponse{}
+	for _, deployment := range deployments {
+		response.Deployments = append(response.Deployments, sv.adapter.ToDeploymentProto(deployment))
+	}
+	return response, nil
+}
+
 func NewRuntimeServiceServer(
 	version string,
 	jobSvc models.JobService,
@@ -858,6 +1204,9 @@ func NewRuntimeServiceServer(
 	progressObserver progress.Observer,
 	instSvc models.InstanceService,
 	scheduler models.SchedulerUnit,
+	auditEventRepo store.AuditEventRepository,
+	deployManager job.DeployManager,
+	archiveManager job.ArchiveManager,
 ) *RuntimeServiceServer {
 	return &RuntimeServiceServer{
 		version:              version,
@@ -871,16 +1220,69 @@ func NewRuntimeServiceServer(
 		instSvc:              instSvc,
 		scheduler:            scheduler,
 		secretRepoFactory:    secretRepoFactory,
+		auditEventRepo:       auditEventRepo,
+		deployManager:        deployManager,
+		archiveManager:       archiveManager,
 	}
 }
 
+// jobSyncObserver streams progress of a deployment till it is detached,
+// deployment outlives the stream if client goes away
 type jobSyncObserver struct {
 	stream pb.RuntimeService_DeployJobSpecificationServer
 	log    logrus.FieldLogger
+
+	// receives deployment once it finishes
+	finished chan models.Deployment
+
+	// serializes sends as jobs are uploaded concurrently
+	mu       sync.Mutex
+	detached bool
+
+	// events notified before deployment id is sent
+	started bool
+	pending []progress.Event
+}
+
+// start sends resp ahead of any progress of the deployment
+func (obs *jobSyncObserver) start(resp *pb.DeployJobSpecificationResponse) error {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	if err := obs.stream.Send(resp); err != nil {
+		return err
+	}
+	obs.started = true
+	for _, e := range obs.pending {
+		obs.send(e)
+	}
+	obs.pending = nil
+	return nil
+}
+
+// detach stops streaming, stream can't be used once its handler returns
+func (obs *jobSyncObserver) detach() {
+	obs.mu.Lock()
+	obs.detached = true
+	obs.mu.Unlock()
 }
 
 func (obs *jobSyncObserver) Notify(e progress.Event) {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	if obs.detached {
+		return
+	}
+	if !obs.started {
+		obs.pending = append(obs.pending, e)
+		return
+	}
+	obs.send(e)
+}
+
+func (obs *jobSyncObserver) send(e progress.Event) {
 	switch evt := e.(type) {
+	case *job.EventDeploymentFinish:
+		obs.finished <- evt.Deployment
 	case *job.EventJobUpload:
 		resp := &pb.DeployJobSpecificationResponse{
 			Success: true,
@@ -935,6 +1337,24 @@ func (obs *resourceObserver) Notify(e progress.Event) {
 		if err := obs.stream.Send(resp); err != nil {
 			obs.log.Error(errors.Wrapf(err, "failed to send deploy spec ack for: %s", evt.Spec.Name))
 		}
+	case *datastore.EventResourceSchemaChanged:
+		resp := &pb.DeployResourceSpecificationResponse{
+			Success:      !evt.Blocked,
+			ResourceName: evt.Spec.Name,
+			Message:      evt.String(),
+		}
+		if err := obs.stream.Send(resp); err != nil {
+			obs.log.Error(errors.Wrapf(err, "failed to send schema changes of: %s", evt.Spec.Name))
+		}
+	case *datastore.EventResourceDeleted:
+		resp := &pb.DeployResourceSpecificationResponse{
+			Success:      evt.Err == nil,
+			ResourceName: evt.Spec.Name,
+			Message:      evt.String(),
+		}
+		if err := obs.stream.Send(resp); err != nil {
+			obs.log.Error(errors.Wrapf(err, "failed to send prune event for: %s", evt.Spec.Name))
+		}
 	}
 }
 
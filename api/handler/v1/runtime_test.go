@@ -11,11 +11,15 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"google.golang.org/protobuf/types/known/structpb"
 
+	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/core/tree"
+	"github.com/odpf/optimus/datastore"
 	"github.com/odpf/optimus/job"
 
 	"github.com/odpf/optimus/instance"
@@ -31,6 +35,7 @@ import (
 
 	"github.com/odpf/optimus/mock"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 )
 
 func TestRuntimeServiceServer(t *testing.T) {
@@ -50,6 +55,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			versionRequest := pb.VersionRequest{Client: Version}
 			resp, err := runtimeServiceServer.Version(context.Background(), &versionRequest)
@@ -152,8 +160,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 			defer jobService.AssertExpectations(t)
 
 			instanceService := new(mock.InstanceService)
-			instanceService.On("Register", jobSpec, scheduledAt, models.InstanceTypeTask).Return(instanceSpec, nil)
-			instanceService.On("Compile", namespaceSpec, jobSpec, instanceSpec, models.InstanceTypeTask, "test").Return(
+			instanceService.On("Register", mock2.Anything, jobSpec, scheduledAt, models.InstanceTypeTask).Return(instanceSpec, nil)
+			instanceService.On("Compile", mock2.Anything, namespaceSpec, jobSpec, instanceSpec, models.InstanceTypeTask, "test").Return(
 				map[string]string{
 					instance.ConfigKeyExecutionTime: mockedTimeNow.Format(models.InstanceScheduledAtTimeLayout),
 					instance.ConfigKeyDstart:        jobSpec.Task.Window.GetStart(scheduledAt).Format(models.InstanceScheduledAtTimeLayout),
@@ -175,6 +183,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				instanceService,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			versionRequest := pb.RegisterInstanceRequest{ProjectName: projectName, JobName: jobName,
@@ -232,6 +243,10 @@ func TestRuntimeServiceServer(t *testing.T) {
 			jobService := new(mock.JobService)
 			defer jobService.AssertExpectations(t)
 
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("PurgeExpiredProject", projectName).Return(nil)
+			defer archiveManager.AssertExpectations(t)
+
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				"someVersion1.0",
 				jobService, nil, nil,
@@ -243,6 +258,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				archiveManager,
 			)
 
 			projectRequest := pb.RegisterProjectRequest{Project: adapter.ToProjectProto(projectSpec)}
@@ -272,6 +290,10 @@ func TestRuntimeServiceServer(t *testing.T) {
 			jobService := new(mock.JobService)
 			defer jobService.AssertExpectations(t)
 
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("PurgeExpiredProject", projectName).Return(nil)
+			defer archiveManager.AssertExpectations(t)
+
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				"someVersion1.0",
 				jobService, nil, nil,
@@ -283,6 +305,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				archiveManager,
 			)
 
 			projectRequest := pb.RegisterProjectRequest{Project: adapter.ToProjectProto(projectSpec)}
@@ -330,6 +355,11 @@ func TestRuntimeServiceServer(t *testing.T) {
 			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
 			defer namespaceRepoFact.AssertExpectations(t)
 
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("PurgeExpiredProject", projectName).Return(nil)
+			archiveManager.On("PurgeExpiredNamespace", projectSpec, namespaceSpec.Name).Return(nil)
+			defer archiveManager.AssertExpectations(t)
+
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				"someVersion1.0",
 				jobSvc,
@@ -341,6 +371,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				archiveManager,
 			)
 
 			projectRequest := pb.RegisterProjectRequest{
@@ -393,6 +426,10 @@ func TestRuntimeServiceServer(t *testing.T) {
 			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
 			defer namespaceRepoFact.AssertExpectations(t)
 
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("PurgeExpiredNamespace", projectSpec, namespaceSpec.Name).Return(nil)
+			defer archiveManager.AssertExpectations(t)
+
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				"someVersion1.0",
 				jobSvc,
@@ -404,6 +441,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				archiveManager,
 			)
 
 			namespaceRequest := pb.RegisterProjectNamespaceRequest{
@@ -417,6 +457,60 @@ func TestRuntimeServiceServer(t *testing.T) {
 				Message: "saved successfully",
 			}, resp)
 		})
+		t.Run("should reject saving a namespace archived within retention period", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				Name: "a-data-project",
+			}
+			namespaceSpec := models.NamespaceSpec{
+				Name:   "dev-test-namespace-1",
+				Config: map[string]string{},
+			}
+
+			adapter := v1.NewAdapter(nil, nil)
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("PurgeExpiredNamespace", projectSpec, namespaceSpec.Name).
+				Return(errors.Wrapf(job.ErrArchiveRetained, "namespace %s", namespaceSpec.Name))
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.RegisterProjectNamespace(context.Background(), &pb.RegisterProjectNamespaceRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   adapter.ToNamespaceProto(namespaceSpec),
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
 		t.Run("should throw error if project does not exist while saving a namespace", func(t *testing.T) {
 			projectName := "a-data-project"
 
@@ -461,6 +555,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			namespaceRequest := pb.RegisterProjectNamespaceRequest{
@@ -473,6 +570,266 @@ func TestRuntimeServiceServer(t *testing.T) {
 		})
 	})
 
+	t.Run("DeleteNamespace", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-test-namespace-1",
+			ProjectSpec: projectSpec,
+		}
+		now := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+
+		setup := func(t *testing.T) (*mock.ProjectRepoFactory, *mock.NamespaceRepoFactory) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectSpec.Name).Return(projectSpec, nil)
+			t.Cleanup(func() { projectRepository.AssertExpectations(t) })
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			t.Cleanup(func() { projectRepoFactory.AssertExpectations(t) })
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			t.Cleanup(func() { namespaceRepository.AssertExpectations(t) })
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			t.Cleanup(func() { namespaceRepoFact.AssertExpectations(t) })
+			return projectRepoFactory, namespaceRepoFact
+		}
+
+		t.Run("should reject deleting a namespace whose jobs are used by other jobs", func(t *testing.T) {
+			projectRepoFactory, namespaceRepoFact := setup(t)
+
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("ArchiveNamespace", context.Background(), namespaceSpec, job.ArchiveOptions{}, mock2.Anything).
+				Return(time.Time{}, errors.Wrap(job.ErrJobsInUse, "a-data-project/job-b depends on a-data-project/job-a"))
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.DeleteNamespace(context.Background(), &pb.DeleteNamespaceRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceSpec.Name,
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Contains(t, err.Error(), "a-data-project/job-b depends on a-data-project/job-a")
+		})
+		t.Run("should archive the namespace and report deleted jobs and resources", func(t *testing.T) {
+			projectRepoFactory, namespaceRepoFact := setup(t)
+
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("ArchiveNamespace", context.Background(), namespaceSpec, job.ArchiveOptions{DropResources: true}, mock2.Anything).
+				Run(func(args mock2.Arguments) {
+					obs := args.Get(3).(progress.Observer)
+					obs.Notify(&job.EventJobRemoteDelete{Name: "job-b"})
+					obs.Notify(&job.EventJobRemoteDelete{Name: "job-a"})
+					obs.Notify(&datastore.EventResourceDeleted{Spec: models.ResourceSpec{Name: "proj.dataset.table"}})
+					obs.Notify(&datastore.EventResourceDeleted{Spec: models.ResourceSpec{Name: "proj.dataset.__keep"}, Skipped: true, Protected: true})
+				}).Return(now.Add(24*time.Hour), nil)
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.DeleteNamespace(context.Background(), &pb.DeleteNamespaceRequest{
+				ProjectName:   projectSpec.Name,
+				Namespace:     namespaceSpec.Name,
+				DropResources: true,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"job-a", "job-b"}, resp.DeletedJobs)
+			assert.Equal(t, []string{"proj.dataset.table"}, resp.DeletedResources)
+			assert.Equal(t, now.Add(24*time.Hour), resp.RestorableUntil.AsTime())
+		})
+	})
+
+	t.Run("RestoreNamespace", func(t *testing.T) {
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "a-data-project",
+		}
+		namespaceName := "dev-test-namespace-1"
+
+		setup := func(t *testing.T) *mock.ProjectRepoFactory {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectSpec.Name).Return(projectSpec, nil)
+			t.Cleanup(func() { projectRepository.AssertExpectations(t) })
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			t.Cleanup(func() { projectRepoFactory.AssertExpectations(t) })
+			return projectRepoFactory
+		}
+
+		t.Run("should restore the namespace and report deployment of its jobs", func(t *testing.T) {
+			deploymentID := uuid.Must(uuid.NewRandom())
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("RestoreNamespace", context.Background(), projectSpec, namespaceName).
+				Return(models.Deployment{ID: deploymentID, Status: models.DeploymentStatusQueued}, nil)
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				setup(t),
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.RestoreNamespace(context.Background(), &pb.RestoreNamespaceRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceName,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+			assert.Contains(t, resp.Message, deploymentID.String())
+		})
+		t.Run("should not restore the namespace once retention period is over", func(t *testing.T) {
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("RestoreNamespace", context.Background(), projectSpec, namespaceName).
+				Return(models.Deployment{}, errors.Wrapf(job.ErrArchiveExpired, "namespace %s", namespaceName))
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				setup(t),
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.RestoreNamespace(context.Background(), &pb.RestoreNamespaceRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceName,
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+		t.Run("should not restore a namespace which isn't archived", func(t *testing.T) {
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("RestoreNamespace", context.Background(), projectSpec, namespaceName).
+				Return(models.Deployment{}, errors.Wrapf(job.ErrNotArchived, "namespace %s", namespaceName))
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				setup(t),
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.RestoreNamespace(context.Background(), &pb.RestoreNamespaceRequest{
+				ProjectName: projectSpec.Name,
+				Namespace:   namespaceName,
+			})
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	})
+
+	t.Run("ArchiveProject", func(t *testing.T) {
+		t.Run("should archive the project", func(t *testing.T) {
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "a-data-project",
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectSpec.Name).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			archiveManager := new(mock.ArchiveManager)
+			archiveManager.On("ArchiveProject", context.Background(), projectSpec, job.ArchiveOptions{Force: true}, mock2.Anything).
+				Return(time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC), nil)
+			defer archiveManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"someVersion1.0",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				archiveManager,
+			)
+
+			resp, err := runtimeServiceServer.ArchiveProject(context.Background(), &pb.ArchiveProjectRequest{
+				ProjectName: projectSpec.Name,
+				Force:       true,
+			})
+			assert.Nil(t, err)
+			assert.True(t, resp.Success)
+			assert.Equal(t, time.Date(2021, 7, 1, 10, 0, 0, 0, time.UTC), resp.RestorableUntil.AsTime())
+		})
+	})
+
 	t.Run("RegisterJobSpecification", func(t *testing.T) {
 		t.Run("should save a job specification", func(t *testing.T) {
 			projectName := "a-data-project"
@@ -542,7 +899,7 @@ func TestRuntimeServiceServer(t *testing.T) {
 
 			jobSvc := new(mock.JobService)
 			jobSvc.On("Create", jobSpec, namespaceSpec).Return(nil)
-			jobSvc.On("Check", namespaceSpec, []models.JobSpec{jobSpec}, mock2.Anything).Return(nil)
+			jobSvc.On("Check", mock2.Anything, namespaceSpec, []models.JobSpec{jobSpec}, mock2.Anything).Return(nil)
 			jobSvc.On("Sync", mock2.Anything, namespaceSpec, mock2.Anything).Return(nil)
 			defer jobSvc.AssertExpectations(t)
 
@@ -565,6 +922,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			jobProto, _ := adapter.ToJobProto(jobSpec)
@@ -628,6 +988,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.RegisterSecretRequest{
@@ -686,6 +1049,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			secretRequest := pb.RegisterSecretRequest{
@@ -700,29 +1066,28 @@ func TestRuntimeServiceServer(t *testing.T) {
 	})
 
 	t.Run("DeployJobSpecification", func(t *testing.T) {
-		t.Run("should deploy the job", func(t *testing.T) {
-			Version := "1.0.1"
-
-			projectName := "a-data-project"
-			jobName1 := "a-data-job"
-			taskName := "a-data-task"
-
-			projectSpec := models.ProjectSpec{
-				ID:   uuid.Must(uuid.NewRandom()),
-				Name: projectName,
-				Config: map[string]string{
-					"bucket": "gs://some_folder",
-				},
-			}
-
-			namespaceSpec := models.NamespaceSpec{
-				ID:   uuid.Must(uuid.NewRandom()),
-				Name: "dev-test-namespace-1",
-				Config: map[string]string{
-					"bucket": "gs://some_folder",
-				},
-				ProjectSpec: projectSpec,
-			}
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+			Config: map[string]string{
+				"bucket": "gs://some_folder",
+			},
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "dev-test-namespace-1",
+			Config: map[string]string{
+				"bucket": "gs://some_folder",
+			},
+			ProjectSpec: projectSpec,
+		}
+
+		t.Run("should deploy the job", func(t *testing.T) {
+			Version := "1.0.1"
+
+			jobName1 := "a-data-job"
+			taskName := "a-data-task"
 
 			execUnit1 := new(mock.BasePlugin)
 			execUnit1.On("PluginInfo").Return(&models.PluginInfoResponse{
@@ -788,27 +1153,56 @@ func TestRuntimeServiceServer(t *testing.T) {
 			projectJobSpecRepoFactory := new(mock.ProjectJobSpecRepoFactory)
 			defer projectJobSpecRepoFactory.AssertExpectations(t)
 
-			jobService := new(mock.JobService)
-			jobService.On("Create", mock2.Anything, namespaceSpec).Return(nil)
-			jobService.On("KeepOnly", namespaceSpec, mock2.Anything, mock2.Anything).Return(nil)
-			jobService.On("Sync", mock2.Anything, namespaceSpec, mock2.Anything).Return(nil)
-			defer jobService.AssertExpectations(t)
+			deploymentID := uuid.Must(uuid.NewRandom())
+			queued := models.Deployment{
+				ID:        deploymentID,
+				Namespace: namespaceSpec,
+				Status:    models.DeploymentStatusQueued,
+			}
+			finished := queued
+			finished.Status = models.DeploymentStatusSucceeded
+			finished.Jobs = []models.DeploymentJobResult{{Name: jobName1, Success: true}}
+
+			deployManager := new(mock.DeployManager)
+			deployManager.On("Deploy", context.Background(), namespaceSpec, mock2.Anything, mock2.Anything).Run(func(args mock2.Arguments) {
+				// progress notified before deployment is queued is sent after its id
+				observer := args.Get(3).(progress.Observer)
+				observer.Notify(&job.EventJobUpload{Job: jobSpecs[0]})
+				observer.Notify(&job.EventDeploymentFinish{Deployment: finished})
+			}).Return(queued, nil)
+			defer deployManager.AssertExpectations(t)
 
 			grpcRespStream := new(mock.RuntimeService_DeployJobSpecificationServer)
 			grpcRespStream.On("Context").Return(context.Background())
+			grpcRespStream.On("Send", &pb.DeployJobSpecificationResponse{
+				DeploymentId: deploymentID.String(),
+				Message:      fmt.Sprintf("queued deployment %s", deploymentID),
+			}).Return(nil).Once()
+			grpcRespStream.On("Send", &pb.DeployJobSpecificationResponse{
+				Success: true,
+				Ack:     true,
+				JobName: jobName1,
+			}).Return(nil).Once()
+			grpcRespStream.On("Send", &pb.DeployJobSpecificationResponse{
+				Success:    true,
+				Deployment: adapter.ToDeploymentProto(finished),
+			}).Return(nil).Once()
 			defer grpcRespStream.AssertExpectations(t)
 
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				Version,
-				jobService,
+				nil,
 				nil, nil,
 				projectRepoFactory,
 				namespaceRepoFact,
 				nil,
 				adapter,
+				new(progress.ObserverChain),
 				nil,
 				nil,
 				nil,
+				deployManager,
+				nil,
 			)
 
 			jobSpecsAdapted := []*pb.JobSpecification{}
@@ -820,6 +1214,287 @@ func TestRuntimeServiceServer(t *testing.T) {
 			err := runtimeServiceServer.DeployJobSpecification(&deployRequest, grpcRespStream)
 			assert.Nil(t, err)
 		})
+		t.Run("should return error if deployment fails", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			failed := models.Deployment{
+				ID:        uuid.Must(uuid.NewRandom()),
+				Namespace: namespaceSpec,
+				Status:    models.DeploymentStatusFailed,
+				Message:   "failed to sync jobs: scheduler is down",
+			}
+			deployManager := new(mock.DeployManager)
+			deployManager.On("Deploy", context.Background(), namespaceSpec, mock2.Anything, mock2.Anything).Run(func(args mock2.Arguments) {
+				args.Get(3).(progress.Observer).Notify(&job.EventDeploymentFinish{Deployment: failed})
+			}).Return(models.Deployment{ID: failed.ID, Status: models.DeploymentStatusQueued}, nil)
+			defer deployManager.AssertExpectations(t)
+
+			grpcRespStream := new(mock.RuntimeService_DeployJobSpecificationServer)
+			grpcRespStream.On("Context").Return(context.Background())
+			grpcRespStream.On("Send", mock2.Anything).Return(nil).Twice()
+			defer grpcRespStream.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				new(progress.ObserverChain),
+				nil,
+				nil,
+				nil,
+				deployManager,
+				nil,
+			)
+			deployRequest := pb.DeployJobSpecificationRequest{ProjectName: projectName, Namespace: namespaceSpec.Name}
+			err := runtimeServiceServer.DeployJobSpecification(&deployRequest, grpcRespStream)
+			assert.Equal(t, codes.Internal, status.Code(err))
+			assert.Contains(t, err.Error(), "failed to sync jobs: scheduler is down")
+		})
+		t.Run("should only return deployment id if detached", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			deploymentID := uuid.Must(uuid.NewRandom())
+			deployManager := new(mock.DeployManager)
+			deployManager.On("Deploy", context.Background(), namespaceSpec, mock2.Anything, mock2.Anything).
+				Return(models.Deployment{ID: deploymentID, Status: models.DeploymentStatusQueued}, nil)
+			defer deployManager.AssertExpectations(t)
+
+			grpcRespStream := new(mock.RuntimeService_DeployJobSpecificationServer)
+			grpcRespStream.On("Context").Return(context.Background())
+			grpcRespStream.On("Send", &pb.DeployJobSpecificationResponse{
+				DeploymentId: deploymentID.String(),
+				Message:      fmt.Sprintf("queued deployment %s", deploymentID),
+			}).Return(nil).Once()
+			defer grpcRespStream.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				new(progress.ObserverChain),
+				nil,
+				nil,
+				nil,
+				deployManager,
+				nil,
+			)
+			deployRequest := pb.DeployJobSpecificationRequest{ProjectName: projectName, Namespace: namespaceSpec.Name, Detach: true}
+			err := runtimeServiceServer.DeployJobSpecification(&deployRequest, grpcRespStream)
+			assert.Nil(t, err)
+		})
+		t.Run("should return unavailable if deployment can't be queued", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			deployManager := new(mock.DeployManager)
+			deployManager.On("Deploy", context.Background(), namespaceSpec, mock2.Anything, mock2.Anything).
+				Return(models.Deployment{}, job.ErrDeployQueueFull)
+			defer deployManager.AssertExpectations(t)
+
+			grpcRespStream := new(mock.RuntimeService_DeployJobSpecificationServer)
+			grpcRespStream.On("Context").Return(context.Background())
+			defer grpcRespStream.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				new(progress.ObserverChain),
+				nil,
+				nil,
+				nil,
+				deployManager,
+				nil,
+			)
+			deployRequest := pb.DeployJobSpecificationRequest{ProjectName: projectName, Namespace: namespaceSpec.Name}
+			err := runtimeServiceServer.DeployJobSpecification(&deployRequest, grpcRespStream)
+			assert.Equal(t, codes.Unavailable, status.Code(err))
+		})
+	})
+
+	t.Run("GetDeployment", func(t *testing.T) {
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-test-namespace-1",
+			ProjectSpec: projectSpec,
+		}
+		deployment := models.Deployment{
+			ID:        uuid.Must(uuid.NewRandom()),
+			Namespace: namespaceSpec,
+			Status:    models.DeploymentStatusFailed,
+			Message:   "failed to sync jobs",
+			Jobs:      []models.DeploymentJobResult{{Name: "a-data-job", Message: "failed to compile"}},
+		}
+		newServer := func(deployManager *mock.DeployManager) *v1.RuntimeServiceServer {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			return v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				deployManager,
+				nil,
+			)
+		}
+
+		t.Run("should return deployment along with outcome of its jobs", func(t *testing.T) {
+			deployManager := new(mock.DeployManager)
+			deployManager.On("GetDeployment", context.Background(), deployment.ID).Return(deployment, nil)
+			defer deployManager.AssertExpectations(t)
+
+			resp, err := newServer(deployManager).GetDeployment(context.Background(), &pb.GetDeploymentRequest{
+				ProjectName:  projectName,
+				DeploymentId: deployment.ID.String(),
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, deployment.ID.String(), resp.Deployment.Id)
+			assert.Equal(t, namespaceSpec.Name, resp.Deployment.Namespace)
+			assert.Equal(t, models.DeploymentStatusFailed, resp.Deployment.Status)
+			assert.Equal(t, []*pb.DeploymentJobResult{{JobName: "a-data-job", Message: "failed to compile"}}, resp.Deployment.Jobs)
+		})
+		t.Run("should not return deployment of another project", func(t *testing.T) {
+			otherDeployment := deployment
+			otherDeployment.Namespace.ProjectSpec = models.ProjectSpec{ID: uuid.Must(uuid.NewRandom()), Name: "other-project"}
+			deployManager := new(mock.DeployManager)
+			deployManager.On("GetDeployment", context.Background(), deployment.ID).Return(otherDeployment, nil)
+			defer deployManager.AssertExpectations(t)
+
+			_, err := newServer(deployManager).GetDeployment(context.Background(), &pb.GetDeploymentRequest{
+				ProjectName:  projectName,
+				DeploymentId: deployment.ID.String(),
+			})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+		t.Run("should return not found if deployment doesn't exist", func(t *testing.T) {
+			deployManager := new(mock.DeployManager)
+			deployManager.On("GetDeployment", context.Background(), deployment.ID).Return(models.Deployment{}, store.ErrResourceNotFound)
+			defer deployManager.AssertExpectations(t)
+
+			_, err := newServer(deployManager).GetDeployment(context.Background(), &pb.GetDeploymentRequest{
+				ProjectName:  projectName,
+				DeploymentId: deployment.ID.String(),
+			})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+		t.Run("should return invalid argument if deployment id is malformed", func(t *testing.T) {
+			_, err := newServer(new(mock.DeployManager)).GetDeployment(context.Background(), &pb.GetDeploymentRequest{
+				ProjectName:  projectName,
+				DeploymentId: "not-a-uuid",
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+
+	t.Run("ListDeployments", func(t *testing.T) {
+		t.Run("should list deployments of a namespace", func(t *testing.T) {
+			projectName := "a-data-project"
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: projectName,
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "dev-test-namespace-1",
+				ProjectSpec: projectSpec,
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			deployments := []models.Deployment{
+				{ID: uuid.Must(uuid.NewRandom()), Namespace: namespaceSpec, Status: models.DeploymentStatusInProgress},
+				{ID: uuid.Must(uuid.NewRandom()), Namespace: namespaceSpec, Status: models.DeploymentStatusSucceeded},
+			}
+			deployManager := new(mock.DeployManager)
+			deployManager.On("GetDeployments", context.Background(), models.DeploymentFilter{
+				ProjectID:   projectSpec.ID,
+				NamespaceID: namespaceSpec.ID,
+				Limit:       5,
+			}).Return(deployments, nil)
+			defer deployManager.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				deployManager,
+				nil,
+			)
+			resp, err := runtimeServiceServer.ListDeployments(context.Background(), &pb.ListDeploymentsRequest{
+				ProjectName: projectName,
+				Namespace:   namespaceSpec.Name,
+				Limit:       5,
+			})
+			assert.Nil(t, err)
+			assert.Len(t, resp.Deployments, 2)
+			assert.Equal(t, deployments[0].ID.String(), resp.Deployments[0].Id)
+			assert.Equal(t, models.DeploymentStatusSucceeded, resp.Deployments[1].Status)
+		})
 	})
 
 	t.Run("ReadJobSpecification", func(t *testing.T) {
@@ -912,6 +1587,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			jobSpecAdapted, _ := adapter.ToJobProto(jobSpecs[0])
@@ -956,7 +1634,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 			adapter := v1.NewAdapter(nil, nil)
 
 			namespaceRepository := new(mock.NamespaceRepository)
-			namespaceRepository.On("GetAll").Return([]models.NamespaceSpec{namespaceSpec}, nil)
+			namespaceRepository.On("GetByFilter", models.NameFilter{
+				Page: models.Page{Limit: models.PageDefaultSize + 1},
+			}).Return([]models.NamespaceSpec{namespaceSpec}, nil)
 			defer namespaceRepository.AssertExpectations(t)
 
 			namespaceRepoFact := new(mock.NamespaceRepoFactory)
@@ -977,6 +1657,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			namespaceAdapted := adapter.ToNamespaceProto(namespaceSpec)
@@ -984,59 +1667,264 @@ func TestRuntimeServiceServer(t *testing.T) {
 			resp, err := runtimeServiceServer.ListProjectNamespaces(context.Background(), &request)
 			assert.Nil(t, err)
 			assert.Equal(t, []*pb.NamespaceSpecification{namespaceAdapted}, resp.GetNamespaces())
+			assert.Empty(t, resp.GetNextPageToken())
 		})
-	})
-
-	t.Run("DeleteJobSpecification", func(t *testing.T) {
-		t.Run("should delete the job", func(t *testing.T) {
-			Version := "1.0.1"
-
+		t.Run("should list namespaces page by page", func(t *testing.T) {
 			projectName := "a-data-project"
-			jobName1 := "a-data-job"
-			taskName := "a-data-task"
-
 			projectSpec := models.ProjectSpec{
 				ID:   uuid.Must(uuid.NewRandom()),
 				Name: projectName,
-				Config: map[string]string{
-					"bucket": "gs://some_folder",
-				},
-			}
-
-			namespaceSpec := models.NamespaceSpec{
-				ID:   uuid.Must(uuid.NewRandom()),
-				Name: "dev-test-namespace-1",
-				Config: map[string]string{
-					"bucket": "gs://some_folder",
-				},
-				ProjectSpec: projectSpec,
 			}
-
-			execUnit1 := new(mock.BasePlugin)
-			defer execUnit1.AssertExpectations(t)
-
-			jobSpecs := []models.JobSpec{
-				{
-					Name: jobName1,
-					Task: models.JobSpecTask{
-						Unit: &models.Plugin{
-							Base: execUnit1,
-						},
-						Config: models.JobSpecConfigs{
-							{
-								Name:  "do",
-								Value: "this",
-							},
-						},
-					},
-					Assets: *models.JobAssets{}.New(
-						[]models.JobSpecAsset{
-							{
-								Name:  "query.sql",
-								Value: "select * from 1",
-							},
-						}),
-				},
+			namespaceSpecs := []models.NamespaceSpec{
+				{Name: "dev-team-1", ProjectSpec: projectSpec},
+				{Name: "dev-team-2", ProjectSpec: projectSpec},
+				{Name: "dev-team-3", ProjectSpec: projectSpec},
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByFilter", models.NameFilter{
+				NamePrefix: "dev-",
+				Page:       models.Page{Limit: 3},
+			}).Return(namespaceSpecs, nil)
+			namespaceRepository.On("GetByFilter", models.NameFilter{
+				NamePrefix: "dev-",
+				Page:       models.Page{After: "dev-team-2", Limit: 3},
+			}).Return(namespaceSpecs[2:], nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			request := &pb.ListProjectNamespacesRequest{ProjectName: projectName, PageSize: 2, NamePrefix: "dev-"}
+			resp, err := runtimeServiceServer.ListProjectNamespaces(context.Background(), request)
+			assert.Nil(t, err)
+			assert.Len(t, resp.GetNamespaces(), 2)
+			assert.Equal(t, "dev-team-2", resp.GetNamespaces()[1].GetName())
+			assert.NotEmpty(t, resp.GetNextPageToken())
+
+			request.PageToken = resp.GetNextPageToken()
+			resp, err = runtimeServiceServer.ListProjectNamespaces(context.Background(), request)
+			assert.Nil(t, err)
+			assert.Len(t, resp.GetNamespaces(), 1)
+			assert.Equal(t, "dev-team-3", resp.GetNamespaces()[0].GetName())
+			assert.Empty(t, resp.GetNextPageToken())
+		})
+		t.Run("should reject malformed page token", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			_, err := runtimeServiceServer.ListProjectNamespaces(context.Background(), &pb.ListProjectNamespacesRequest{
+				ProjectName: "a-data-project",
+				PageToken:   "not a token",
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+
+	t.Run("ListJobSpecification", func(t *testing.T) {
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-test-namespace-1",
+			ProjectSpec: projectSpec,
+		}
+
+		t.Run("should list jobs matching filters with selected fields only", func(t *testing.T) {
+			execUnit := new(mock.BasePlugin)
+			execUnit.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq"}, nil)
+			defer execUnit.AssertExpectations(t)
+			adapter := v1.NewAdapter(nil, nil)
+
+			jobSpec := models.JobSpec{
+				Name:   "team-1-job",
+				Owner:  "data@example.io",
+				Labels: map[string]string{"tier": "1"},
+				Task: models.JobSpecTask{
+					Unit: &models.Plugin{Base: execUnit},
+				},
+				Assets: *models.JobAssets{}.New([]models.JobSpecAsset{{Name: "query.sql", Value: "select 1"}}),
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetByFilter", namespaceSpec, models.JobSpecFilter{
+				NamePrefix: "team-1-",
+				Owner:      "data@example.io",
+				TaskName:   "bq2bq",
+				Labels:     map[string]string{"tier": "1", "team": "data"},
+				Fields:     []string{"name", "owner", "labels"},
+				Page:       models.Page{Limit: 11},
+			}).Return([]models.JobSpec{jobSpec}, nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				jobService,
+				nil, nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				adapter,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.ListJobSpecification(context.Background(), &pb.ListJobSpecificationRequest{
+				ProjectName:   projectName,
+				Namespace:     namespaceSpec.Name,
+				PageSize:      10,
+				NamePrefix:    "team-1-",
+				Owner:         "data@example.io",
+				TaskName:      "bq2bq",
+				LabelSelector: "tier=1, team=data",
+				ReadMask:      &fieldmaskpb.FieldMask{Paths: []string{"name", "owner", "labels"}},
+			})
+			assert.Nil(t, err)
+			assert.Empty(t, resp.GetNextPageToken())
+			assert.Len(t, resp.GetJobs(), 1)
+			assert.True(t, proto.Equal(&pb.JobSpecification{
+				Name:   "team-1-job",
+				Owner:  "data@example.io",
+				Labels: map[string]string{"tier": "1"},
+			}, resp.GetJobs()[0]), resp.GetJobs()[0].String())
+		})
+		t.Run("should reject invalid filters", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"1.0.1",
+				nil,
+				nil, nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			for _, request := range []*pb.ListJobSpecificationRequest{
+				{LabelSelector: "tier"},
+				{PageSize: -1},
+				{ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"colour"}}},
+				{ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"behavior.retry"}}},
+			} {
+				request.ProjectName = projectName
+				request.Namespace = namespaceSpec.Name
+				_, err := runtimeServiceServer.ListJobSpecification(context.Background(), request)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err), request.String())
+			}
+		})
+	})
+
+	t.Run("DeleteJobSpecification", func(t *testing.T) {
+		t.Run("should delete the job", func(t *testing.T) {
+			Version := "1.0.1"
+
+			projectName := "a-data-project"
+			jobName1 := "a-data-job"
+			taskName := "a-data-task"
+
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: projectName,
+				Config: map[string]string{
+					"bucket": "gs://some_folder",
+				},
+			}
+
+			namespaceSpec := models.NamespaceSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: "dev-test-namespace-1",
+				Config: map[string]string{
+					"bucket": "gs://some_folder",
+				},
+				ProjectSpec: projectSpec,
+			}
+
+			execUnit1 := new(mock.BasePlugin)
+			defer execUnit1.AssertExpectations(t)
+
+			jobSpecs := []models.JobSpec{
+				{
+					Name: jobName1,
+					Task: models.JobSpecTask{
+						Unit: &models.Plugin{
+							Base: execUnit1,
+						},
+						Config: models.JobSpecConfigs{
+							{
+								Name:  "do",
+								Value: "this",
+							},
+						},
+					},
+					Assets: *models.JobAssets{}.New(
+						[]models.JobSpecAsset{
+							{
+								Name:  "query.sql",
+								Value: "select * from 1",
+							},
+						}),
+				},
 			}
 
 			projectRepository := new(mock.ProjectRepository)
@@ -1079,6 +1967,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			deployRequest := pb.DeleteJobSpecificationRequest{ProjectName: projectName, JobName: jobSpec.Name, Namespace: namespaceSpec.Name}
@@ -1145,6 +2036,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				scheduler,
+				nil,
+				nil,
+				nil,
 			)
 
 			req := &pb.JobStatusRequest{
@@ -1235,6 +2129,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			req := &pb.RegisterJobEventRequest{
 				ProjectName: projectSpec.Name,
@@ -1264,6 +2161,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			scheduledAt := time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC)
 			scheduledAtTimestamp := timestamppb.New(scheduledAt)
@@ -1292,6 +2192,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			scheduledAt := time.Date(2020, 11, 11, 0, 0, 0, 0, time.UTC)
 			scheduledAtTimestamp := timestamppb.New(scheduledAt)
@@ -1331,9 +2234,11 @@ func TestRuntimeServiceServer(t *testing.T) {
 			}
 
 			compiledJob := models.Job{
-				Name:        jobName,
-				NamespaceID: namespaceSpec.ID.String(),
-				Contents:    []byte("content-of-dag"),
+				Name:           jobName,
+				NamespaceID:    namespaceSpec.ID.String(),
+				Contents:       []byte("content-of-dag"),
+				Priority:       9990,
+				PriorityReason: "depth: 1 level(s) below root jobs",
 			}
 
 			baseUnit := new(mock.BasePlugin)
@@ -1372,7 +2277,7 @@ func TestRuntimeServiceServer(t *testing.T) {
 
 			jobService := new(mock.JobService)
 			jobService.On("GetByName", jobName, namespaceSpec).Return(jobSpec, nil)
-			jobService.On("Dump", namespaceSpec, jobSpec).Return(compiledJob, nil)
+			jobService.On("Dump", mock2.Anything, namespaceSpec, jobSpec).Return(compiledJob, nil)
 			defer jobService.AssertExpectations(t)
 
 			jobSpecRepository := new(mock.JobSpecRepository)
@@ -1415,6 +2320,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			req := pb.DumpJobSpecificationRequest{
@@ -1426,6 +2334,8 @@ func TestRuntimeServiceServer(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, true, resp.GetSuccess())
 			assert.Equal(t, "content-of-dag", resp.GetContent())
+			assert.Equal(t, int32(9990), resp.GetPriorityWeight())
+			assert.Equal(t, "depth: 1 level(s) below root jobs", resp.GetPriorityReason())
 		})
 	})
 
@@ -1519,6 +2429,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 
 			resp, err := runtimeServiceServer.CreateResource(context.Background(), &req)
@@ -1594,10 +2507,351 @@ func TestRuntimeServiceServer(t *testing.T) {
 			projectRepoFactory.On("New").Return(projectRepository)
 			defer projectRepoFactory.AssertExpectations(t)
 
-			resourceSvc := new(mock.DatastoreService)
-			resourceSvc.On("UpdateResource", context.Background(), namespaceSpec, []models.ResourceSpec{resourceSpec}, nil).Return(nil)
-			defer resourceSvc.AssertExpectations(t)
-
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("UpdateResource", context.Background(), namespaceSpec, []models.ResourceSpec{resourceSpec}, false, nil).Return(nil)
+			defer resourceSvc.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil,
+				resourceSvc,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, dsRepo),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.UpdateResource(context.Background(), &req)
+			assert.Nil(t, err)
+			assert.Equal(t, true, resp.GetSuccess())
+		})
+		t.Run("should fail with failed precondition if breaking schema changes are not allowed", func(t *testing.T) {
+			projectName := "a-data-project"
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: projectName,
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "dev-test-namespace-1",
+				ProjectSpec: projectSpec,
+			}
+
+			dsTypeTableAdapter := new(mock.DatastoreTypeAdapter)
+			dsTypeTableController := new(mock.DatastoreTypeController)
+			dsTypeTableController.On("Adapter").Return(dsTypeTableAdapter)
+
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+				models.ResourceTypeTable: dsTypeTableController,
+			})
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetByName", "bq").Return(datastorer, nil)
+
+			resourceSpec := models.ResourceSpec{
+				Version:   1,
+				Name:      "proj.datas.table",
+				Type:      models.ResourceTypeTable,
+				Datastore: datastorer,
+			}
+			dsTypeTableAdapter.On("FromProtobuf", mock2.Anything).Return(resourceSpec, nil)
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("UpdateResource", context.Background(), namespaceSpec, []models.ResourceSpec{resourceSpec}, false, nil).
+				Return(errors.Wrap(models.ErrBreakingSchemaChange, "1 breaking change(s) in proj.datas.table"))
+			defer resourceSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil,
+				resourceSvc,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, dsRepo),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			_, err := runtimeServiceServer.UpdateResource(context.Background(), &pb.UpdateResourceRequest{
+				ProjectName:   projectName,
+				DatastoreName: "bq",
+				Resource: &pb.ResourceSpecification{
+					Version: 1,
+					Name:    "proj.datas.table",
+					Type:    models.ResourceTypeTable.String(),
+				},
+				Namespace: namespaceSpec.Name,
+			})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+
+	t.Run("DeployResourceSpecification", func(t *testing.T) {
+		t.Run("should stream plan of each resource without updating them in plan mode", func(t *testing.T) {
+			projectName := "a-data-project"
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: projectName,
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "dev-test-namespace-1",
+				ProjectSpec: projectSpec,
+			}
+
+			dsTypeTableAdapter := new(mock.DatastoreTypeAdapter)
+			dsTypeTableController := new(mock.DatastoreTypeController)
+			dsTypeTableController.On("Adapter").Return(dsTypeTableAdapter)
+
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+				models.ResourceTypeTable: dsTypeTableController,
+			})
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetByName", "bq").Return(datastorer, nil)
+
+			resourceSpec := models.ResourceSpec{
+				Version:   1,
+				Name:      "proj.datas.table",
+				Type:      models.ResourceTypeTable,
+				Datastore: datastorer,
+			}
+			dsTypeTableAdapter.On("FromProtobuf", mock2.Anything).Return(resourceSpec, nil)
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+
+			plan := models.ResourcePlan{
+				Spec:   resourceSpec,
+				Action: models.ResourcePlanUpdate,
+				Fields: []models.ResourceFieldDrift{{Field: "description", Expected: "all orders", Actual: "orders"}},
+				SchemaChanges: []models.ResourceSchemaChange{
+					{Field: "schema.id", Kind: models.SchemaChangeBreaking, From: "INTEGER NULLABLE"},
+				},
+			}
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("PlanResource", context.Background(), namespaceSpec, "bq", []models.ResourceSpec{resourceSpec}, true).
+				Return([]models.ResourcePlan{plan}, nil)
+			defer resourceSvc.AssertExpectations(t)
+
+			grpcRespStream := new(mock.RuntimeService_DeployResourceSpecificationServer)
+			grpcRespStream.On("Context").Return(context.Background())
+			grpcRespStream.On("Send", &pb.DeployResourceSpecificationResponse{
+				Success:      true,
+				Ack:          true,
+				ResourceName: resourceSpec.Name,
+				Message:      "update",
+				Plan: &pb.ResourcePlan{
+					ResourceName: resourceSpec.Name,
+					Type:         "table",
+					Action:       "update",
+					Fields:       []*pb.ResourceFieldDrift{{Field: "description", Expected: "all orders", Actual: "orders"}},
+					SchemaChanges: []*pb.ResourceSchemaChange{
+						{Field: "schema.id", Kind: "breaking", From: "INTEGER NULLABLE"},
+					},
+				},
+			}).Return(nil)
+			defer grpcRespStream.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil,
+				resourceSvc,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, dsRepo),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			err := runtimeServiceServer.DeployResourceSpecification(&pb.DeployResourceSpecificationRequest{
+				ProjectName:   projectName,
+				DatastoreName: "bq",
+				Namespace:     namespaceSpec.Name,
+				Resources: []*pb.ResourceSpecification{{
+					Version: 1,
+					Name:    resourceSpec.Name,
+					Type:    models.ResourceTypeTable.String(),
+				}},
+				Prune: true,
+				Plan:  true,
+			}, grpcRespStream)
+			assert.Nil(t, err)
+		})
+	})
+
+	t.Run("DeleteResource", func(t *testing.T) {
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+			Config: map[string]string{
+				"bucket": "gs://some_folder",
+			},
+		}
+
+		namespaceSpec := models.NamespaceSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "dev-test-namespace-1",
+			Config: map[string]string{
+				"bucket": "gs://some_folder",
+			},
+			ProjectSpec: projectSpec,
+		}
+
+		req := pb.DeleteResourceRequest{
+			ProjectName:   projectName,
+			DatastoreName: "bq",
+			ResourceName:  "proj.datas",
+			Namespace:     namespaceSpec.Name,
+		}
+
+		t.Run("should delete datastore resource successfully", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("DeleteResource", context.Background(), namespaceSpec, "bq", "proj.datas").Return(nil)
+			defer resourceSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil,
+				resourceSvc,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.DeleteResource(context.Background(), &req)
+			assert.Nil(t, err)
+			assert.Equal(t, true, resp.GetSuccess())
+		})
+		t.Run("should return not found if resource doesn't exist", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("DeleteResource", context.Background(), namespaceSpec, "bq", "proj.datas").Return(store.ErrResourceNotFound)
+			defer resourceSvc.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil,
+				resourceSvc,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+
+			resp, err := runtimeServiceServer.DeleteResource(context.Background(), &req)
+			assert.Nil(t, resp)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	})
+
+	t.Run("DetectResourceDrift", func(t *testing.T) {
+		t.Run("should return drift of resources in namespace", func(t *testing.T) {
+			projectName := "a-data-project"
+			projectSpec := models.ProjectSpec{
+				ID:   uuid.Must(uuid.NewRandom()),
+				Name: projectName,
+			}
+			namespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "dev-test-namespace-1",
+				ProjectSpec: projectSpec,
+			}
+
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
 			namespaceRepository := new(mock.NamespaceRepository)
 			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
 			defer namespaceRepository.AssertExpectations(t)
@@ -1606,6 +2860,24 @@ func TestRuntimeServiceServer(t *testing.T) {
 			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
 			defer namespaceRepoFact.AssertExpectations(t)
 
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("DetectDrift", context.Background(), namespaceSpec, "bq", []string(nil)).Return([]models.ResourceDrift{
+				{
+					Spec: models.ResourceSpec{Name: "proj.datas.t1", Type: models.ResourceTypeTable},
+					Fields: []models.ResourceFieldDrift{
+						{Field: "description", Expected: "orders", Actual: "all orders"},
+					},
+				},
+				{
+					Spec:    models.ResourceSpec{Name: "proj.datas.t2", Type: models.ResourceTypeTable},
+					Missing: true,
+				},
+				{
+					Spec: models.ResourceSpec{Name: "proj.datas.t3", Type: models.ResourceTypeTable},
+				},
+			}, nil)
+			defer resourceSvc.AssertExpectations(t)
+
 			runtimeServiceServer := v1.NewRuntimeServiceServer(
 				"Version",
 				nil, nil,
@@ -1613,15 +2885,27 @@ func TestRuntimeServiceServer(t *testing.T) {
 				projectRepoFactory,
 				namespaceRepoFact,
 				nil,
-				v1.NewAdapter(nil, dsRepo),
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
 				nil,
 				nil,
 				nil,
 			)
 
-			resp, err := runtimeServiceServer.UpdateResource(context.Background(), &req)
+			resp, err := runtimeServiceServer.DetectResourceDrift(context.Background(), &pb.DetectResourceDriftRequest{
+				ProjectName:   projectName,
+				DatastoreName: "bq",
+				Namespace:     namespaceSpec.Name,
+			})
 			assert.Nil(t, err)
-			assert.Equal(t, true, resp.GetSuccess())
+			assert.Len(t, resp.GetResources(), 3)
+			assert.True(t, resp.GetResources()[0].GetDrifted())
+			assert.Equal(t, "description", resp.GetResources()[0].GetFields()[0].GetField())
+			assert.True(t, resp.GetResources()[1].GetMissing())
+			assert.True(t, resp.GetResources()[1].GetDrifted())
+			assert.False(t, resp.GetResources()[2].GetDrifted())
 		})
 	})
 
@@ -1680,7 +2964,7 @@ func TestRuntimeServiceServer(t *testing.T) {
 
 			jobService := new(mock.JobService)
 			jobService.On("GetByName", jobName, namespaceSpec).Return(jobSpec, nil)
-			jobService.On("ReplayDryRun", replayWorkerRequest).Return(dagNode, nil)
+			jobService.On("ReplayDryRun", mock2.Anything, replayWorkerRequest).Return(dagNode, nil)
 			defer jobService.AssertExpectations(t)
 
 			projectRepository := new(mock.ProjectRepository)
@@ -1710,6 +2994,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -1763,6 +3050,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -1788,7 +3078,7 @@ func TestRuntimeServiceServer(t *testing.T) {
 
 			jobService := new(mock.JobService)
 			jobService.On("GetByName", jobName, namespaceSpec).Return(jobSpec, nil)
-			jobService.On("ReplayDryRun", replayWorkerRequest).Return(dagNode, errors.New("populating jobs spec failed"))
+			jobService.On("ReplayDryRun", mock2.Anything, replayWorkerRequest).Return(dagNode, errors.New("populating jobs spec failed"))
 			defer jobService.AssertExpectations(t)
 
 			projectRepository := new(mock.ProjectRepository)
@@ -1819,6 +3109,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -1915,6 +3208,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -1956,6 +3252,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -2011,6 +3310,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -2048,6 +3350,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -2096,6 +3401,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -2151,6 +3459,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -2207,6 +3518,9 @@ func TestRuntimeServiceServer(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
+				nil,
 			)
 			replayRequest := pb.ReplayRequest{
 				ProjectName: projectName,
@@ -2222,4 +3536,299 @@ func TestRuntimeServiceServer(t *testing.T) {
 			assert.Nil(t, replayResponse)
 		})
 	})
+
+	t.Run("GetJobGraph", func(t *testing.T) {
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+		}
+
+		t.Run("should return the job graph of a project", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepository.On("GetAll").Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			graph := models.JobGraph{
+				Nodes: []models.JobGraphNode{
+					{ID: "a-data-project/job-a", Name: "job-a", Project: projectName, Type: models.JobGraphNodeTypeJob},
+					{ID: "a-data-project/job-b", Name: "job-b", Project: projectName, Type: models.JobGraphNodeTypeJob},
+				},
+				Edges: []models.JobGraphEdge{
+					{Upstream: "a-data-project/job-a", Downstream: "a-data-project/job-b", Type: models.JobSpecDependencyTypeIntra},
+				},
+			}
+			jobService := new(mock.JobService)
+			jobService.On("GetJobGraph", mock2.Anything, projectSpec, []models.ProjectSpec{projectSpec}, "job-b", 2).Return(graph, nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				jobService,
+				nil,
+				nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.GetJobGraph(context.Background(), &pb.GetJobGraphRequest{
+				ProjectName: projectName,
+				JobName:     "job-b",
+				Depth:       2,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 2, len(resp.Nodes))
+			assert.Equal(t, "job", resp.Nodes[0].Type)
+			assert.Equal(t, []*pb.JobGraphEdge{
+				{Upstream: "a-data-project/job-a", Downstream: "a-data-project/job-b", Type: "intra"},
+			}, resp.Edges)
+		})
+		t.Run("should return not found when job doesn't exist in project", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepository.On("GetAll").Return([]models.ProjectSpec{projectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			jobService := new(mock.JobService)
+			jobService.On("GetJobGraph", mock2.Anything, projectSpec, []models.ProjectSpec{projectSpec}, "job-x", 0).Return(models.JobGraph{}, errors.Wrap(job.ErrJobSpecNotFound, "job-x"))
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				jobService,
+				nil,
+				nil,
+				projectRepoFactory,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.GetJobGraph(context.Background(), &pb.GetJobGraphRequest{
+				ProjectName: projectName,
+				JobName:     "job-x",
+			})
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+			assert.Nil(t, resp)
+		})
+	})
+
+	t.Run("PlanDeployment", func(t *testing.T) {
+		projectName := "a-data-project"
+		projectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: projectName,
+		}
+		namespaceSpec := models.NamespaceSpec{
+			ID:          uuid.Must(uuid.NewRandom()),
+			Name:        "dev-test-namespace-1",
+			ProjectSpec: projectSpec,
+		}
+		externalProjectSpec := models.ProjectSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "b-data-project",
+		}
+
+		t.Run("should return impact of deploying job specs", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			projectRepository.On("GetAll").Return([]models.ProjectSpec{projectSpec, externalProjectSpec}, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			plan := models.JobDeploymentPlan{
+				Removed: []string{"job-a"},
+				Changed: []models.JobSpecChange{
+					{Name: "job-b", Fields: []string{"destination"}, OldDestination: "p.d.b", NewDestination: "p.d.b2"},
+				},
+				DependencyChanges: []models.JobDependencyChange{
+					{
+						Job:     models.JobGraphNode{ID: "b-data-project/job-x", Name: "job-x", Project: "b-data-project", Type: models.JobGraphNodeTypeJob},
+						Removed: []string{"a-data-project/job-b"},
+						Unknown: []string{"p.d.b"},
+					},
+				},
+				Affected: []models.JobGraphNode{
+					{ID: "b-data-project/job-x", Name: "job-x", Project: "b-data-project", Type: models.JobGraphNodeTypeJob},
+				},
+			}
+			jobService := new(mock.JobService)
+			jobService.On("PlanDeployment", mock2.Anything, namespaceSpec, []models.JobSpec{},
+				[]models.ProjectSpec{projectSpec, externalProjectSpec}).Return(plan, nil)
+			defer jobService.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				jobService,
+				nil,
+				nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.PlanDeployment(context.Background(), &pb.PlanDeploymentRequest{
+				ProjectName: projectName,
+				Namespace:   namespaceSpec.Name,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"job-a"}, resp.RemovedJobs)
+			assert.Equal(t, "p.d.b2", resp.ChangedJobs[0].NewDestination)
+			assert.Equal(t, "b-data-project/job-x", resp.DependencyChanges[0].JobId)
+			assert.Equal(t, []string{"p.d.b"}, resp.DependencyChanges[0].Unknown)
+			assert.Equal(t, "b-data-project", resp.AffectedJobs[0].ProjectName)
+		})
+		t.Run("should return error if namespace is not found", func(t *testing.T) {
+			projectRepository := new(mock.ProjectRepository)
+			projectRepository.On("GetByName", projectName).Return(projectSpec, nil)
+			defer projectRepository.AssertExpectations(t)
+
+			projectRepoFactory := new(mock.ProjectRepoFactory)
+			projectRepoFactory.On("New").Return(projectRepository)
+			defer projectRepoFactory.AssertExpectations(t)
+
+			namespaceRepository := new(mock.NamespaceRepository)
+			namespaceRepository.On("GetByName", namespaceSpec.Name).Return(models.NamespaceSpec{}, errors.New("not found"))
+			defer namespaceRepository.AssertExpectations(t)
+
+			namespaceRepoFact := new(mock.NamespaceRepoFactory)
+			namespaceRepoFact.On("New", projectSpec).Return(namespaceRepository)
+			defer namespaceRepoFact.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil,
+				nil,
+				nil,
+				projectRepoFactory,
+				namespaceRepoFact,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.PlanDeployment(context.Background(), &pb.PlanDeploymentRequest{
+				ProjectName: projectName,
+				Namespace:   namespaceSpec.Name,
+			})
+			assert.NotNil(t, err)
+			assert.Equal(t, codes.NotFound, status.Code(err))
+			assert.Nil(t, resp)
+		})
+	})
+	t.Run("ListAuditEvents", func(t *testing.T) {
+		t.Run("should list audit events of project matching filters", func(t *testing.T) {
+			since := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+			event := models.AuditEvent{
+				ID:            uuid.Must(uuid.NewRandom()),
+				Actor:         "token:ci",
+				ProjectName:   "a-data-project",
+				NamespaceName: "game_jam",
+				Operation:     "DeleteJobSpecification",
+				Targets:       []string{"transform-tables"},
+				RequestDigest: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+				Outcome:       models.AuditOutcomeSuccess,
+				CreatedAt:     since.Add(time.Hour),
+			}
+
+			auditEventRepo := new(mock.AuditEventRepository)
+			auditEventRepo.On("GetAll", models.AuditFilter{
+				ProjectName: "a-data-project",
+				Operation:   "DeleteJobSpecification",
+				Since:       since,
+				Limit:       10,
+			}).Return([]models.AuditEvent{event}, nil)
+			defer auditEventRepo.AssertExpectations(t)
+
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				auditEventRepo,
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.ListAuditEvents(context.Background(), &pb.ListAuditEventsRequest{
+				ProjectName: "a-data-project",
+				Operation:   "DeleteJobSpecification",
+				Since:       timestamppb.New(since),
+				Limit:       10,
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(resp.Events))
+			assert.Equal(t, event.ID.String(), resp.Events[0].Id)
+			assert.Equal(t, "token:ci", resp.Events[0].Actor)
+			assert.Equal(t, "game_jam", resp.Events[0].Namespace)
+			assert.Equal(t, []string{"transform-tables"}, resp.Events[0].Targets)
+			assert.Equal(t, event.CreatedAt, resp.Events[0].CreatedAt.AsTime())
+		})
+		t.Run("should require project name", func(t *testing.T) {
+			runtimeServiceServer := v1.NewRuntimeServiceServer(
+				"Version",
+				nil, nil, nil,
+				nil,
+				nil,
+				nil,
+				v1.NewAdapter(nil, nil),
+				nil,
+				nil,
+				nil,
+				new(mock.AuditEventRepository),
+				nil,
+				nil,
+			)
+			resp, err := runtimeServiceServer.ListAuditEvents(context.Background(), &pb.ListAuditEventsRequest{})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Nil(t, resp)
+		})
+	})
 }
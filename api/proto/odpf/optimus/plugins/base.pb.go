@@ -24,9 +24,10 @@ const (
 type PluginType int32
 
 const (
-	PluginType_PluginType_UNKNOWN PluginType = 0
-	PluginType_PluginType_TASK    PluginType = 1
-	PluginType_PluginType_HOOK    PluginType = 2
+	PluginType_PluginType_UNKNOWN   PluginType = 0
+	PluginType_PluginType_TASK      PluginType = 1
+	PluginType_PluginType_HOOK      PluginType = 2
+	PluginType_PluginType_DATASTORE PluginType = 3
 )
 
 // Enum value maps for PluginType.
@@ -35,11 +36,13 @@ var (
 		0: "PluginType_UNKNOWN",
 		1: "PluginType_TASK",
 		2: "PluginType_HOOK",
+		3: "PluginType_DATASTORE",
 	}
 	PluginType_value = map[string]int32{
-		"PluginType_UNKNOWN": 0,
-		"PluginType_TASK":    1,
-		"PluginType_HOOK":    2,
+		"PluginType_UNKNOWN":   0,
+		"PluginType_TASK":      1,
+		"PluginType_HOOK":      2,
+		"PluginType_DATASTORE": 3,
 	}
 )
 
@@ -77,6 +80,7 @@ const (
 	PluginMod_PluginMod_UNKNOWN            PluginMod = 0
 	PluginMod_PluginMod_CLI                PluginMod = 1
 	PluginMod_PluginMod_DEPENDENCYRESOLVER PluginMod = 2
+	PluginMod_PluginMod_DATASTORE          PluginMod = 3
 )
 
 // Enum value maps for PluginMod.
@@ -85,11 +89,13 @@ var (
 		0: "PluginMod_UNKNOWN",
 		1: "PluginMod_CLI",
 		2: "PluginMod_DEPENDENCYRESOLVER",
+		3: "PluginMod_DATASTORE",
 	}
 	PluginMod_value = map[string]int32{
 		"PluginMod_UNKNOWN":            0,
 		"PluginMod_CLI":                1,
 		"PluginMod_DEPENDENCYRESOLVER": 2,
+		"PluginMod_DATASTORE":          3,
 	}
 )
 
@@ -422,35 +428,38 @@ var file_odpf_optimus_plugins_base_proto_rawDesc = []byte{
 	0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x28, 0x0a, 0x0d, 0x50, 0x6c, 0x75,
 	0x67, 0x69, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
 	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
-	0x52, 0x75, 0x6e, 0x2a, 0x4e, 0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x52, 0x75, 0x6e, 0x2a, 0x68, 0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70,
 	0x65, 0x12, 0x16, 0x0a, 0x12, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x5f,
 	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x6c, 0x75,
 	0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x10, 0x01, 0x12, 0x13,
 	0x0a, 0x0f, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x48, 0x4f, 0x4f,
-	0x4b, 0x10, 0x02, 0x2a, 0x57, 0x0a, 0x09, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64,
-	0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x4d, 0x6f, 0x64, 0x5f, 0x43, 0x4c, 0x49, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x6c,
-	0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e,
-	0x43, 0x59, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x58, 0x0a, 0x08,
-	0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x48, 0x6f, 0x6f, 0x6b,
-	0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x10,
-	0x0a, 0x0c, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x50, 0x52, 0x45, 0x10, 0x01,
-	0x12, 0x11, 0x0a, 0x0d, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x50, 0x4f, 0x53,
-	0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x5f,
-	0x46, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x32, 0x67, 0x0a, 0x04, 0x42, 0x61, 0x73, 0x65, 0x12, 0x5f,
-	0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e, 0x6f,
-	0x64, 0x70, 0x66, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67,
-	0x69, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x6f, 0x70, 0x74,
-	0x69, 0x6d, 0x75, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x53, 0x0a, 0x1e, 0x69, 0x6f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x73, 0x42, 0x0f, 0x42, 0x61, 0x73, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x6f, 0x64, 0x70, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f, 0x6f, 0x70, 0x74,
-	0x69, 0x6d, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4b, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x2a, 0x70, 0x0a,
+	0x09, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f, 0x64, 0x5f, 0x43,
+	0x4c, 0x49, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x4d, 0x6f,
+	0x64, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x52, 0x45, 0x53, 0x4f,
+	0x4c, 0x56, 0x45, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x4d, 0x6f, 0x64, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x10, 0x03, 0x2a,
+	0x58, 0x0a, 0x08, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x48,
+	0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x10, 0x0a, 0x0c, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x5f, 0x50, 0x52,
+	0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79, 0x70, 0x65, 0x5f,
+	0x50, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x48, 0x6f, 0x6f, 0x6b, 0x54, 0x79,
+	0x70, 0x65, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x32, 0x67, 0x0a, 0x04, 0x42, 0x61, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x27, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x53, 0x0a, 0x1e, 0x69, 0x6f, 0x2e, 0x6f, 0x64, 0x70, 0x66, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x6e, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x42, 0x0f, 0x42, 0x61, 0x73, 0x65, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x64, 0x70, 0x66, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x6e, 0x2f,
+	0x6f, 0x70, 0x74, 0x69, 0x6d, 0x75, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/auth"
+	"github.com/odpf/optimus/core/logger"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const runtimeService = "/odpf.optimus.RuntimeService/"
+
+// auditedMethods are the mutating methods recorded in audit log
+var auditedMethods = map[string]bool{
+	runtimeService + "DeployJobSpecification":      true,
+	runtimeService + "CreateJobSpecification":      true,
+	runtimeService + "DeleteJobSpecification":      true,
+	runtimeService + "RegisterProject":             true,
+	runtimeService + "RegisterProjectNamespace":    true,
+	runtimeService + "RegisterSecret":              true,
+	runtimeService + "DeleteNamespace":             true,
+	runtimeService + "RestoreNamespace":            true,
+	runtimeService + "ArchiveProject":              true,
+	runtimeService + "RestoreProject":              true,
+	runtimeService + "DeployResourceSpecification": true,
+	runtimeService + "CreateResource":              true,
+	runtimeService + "UpdateResource":              true,
+	runtimeService + "DeleteResource":              true,
+	runtimeService + "Replay":                      true,
+}
+
+// Interceptor records an audit event for every mutating request once it
+// is handled, it runs before auth interceptor so requests rejected by it are
+// recorded too, with the caller auth interceptor authenticated as the actor.
+// Requests which queued a deployment are recorded as queued, deploy manager
+// settles their outcome once the deployment finishes
+type Interceptor struct {
+	repo           store.AuditEventRepository
+	deploymentRepo store.DeploymentRepository
+	digestKey      []byte
+	now            func() time.Time
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !auditedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		ctx, attempted := auth.ContextWithAttemptedPrincipal(ctx)
+		resp, err := handler(ctx, req)
+		i.record(ctx, attempted, info.FullMethod, req, uuid.Nil, err)
+		return resp, err
+	}
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !auditedMethods[info.FullMethod] {
+			return handler(srv, ss)
+		}
+		ctx, attempted := auth.ContextWithAttemptedPrincipal(ss.Context())
+		stream := &recordingStream{ServerStream: ss, ctx: ctx}
+		err := handler(srv, stream)
+		i.record(ctx, attempted, info.FullMethod, stream.req, stream.deploymentID, err)
+		return err
+	}
+}
+
+func (i *Interceptor) record(ctx context.Context, attempted *auth.AttemptedPrincipal, fullMethod string, req interface{},
+	deploymentID uuid.UUID, err error) {
+	actor := models.AuditActorAnonymous
+	if principal, ok := auth.PrincipalFromContext(ctx); ok {
+		actor = principal.Name
+	} else if principal, ok := attempted.Get(); ok {
+		actor = principal.Name
+	}
+	project, namespace := auth.RequestScope(req)
+	event := models.AuditEvent{
+		Actor:         actor,
+		ProjectName:   project,
+		NamespaceName: namespace,
+		Operation:     strings.TrimPrefix(fullMethod, runtimeService),
+		Targets:       RequestTargets(req),
+		RequestDigest: i.digest(req),
+		Outcome:       models.AuditOutcomeSuccess,
+		CreatedAt:     i.now(),
+	}
+	switch {
+	case deploymentID != uuid.Nil:
+		// deployment goes on even if client went away meanwhile
+		event.Outcome = models.AuditOutcomeQueued
+		event.DeploymentID = deploymentID
+	case err != nil:
+		st := status.Convert(err)
+		event.Outcome = st.Code().String()
+		event.Error = st.Message()
+	}
+	if err := i.repo.Save(event); err != nil {
+		logger.E("failed to save audit event of ", event.Operation, " by ", event.Actor, ": ", err)
+		return
+	}
+	if deploymentID != uuid.Nil {
+		i.settleDeployment(deploymentID)
+	}
+}
+
+// settleDeployment records outcome of deployment if it finished before its
+// audit event was saved, deploy manager couldn't settle the event then
+func (i *Interceptor) settleDeployment(deploymentID uuid.UUID) {
+	deployment, err := i.deploymentRepo.GetByID(deploymentID)
+	if err != nil {
+		logger.E("failed to look up deployment ", deploymentID, " for audit log: ", err)
+		return
+	}
+	if !deployment.Finished() {
+		return
+	}
+	outcome, errMsg := models.DeploymentAuditOutcome(deployment)
+	if err := i.repo.UpdateDeploymentOutcome(deploymentID, outcome, errMsg); err != nil {
+		logger.E("failed to record outcome of deployment ", deploymentID, " in audit log: ", err)
+	}
+}
+
+// digest is keyed so secrets in requests can't be guessed from it
+func (i *Interceptor) digest(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	mac := hmac.New(sha256.New, i.digestKey)
+	mac.Write(raw)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RequestTargets returns names of jobs, resources, secrets or projects a
+// mutating request acts on
+func RequestTargets(req interface{}) []string {
+	var targets []string
+	switch r := req.(type) {
+	case *pb.DeployJobSpecificationRequest:
+		for _, spec := range r.GetJobs() {
+			targets = append(targets, spec.GetName())
+		}
+	case *pb.CreateJobSpecificationRequest:
+		targets = append(targets, r.GetSpec().GetName())
+	case *pb.DeleteJobSpecificationRequest:
+		targets = append(targets, r.GetJobName())
+	case *pb.RegisterProjectRequest:
+		targets = append(targets, r.GetProject().GetName())
+	case *pb.RegisterProjectNamespaceRequest:
+		targets = append(targets, r.GetNamespace().GetName())
+	case *pb.RegisterSecretRequest:
+		targets = append(targets, r.GetSecretName())
+	case *pb.DeleteNamespaceRequest:
+		targets = append(targets, r.GetNamespace())
+	case *pb.RestoreNamespaceRequest:
+		targets = append(targets, r.GetNamespace())
+	case *pb.ArchiveProjectRequest:
+		targets = append(targets, r.GetProjectName())
+	case *pb.RestoreProjectRequest:
+		targets = append(targets, r.GetProjectName())
+	case *pb.DeployResourceSpecificationRequest:
+		for _, spec := range r.GetResources() {
+			targets = append(targets, spec.GetName())
+		}
+		targets = append(targets, r.GetPruneConfirmed()...)
+	case *pb.CreateResourceRequest:
+		targets = append(targets, r.GetResource().GetName())
+	case *pb.UpdateResourceRequest:
+		targets = append(targets, r.GetResource().GetName())
+	case *pb.DeleteResourceRequest:
+		targets = append(targets, r.GetResourceName())
+	case *pb.ReplayRequest:
+		targets = append(targets, r.GetJobName())
+	}
+	return targets
+}
+
+// recordingStream keeps the request received from client of a server
+// streaming method and id of the deployment queued for it, if any
+type recordingStream struct {
+	grpc.ServerStream
+	ctx          context.Context
+	req          interface{}
+	deploymentID uuid.UUID
+}
+
+func (s *recordingStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	s.req = m
+	return nil
+}
+
+func (s *recordingStream) SendMsg(m interface{}) error {
+	if resp, ok := m.(*pb.DeployJobSpecificationResponse); ok && resp.GetDeploymentId() != "" {
+		if id, err := uuid.Parse(resp.GetDeploymentId()); err == nil {
+			s.deploymentID = id
+		}
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+// NewInterceptor saves audit events in repo, digestKey keys the hash of
+// requests recorded with events. Status of deployments queued by requests is
+// read from deploymentRepo
+func NewInterceptor(repo store.AuditEventRepository, deploymentRepo store.DeploymentRepository, digestKey []byte,
+	now func() time.Time) *Interceptor {
+	return &Interceptor{
+		repo:           repo,
+		deploymentRepo: deploymentRepo,
+		digestKey:      digestKey,
+		now:            now,
+	}
+}
@@ -0,0 +1,227 @@
+package audit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/audit"
+	"github.com/odpf/optimus/auth"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	tmock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type staticAuthenticator map[string]models.AuthPrincipal
+
+func (a staticAuthenticator) Authenticate(ctx context.Context, credential string) (models.AuthPrincipal, error) {
+	if principal, ok := a[credential]; ok {
+		return principal, nil
+	}
+	return models.AuthPrincipal{}, errors.Wrap(models.ErrUnauthenticated, "unknown token")
+}
+
+type recvStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (s *recvStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func (s *recvStream) SendMsg(m interface{}) error {
+	return nil
+}
+
+func TestInterceptor(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+	principal := models.AuthPrincipal{Name: "token:ci"}
+	ctx := auth.ContextWithPrincipal(context.Background(), principal)
+	info := func(method string) *grpc.UnaryServerInfo {
+		return &grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.RuntimeService/" + method}
+	}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "done", nil
+	}
+
+	t.Run("should record mutating requests with actor and outcome", func(t *testing.T) {
+		repo := new(mock.AuditEventRepository)
+		var saved []models.AuditEvent
+		repo.On("Save", tmock.AnythingOfType("models.AuditEvent")).Run(func(args tmock.Arguments) {
+			saved = append(saved, args.Get(0).(models.AuditEvent))
+		}).Return(nil)
+		defer repo.AssertExpectations(t)
+		interceptor := audit.NewInterceptor(repo, nil, []byte("key"), nowFn)
+
+		req := &pb.DeleteJobSpecificationRequest{ProjectName: "sales", Namespace: "reporting", JobName: "orders"}
+		resp, err := interceptor.Unary()(ctx, req, info("DeleteJobSpecification"), ok)
+		assert.Nil(t, err)
+		assert.Equal(t, "done", resp)
+
+		_, err = interceptor.Unary()(context.Background(), req, info("DeleteJobSpecification"),
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.NotFound, "job orders not found")
+			})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		assert.Equal(t, 2, len(saved))
+		assert.Equal(t, models.AuditEvent{
+			Actor:         "token:ci",
+			ProjectName:   "sales",
+			NamespaceName: "reporting",
+			Operation:     "DeleteJobSpecification",
+			Targets:       []string{"orders"},
+			RequestDigest: saved[0].RequestDigest,
+			Outcome:       models.AuditOutcomeSuccess,
+			CreatedAt:     now,
+		}, saved[0])
+		assert.Equal(t, 64, len(saved[0].RequestDigest))
+
+		assert.Equal(t, models.AuditActorAnonymous, saved[1].Actor)
+		assert.Equal(t, "NotFound", saved[1].Outcome)
+		assert.Equal(t, "job orders not found", saved[1].Error)
+		assert.Equal(t, saved[0].RequestDigest, saved[1].RequestDigest)
+	})
+	t.Run("should record requests rejected by auth with caller attempting them", func(t *testing.T) {
+		repo := new(mock.AuditEventRepository)
+		var saved []models.AuditEvent
+		repo.On("Save", tmock.AnythingOfType("models.AuditEvent")).Run(func(args tmock.Arguments) {
+			saved = append(saved, args.Get(0).(models.AuditEvent))
+		}).Return(nil)
+		defer repo.AssertExpectations(t)
+
+		viewer := models.AuthPrincipal{
+			Name:     "token:dashboard",
+			Bindings: []models.AuthRoleBinding{{Project: "sales", Role: models.AuthRoleViewer}},
+		}
+		authInterceptor := auth.NewInterceptor(auth.NewChainAuthenticator(staticAuthenticator{"opt_dashboard": viewer}), nil)
+		chained := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) error {
+			_, err := audit.NewInterceptor(repo, nil, []byte("key"), nowFn).Unary()(ctx, req, info,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return authInterceptor.Unary()(ctx, req, info, ok)
+				})
+			return err
+		}
+		withCredential := func(credential string) context.Context {
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", credential))
+		}
+
+		req := &pb.DeleteJobSpecificationRequest{ProjectName: "sales", Namespace: "reporting", JobName: "orders"}
+		err := chained(withCredential("Bearer opt_dashboard"), req, info("DeleteJobSpecification"))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		err = chained(withCredential("Bearer opt_unknown"), req, info("DeleteJobSpecification"))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		assert.Equal(t, 2, len(saved))
+		assert.Equal(t, "token:dashboard", saved[0].Actor)
+		assert.Equal(t, "PermissionDenied", saved[0].Outcome)
+		assert.Equal(t, models.AuditActorAnonymous, saved[1].Actor)
+		assert.Equal(t, "Unauthenticated", saved[1].Outcome)
+	})
+	t.Run("should key request digest", func(t *testing.T) {
+		var digests []string
+		for _, key := range []string{"key", "other-key"} {
+			repo := new(mock.AuditEventRepository)
+			repo.On("Save", tmock.AnythingOfType("models.AuditEvent")).Run(func(args tmock.Arguments) {
+				digests = append(digests, args.Get(0).(models.AuditEvent).RequestDigest)
+			}).Return(nil)
+			req := &pb.RegisterSecretRequest{ProjectName: "sales", SecretName: "STORAGE", Value: "c2VjcmV0"}
+			_, err := audit.NewInterceptor(repo, nil, []byte(key), nowFn).Unary()(ctx, req, info("RegisterSecret"), ok)
+			assert.Nil(t, err)
+		}
+		assert.NotEqual(t, digests[0], digests[1])
+	})
+	t.Run("should not record read only requests", func(t *testing.T) {
+		repo := new(mock.AuditEventRepository)
+		defer repo.AssertExpectations(t)
+		_, err := audit.NewInterceptor(repo, nil, []byte("key"), nowFn).Unary()(ctx, &pb.ListProjectsRequest{}, info("ListProjects"), ok)
+		assert.Nil(t, err)
+	})
+	t.Run("should record request received on stream", func(t *testing.T) {
+		repo := new(mock.AuditEventRepository)
+		var saved models.AuditEvent
+		repo.On("Save", tmock.AnythingOfType("models.AuditEvent")).Run(func(args tmock.Arguments) {
+			saved = args.Get(0).(models.AuditEvent)
+		}).Return(nil)
+		defer repo.AssertExpectations(t)
+
+		stream := &recvStream{ctx: ctx, req: &pb.DeployJobSpecificationRequest{
+			ProjectName: "sales",
+			Namespace:   "reporting",
+			Jobs:        []*pb.JobSpecification{{Name: "orders"}, {Name: "customers"}},
+		}}
+		err := audit.NewInterceptor(repo, nil, []byte("key"), nowFn).Stream()(nil, stream,
+			&grpc.StreamServerInfo{FullMethod: "/odpf.optimus.RuntimeService/DeployJobSpecification"},
+			func(srv interface{}, ss grpc.ServerStream) error {
+				return ss.RecvMsg(&pb.DeployJobSpecificationRequest{})
+			})
+		assert.Nil(t, err)
+		assert.Equal(t, "DeployJobSpecification", saved.Operation)
+		assert.Equal(t, "reporting", saved.NamespaceName)
+		assert.Equal(t, []string{"orders", "customers"}, saved.Targets)
+	})
+	t.Run("should record deployment queued on stream till it finishes", func(t *testing.T) {
+		deploymentID := uuid.New()
+		deployStream := func(repo *mock.AuditEventRepository, deploymentRepo *mock.DeploymentRepository) error {
+			stream := &recvStream{ctx: ctx, req: &pb.DeployJobSpecificationRequest{ProjectName: "sales", Namespace: "reporting"}}
+			return audit.NewInterceptor(repo, deploymentRepo, []byte("key"), nowFn).Stream()(nil, stream,
+				&grpc.StreamServerInfo{FullMethod: "/odpf.optimus.RuntimeService/DeployJobSpecification"},
+				func(srv interface{}, ss grpc.ServerStream) error {
+					if err := ss.RecvMsg(&pb.DeployJobSpecificationRequest{}); err != nil {
+						return err
+					}
+					if err := ss.SendMsg(&pb.DeployJobSpecificationResponse{DeploymentId: deploymentID.String()}); err != nil {
+						return err
+					}
+					// client going away doesn't stop the deployment
+					return status.Error(codes.Canceled, "context canceled")
+				})
+		}
+		queued := tmock.MatchedBy(func(e models.AuditEvent) bool {
+			return e.Outcome == models.AuditOutcomeQueued && e.DeploymentID == deploymentID && e.Error == ""
+		})
+
+		t.Run("and leave settling it to deploy manager", func(t *testing.T) {
+			repo := new(mock.AuditEventRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Save", queued).Return(nil)
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("GetByID", deploymentID).Return(models.Deployment{
+				ID: deploymentID, Status: models.DeploymentStatusInProgress,
+			}, nil)
+
+			assert.Equal(t, codes.Canceled, status.Code(deployStream(repo, deploymentRepo)))
+		})
+		t.Run("and settle it if it finished before event was saved", func(t *testing.T) {
+			repo := new(mock.AuditEventRepository)
+			defer repo.AssertExpectations(t)
+			repo.On("Save", queued).Return(nil)
+			repo.On("UpdateDeploymentOutcome", deploymentID, models.AuditOutcomeFailed, "failed to sync jobs").Return(nil)
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("GetByID", deploymentID).Return(models.Deployment{
+				ID: deploymentID, Status: models.DeploymentStatusFailed, Message: "failed to sync jobs",
+			}, nil)
+
+			assert.Equal(t, codes.Canceled, status.Code(deployStream(repo, deploymentRepo)))
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// ErrUnsupportedCredential is returned by an Authenticator for credentials
+// of a kind it doesn't verify, e.g. a JWT sent to token authenticator
+var ErrUnsupportedCredential = errors.New("unsupported credential")
+
+// Authenticator verifies credentials sent by callers of optimus APIs and
+// resolves the principal they belong to
+type Authenticator interface {
+	Authenticate(ctx context.Context, credential string) (models.AuthPrincipal, error)
+}
+
+type chainAuthenticator []Authenticator
+
+// NewChainAuthenticator authenticates credentials using the first of
+// authenticators supporting them
+func NewChainAuthenticator(authenticators ...Authenticator) Authenticator {
+	return chainAuthenticator(authenticators)
+}
+
+func (c chainAuthenticator) Authenticate(ctx context.Context, credential string) (models.AuthPrincipal, error) {
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(ctx, credential)
+		if errors.Is(err, ErrUnsupportedCredential) {
+			continue
+		}
+		return principal, err
+	}
+	return models.AuthPrincipal{}, errors.Wrap(models.ErrUnauthenticated, "unsupported credential")
+}
+
+type principalContextKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal models.AuthPrincipal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns caller of the request, it is false if
+// authentication is disabled or the method is public
+func PrincipalFromContext(ctx context.Context) (models.AuthPrincipal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(models.AuthPrincipal)
+	return principal, ok
+}
+
+type attemptedPrincipalContextKey struct{}
+
+// AttemptedPrincipal is filled by auth interceptor once the caller is
+// authenticated, even if the request is then denied. Interceptors running
+// before authentication, like audit, read it to know who made the attempt.
+type AttemptedPrincipal struct {
+	principal models.AuthPrincipal
+	ok        bool
+}
+
+func (p *AttemptedPrincipal) Get() (models.AuthPrincipal, bool) {
+	return p.principal, p.ok
+}
+
+func ContextWithAttemptedPrincipal(ctx context.Context) (context.Context, *AttemptedPrincipal) {
+	attempted := &AttemptedPrincipal{}
+	return context.WithValue(ctx, attemptedPrincipalContextKey{}, attempted), attempted
+}
+
+func recordAttemptedPrincipal(ctx context.Context, principal models.AuthPrincipal) {
+	if attempted, ok := ctx.Value(attemptedPrincipalContextKey{}).(*AttemptedPrincipal); ok {
+		attempted.principal = principal
+		attempted.ok = true
+	}
+}
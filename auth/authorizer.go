@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"fmt"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const runtimeService = "/odpf.optimus.RuntimeService/"
+
+var (
+	// publicMethods can be called without credentials
+	publicMethods = map[string]bool{
+		runtimeService + "Version": true,
+
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+		"/grpc.health.v1.Health/Check":                                   true,
+		"/grpc.health.v1.Health/Watch":                                   true,
+	}
+
+	// methodRoles is the least role needed to call a method in project and
+	// namespace of its request, methods missing here need admin role
+	methodRoles = map[string]models.AuthRole{
+		runtimeService + "ReadJobSpecification":      models.AuthRoleViewer,
+		runtimeService + "ListJobSpecification":      models.AuthRoleViewer,
+		runtimeService + "DumpJobSpecification":      models.AuthRoleViewer,
+		runtimeService + "CheckJobSpecification":     models.AuthRoleViewer,
+		runtimeService + "CheckJobSpecifications":    models.AuthRoleViewer,
+		runtimeService + "ListProjects":              models.AuthRoleViewer,
+		runtimeService + "ListProjectNamespaces":     models.AuthRoleViewer,
+		runtimeService + "JobStatus":                 models.AuthRoleViewer,
+		runtimeService + "GetWindow":                 models.AuthRoleViewer,
+		runtimeService + "ListResourceSpecification": models.AuthRoleViewer,
+		runtimeService + "ReadResource":              models.AuthRoleViewer,
+		runtimeService + "DetectResourceDrift":       models.AuthRoleViewer,
+		runtimeService + "ReplayDryRun":              models.AuthRoleViewer,
+		runtimeService + "GetJobGraph":               models.AuthRoleViewer,
+		runtimeService + "PlanDeployment":            models.AuthRoleViewer,
+		runtimeService + "GetDeployment":             models.AuthRoleViewer,
+		runtimeService + "ListDeployments":           models.AuthRoleViewer,
+
+		runtimeService + "DeployJobSpecification":      models.AuthRoleDeployer,
+		runtimeService + "CreateJobSpecification":      models.AuthRoleDeployer,
+		runtimeService + "DeleteJobSpecification":      models.AuthRoleDeployer,
+		runtimeService + "RegisterInstance":            models.AuthRoleDeployer,
+		runtimeService + "RegisterJobEvent":            models.AuthRoleDeployer,
+		runtimeService + "DeployResourceSpecification": models.AuthRoleDeployer,
+		runtimeService + "CreateResource":              models.AuthRoleDeployer,
+		runtimeService + "UpdateResource":              models.AuthRoleDeployer,
+		runtimeService + "DeleteResource":              models.AuthRoleDeployer,
+		runtimeService + "Replay":                      models.AuthRoleDeployer,
+
+		runtimeService + "RegisterProject":          models.AuthRoleAdmin,
+		runtimeService + "RegisterProjectNamespace": models.AuthRoleAdmin,
+		runtimeService + "RegisterSecret":           models.AuthRoleAdmin,
+		runtimeService + "DeleteNamespace":          models.AuthRoleAdmin,
+		runtimeService + "RestoreNamespace":         models.AuthRoleAdmin,
+		runtimeService + "ArchiveProject":           models.AuthRoleAdmin,
+		runtimeService + "RestoreProject":           models.AuthRoleAdmin,
+		runtimeService + "ListAuditEvents":          models.AuthRoleAdmin,
+	}
+
+	// namespaceFilteredMethods list what is in any namespace of project if
+	// their request has no namespace, the response is then filtered down to
+	// namespaces principal is granted the role in
+	namespaceFilteredMethods = map[string]bool{
+		runtimeService + "ListDeployments": true,
+	}
+)
+
+// IsPublicMethod is true for methods which can be called without credentials
+func IsPublicMethod(fullMethod string) bool {
+	return publicMethods[fullMethod]
+}
+
+// RequiredRole is the least role needed to call the method
+func RequiredRole(fullMethod string) models.AuthRole {
+	if role, ok := methodRoles[fullMethod]; ok {
+		return role
+	}
+	return models.AuthRoleAdmin
+}
+
+// Authorize checks if principal is granted the role required by method in
+// project and namespace of the request
+func Authorize(principal models.AuthPrincipal, fullMethod string, req interface{}) error {
+	project, namespace := RequestScope(req)
+	return AuthorizeScope(principal, fullMethod, project, namespace)
+}
+
+// AuthorizeScope checks if principal is granted the role required by method
+// in namespace of project, for requests whose scope is resolved elsewhere
+func AuthorizeScope(principal models.AuthPrincipal, fullMethod, project, namespace string) error {
+	role := RequiredRole(fullMethod)
+	if principal.HasRole(project, namespace, role) {
+		return nil
+	}
+	if namespace == "" && namespaceFilteredMethods[fullMethod] && principal.HasRoleInProject(project, role) {
+		return nil
+	}
+
+	scope := "any project"
+	if project != "" {
+		scope = fmt.Sprintf("project %s", project)
+		if namespace != "" {
+			scope = fmt.Sprintf("namespace %s of project %s", namespace, project)
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s: %s requires %s role in %s", models.ErrUnauthorized, principal.Name, role, scope)
+}
+
+// FilterResponse drops what principal isn't granted the role required by
+// method in from responses of methods which aren't scoped to a project or
+// namespace, as those are authorized against bindings of any of them
+func FilterResponse(principal models.AuthPrincipal, fullMethod string, resp interface{}) interface{} {
+	role := RequiredRole(fullMethod)
+	switch r := resp.(type) {
+	case *pb.ListProjectsResponse:
+		projects := make([]*pb.ProjectSpecification, 0, len(r.Projects))
+		for _, project := range r.Projects {
+			if principal.HasRoleInProject(project.GetName(), role) {
+				projects = append(projects, project)
+			}
+		}
+		r.Projects = projects
+	case *pb.ListDeploymentsResponse:
+		deployments := make([]*pb.Deployment, 0, len(r.Deployments))
+		for _, deployment := range r.Deployments {
+			if principal.HasRole(deployment.GetProjectName(), deployment.GetNamespace(), role) {
+				deployments = append(deployments, deployment)
+			}
+		}
+		r.Deployments = deployments
+	}
+	return resp
+}
+
+// RequestScope is the project and namespace a request is made in, both
+// could be empty for requests which aren't scoped, e.g. listing projects
+func RequestScope(req interface{}) (project string, namespace string) {
+	switch r := req.(type) {
+	case interface{ GetProjectName() string }:
+		project = r.GetProjectName()
+	case interface {
+		GetProject() *pb.ProjectSpecification
+	}:
+		project = r.GetProject().GetName()
+	}
+	switch r := req.(type) {
+	case interface{ GetNamespace() string }:
+		namespace = r.GetNamespace()
+	case interface {
+		GetNamespace() *pb.NamespaceSpecification
+	}:
+		namespace = r.GetNamespace().GetName()
+	}
+	return project, namespace
+}
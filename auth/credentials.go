@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+
+	"google.golang.org/grpc/credentials"
+)
+
+type tokenCredentials struct {
+	token string
+}
+
+// NewTokenCredentials sends token, an API token or a JWT, as bearer
+// credentials with every call made on a grpc connection
+func NewTokenCredentials(token string) credentials.PerRPCCredentials {
+	return &tokenCredentials{token: token}
+}
+
+func (c *tokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationKey: "Bearer " + c.token,
+	}, nil
+}
+
+// RequireTransportSecurity is false as optimus is often reached through an
+// ingress terminating tls
+func (c *tokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// authorizationKey is the metadata key credentials are sent in, grpc
+	// gateway forwards Authorization header of http requests with it
+	authorizationKey = "authorization"
+	bearerScheme     = "bearer "
+)
+
+// Interceptor authenticates callers of grpc methods and authorizes their
+// requests before they reach the handlers
+type Interceptor struct {
+	authenticator  Authenticator
+	deploymentRepo store.DeploymentRepository
+}
+
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if IsPublicMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		principal, err := i.authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		recordAttemptedPrincipal(ctx, principal)
+		project, namespace, err := i.requestScope(req)
+		if err != nil {
+			return nil, err
+		}
+		if err := AuthorizeScope(principal, info.FullMethod, project, namespace); err != nil {
+			return nil, err
+		}
+		resp, err := handler(ContextWithPrincipal(ctx, principal), req)
+		if err != nil {
+			return nil, err
+		}
+		return FilterResponse(principal, info.FullMethod, resp), nil
+	}
+}
+
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if IsPublicMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		principal, err := i.authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		recordAttemptedPrincipal(ss.Context(), principal)
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          ContextWithPrincipal(ss.Context(), principal),
+			principal:    principal,
+			fullMethod:   info.FullMethod,
+		})
+	}
+}
+
+func (i *Interceptor) authenticate(ctx context.Context) (models.AuthPrincipal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationKey)
+	if len(values) == 0 {
+		return models.AuthPrincipal{}, status.Errorf(codes.Unauthenticated, "%s: %s header not found", models.ErrUnauthenticated, authorizationKey)
+	}
+	credential := values[0]
+	if len(credential) > len(bearerScheme) && strings.EqualFold(credential[:len(bearerScheme)], bearerScheme) {
+		credential = credential[len(bearerScheme):]
+	}
+
+	principal, err := i.authenticator.Authenticate(ctx, strings.TrimSpace(credential))
+	if err != nil {
+		if errors.Is(err, models.ErrUnauthenticated) || errors.Is(err, ErrUnsupportedCredential) {
+			return models.AuthPrincipal{}, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return models.AuthPrincipal{}, status.Errorf(codes.Internal, "failed to authenticate: %s", err)
+	}
+	return principal, nil
+}
+
+// requestScope is the project and namespace of request, requests which only
+// refer to something stored in a namespace get the namespace it's stored in
+func (i *Interceptor) requestScope(req interface{}) (project string, namespace string, err error) {
+	project, namespace = RequestScope(req)
+	r, ok := req.(*pb.GetDeploymentRequest)
+	if !ok {
+		return project, namespace, nil
+	}
+	deploymentID, err := uuid.Parse(r.GetDeploymentId())
+	if err != nil {
+		// handler rejects it, authorizing in whole project till then
+		return project, namespace, nil
+	}
+	deployment, err := i.deploymentRepo.GetByID(deploymentID)
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return project, namespace, nil
+		}
+		return "", "", status.Errorf(codes.Internal, "%s: failed to get deployment %s", err.Error(), r.GetDeploymentId())
+	}
+	if deployment.Namespace.ProjectSpec.Name == project {
+		namespace = deployment.Namespace.Name
+	}
+	return project, namespace, nil
+}
+
+// authorizedStream authorizes every message received from client of a stream
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	principal  models.AuthPrincipal
+	fullMethod string
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return Authorize(s.principal, s.fullMethod, m)
+}
+
+// NewInterceptor authorizes requests referring to a deployment in namespace
+// the deployment is stored in by reading it from deploymentRepo
+func NewInterceptor(authenticator Authenticator, deploymentRepo store.DeploymentRepository) *Interceptor {
+	return &Interceptor{
+		authenticator:  authenticator,
+		deploymentRepo: deploymentRepo,
+	}
+}
@@ -0,0 +1,200 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/auth"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type staticAuthenticator map[string]models.AuthPrincipal
+
+func (a staticAuthenticator) Authenticate(ctx context.Context, credential string) (models.AuthPrincipal, error) {
+	if principal, ok := a[credential]; ok {
+		return principal, nil
+	}
+	return models.AuthPrincipal{}, errors.Wrap(models.ErrUnauthenticated, "unknown token")
+}
+
+type recvStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *pb.DeployJobSpecificationRequest
+}
+
+func (s *recvStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recvStream) RecvMsg(m interface{}) error {
+	proto.Merge(m.(*pb.DeployJobSpecificationRequest), s.req)
+	return nil
+}
+
+func TestInterceptor(t *testing.T) {
+	deployer := models.AuthPrincipal{
+		Name:     "token:ci",
+		Bindings: []models.AuthRoleBinding{{Project: "sales", Role: models.AuthRoleDeployer}},
+	}
+	interceptor := auth.NewInterceptor(auth.NewChainAuthenticator(staticAuthenticator{"opt_ci": deployer}), nil)
+	withCredential := func(credential string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", credential))
+	}
+	call := func(ctx context.Context, method string, req interface{}) (models.AuthPrincipal, error) {
+		var principal models.AuthPrincipal
+		_, err := interceptor.Unary()(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.RuntimeService/" + method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				principal, _ = auth.PrincipalFromContext(ctx)
+				return nil, nil
+			})
+		return principal, err
+	}
+
+	t.Run("should allow requests in scope of principal roles", func(t *testing.T) {
+		principal, err := call(withCredential("Bearer opt_ci"), "ReadJobSpecification",
+			&pb.ReadJobSpecificationRequest{ProjectName: "sales", Namespace: "reporting"})
+		assert.Nil(t, err)
+		assert.Equal(t, deployer, principal)
+
+		_, err = call(withCredential("bearer opt_ci"), "DeleteResource", &pb.DeleteResourceRequest{ProjectName: "sales", Namespace: "reporting"})
+		assert.Nil(t, err)
+		_, err = call(withCredential("opt_ci"), "ListProjects", &pb.ListProjectsRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("should only list projects principal is granted a role in", func(t *testing.T) {
+		viewer := models.AuthPrincipal{
+			Name: "user:viewer@example.io",
+			Bindings: []models.AuthRoleBinding{
+				{Project: "sales", Role: models.AuthRoleViewer},
+				{Project: "finance", Namespace: "reporting", Role: models.AuthRoleViewer},
+			},
+		}
+		admin := models.AuthPrincipal{
+			Name:     "user:admin@example.io",
+			Bindings: []models.AuthRoleBinding{{Project: models.AuthAnyProject, Role: models.AuthRoleAdmin}},
+		}
+		interceptor := auth.NewInterceptor(staticAuthenticator{"opt_viewer": viewer, "opt_admin": admin}, nil)
+		listProjects := func(credential string) []string {
+			resp, err := interceptor.Unary()(withCredential(credential), &pb.ListProjectsRequest{},
+				&grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.RuntimeService/ListProjects"},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return &pb.ListProjectsResponse{Projects: []*pb.ProjectSpecification{
+						{Name: "sales"}, {Name: "finance"}, {Name: "marketing"},
+					}}, nil
+				})
+			assert.Nil(t, err)
+			var names []string
+			for _, project := range resp.(*pb.ListProjectsResponse).GetProjects() {
+				names = append(names, project.GetName())
+			}
+			return names
+		}
+
+		assert.Equal(t, []string{"sales", "finance"}, listProjects("opt_viewer"))
+		assert.Equal(t, []string{"sales", "finance", "marketing"}, listProjects("opt_admin"))
+	})
+	t.Run("should authorize deployments in namespace they are deployed to", func(t *testing.T) {
+		viewer := models.AuthPrincipal{
+			Name:     "user:viewer@example.io",
+			Bindings: []models.AuthRoleBinding{{Project: "sales", Namespace: "reporting", Role: models.AuthRoleViewer}},
+		}
+		reportingID, billingID := uuid.New(), uuid.New()
+		projectSpec := models.ProjectSpec{Name: "sales"}
+		deploymentRepo := new(mock.DeploymentRepository)
+		defer deploymentRepo.AssertExpectations(t)
+		deploymentRepo.On("GetByID", reportingID).Return(models.Deployment{
+			ID: reportingID, Namespace: models.NamespaceSpec{Name: "reporting", ProjectSpec: projectSpec},
+		}, nil)
+		deploymentRepo.On("GetByID", billingID).Return(models.Deployment{
+			ID: billingID, Namespace: models.NamespaceSpec{Name: "billing", ProjectSpec: projectSpec},
+		}, nil)
+
+		interceptor := auth.NewInterceptor(staticAuthenticator{"opt_viewer": viewer}, deploymentRepo)
+		call := func(method string, req interface{}, resp interface{}) (interface{}, error) {
+			return interceptor.Unary()(withCredential("opt_viewer"), req,
+				&grpc.UnaryServerInfo{FullMethod: "/odpf.optimus.RuntimeService/" + method},
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return resp, nil
+				})
+		}
+
+		_, err := call("GetDeployment", &pb.GetDeploymentRequest{ProjectName: "sales", DeploymentId: reportingID.String()}, nil)
+		assert.Nil(t, err)
+		_, err = call("GetDeployment", &pb.GetDeploymentRequest{ProjectName: "sales", DeploymentId: billingID.String()}, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		resp, err := call("ListDeployments", &pb.ListDeploymentsRequest{ProjectName: "sales"}, &pb.ListDeploymentsResponse{
+			Deployments: []*pb.Deployment{
+				{Id: reportingID.String(), ProjectName: "sales", Namespace: "reporting"},
+				{Id: billingID.String(), ProjectName: "sales", Namespace: "billing"},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []*pb.Deployment{{Id: reportingID.String(), ProjectName: "sales", Namespace: "reporting"}},
+			resp.(*pb.ListDeploymentsResponse).GetDeployments())
+		_, err = call("ListDeployments", &pb.ListDeploymentsRequest{ProjectName: "sales", Namespace: "billing"}, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = call("ListDeployments", &pb.ListDeploymentsRequest{ProjectName: "finance"}, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("should allow public methods without credentials", func(t *testing.T) {
+		_, err := call(context.Background(), "Version", &pb.VersionRequest{})
+		assert.Nil(t, err)
+	})
+	t.Run("should reject requests without valid credentials", func(t *testing.T) {
+		_, err := call(context.Background(), "ListProjects", &pb.ListProjectsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		_, err = call(withCredential("Bearer opt_unknown"), "ListProjects", &pb.ListProjectsRequest{})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("should reject requests outside scope of principal roles", func(t *testing.T) {
+		forbidden := map[string]interface{}{
+			"RegisterSecret":   &pb.RegisterSecretRequest{ProjectName: "sales"},
+			"DeleteResource":   &pb.DeleteResourceRequest{ProjectName: "finance", Namespace: "reporting"},
+			"RegisterProject":  &pb.RegisterProjectRequest{Project: &pb.ProjectSpecification{Name: "sales"}},
+			"SomeFutureMethod": &pb.ListProjectsRequest{},
+		}
+		for method, req := range forbidden {
+			_, err := call(withCredential("Bearer opt_ci"), method, req)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), method)
+		}
+	})
+	t.Run("should authorize every message received on stream", func(t *testing.T) {
+		streamCall := func(req *pb.DeployJobSpecificationRequest) error {
+			stream := &recvStream{ctx: withCredential("Bearer opt_ci"), req: req}
+			return interceptor.Stream()(nil, stream, &grpc.StreamServerInfo{FullMethod: "/odpf.optimus.RuntimeService/DeployJobSpecification"},
+				func(srv interface{}, ss grpc.ServerStream) error {
+					if _, ok := auth.PrincipalFromContext(ss.Context()); !ok {
+						return errors.New("principal not found in stream context")
+					}
+					return ss.RecvMsg(&pb.DeployJobSpecificationRequest{})
+				})
+		}
+		assert.Nil(t, streamCall(&pb.DeployJobSpecificationRequest{ProjectName: "sales", Namespace: "reporting"}))
+		assert.Equal(t, codes.PermissionDenied, status.Code(streamCall(&pb.DeployJobSpecificationRequest{ProjectName: "finance"})))
+	})
+}
+
+func TestRequestScope(t *testing.T) {
+	project, namespace := auth.RequestScope(&pb.RegisterProjectNamespaceRequest{
+		ProjectName: "sales",
+		Namespace:   &pb.NamespaceSpecification{Name: "reporting"},
+	})
+	assert.Equal(t, "sales", project)
+	assert.Equal(t, "reporting", namespace)
+
+	project, namespace = auth.RequestScope(&pb.GetWindowRequest{})
+	assert.Empty(t, project)
+	assert.Empty(t, namespace)
+}
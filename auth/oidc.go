@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultRolesClaim lists role bindings of the subject in a JWT
+	DefaultRolesClaim = "optimus_roles"
+)
+
+var (
+	// supportedSigningAlgs are accepted algorithms of JWTs, the algorithm
+	// must also match type of the key a token is signed with
+	supportedSigningAlgs = []string{
+		oidc.RS256, oidc.RS384, oidc.RS512,
+		oidc.ES256, oidc.ES384, oidc.ES512,
+	}
+)
+
+type OIDCConfig struct {
+	// Issuer must match iss claim of tokens, not checked if empty
+	Issuer string
+	// Audience must be one of aud claim of tokens, it is required as tokens
+	// minted for other clients of the provider shouldn't be accepted
+	Audience string
+	// JWKSURL serves public keys tokens are signed with
+	JWKSURL string
+	// RolesClaim lists role bindings of subject formatted as
+	// <project>[/<namespace>]:<role>, DefaultRolesClaim if empty
+	RolesClaim string
+}
+
+// OIDCAuthenticator verifies JWTs issued by an OpenID Connect provider
+// against public keys fetched from its JWKS endpoint
+type OIDCAuthenticator struct {
+	config   OIDCConfig
+	verifier *oidc.IDTokenVerifier
+}
+
+func (a *OIDCAuthenticator) Authenticate(ctx context.Context, credential string) (models.AuthPrincipal, error) {
+	if strings.Count(credential, ".") != 2 {
+		return models.AuthPrincipal{}, ErrUnsupportedCredential
+	}
+
+	token, err := a.verifier.Verify(ctx, credential)
+	if err != nil {
+		return models.AuthPrincipal{}, errors.Wrap(models.ErrUnauthenticated, err.Error())
+	}
+	claims := map[string]interface{}{}
+	if err := token.Claims(&claims); err != nil {
+		return models.AuthPrincipal{}, errors.Wrap(models.ErrUnauthenticated, "malformed jwt claims")
+	}
+
+	subject := token.Subject
+	if email, ok := claims["email"].(string); ok && email != "" {
+		subject = email
+	}
+	return models.AuthPrincipal{
+		Name:     "user:" + subject,
+		Bindings: roleBindings(claims[a.rolesClaim()]),
+	}, nil
+}
+
+func (a *OIDCAuthenticator) rolesClaim() string {
+	if a.config.RolesClaim != "" {
+		return a.config.RolesClaim
+	}
+	return DefaultRolesClaim
+}
+
+// roleBindings reads bindings from a list or space separated string, values
+// which aren't role bindings are ignored as the claim could be shared, e.g.
+// groups of user
+func roleBindings(claim interface{}) []models.AuthRoleBinding {
+	var values []string
+	switch v := claim.(type) {
+	case string:
+		values = strings.Fields(v)
+	case []interface{}:
+		for _, item := range v {
+			values = append(values, fmt.Sprint(item))
+		}
+	}
+	var bindings []models.AuthRoleBinding
+	for _, value := range values {
+		if binding, err := models.ParseAuthRoleBinding(value); err == nil {
+			bindings = append(bindings, binding)
+		}
+	}
+	return bindings
+}
+
+// NewOIDCAuthenticator verifies tokens with keys fetched from JWKS endpoint
+// using client, keys are fetched again when a token is signed by an unknown key
+func NewOIDCAuthenticator(config OIDCConfig, client *http.Client, now func() time.Time) (*OIDCAuthenticator, error) {
+	if config.JWKSURL == "" {
+		return nil, errors.New("jwks url of oidc provider is required")
+	}
+	if config.Audience == "" {
+		return nil, errors.New("audience of oidc tokens is required")
+	}
+
+	keySet := oidc.NewRemoteKeySet(oidc.ClientContext(context.Background(), client), config.JWKSURL)
+	return &OIDCAuthenticator{
+		config: config,
+		verifier: oidc.NewVerifier(config.Issuer, keySet, &oidc.Config{
+			ClientID:             config.Audience,
+			SupportedSigningAlgs: supportedSigningAlgs,
+			SkipIssuerCheck:      config.Issuer == "",
+			Now:                  now,
+		}),
+	}, nil
+}
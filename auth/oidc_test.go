@@ -0,0 +1,169 @@
+package auth_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/auth"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOIDCAuthenticator(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+	ctx := context.Background()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	jwksRequests := 0
+	jwks := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jwksRequests++
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{
+				{
+					"kty": "RSA", "kid": "rsa-1", "use": "sig",
+					"n": encodeSegment(rsaKey.N.Bytes()),
+					"e": encodeSegment(big.NewInt(int64(rsaKey.E)).Bytes()),
+				},
+				{
+					"kty": "EC", "kid": "ec-1", "crv": "P-256",
+					"x": encodeSegment(ecKey.X.Bytes()),
+					"y": encodeSegment(ecKey.Y.Bytes()),
+				},
+			},
+		})
+	}))
+	defer jwks.Close()
+
+	authenticator, err := auth.NewOIDCAuthenticator(auth.OIDCConfig{
+		Issuer:   "https://accounts.example.io",
+		Audience: "optimus",
+		JWKSURL:  jwks.URL,
+	}, jwks.Client(), nowFn)
+	assert.Nil(t, err)
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":                  "https://accounts.example.io",
+			"aud":                  []string{"optimus", "other"},
+			"sub":                  "1234",
+			"email":                "jane@example.io",
+			"exp":                  now.Add(time.Hour).Unix(),
+			auth.DefaultRolesClaim: []string{"sales:deployer", "everyone", "finance/reporting:viewer"},
+		}
+	}
+
+	t.Run("should authenticate jwt signed by rsa key of provider", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(ctx, signRS256(t, rsaKey, "rsa-1", validClaims()))
+		assert.Nil(t, err)
+		assert.Equal(t, models.AuthPrincipal{
+			Name: "user:jane@example.io",
+			Bindings: []models.AuthRoleBinding{
+				{Project: "sales", Role: models.AuthRoleDeployer},
+				{Project: "finance", Namespace: "reporting", Role: models.AuthRoleViewer},
+			},
+		}, principal)
+	})
+	t.Run("should authenticate jwt signed by ec key of provider", func(t *testing.T) {
+		principal, err := authenticator.Authenticate(ctx, signES256(t, ecKey, "ec-1", validClaims()))
+		assert.Nil(t, err)
+		assert.Equal(t, "user:jane@example.io", principal.Name)
+		assert.Equal(t, 1, jwksRequests)
+	})
+	t.Run("should fail to authenticate invalid jwt", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		assert.Nil(t, err)
+
+		expired := validClaims()
+		expired["exp"] = now.Add(-time.Hour).Unix()
+		otherIssuer := validClaims()
+		otherIssuer["iss"] = "https://evil.example.io"
+		otherAudience := validClaims()
+		otherAudience["aud"] = "other"
+		noExpiry := validClaims()
+		delete(noExpiry, "exp")
+
+		// signature shorter than the size of the curve of the key
+		ecSignature := signES256(t, ecKey, "ec-1", validClaims())
+		truncatedSignature := ecSignature[:len(ecSignature)-4]
+
+		invalidTokens := map[string]string{
+			"forged signature":    signRS256(t, otherKey, "rsa-1", validClaims()),
+			"ec signature length": truncatedSignature,
+			"alg of other key":    withHeader(t, signRS256(t, rsaKey, "rsa-1", validClaims()), map[string]string{"alg": "ES256", "kid": "rsa-1"}),
+			"unknown key":         signRS256(t, rsaKey, "rsa-2", validClaims()),
+			"expired":             signRS256(t, rsaKey, "rsa-1", expired),
+			"other issuer":        signRS256(t, rsaKey, "rsa-1", otherIssuer),
+			"other audience":      signRS256(t, rsaKey, "rsa-1", otherAudience),
+			"no expiry":           signRS256(t, rsaKey, "rsa-1", noExpiry),
+			"no signature":        encodeJSON(t, map[string]string{"alg": "none"}) + "." + encodeJSON(t, validClaims()) + ".",
+		}
+		for name, token := range invalidTokens {
+			_, err := authenticator.Authenticate(ctx, token)
+			assert.True(t, errors.Is(err, models.ErrUnauthenticated), name)
+		}
+	})
+	t.Run("should require audience of tokens", func(t *testing.T) {
+		_, err := auth.NewOIDCAuthenticator(auth.OIDCConfig{
+			Issuer:  "https://accounts.example.io",
+			JWKSURL: jwks.URL,
+		}, jwks.Client(), nowFn)
+		assert.NotNil(t, err)
+	})
+	t.Run("should not support credentials other than jwt", func(t *testing.T) {
+		_, err := authenticator.Authenticate(ctx, "opt_secret")
+		assert.Equal(t, auth.ErrUnsupportedCredential, err)
+	})
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	signingInput := encodeJSON(t, map[string]string{"alg": "RS256", "kid": kid, "typ": "JWT"}) + "." + encodeJSON(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	assert.Nil(t, err)
+	return signingInput + "." + encodeSegment(signature)
+}
+
+func signES256(t *testing.T, key *ecdsa.PrivateKey, kid string, claims map[string]interface{}) string {
+	signingInput := encodeJSON(t, map[string]string{"alg": "ES256", "kid": kid, "typ": "JWT"}) + "." + encodeJSON(t, claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	assert.Nil(t, err)
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return signingInput + "." + encodeSegment(signature)
+}
+
+// withHeader replaces header of a signed jwt keeping its claims and signature
+func withHeader(t *testing.T, token string, header map[string]string) string {
+	parts := strings.SplitN(token, ".", 2)
+	return encodeJSON(t, header) + "." + parts[1]
+}
+
+func encodeJSON(t *testing.T, v interface{}) string {
+	raw, err := json.Marshal(v)
+	assert.Nil(t, err)
+	return encodeSegment(raw)
+}
+
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,151 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"log"
+	"math/big"
+	"net"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	dir  string
+}
+
+func newTestCA(t *testing.T, dir, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+	cert, err := x509.ParseCertificate(raw)
+	assert.Nil(t, err)
+
+	ca := &testCA{cert: cert, key: key, dir: dir}
+	ca.write(t, name+".pem", "CERTIFICATE", raw)
+	return ca
+}
+
+// issue writes a certificate signed by ca along with its key, returning
+// paths of both
+func (ca *testCA) issue(t *testing.T, name string, usage x509.ExtKeyUsage, dnsNames ...string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		DNSNames:     dnsNames,
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	raw, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.Nil(t, err)
+	rawKey, err := x509.MarshalECPrivateKey(key)
+	assert.Nil(t, err)
+	return ca.write(t, name+".pem", "CERTIFICATE", raw), ca.write(t, name+"-key.pem", "EC PRIVATE KEY", rawKey)
+}
+
+func (ca *testCA) write(t *testing.T, name, blockType string, raw []byte) string {
+	path := filepath.Join(ca.dir, name)
+	assert.Nil(t, ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: raw}), 0600))
+	return path
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t, dir, "optimus-ca")
+	otherCA := newTestCA(t, dir, "other-ca")
+	serverCert, serverKey := ca.issue(t, "server", x509.ExtKeyUsageServerAuth, "optimus.internal")
+	clientCert, clientKey := ca.issue(t, "client", x509.ExtKeyUsageClientAuth)
+	untrustedCert, untrustedKey := otherCA.issue(t, "untrusted", x509.ExtKeyUsageClientAuth)
+	caFile, otherCAFile := filepath.Join(dir, "optimus-ca.pem"), filepath.Join(dir, "other-ca.pem")
+
+	// serve grpc the way optimus server does, through a http server
+	serve := func(t *testing.T, clientCAFile string) string {
+		serverTLS, err := auth.NewServerTLSConfig(serverCert, serverKey, clientCAFile)
+		assert.Nil(t, err)
+		grpcServer := grpc.NewServer()
+		healthpb.RegisterHealthServer(grpcServer, health.NewServer())
+		srv := &http.Server{
+			Handler:   grpcServer,
+			TLSConfig: serverTLS,
+			ErrorLog:  log.New(ioutil.Discard, "", 0),
+		}
+
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		assert.Nil(t, err)
+		go func() {
+			_ = srv.ServeTLS(lis, "", "")
+		}()
+		t.Cleanup(func() {
+			_ = srv.Close()
+		})
+		return lis.Addr().String()
+	}
+	check := func(t *testing.T, addr, caFile, certFile, keyFile, serverName string) error {
+		clientTLS, err := auth.NewClientTLSConfig(caFile, certFile, keyFile, serverName)
+		assert.Nil(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+		assert.Nil(t, err)
+		defer conn.Close()
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	t.Run("should connect to server with certificate signed by trusted ca", func(t *testing.T) {
+		addr := serve(t, "")
+		assert.Nil(t, check(t, addr, caFile, "", "", ""))
+		assert.Nil(t, check(t, addr, caFile, "", "", "optimus.internal"))
+	})
+	t.Run("should fail to connect to server with untrusted or mismatched certificate", func(t *testing.T) {
+		addr := serve(t, "")
+		assert.NotNil(t, check(t, addr, otherCAFile, "", "", ""))
+		assert.NotNil(t, check(t, addr, caFile, "", "", "optimus.example.io"))
+	})
+	t.Run("should require client certificate signed by client ca", func(t *testing.T) {
+		addr := serve(t, caFile)
+		assert.Nil(t, check(t, addr, caFile, clientCert, clientKey, ""))
+		assert.NotNil(t, check(t, addr, caFile, "", "", ""))
+		assert.NotNil(t, check(t, addr, caFile, untrustedCert, untrustedKey, ""))
+	})
+	t.Run("should fail to load missing or invalid files", func(t *testing.T) {
+		_, err := auth.NewServerTLSConfig(filepath.Join(dir, "missing.pem"), serverKey, "")
+		assert.NotNil(t, err)
+		_, err = auth.NewServerTLSConfig(serverCert, serverKey, serverKey)
+		assert.NotNil(t, err)
+		_, err = auth.NewClientTLSConfig(caFile, clientCert, "", "")
+		assert.NotNil(t, err)
+	})
+}
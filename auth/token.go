@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+const (
+	// TokenPrefix tells static API tokens apart from other credentials
+	TokenPrefix = "opt_"
+
+	tokenSize = 32
+)
+
+// GenerateToken returns a new random API token and the hash to be stored
+func GenerateToken() (token string, hash string, err error) {
+	b := make([]byte, tokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = TokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return token, HashToken(token), nil
+}
+
+// HashToken is the hex encoded sha256 of token, tokens are random enough to
+// not need a salt
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// TokenAuthenticator verifies static API tokens stored hashed in a repository
+type TokenAuthenticator struct {
+	repo store.AuthTokenRepository
+	now  func() time.Time
+}
+
+func (a *TokenAuthenticator) Authenticate(ctx context.Context, credential string) (models.AuthPrincipal, error) {
+	if !strings.HasPrefix(credential, TokenPrefix) {
+		return models.AuthPrincipal{}, ErrUnsupportedCredential
+	}
+	token, err := a.repo.GetByHash(HashToken(credential))
+	if err != nil {
+		if errors.Is(err, store.ErrResourceNotFound) {
+			return models.AuthPrincipal{}, errors.Wrap(models.ErrUnauthenticated, "unknown token")
+		}
+		return models.AuthPrincipal{}, errors.Wrap(err, "failed to read token")
+	}
+	if token.Expired(a.now()) {
+		return models.AuthPrincipal{}, errors.Wrapf(models.ErrUnauthenticated, "token %s expired", token.Name)
+	}
+	return models.AuthPrincipal{
+		Name:     "token:" + token.Name,
+		Bindings: token.Bindings,
+	}, nil
+}
+
+func NewTokenAuthenticator(repo store.AuthTokenRepository, now func() time.Time) *TokenAuthenticator {
+	return &TokenAuthenticator{
+		repo: repo,
+		now:  now,
+	}
+}
@@ -0,0 +1,66 @@
+package auth_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/auth"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenAuthenticator(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	nowFn := func() time.Time { return now }
+	ctx := context.Background()
+
+	t.Run("should generate random tokens with prefix", func(t *testing.T) {
+		token, hash, err := auth.GenerateToken()
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(token, auth.TokenPrefix))
+		assert.Equal(t, auth.HashToken(token), hash)
+
+		other, _, err := auth.GenerateToken()
+		assert.Nil(t, err)
+		assert.NotEqual(t, token, other)
+	})
+	t.Run("should authenticate known token", func(t *testing.T) {
+		bindings := []models.AuthRoleBinding{{Project: "sales", Role: models.AuthRoleDeployer}}
+		repo := new(mock.AuthTokenRepository)
+		repo.On("GetByHash", auth.HashToken("opt_secret")).Return(models.AuthToken{
+			Name:      "ci",
+			Bindings:  bindings,
+			ExpiresAt: now.Add(time.Hour),
+		}, nil)
+		defer repo.AssertExpectations(t)
+
+		principal, err := auth.NewTokenAuthenticator(repo, nowFn).Authenticate(ctx, "opt_secret")
+		assert.Nil(t, err)
+		assert.Equal(t, models.AuthPrincipal{Name: "token:ci", Bindings: bindings}, principal)
+	})
+	t.Run("should fail to authenticate unknown or expired token", func(t *testing.T) {
+		repo := new(mock.AuthTokenRepository)
+		repo.On("GetByHash", auth.HashToken("opt_unknown")).Return(models.AuthToken{}, store.ErrResourceNotFound)
+		repo.On("GetByHash", auth.HashToken("opt_expired")).Return(models.AuthToken{
+			Name:      "ci",
+			ExpiresAt: now.Add(-time.Hour),
+		}, nil)
+		defer repo.AssertExpectations(t)
+		authenticator := auth.NewTokenAuthenticator(repo, nowFn)
+
+		_, err := authenticator.Authenticate(ctx, "opt_unknown")
+		assert.True(t, errors.Is(err, models.ErrUnauthenticated))
+		_, err = authenticator.Authenticate(ctx, "opt_expired")
+		assert.True(t, errors.Is(err, models.ErrUnauthenticated))
+		assert.Contains(t, err.Error(), "token ci expired")
+	})
+	t.Run("should not support credentials other than tokens", func(t *testing.T) {
+		_, err := auth.NewTokenAuthenticator(new(mock.AuthTokenRepository), nowFn).Authenticate(ctx, "a.b.c")
+		assert.Equal(t, auth.ErrUnsupportedCredential, err)
+	})
+}
@@ -1,18 +1,22 @@
 package cmd
 
 import (
+	"github.com/odpf/optimus/config"
 	"github.com/odpf/optimus/models"
 	cli "github.com/spf13/cobra"
 )
 
 // adminCommand requests a resource from optimus
-func adminCommand(l logger, pluginRepo models.PluginRepository) *cli.Command {
+func adminCommand(l logger, conf config.Provider, pluginRepo models.PluginRepository) *cli.Command {
 	cmd := &cli.Command{
 		Use:   "admin",
 		Short: "administration commands, should not be used by user",
 	}
 	cmd.AddCommand(adminBuildCommand(l))
 	cmd.AddCommand(adminGetCommand(l, pluginRepo))
+	cmd.AddCommand(adminAuditCommand(l, conf))
+	cmd.AddCommand(adminNamespaceCommand(l, conf))
+	cmd.AddCommand(adminProjectCommand(l, conf))
 	return cmd
 }
 
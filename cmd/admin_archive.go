@@ -0,0 +1,224 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/config"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+)
+
+const (
+	adminArchiveTimeout = time.Minute * 10
+)
+
+// adminNamespaceCommand manages lifecycle of namespaces
+func adminNamespaceCommand(l logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "namespace",
+		Short: "Delete or restore namespaces of a project",
+	}
+	cmd.AddCommand(adminNamespaceDeleteCommand(l, conf))
+	cmd.AddCommand(adminNamespaceRestoreCommand(l, conf))
+	return cmd
+}
+
+// adminProjectCommand manages lifecycle of projects
+func adminProjectCommand(l logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "project",
+		Short: "Archive or restore projects",
+	}
+	cmd.AddCommand(adminProjectArchiveCommand(l, conf))
+	cmd.AddCommand(adminProjectRestoreCommand(l, conf))
+	return cmd
+}
+
+func adminNamespaceDeleteCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		request     = &pb.DeleteNamespaceRequest{}
+		skipConfirm bool
+	)
+	cmd := &cli.Command{
+		Use:   "delete",
+		Short: "Remove jobs of a namespace from scheduler and archive it",
+		Long: `Removes jobs of a namespace from scheduler and archives the namespace along
+with its job and resource specifications. An archived namespace can be
+restored till the retention period configured on server is over.`,
+		Example: "optimus admin namespace delete kush --project a-data-project",
+		Args:    cli.ExactArgs(1),
+	}
+	cmd.Flags().StringVarP(&request.ProjectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().BoolVar(&request.DropResources, "drop-resources", false, "delete resources of the namespace from datastores as well")
+	cmd.Flags().BoolVar(&request.Force, "force", false, "delete even if jobs outside of the namespace depend on its jobs")
+	cmd.Flags().BoolVarP(&skipConfirm, "yes", "y", false, "delete without asking for confirmation")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		request.Namespace = args[0]
+		if !skipConfirm {
+			confirmed, err := askConfirmation(deletionQuestion("namespace", request.Namespace, request.DropResources))
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				l.Println("aborted")
+				return nil
+			}
+		}
+
+		var resp *pb.DeleteNamespaceResponse
+		err := callRuntimeService(l, conf, func(ctx context.Context, runtime pb.RuntimeServiceClient) (err error) {
+			resp, err = runtime.DeleteNamespace(ctx, request)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to delete namespace %s", request.Namespace)
+		}
+		printArchived(l, resp.GetMessage(), resp.GetDeletedJobs(), resp.GetDeletedResources(), resp.GetRestorableUntil().AsTime())
+		return nil
+	}
+	return cmd
+}
+
+func adminNamespaceRestoreCommand(l logger, conf config.Provider) *cli.Command {
+	request := &pb.RestoreNamespaceRequest{}
+	cmd := &cli.Command{
+		Use:     "restore",
+		Short:   "Restore an archived namespace and deploy its jobs again",
+		Example: "optimus admin namespace restore kush --project a-data-project",
+		Args:    cli.ExactArgs(1),
+	}
+	cmd.Flags().StringVarP(&request.ProjectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		request.Namespace = args[0]
+		var resp *pb.RestoreNamespaceResponse
+		err := callRuntimeService(l, conf, func(ctx context.Context, runtime pb.RuntimeServiceClient) (err error) {
+			resp, err = runtime.RestoreNamespace(ctx, request)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to restore namespace %s", request.Namespace)
+		}
+		l.Println(coloredSuccess(resp.GetMessage()))
+		return nil
+	}
+	return cmd
+}
+
+func adminProjectArchiveCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		request     = &pb.ArchiveProjectRequest{}
+		skipConfirm bool
+	)
+	cmd := &cli.Command{
+		Use:   "archive",
+		Short: "Remove jobs of a project from scheduler and archive it",
+		Long: `Removes jobs of all namespaces of a project from scheduler and archives the
+project along with its namespaces, secrets, job and resource specifications.
+An archived project can be restored till the retention period configured on
+server is over.`,
+		Example: "optimus admin project archive a-data-project",
+		Args:    cli.ExactArgs(1),
+	}
+	cmd.Flags().BoolVar(&request.DropResources, "drop-resources", false, "delete resources of the project from datastores as well")
+	cmd.Flags().BoolVar(&request.Force, "force", false, "archive even if jobs of other projects depend on its jobs")
+	cmd.Flags().BoolVarP(&skipConfirm, "yes", "y", false, "archive without asking for confirmation")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		request.ProjectName = args[0]
+		if !skipConfirm {
+			confirmed, err := askConfirmation(deletionQuestion("project", request.ProjectName, request.DropResources))
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				l.Println("aborted")
+				return nil
+			}
+		}
+
+		var resp *pb.ArchiveProjectResponse
+		err := callRuntimeService(l, conf, func(ctx context.Context, runtime pb.RuntimeServiceClient) (err error) {
+			resp, err = runtime.ArchiveProject(ctx, request)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to archive project %s", request.ProjectName)
+		}
+		printArchived(l, resp.GetMessage(), resp.GetDeletedJobs(), resp.GetDeletedResources(), resp.GetRestorableUntil().AsTime())
+		return nil
+	}
+	return cmd
+}
+
+func adminProjectRestoreCommand(l logger, conf config.Provider) *cli.Command {
+	request := &pb.RestoreProjectRequest{}
+	cmd := &cli.Command{
+		Use:     "restore",
+		Short:   "Restore an archived project and deploy its jobs again",
+		Example: "optimus admin project restore a-data-project",
+		Args:    cli.ExactArgs(1),
+	}
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		request.ProjectName = args[0]
+		var resp *pb.RestoreProjectResponse
+		err := callRuntimeService(l, conf, func(ctx context.Context, runtime pb.RuntimeServiceClient) (err error) {
+			resp, err = runtime.RestoreProject(ctx, request)
+			return err
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to restore project %s", request.ProjectName)
+		}
+		l.Println(coloredSuccess(resp.GetMessage()))
+		return nil
+	}
+	return cmd
+}
+
+func deletionQuestion(kind, name string, dropResources bool) string {
+	question := fmt.Sprintf("remove jobs of %s %s from scheduler and archive it?", kind, name)
+	if dropResources {
+		question = fmt.Sprintf("remove jobs of %s %s from scheduler, delete its resources from datastores permanently and archive it?",
+			kind, name)
+	}
+	return question
+}
+
+// callRuntimeService dials optimus and calls runtime service with a timeout
+// long enough for all jobs of a project to be deployed or removed
+func callRuntimeService(l logger, conf config.Provider, call func(context.Context, pb.RuntimeServiceClient) error) error {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	conn, err := createConnection(dialTimeoutCtx, conf.GetHost())
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("can't reach optimus service, timing out")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminArchiveTimeout)
+	defer cancel()
+	return call(timeoutCtx, pb.NewRuntimeServiceClient(conn))
+}
+
+func printArchived(l logger, message string, jobs, resources []string, restorableUntil time.Time) {
+	l.Println(coloredSuccess(message))
+	if len(jobs) > 0 {
+		l.Printf("removed %d job(s) from scheduler:\n  %s\n", len(jobs), strings.Join(jobs, "\n  "))
+	}
+	if len(resources) > 0 {
+		l.Printf("deleted %d resource(s):\n  %s\n", len(resources), strings.Join(resources, "\n  "))
+	}
+	l.Printf("can be restored until %s\n", restorableUntil.Format(time.RFC3339))
+}
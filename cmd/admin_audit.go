@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/config"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	adminAuditTimeout = time.Minute * 1
+)
+
+func adminAuditCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		optimusHost string
+		request     = &pb.ListAuditEventsRequest{}
+		since       string
+		until       string
+	)
+	cmd := &cli.Command{
+		Use:   "audit",
+		Short: "List mutating requests made to optimus in a project, latest first",
+		Long: `Lists who deployed, deleted or replayed jobs and resources or registered
+projects, namespaces and secrets. --since and --until accept a time in
+RFC3339 format or a duration before now, e.g. 24h.`,
+		Example: "optimus admin audit --project a-data-project --operation DeleteJobSpecification --since 168h",
+	}
+	cmd.Flags().StringVarP(&request.ProjectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", "", "only list requests made in this namespace")
+	cmd.Flags().StringVar(&request.Actor, "actor", "", "only list requests made by this actor, e.g. token:ci")
+	cmd.Flags().StringVar(&request.Operation, "operation", "", "only list requests to this method, e.g. RegisterSecret")
+	cmd.Flags().StringVar(&since, "since", "", "only list requests made at or after this time")
+	cmd.Flags().StringVar(&until, "until", "", "only list requests made before this time")
+	cmd.Flags().Int32Var(&request.Limit, "limit", 100, "maximum number of requests listed")
+	cmd.Flags().StringVar(&optimusHost, "host", conf.GetHost(), "optimus service endpoint url")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		now := time.Now().UTC()
+		if since != "" {
+			t, err := parseAuditTime(since, now)
+			if err != nil {
+				return errors.Wrap(err, "invalid --since")
+			}
+			request.Since = timestamppb.New(t)
+		}
+		if until != "" {
+			t, err := parseAuditTime(until, now)
+			if err != nil {
+				return errors.Wrap(err, "invalid --until")
+			}
+			request.Until = timestamppb.New(t)
+		}
+
+		events, err := listAuditEventsRequest(l, optimusHost, request)
+		if err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			l.Println("no audit events found")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(l.Writer())
+		table.SetBorder(false)
+		table.SetAutoWrapText(false)
+		table.SetHeader([]string{"Time", "Actor", "Namespace", "Operation", "Targets", "Outcome"})
+		for _, event := range events {
+			outcome := event.GetOutcome()
+			if event.GetError() != "" {
+				outcome += ": " + event.GetError()
+			}
+			table.Append([]string{
+				event.GetCreatedAt().AsTime().Format(time.RFC3339),
+				event.GetActor(),
+				event.GetNamespace(),
+				event.GetOperation(),
+				strings.Join(event.GetTargets(), "\n"),
+				outcome,
+			})
+		}
+		table.Render()
+		return nil
+	}
+	return cmd
+}
+
+// parseAuditTime reads a RFC3339 time or a duration before now
+func parseAuditTime(value string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(value); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
+
+func listAuditEventsRequest(l logger, host string, request *pb.ListAuditEventsRequest) ([]*pb.AuditEvent, error) {
+	var err error
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("can't reach optimus service, timing out")
+		}
+		return nil, err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), adminAuditTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	response, err := runtime.ListAuditEvents(timeoutCtx, request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "request failed for project %s", request.ProjectName)
+	}
+	return response.GetEvents(), nil
+}
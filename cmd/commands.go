@@ -10,10 +10,13 @@ import (
 	"github.com/odpf/optimus/store/local"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 
 	"github.com/fatih/color"
+	"github.com/odpf/optimus/auth"
 	"github.com/odpf/optimus/config"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/telemetry"
 	cli "github.com/spf13/cobra"
 )
 
@@ -34,6 +37,13 @@ var (
 	GRPCMaxClientRecvSize = 45 << 20 // 45MB
 
 	OptimusDialTimeout = time.Second * 2
+
+	// credentials sent with every call to optimus server, read from
+	// auth.token config or OPTIMUS_AUTH_TOKEN env
+	authToken = ""
+
+	// tls settings of connections to optimus server, read from tls config
+	clientTLS config.TLSConfig
 )
 
 func programPrologue(ver string) string {
@@ -70,6 +80,8 @@ func New(
 		SilenceUsage: true,
 	}
 	cmd.PersistentFlags().BoolVar(&disableColoredOut, "no-color", disableColoredOut, "disable colored output")
+	authToken = conf.GetAuth().Token
+	clientTLS = conf.GetTLS()
 
 	//init local specs
 	var jobSpecRepo JobSpecRepository
@@ -93,25 +105,45 @@ func New(
 	cmd.AddCommand(validateCommand(l, conf.GetHost(), pluginRepo, jobSpecRepo))
 	cmd.AddCommand(optimusServeCommand(l, conf))
 	cmd.AddCommand(replayCommand(l, conf))
+	cmd.AddCommand(graphCommand(l, conf))
+	cmd.AddCommand(resourceCommand(l, conf, dsRepo, datastoreSpecsFs))
+	if jobSpecRepo != nil {
+		cmd.AddCommand(planCommand(l, conf.GetHost(), pluginRepo, jobSpecRepo))
+	}
 
 	// admin specific commands
 	if conf.GetAdmin().Enabled {
-		cmd.AddCommand(adminCommand(l, pluginRepo))
+		cmd.AddCommand(adminCommand(l, conf, pluginRepo))
 	}
 
 	return cmd
 }
 
 func createConnection(ctx context.Context, host string) (*grpc.ClientConn, error) {
+	transportOpt := grpc.WithInsecure()
+	if clientTLS.Enabled || clientTLS.CAFile != "" || clientTLS.CertFile != "" {
+		tlsConfig, err := auth.NewClientTLSConfig(clientTLS.CAFile, clientTLS.CertFile, clientTLS.KeyFile, clientTLS.ServerName)
+		if err != nil {
+			return nil, err
+		}
+		transportOpt = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+
 	var opts []grpc.DialOption
 	opts = append(opts,
-		grpc.WithInsecure(),
+		transportOpt,
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallSendMsgSize(GRPCMaxClientSendSize),
 			grpc.MaxCallRecvMsgSize(GRPCMaxClientRecvSize),
 		),
+		// failures carry trace id of the request for looking them up on server
+		grpc.WithUnaryInterceptor(telemetry.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(telemetry.StreamClientInterceptor()),
 	)
+	if authToken != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.NewTokenCredentials(authToken)))
+	}
 
 	conn, err := grpc.DialContext(ctx, host, opts...)
 	if err != nil {
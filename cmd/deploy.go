@@ -31,6 +31,11 @@ func deployCommand(l logger, conf config.Provider, jobSpecRepo JobSpecRepository
 	var namespace string
 	var ignoreJobs bool
 	var ignoreResources bool
+	var pruneResources bool
+	var skipPruneConfirm bool
+	var allowBreakingChanges bool
+	var planOnly bool
+	var detach bool
 
 	cmd := &cli.Command{
 		Use:   "deploy",
@@ -42,8 +47,19 @@ func deployCommand(l logger, conf config.Provider, jobSpecRepo JobSpecRepository
 	cmd.MarkFlagRequired("namespace")
 	cmd.Flags().BoolVar(&ignoreJobs, "ignore-jobs", false, "ignore deployment of jobs")
 	cmd.Flags().BoolVar(&ignoreResources, "ignore-resources", false, "ignore deployment of resources")
+	cmd.Flags().BoolVar(&pruneResources, "prune-resources", false, "delete registered resources missing from specs after confirmation")
+	cmd.Flags().BoolVar(&skipPruneConfirm, "yes", false, "prune all missing resources without asking for confirmation")
+	cmd.Flags().BoolVar(&allowBreakingChanges, "allow-breaking-changes", false, "apply schema changes of resources breaking existing data or readers")
+	cmd.Flags().BoolVar(&planOnly, "plan", false, "print what deployment would do to resources without changing anything")
+	cmd.Flags().BoolVar(&detach, "detach", false, "return once jobs are queued for deployment instead of waiting for it to finish")
 
 	cmd.RunE = func(c *cli.Command, args []string) error {
+		if planOnly {
+			l.Printf("planning resources of project %s for namespace %s at %s\nplease wait...\n", projectName, namespace, conf.GetHost())
+			return postResourcePlanRequest(l, projectName, namespace, conf, pluginRepo, datastoreRepo, datastoreSpecFs,
+				pruneResources, allowBreakingChanges)
+		}
+
 		l.Printf("deploying project %s for namespace %s at %s\nplease wait...\n", projectName, namespace, conf.GetHost())
 		start := time.Now()
 		if jobSpecRepo == nil {
@@ -52,13 +68,15 @@ func deployCommand(l logger, conf config.Provider, jobSpecRepo JobSpecRepository
 		}
 
 		if err := postDeploymentRequest(l, projectName, namespace, jobSpecRepo, conf, pluginRepo, datastoreRepo,
-			datastoreSpecFs, ignoreJobs, ignoreResources); err != nil {
+			datastoreSpecFs, ignoreJobs, ignoreResources, pruneResources, skipPruneConfirm, allowBreakingChanges, detach); err != nil {
 			return err
 		}
 
 		l.Printf("deployment took %v\n", time.Since(start))
 		return nil
 	}
+	cmd.AddCommand(deployStatusSubCommand(l, conf))
+	cmd.AddCommand(deployListSubCommand(l, conf))
 
 	return cmd
 }
@@ -66,7 +84,7 @@ func deployCommand(l logger, conf config.Provider, jobSpecRepo JobSpecRepository
 // postDeploymentRequest send a deployment request to service
 func postDeploymentRequest(l logger, projectName string, namespace string, jobSpecRepo JobSpecRepository,
 	conf config.Provider, pluginRepo models.PluginRepository, datastoreRepo models.DatastoreRepo, datastoreSpecFs map[string]afero.Fs,
-	ignoreJobDeployment, ignoreResources bool) (err error) {
+	ignoreJobDeployment, ignoreResources, pruneResources, skipPruneConfirm, allowBreakingChanges, detach bool) (err error) {
 	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
 	defer dialCancel()
 
@@ -130,12 +148,24 @@ func postDeploymentRequest(l logger, projectName string, namespace string, jobSp
 				adaptedSpecs = append(adaptedSpecs, adapted)
 			}
 
+			// resources removed from specs are deleted only once confirmed
+			var pruneConfirmed []string
+			if pruneResources {
+				if pruneConfirmed, err = confirmResourcesToPrune(deployTimeoutCtx, l, runtime, projectName, namespace,
+					storeName, adaptedSpecs, skipPruneConfirm); err != nil {
+					return err
+				}
+			}
+
 			// send call
 			respStream, err := runtime.DeployResourceSpecification(deployTimeoutCtx, &pb.DeployResourceSpecificationRequest{
-				Resources:     adaptedSpecs,
-				ProjectName:   projectName,
-				DatastoreName: storeName,
-				Namespace:     namespace,
+				Resources:            adaptedSpecs,
+				ProjectName:          projectName,
+				DatastoreName:        storeName,
+				Namespace:            namespace,
+				Prune:                pruneResources,
+				PruneConfirmed:       pruneConfirmed,
+				AllowBreakingChanges: allowBreakingChanges,
 			})
 			if err != nil {
 				if errors.Is(err, context.DeadlineExceeded) {
@@ -193,6 +223,7 @@ func postDeploymentRequest(l logger, projectName string, namespace string, jobSp
 			Jobs:        adaptedJobSpecs,
 			ProjectName: projectName,
 			Namespace:   namespace,
+			Detach:      detach,
 		})
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) {
@@ -201,7 +232,9 @@ func postDeploymentRequest(l logger, projectName string, namespace string, jobSp
 			return errors.Wrapf(err, "deployement failed")
 		}
 
-		jobCounter := 0
+		deploymentID := ""
+		var deployment *pb.Deployment
+		jobCounter, failedJobs := 0, 0
 		totalJobs := len(jobSpecs)
 		for {
 			resp, err := respStream.Recv()
@@ -209,20 +242,50 @@ func postDeploymentRequest(l logger, projectName string, namespace string, jobSp
 				if err == io.EOF {
 					break
 				}
-				return errors.Wrapf(err, "failed to receive deployment ack")
+				if deployment != nil {
+					// server reports failed deployments as an error after the outcome
+					break
+				}
+				if deploymentID == "" {
+					return errors.Wrapf(err, "failed to receive deployment ack")
+				}
+				// deployment carries on at the server regardless of the stream
+				l.Println(coloredNotice(fmt.Sprintf("lost progress of deployment %s, checking its status instead: %v", deploymentID, err)))
+				if deployment, err = waitForDeployment(l, runtime, projectName, deploymentID); err != nil {
+					return err
+				}
+				break
 			}
-			if resp.Ack {
+			if resp.GetDeploymentId() != "" {
+				deploymentID = resp.GetDeploymentId()
+				l.Println(resp.GetMessage())
+				if detach {
+					l.Printf("check its status with: optimus deploy status %s --project %s\n", deploymentID, projectName)
+					return nil
+				}
+			} else if resp.GetDeployment() != nil {
+				deployment = resp.GetDeployment()
+			} else if resp.Ack {
 				// ack for the job spec
+				jobCounter++
 				if !resp.GetSuccess() {
-					return errors.Errorf("unable to deploy: %s %s", resp.GetJobName(), resp.GetMessage())
+					failedJobs++
+					l.Printf("%d/%d. %s %s\n", jobCounter, totalJobs, resp.GetJobName(), coloredError("failed to deploy: "+resp.GetMessage()))
+					continue
 				}
-				jobCounter++
 				l.Printf("%d/%d. %s successfully deployed\n", jobCounter, totalJobs, resp.GetJobName())
 			} else {
 				// ordinary progress event
 				l.Printf("info '%s': %s\n", resp.GetJobName(), resp.GetMessage())
 			}
 		}
+		if deployment != nil && deployment.GetStatus() == models.DeploymentStatusFailed {
+			printDeployment(l, deployment)
+			return errors.Errorf("deployment %s failed", deployment.GetId())
+		}
+		if failedJobs > 0 {
+			return errors.Errorf("unable to deploy %d of %d jobs", failedJobs, totalJobs)
+		}
 		l.Println("deployed jobs")
 	} else {
 		l.Println("skipping job deployment")
@@ -231,3 +294,128 @@ func postDeploymentRequest(l logger, projectName string, namespace string, jobSp
 	l.Println("deployment completed successfully")
 	return nil
 }
+
+// postResourcePlanRequest prints what deploying resources would do, without
+// registering the project or changing any resource
+func postResourcePlanRequest(l logger, projectName string, namespace string, conf config.Provider,
+	pluginRepo models.PluginRepository, datastoreRepo models.DatastoreRepo, datastoreSpecFs map[string]afero.Fs,
+	pruneResources, allowBreakingChanges bool) (err error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("can't reach optimus service")
+		}
+		return err
+	}
+	defer conn.Close()
+
+	planTimeoutCtx, planCancel := context.WithTimeout(context.Background(), deploymentTimeout)
+	defer planCancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	adapt := v1handler.NewAdapter(pluginRepo, datastoreRepo)
+
+	summary := map[string]int{}
+	failedPlans := 0
+	for storeName, repoFS := range datastoreSpecFs {
+		ds, err := datastoreRepo.GetByName(storeName)
+		if err != nil {
+			return fmt.Errorf("unsupported datastore: %s\n", storeName)
+		}
+		resourceSpecs, err := local.NewResourceSpecRepository(repoFS, ds).GetAll()
+		if err == models.ErrNoResources {
+			l.Println(coloredNotice("no resource specifications found"))
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "resourceSpecRepo.GetAll()")
+		}
+
+		adaptedSpecs := []*pb.ResourceSpecification{}
+		for _, spec := range resourceSpecs {
+			adapted, err := adapt.ToResourceProto(spec)
+			if err != nil {
+				return errors.Wrapf(err, "failed to serialize: %s", spec.Name)
+			}
+			adaptedSpecs = append(adaptedSpecs, adapted)
+		}
+
+		respStream, err := runtime.DeployResourceSpecification(planTimeoutCtx, &pb.DeployResourceSpecificationRequest{
+			Resources:     adaptedSpecs,
+			ProjectName:   projectName,
+			DatastoreName: storeName,
+			Namespace:     namespace,
+			Prune:         pruneResources,
+			Plan:          true,
+		})
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Println("planning took too long, timing out")
+			}
+			return errors.Wrapf(err, "plan failed")
+		}
+
+		l.Printf("datastore %s:\n", storeName)
+		for {
+			resp, err := respStream.Recv()
+			if err != nil {
+				if err == io.EOF {
+					break
+				}
+				return errors.Wrapf(err, "failed to receive plan")
+			}
+			plan := resp.GetPlan()
+			if plan == nil {
+				continue
+			}
+			if plan.GetError() != "" {
+				failedPlans++
+				l.Printf("! %s: %s\n", plan.GetResourceName(), coloredError("failed to plan, "+plan.GetError()))
+				continue
+			}
+			summary[plan.GetAction()]++
+			printResourcePlan(l, plan, allowBreakingChanges)
+		}
+	}
+
+	l.Printf("plan: %d to create, %d to update, %d to delete, %d unchanged, %d unsupported\n",
+		summary[string(models.ResourcePlanCreate)], summary[string(models.ResourcePlanUpdate)],
+		summary[string(models.ResourcePlanDelete)], summary[string(models.ResourcePlanNoop)],
+		summary[string(models.ResourcePlanUnsupported)])
+	if failedPlans > 0 {
+		return errors.Errorf("failed to plan %d resource(s)", failedPlans)
+	}
+	return nil
+}
+
+func printResourcePlan(l logger, plan *pb.ResourcePlan, allowBreakingChanges bool) {
+	switch models.ResourcePlanAction(plan.GetAction()) {
+	case models.ResourcePlanCreate:
+		l.Printf("+ %s %s\n", plan.GetResourceName(), coloredSuccess("will be created"))
+	case models.ResourcePlanDelete:
+		l.Printf("- %s %s\n", plan.GetResourceName(), coloredError("will be deleted"))
+	case models.ResourcePlanNoop:
+		l.Printf("= %s unchanged\n", plan.GetResourceName())
+	case models.ResourcePlanUnsupported:
+		line := "can't be compared by datastore, will be updated"
+		if !allowBreakingChanges {
+			line = coloredError("can't be compared by datastore, blocked without --allow-breaking-changes")
+		}
+		l.Printf("? %s %s\n", plan.GetResourceName(), line)
+	default:
+		l.Printf("~ %s %s\n", plan.GetResourceName(), coloredNotice("will be updated"))
+		for _, field := range plan.GetFields() {
+			l.Printf("\t%s: %q -> %q\n", field.GetField(), field.GetActual(), field.GetExpected())
+		}
+		for _, change := range plan.GetSchemaChanges() {
+			line := fmt.Sprintf("%s change of %s: %q -> %q", change.GetKind(), change.GetField(), change.GetFrom(), change.GetTo())
+			if change.GetKind() == string(models.SchemaChangeBreaking) && !allowBreakingChanges {
+				line = coloredError(line + ", blocked without --allow-breaking-changes")
+			}
+			l.Printf("\t%s\n", line)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/models"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	deploymentRequestTimeout = time.Second * 30
+
+	// how often status of a deployment is checked while waiting for it
+	deploymentPollInterval = time.Second * 5
+)
+
+func deployStatusSubCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		wait        bool
+	)
+	cmd := &cli.Command{
+		Use:     "status",
+		Short:   "Get status of a job deployment along with outcome of its jobs",
+		Example: "optimus deploy status 2a6e0bd1-7c49-4e49-92d2-ad6d3a5bb2b7 --project a-data-project --wait",
+		Args:    cli.ExactArgs(1),
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().BoolVar(&wait, "wait", false, "wait till deployment finishes")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		conn, err := dialDeploymentService(l, conf)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		runtime := pb.NewRuntimeServiceClient(conn)
+
+		var deployment *pb.Deployment
+		if wait {
+			deployment, err = waitForDeployment(l, runtime, projectName, args[0])
+		} else {
+			deployment, err = getDeployment(runtime, projectName, args[0])
+		}
+		if err != nil {
+			return err
+		}
+		printDeployment(l, deployment)
+		if deployment.GetStatus() == models.DeploymentStatusFailed {
+			return errors.Errorf("deployment %s failed", deployment.GetId())
+		}
+		return nil
+	}
+	return cmd
+}
+
+func deployListSubCommand(l logger, conf config.Provider) *cli.Command {
+	request := &pb.ListDeploymentsRequest{}
+	cmd := &cli.Command{
+		Use:     "list",
+		Short:   "List job deployments of a project, latest first",
+		Example: "optimus deploy list --project a-data-project --namespace reporting",
+	}
+	cmd.Flags().StringVarP(&request.ProjectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&request.Namespace, "namespace", "n", "", "only list deployments of this namespace")
+	cmd.Flags().Int32Var(&request.Limit, "limit", models.DeploymentDefaultLimit, "maximum number of deployments listed")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		conn, err := dialDeploymentService(l, conf)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+
+		requestCtx, cancel := context.WithTimeout(context.Background(), deploymentRequestTimeout)
+		defer cancel()
+		resp, err := pb.NewRuntimeServiceClient(conn).ListDeployments(requestCtx, request)
+		if err != nil {
+			return errors.Wrapf(err, "failed to list deployments of project %s", request.ProjectName)
+		}
+		if len(resp.GetDeployments()) == 0 {
+			l.Println("no deployments found")
+			return nil
+		}
+
+		table := tablewriter.NewWriter(l.Writer())
+		table.SetBorder(false)
+		table.SetAutoWrapText(false)
+		table.SetHeader([]string{"ID", "Namespace", "Status", "Jobs", "Created", "Updated"})
+		for _, deployment := range resp.GetDeployments() {
+			failedJobs := 0
+			for _, result := range deployment.GetJobs() {
+				if !result.GetSuccess() {
+					failedJobs++
+				}
+			}
+			table.Append([]string{
+				deployment.GetId(),
+				deployment.GetNamespace(),
+				deployment.GetStatus(),
+				fmt.Sprintf("%d deployed, %d failed", len(deployment.GetJobs())-failedJobs, failedJobs),
+				deployment.GetCreatedAt().AsTime().Format(time.RFC3339),
+				deployment.GetUpdatedAt().AsTime().Format(time.RFC3339),
+			})
+		}
+		table.Render()
+		return nil
+	}
+	return cmd
+}
+
+func dialDeploymentService(l logger, conf config.Provider) (*grpc.ClientConn, error) {
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	conn, err := createConnection(dialTimeoutCtx, conf.GetHost())
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("can't reach optimus service, timing out")
+		}
+		return nil, err
+	}
+	return conn, nil
+}
+
+func getDeployment(runtime pb.RuntimeServiceClient, projectName, deploymentID string) (*pb.Deployment, error) {
+	requestCtx, cancel := context.WithTimeout(context.Background(), deploymentRequestTimeout)
+	defer cancel()
+
+	resp, err := runtime.GetDeployment(requestCtx, &pb.GetDeploymentRequest{
+		ProjectName:  projectName,
+		DeploymentId: deploymentID,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get deployment %s", deploymentID)
+	}
+	return resp.GetDeployment(), nil
+}
+
+// waitForDeployment polls status of deployment till it finishes
+func waitForDeployment(l logger, runtime pb.RuntimeServiceClient, projectName, deploymentID string) (*pb.Deployment, error) {
+	for {
+		deployment, err := getDeployment(runtime, projectName, deploymentID)
+		if err != nil {
+			return nil, err
+		}
+		status := deployment.GetStatus()
+		if status == models.DeploymentStatusSucceeded || status == models.DeploymentStatusFailed {
+			return deployment, nil
+		}
+		l.Printf("deployment %s is %s, %d job(s) uploaded so far\n", deploymentID, status, len(deployment.GetJobs()))
+		time.Sleep(deploymentPollInterval)
+	}
+}
+
+func printDeployment(l logger, deployment *pb.Deployment) {
+	l.Printf("deployment %s of namespace %s: %s\n", deployment.GetId(), deployment.GetNamespace(), deployment.GetStatus())
+	for _, result := range deployment.GetJobs() {
+		if result.GetSuccess() {
+			l.Printf("\t%s %s\n", result.GetJobName(), coloredSuccess("deployed"))
+		} else {
+			l.Printf("\t%s %s\n", result.GetJobName(), coloredError("failed, "+result.GetMessage()))
+		}
+	}
+	if deployment.GetMessage() != "" {
+		l.Println(coloredError(deployment.GetMessage()))
+	}
+}
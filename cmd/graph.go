@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/config"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	graphTimeout = time.Minute * 1
+
+	graphFormatJSON    = "json"
+	graphFormatDOT     = "dot"
+	graphFormatMermaid = "mermaid"
+)
+
+var graphFormatters = map[string]func(*pb.GetJobGraphResponse) (string, error){
+	graphFormatJSON:    formatGraphJSON,
+	graphFormatDOT:     formatGraphDOT,
+	graphFormatMermaid: formatGraphMermaid,
+}
+
+func graphCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		projectName string
+		jobName     string
+		depth       int32
+		format      string
+	)
+
+	cmd := &cli.Command{
+		Use:   "graph",
+		Short: "Export resolved dependency graph of jobs in a project",
+		Long: `Fetches the resolved lineage of jobs registered in a project including
+inter project and unknown dependencies. Graph can be limited to the
+upstream and downstream of a single job using --job and --depth.`,
+		Example: "optimus graph --project a-data-project --job sample_job --depth 2 --format dot",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVarP(&jobName, "job", "j", "", "limit graph to upstream and downstream of this job")
+	cmd.Flags().Int32Var(&depth, "depth", 0, "levels of upstream and downstream to include around job, 0 includes all")
+	cmd.Flags().StringVarP(&format, "format", "f", graphFormatJSON, "output format, one of json|dot|mermaid")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		formatter, ok := graphFormatters[format]
+		if !ok {
+			return errors.Errorf("unsupported graph format %s, use one of json|dot|mermaid", format)
+		}
+
+		graph, err := getJobGraphRequest(l, projectName, jobName, depth, conf)
+		if err != nil {
+			return err
+		}
+
+		out, err := formatter(graph)
+		if err != nil {
+			return err
+		}
+		l.Println(out)
+		return nil
+	}
+	return cmd
+}
+
+func getJobGraphRequest(l logger, projectName, jobName string, depth int32, conf config.Provider) (*pb.GetJobGraphResponse, error) {
+	var err error
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, conf.GetHost()); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("can't reach optimus service, timing out")
+		}
+		return nil, err
+	}
+	defer conn.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), graphTimeout)
+	defer cancel()
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	graph, err := runtime.GetJobGraph(timeoutCtx, &pb.GetJobGraphRequest{
+		ProjectName: projectName,
+		JobName:     jobName,
+		Depth:       depth,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println(coloredError("fetching job graph took too long, timing out"))
+		}
+		return nil, errors.Wrapf(err, "request failed for project %s", projectName)
+	}
+	return graph, nil
+}
+
+func formatGraphJSON(graph *pb.GetJobGraphResponse) (string, error) {
+	out, err := protojson.MarshalOptions{
+		Multiline:       true,
+		Indent:          "  ",
+		EmitUnpopulated: true,
+	}.Marshal(graph)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode graph as json")
+	}
+	return string(out), nil
+}
+
+func formatGraphDOT(graph *pb.GetJobGraphResponse) (string, error) {
+	var sb strings.Builder
+	sb.WriteString("digraph optimus {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, node := range graph.Nodes {
+		shape := "box"
+		if node.Type == "unknown" {
+			shape = "ellipse"
+		}
+		sb.WriteString(fmt.Sprintf("  %q [label=%q, shape=%s];\n", node.Id, node.Id, shape))
+	}
+	for _, edge := range graph.Edges {
+		style := "solid"
+		if edge.Type != "intra" {
+			style = "dashed"
+		}
+		sb.WriteString(fmt.Sprintf("  %q -> %q [style=%s];\n", edge.Upstream, edge.Downstream, style))
+	}
+	sb.WriteString("}")
+	return sb.String(), nil
+}
+
+func formatGraphMermaid(graph *pb.GetJobGraphResponse) (string, error) {
+	// mermaid ids can't contain special characters, nodes are referred by index
+	// and the actual id is used as label
+	ids := map[string]string{}
+	var sb strings.Builder
+	sb.WriteString("graph LR\n")
+	for i, node := range graph.Nodes {
+		ids[node.Id] = fmt.Sprintf("n%d", i)
+		label := strings.ReplaceAll(node.Id, "\"", "#quot;")
+		if node.Type == "unknown" {
+			sb.WriteString(fmt.Sprintf("  %s([\"%s\"])\n", ids[node.Id], label))
+		} else {
+			sb.WriteString(fmt.Sprintf("  %s[\"%s\"]\n", ids[node.Id], label))
+		}
+	}
+	for _, edge := range graph.Edges {
+		arrow := "-->"
+		if edge.Type != "intra" {
+			arrow = "-.->"
+		}
+		sb.WriteString(fmt.Sprintf("  %s %s %s\n", ids[edge.Upstream], arrow, ids[edge.Downstream]))
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
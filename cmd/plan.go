@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	v1handler "github.com/odpf/optimus/api/handler/v1"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+const (
+	planTimeout = time.Minute * 5
+)
+
+func planCommand(l logger, host string, pluginRepo models.PluginRepository, jobSpecRepo JobSpecRepository) *cli.Command {
+	var projectName string
+	var namespace string
+	cmd := &cli.Command{
+		Use:   "plan",
+		Short: "Show the impact of deploying local job specifications without deploying them",
+		Long: `Resolves local job specifications against the registered state and reports
+jobs to be added, removed or changed along with dependencies which will break
+or start being used and downstream jobs of other projects affected by it.`,
+		Example: "optimus plan --project a-data-project --namespace kush",
+	}
+	cmd.Flags().StringVar(&projectName, "project", "", "name of the project")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "namespace of deployee")
+	cmd.MarkFlagRequired("namespace")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		jobSpecs, err := jobSpecRepo.GetAll()
+		if err != nil {
+			return err
+		}
+
+		plan, err := planDeploymentRequest(l, projectName, namespace, pluginRepo, jobSpecs, host)
+		if err != nil {
+			return err
+		}
+		printDeploymentPlan(l, plan)
+		return nil
+	}
+	return cmd
+}
+
+func planDeploymentRequest(l logger, projectName string, namespace string,
+	pluginRepo models.PluginRepository, jobSpecs []models.JobSpec, host string) (*pb.PlanDeploymentResponse, error) {
+	adapt := v1handler.NewAdapter(pluginRepo, models.DatastoreRegistry)
+
+	var err error
+	dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+	defer dialCancel()
+
+	var conn *grpc.ClientConn
+	if conn, err = createConnection(dialTimeoutCtx, host); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("can't reach optimus service")
+		}
+		return nil, err
+	}
+	defer conn.Close()
+
+	planTimeoutCtx, planCancel := context.WithTimeout(context.Background(), planTimeout)
+	defer planCancel()
+
+	adaptedJobSpecs := []*pb.JobSpecification{}
+	for _, spec := range jobSpecs {
+		adaptJob, err := adapt.ToJobProto(spec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to serialize: %s", spec.Name)
+		}
+		adaptedJobSpecs = append(adaptedJobSpecs, adaptJob)
+	}
+
+	l.Println("planning deployment please wait...")
+
+	runtime := pb.NewRuntimeServiceClient(conn)
+	plan, err := runtime.PlanDeployment(planTimeoutCtx, &pb.PlanDeploymentRequest{
+		ProjectName: projectName,
+		Jobs:        adaptedJobSpecs,
+		Namespace:   namespace,
+	})
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			l.Println("plan process took too long, timing out")
+		}
+		return nil, errors.Wrapf(err, "plan request failed")
+	}
+	return plan, nil
+}
+
+func printDeploymentPlan(l logger, plan *pb.PlanDeploymentResponse) {
+	if len(plan.GetAddedJobs()) == 0 && len(plan.GetRemovedJobs()) == 0 && len(plan.GetChangedJobs()) == 0 &&
+		len(plan.GetDependencyChanges()) == 0 {
+		l.Println(coloredSuccess("no changes, registered jobs are up to date"))
+		return
+	}
+
+	if len(plan.GetAddedJobs()) > 0 {
+		l.Println(coloredNotice("jobs to be added:"))
+		for _, name := range plan.GetAddedJobs() {
+			l.Printf("  + %s\n", name)
+		}
+	}
+	if len(plan.GetRemovedJobs()) > 0 {
+		l.Println(coloredNotice("jobs to be removed:"))
+		for _, name := range plan.GetRemovedJobs() {
+			l.Printf("  - %s\n", name)
+		}
+	}
+	if len(plan.GetChangedJobs()) > 0 {
+		l.Println(coloredNotice("jobs to be changed:"))
+		for _, change := range plan.GetChangedJobs() {
+			l.Printf("  ~ %s [%s]\n", change.GetName(), strings.Join(change.GetFields(), ", "))
+			if change.GetOldDestination() != change.GetNewDestination() {
+				l.Printf("      destination: %s -> %s\n", change.GetOldDestination(), change.GetNewDestination())
+			}
+		}
+	}
+
+	if len(plan.GetDependencyChanges()) > 0 {
+		l.Println(coloredNotice("dependency changes:"))
+		for _, change := range plan.GetDependencyChanges() {
+			l.Printf("  %s\n", change.GetJobId())
+			for _, upstream := range change.GetAdded() {
+				l.Printf("      + %s\n", upstream)
+			}
+			for _, upstream := range change.GetRemoved() {
+				l.Println(coloredError("      - " + upstream))
+			}
+			for _, destination := range change.GetUnknown() {
+				l.Println(coloredError("      ? " + destination + " will not be produced by any job"))
+			}
+			if change.GetError() != "" {
+				l.Println(coloredError("      ! " + change.GetError()))
+			}
+		}
+	}
+
+	if len(plan.GetAffectedJobs()) > 0 {
+		l.Println(coloredNotice("affected downstream jobs:"))
+		for _, node := range plan.GetAffectedJobs() {
+			l.Printf("  %s\n", node.GetId())
+		}
+	}
+}
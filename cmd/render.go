@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -64,7 +65,7 @@ func renderTemplateCommand(l logger, jobSpecRepo JobSpecRepository) *cli.Command
 		now := time.Now()
 		l.Println("assuming execution time as current time of", now.Format(models.InstanceScheduledAtTimeLayout))
 
-		templates, err := instance.DumpAssets(jobSpec, now, templateEngine, true)
+		templates, err := instance.DumpAssets(context.Background(), jobSpec, now, templateEngine, true)
 		if err != nil {
 			return err
 		}
@@ -137,5 +138,8 @@ func renderJobSpecificationBuildRequest(l logger, projectName, namespace, jobNam
 	}
 
 	l.Println(jobResponse.GetContent())
+	if jobResponse.GetPriorityReason() != "" {
+		l.Println(coloredNotice(fmt.Sprintf("priority weight: %d, %s", jobResponse.GetPriorityWeight(), jobResponse.GetPriorityReason())))
+	}
 	return nil
 }
@@ -0,0 +1,316 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/datastore"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store/local"
+	"github.com/pkg/errors"
+	"github.com/spf13/afero"
+	cli "github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const (
+	resourceTimeout = time.Minute * 2
+
+	// credentialsEnv points to service account used to read existing resources
+	credentialsEnv = "GOOGLE_APPLICATION_CREDENTIALS"
+)
+
+// resourceCommand manages resources of a datastore registered with optimus service
+func resourceCommand(l logger, conf config.Provider, datastoreRepo models.DatastoreRepo,
+	datastoreSpecFs map[string]afero.Fs) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "resource",
+		Short: "Manage datastore resources registered with optimus service",
+	}
+	cmd.AddCommand(resourceDeleteCommand(l, conf))
+	cmd.AddCommand(resourceDriftCommand(l, conf))
+	cmd.AddCommand(resourceImportCommand(l, datastoreRepo, datastoreSpecFs))
+	return cmd
+}
+
+func resourceImportCommand(l logger, datastoreRepo models.DatastoreRepo, datastoreSpecFs map[string]afero.Fs) *cli.Command {
+	var (
+		datastoreName  string
+		datasetName    string
+		tablePattern   string
+		serviceAccount string
+	)
+
+	cmd := &cli.Command{
+		Use:   "import",
+		Short: "Generate specifications of resources already existing in datastore",
+		Long: `Reads metadata of a dataset along with its tables and views from datastore
+and writes them as resource specifications in the configured datastore path.
+Existing specifications of the same resources are overwritten.`,
+		Example: "optimus resource import --dataset project.dataset --table 'orders_*'",
+		Args:    cli.NoArgs,
+	}
+	cmd.Flags().StringVar(&datastoreName, "datastore", "bigquery", "datastore of the resources")
+	cmd.Flags().StringVar(&datasetName, "dataset", "", "dataset to import, for example 'project.dataset'")
+	cmd.MarkFlagRequired("dataset")
+	cmd.Flags().StringVar(&tablePattern, "table", "", "import only tables matching the pattern, for example 'orders_*'")
+	cmd.Flags().StringVar(&serviceAccount, "service-account", os.Getenv(credentialsEnv),
+		fmt.Sprintf("path to service account json used to read resources, defaults to $%s", credentialsEnv))
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		ds, err := datastoreRepo.GetByName(datastoreName)
+		if err != nil {
+			return err
+		}
+		importer, ok := ds.(models.DatastoreImporter)
+		if !ok {
+			return errors.Errorf("datastore %s doesn't support importing resources", datastoreName)
+		}
+		repoFS, ok := datastoreSpecFs[datastoreName]
+		if !ok {
+			return errors.Errorf("unregistered datastore %s, please use configuration file to set datastore path", datastoreName)
+		}
+		if serviceAccount == "" {
+			return errors.Errorf("service account is required, use --service-account or $%s", credentialsEnv)
+		}
+		secret, err := ioutil.ReadFile(serviceAccount)
+		if err != nil {
+			return errors.Wrap(err, "failed to read service account")
+		}
+
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), resourceTimeout)
+		defer cancel()
+
+		resourceSpecs, err := importer.ImportResources(timeoutCtx, models.ImportResourcesRequest{
+			Secret:  string(secret),
+			Parent:  datasetName,
+			Pattern: tablePattern,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "failed to import resources of %s", datasetName)
+		}
+
+		resourceSpecRepo := local.NewResourceSpecRepository(repoFS, ds)
+		for _, resourceSpec := range resourceSpecs {
+			if err := resourceSpecRepo.Save(resourceSpec); err != nil {
+				return errors.Wrapf(err, "failed to save resource %s", resourceSpec.Name)
+			}
+			l.Printf("%s: %s\n", resourceSpec.Name, coloredSuccess("imported"))
+		}
+		l.Printf("imported %d resource(s)\n", len(resourceSpecs))
+		return nil
+	}
+	return cmd
+}
+
+func resourceDeleteCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		projectName   string
+		namespace     string
+		datastoreName string
+		skipConfirm   bool
+	)
+
+	cmd := &cli.Command{
+		Use:     "delete",
+		Short:   "Delete a resource from datastore and optimus service",
+		Example: "optimus resource delete project.dataset.table --project a-data-project --namespace kush",
+		Args:    cli.ExactArgs(1),
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "namespace of the resource")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&datastoreName, "datastore", "bigquery", "datastore of the resource")
+	cmd.Flags().BoolVarP(&skipConfirm, "yes", "y", false, "delete without asking for confirmation")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		resourceName := args[0]
+		if !skipConfirm {
+			confirmed, err := askConfirmation(fmt.Sprintf("delete resource %s from datastore %s permanently?", resourceName, datastoreName))
+			if err != nil {
+				return err
+			}
+			if !confirmed {
+				l.Println("aborted")
+				return nil
+			}
+		}
+
+		dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+		defer dialCancel()
+
+		conn, err := createConnection(dialTimeoutCtx, conf.GetHost())
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Println("can't reach optimus service, timing out")
+			}
+			return err
+		}
+		defer conn.Close()
+
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), resourceTimeout)
+		defer cancel()
+
+		runtime := pb.NewRuntimeServiceClient(conn)
+		if _, err := runtime.DeleteResource(timeoutCtx, &pb.DeleteResourceRequest{
+			ProjectName:   projectName,
+			DatastoreName: datastoreName,
+			ResourceName:  resourceName,
+			Namespace:     namespace,
+		}); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Println(coloredError("resource deletion took too long, timing out"))
+			}
+			return errors.Wrapf(err, "failed to delete resource %s", resourceName)
+		}
+		l.Println(coloredSuccess(fmt.Sprintf("deleted resource %s", resourceName)))
+		return nil
+	}
+	return cmd
+}
+
+func resourceDriftCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		projectName   string
+		namespace     string
+		datastoreName string
+	)
+
+	cmd := &cli.Command{
+		Use:   "drift",
+		Short: "Compare resource specifications with live resources of datastore",
+		Long: `Reads resources registered in a namespace back from datastore and reports
+every field which doesn't match the specification. All resources of the
+namespace are compared if no resource name is provided.`,
+		Example: "optimus resource drift [resource-name]... --project a-data-project --namespace kush",
+	}
+	cmd.Flags().StringVarP(&projectName, "project", "p", "", "project name of optimus managed repository")
+	cmd.MarkFlagRequired("project")
+	cmd.Flags().StringVar(&namespace, "namespace", "", "namespace of the resources")
+	cmd.MarkFlagRequired("namespace")
+	cmd.Flags().StringVar(&datastoreName, "datastore", "bigquery", "datastore of the resources")
+
+	cmd.RunE = func(c *cli.Command, args []string) error {
+		dialTimeoutCtx, dialCancel := context.WithTimeout(context.Background(), OptimusDialTimeout)
+		defer dialCancel()
+
+		conn, err := createConnection(dialTimeoutCtx, conf.GetHost())
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Println("can't reach optimus service, timing out")
+			}
+			return err
+		}
+		defer conn.Close()
+
+		timeoutCtx, cancel := context.WithTimeout(context.Background(), resourceTimeout)
+		defer cancel()
+
+		runtime := pb.NewRuntimeServiceClient(conn)
+		driftResponse, err := runtime.DetectResourceDrift(timeoutCtx, &pb.DetectResourceDriftRequest{
+			ProjectName:   projectName,
+			DatastoreName: datastoreName,
+			Namespace:     namespace,
+			ResourceNames: args,
+		})
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				l.Println(coloredError("drift detection took too long, timing out"))
+			}
+			return errors.Wrap(err, "failed to detect drift")
+		}
+
+		driftCount := 0
+		for _, resource := range driftResponse.GetResources() {
+			switch {
+			case resource.GetError() != "":
+				l.Printf("%s: %s\n", resource.GetResourceName(), coloredError("failed to compare, "+resource.GetError()))
+			case resource.GetMissing():
+				driftCount++
+				l.Printf("%s: %s\n", resource.GetResourceName(), coloredError("doesn't exist in datastore"))
+			case resource.GetDrifted():
+				driftCount++
+				l.Printf("%s: %s\n", resource.GetResourceName(), coloredNotice("drifted"))
+				for _, field := range resource.GetFields() {
+					l.Printf("\t%s: expected %q, found %q\n", field.GetField(), field.GetExpected(), field.GetActual())
+				}
+			default:
+				l.Printf("%s: %s\n", resource.GetResourceName(), coloredSuccess("in sync"))
+			}
+		}
+		l.Printf("%d/%d resource(s) drifted from specs\n", driftCount, len(driftResponse.GetResources()))
+		return nil
+	}
+	return cmd
+}
+
+// confirmResourcesToPrune lists registered resources of a datastore which are
+// missing from local specs and asks which of them should be deleted
+func confirmResourcesToPrune(ctx context.Context, l logger, runtime pb.RuntimeServiceClient, projectName, namespace,
+	datastoreName string, localSpecs []*pb.ResourceSpecification, skipConfirm bool) ([]string, error) {
+	var registered, deployed []models.ResourceSpec
+	listRequest := &pb.ListResourceSpecificationRequest{
+		ProjectName:   projectName,
+		DatastoreName: datastoreName,
+		Namespace:     namespace,
+		PageSize:      models.PageMaxSize,
+		ReadMask:      &fieldmaskpb.FieldMask{Paths: []string{"name", "labels"}},
+	}
+	for {
+		listResponse, err := runtime.ListResourceSpecification(ctx, listRequest)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list resources of datastore %s", datastoreName)
+		}
+		for _, spec := range listResponse.GetResources() {
+			registered = append(registered, models.ResourceSpec{Name: spec.GetName(), Labels: spec.GetLabels()})
+		}
+		if listResponse.GetNextPageToken() == "" {
+			break
+		}
+		listRequest.PageToken = listResponse.GetNextPageToken()
+	}
+	for _, spec := range localSpecs {
+		deployed = append(deployed, models.ResourceSpec{Name: spec.GetName()})
+	}
+
+	var candidates []string
+	for _, spec := range datastore.PrunableResources(registered, deployed) {
+		candidates = append(candidates, spec.Name)
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	if skipConfirm {
+		return candidates, nil
+	}
+
+	var confirmed []string
+	if err := survey.AskOne(&survey.MultiSelect{
+		Message: fmt.Sprintf("select resources of %s missing from specs to delete permanently:", datastoreName),
+		Options: candidates,
+	}, &confirmed); err != nil {
+		return nil, err
+	}
+	if len(confirmed) == 0 {
+		l.Println(coloredNotice("no resource selected for pruning"))
+	}
+	return confirmed, nil
+}
+
+func askConfirmation(message string) (bool, error) {
+	confirmed := false
+	if err := survey.AskOne(&survey.Confirm{
+		Message: message,
+		Default: false,
+	}, &confirmed); err != nil {
+		return false, err
+	}
+	return confirmed, nil
+}
@@ -1,8 +1,18 @@
 package cmd
 
 import (
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/auth"
 	"github.com/odpf/optimus/cmd/server"
 	"github.com/odpf/optimus/config"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/store/postgres"
+	"github.com/olekukonko/tablewriter"
+	"github.com/pkg/errors"
 
 	cli "github.com/spf13/cobra"
 )
@@ -15,5 +25,131 @@ func optimusServeCommand(l logger, conf config.Provider) *cli.Command {
 			return server.Initialize(conf)
 		},
 	}
+	c.AddCommand(serveTokenCommand(l, conf))
 	return c
 }
+
+// serveTokenCommand manages API tokens directly in optimus database, it
+// doesn't need credentials so the first admin token can be created
+func serveTokenCommand(l logger, conf config.Provider) *cli.Command {
+	cmd := &cli.Command{
+		Use:   "token",
+		Short: "Manage API tokens used to authenticate with optimus server",
+	}
+	cmd.AddCommand(serveTokenCreateCommand(l, conf))
+	cmd.AddCommand(serveTokenListCommand(l, conf))
+	cmd.AddCommand(serveTokenRevokeCommand(l, conf))
+	return cmd
+}
+
+func serveTokenCreateCommand(l logger, conf config.Provider) *cli.Command {
+	var (
+		roles     []string
+		expiresIn time.Duration
+	)
+	cmd := &cli.Command{
+		Use:     "create",
+		Short:   "Create an API token, it is printed only once",
+		Example: "optimus serve token create ci --role my-project:deployer --role my-project/reporting:admin",
+		Args:    cli.ExactArgs(1),
+		RunE: func(cmd *cli.Command, args []string) error {
+			token := models.AuthToken{Name: args[0]}
+			for _, role := range roles {
+				binding, err := models.ParseAuthRoleBinding(role)
+				if err != nil {
+					return err
+				}
+				token.Bindings = append(token.Bindings, binding)
+			}
+			if len(token.Bindings) == 0 {
+				return errors.New("at least one role is required")
+			}
+			if expiresIn > 0 {
+				token.ExpiresAt = time.Now().UTC().Add(expiresIn)
+			}
+
+			raw, hash, err := auth.GenerateToken()
+			if err != nil {
+				return err
+			}
+			token.Hash = hash
+			if err := withAuthTokenRepository(conf, func(repo store.AuthTokenRepository) error {
+				return repo.Save(token)
+			}); err != nil {
+				return errors.Wrapf(err, "failed to create token %s", token.Name)
+			}
+
+			l.Println(coloredSuccess("token created, store it safely as it can't be shown again"))
+			l.Println(raw)
+			return nil
+		},
+	}
+	cmd.Flags().StringArrayVarP(&roles, "role", "r", nil, "role granted to token as <project>[/<namespace>]:<role>, roles are viewer, deployer and admin")
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, "time after which token expires, e.g. 720h, never expires if not set")
+	return cmd
+}
+
+func serveTokenListCommand(l logger, conf config.Provider) *cli.Command {
+	return &cli.Command{
+		Use:   "list",
+		Short: "List API tokens",
+		RunE: func(cmd *cli.Command, args []string) error {
+			var tokens []models.AuthToken
+			if err := withAuthTokenRepository(conf, func(repo store.AuthTokenRepository) (err error) {
+				tokens, err = repo.GetAll()
+				return err
+			}); err != nil {
+				return errors.Wrap(err, "failed to list tokens")
+			}
+
+			table := tablewriter.NewWriter(l.Writer())
+			table.SetBorder(false)
+			table.SetHeader([]string{"Name", "Roles", "Created", "Expires"})
+			for _, token := range tokens {
+				var roles []string
+				for _, binding := range token.Bindings {
+					roles = append(roles, binding.String())
+				}
+				expires := "never"
+				if !token.ExpiresAt.IsZero() {
+					expires = token.ExpiresAt.Format(time.RFC3339)
+				}
+				table.Append([]string{token.Name, strings.Join(roles, "\n"), token.CreatedAt.Format(time.RFC3339), expires})
+			}
+			table.Render()
+			return nil
+		},
+	}
+}
+
+func serveTokenRevokeCommand(l logger, conf config.Provider) *cli.Command {
+	return &cli.Command{
+		Use:   "revoke",
+		Short: "Revoke an API token",
+		Args:  cli.ExactArgs(1),
+		RunE: func(cmd *cli.Command, args []string) error {
+			if err := withAuthTokenRepository(conf, func(repo store.AuthTokenRepository) error {
+				return repo.Delete(args[0])
+			}); err != nil {
+				return errors.Wrapf(err, "failed to revoke token %s", args[0])
+			}
+			l.Println(coloredSuccess("token revoked"))
+			return nil
+		},
+	}
+}
+
+func withAuthTokenRepository(conf config.Provider, fn func(repo store.AuthTokenRepository) error) error {
+	dbConf := conf.GetServe().DB
+	if err := postgres.Migrate(dbConf.DSN); err != nil {
+		return errors.Wrap(err, "postgres.Migrate")
+	}
+	dbConn, err := postgres.Connect(dbConf.DSN, dbConf.MaxIdleConnection, dbConf.MaxOpenConnection)
+	if err != nil {
+		return errors.Wrap(err, "postgres.Connect")
+	}
+	defer func(db *gorm.DB) {
+		_ = db.Close()
+	}(dbConn)
+	return fn(postgres.NewAuthTokenRepository(dbConn))
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// inFlightRequests tracks grpc requests served over http2 connections of the
+// http server. Such connections are taken over from the http server, and grpc
+// server can't drain requests it serves over http, so they are waited for here.
+type inFlightRequests struct {
+	mu       sync.Mutex
+	wg       sync.WaitGroup
+	draining bool
+}
+
+// serve hands request over to grpc server, unless server is draining in which
+// case it is rejected as unavailable so clients retry elsewhere
+func (g *inFlightRequests) serve(grpcServer *grpc.Server, w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	if g.draining {
+		g.mu.Unlock()
+		w.Header().Set("Content-Type", "application/grpc")
+		w.Header().Set("Grpc-Status", strconv.Itoa(int(codes.Unavailable)))
+		w.Header().Set("Grpc-Message", "server is shutting down")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	g.wg.Add(1)
+	g.mu.Unlock()
+
+	defer g.wg.Done()
+	grpcServer.ServeHTTP(w, r)
+}
+
+// Drain rejects new requests and waits for ones in flight till ctx is done
+func (g *inFlightRequests) Drain(ctx context.Context) error {
+	g.mu.Lock()
+	g.draining = true
+	g.mu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -3,6 +3,7 @@ package server
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
@@ -35,36 +36,69 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	slackapi "github.com/slack-go/slack"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/test/bufconn"
 
 	v1 "github.com/odpf/optimus/api/handler/v1"
 	v1handler "github.com/odpf/optimus/api/handler/v1"
 	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/audit"
+	"github.com/odpf/optimus/auth"
+	"github.com/odpf/optimus/core/leader"
 	"github.com/odpf/optimus/core/logger"
 	"github.com/odpf/optimus/core/progress"
 	_ "github.com/odpf/optimus/ext/datastore"
 	"github.com/odpf/optimus/ext/scheduler/airflow2"
+	"github.com/odpf/optimus/health"
 	"github.com/odpf/optimus/instance"
 	"github.com/odpf/optimus/job"
 	"github.com/odpf/optimus/models"
-	_ "github.com/odpf/optimus/plugin"
+	"github.com/odpf/optimus/plugin"
+	"github.com/odpf/optimus/plugin/dependencyresolver"
 	"github.com/odpf/optimus/store"
 	"github.com/odpf/optimus/store/gcs"
 	"github.com/odpf/optimus/store/postgres"
+	"github.com/odpf/optimus/telemetry"
 )
 
 var (
 	//listen for sigterm
 	termChan = make(chan os.Signal, 1)
 
-	shutdownWait = 30 * time.Second
+	// budget of each shutdown stage, draining requests in flight and
+	// flushing spans left in memory
+	shutdownWait     = 30 * time.Second
+	tracingFlushWait = 5 * time.Second
+
+	// maximum time spent on checking resources of a namespace for drift
+	resourceDriftTimeout = 10 * time.Minute
+
+	// how often leader looks for replays and deployments left behind by
+	// servers that stopped while running them
+	unfinishedWorkCheckInterval = 5 * time.Minute
+
+	// how often leader looks for accepted replays to dispatch to its workers
+	replayDispatchInterval = 5 * time.Second
+
+	// open database connections left for queries, besides connections pinned
+	// by advisory locks of elector and of deploy and replay workers
+	dbQueryConnections = 4
+
+	// maximum time spent on each health check of a probe
+	healthCheckTimeout = 5 * time.Second
 
 	GRPCMaxRecvMsgSize = 45 << 20 // 45MB
+
+	gatewayListenerBufSize = 1 << 20 // 1MB
 )
 
 // projectJobSpecRepoFactory stores raw specifications
@@ -76,6 +110,15 @@ func (fac *projectJobSpecRepoFactory) New(project models.ProjectSpec) store.Proj
 	return postgres.NewProjectJobSpecRepository(fac.db, project, postgres.NewAdapter(models.PluginRegistry))
 }
 
+// runDurationRepoFactory stores run durations of jobs fetched from scheduler
+type runDurationRepoFactory struct {
+	db *gorm.DB
+}
+
+func (fac *runDurationRepoFactory) New(project models.ProjectSpec) store.JobRunDurationRepository {
+	return postgres.NewJobRunDurationRepository(fac.db, project)
+}
+
 type replaySpecRepoRepository struct {
 	db             *gorm.DB
 	jobSpecRepoFac jobSpecRepoFactory
@@ -226,10 +269,10 @@ func (obs *pipelineLogObserver) Notify(evt progress.Event) {
 	obs.log.Info(evt)
 }
 
-func jobSpecAssetDump() func(jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
+func jobSpecAssetDump() func(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
 	engine := instance.NewGoEngine()
-	return func(jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
-		aMap, err := instance.DumpAssets(jobSpec, scheduledAt, engine, false)
+	return func(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
+		aMap, err := instance.DumpAssets(ctx, jobSpec, scheduledAt, engine, false)
 		if err != nil {
 			return models.JobAssets{}, err
 		}
@@ -245,9 +288,29 @@ func checkRequiredConfigs(conf config.Provider) error {
 	if conf.GetServe().ReplayNumWorkers < 1 {
 		return errors.New(fmt.Sprintf("%s should be greater than 0", config.KeyServeReplayNumWorkers))
 	}
+	if conf.GetServe().DeployNumWorkers < 1 {
+		return errors.New(fmt.Sprintf("%s should be greater than 0", config.KeyServeDeployNumWorkers))
+	}
+	if conf.GetServe().DeployQueueSize < 1 {
+		return errors.New(fmt.Sprintf("%s should be greater than 0", config.KeyServeDeployQueueSize))
+	}
+	if conf.GetServe().LeaderElectionInterval <= 0 {
+		return errors.New(fmt.Sprintf("%s should be greater than 0", config.KeyServeLeaderElectionInterval))
+	}
 	if conf.GetServe().DB.DSN == "" {
 		return errors.Wrap(errRequiredMissing, "serve.db.dsn")
 	}
+	// each held advisory lock pins a connection, workers waiting for pooled
+	// connections held by other workers would starve queries otherwise
+	minOpenConnection := 1 + conf.GetServe().DeployNumWorkers + conf.GetServe().ReplayNumWorkers + dbQueryConnections
+	if maxOpen := conf.GetServe().DB.MaxOpenConnection; maxOpen > 0 && maxOpen < minOpenConnection {
+		return errors.New(fmt.Sprintf("%s should be at least %d, 1 for leader election, %s and %s and %d for queries",
+			config.KeyServeDBMaxOpenConnection, minOpenConnection, config.KeyServeDeployNumWorkers,
+			config.KeyServeReplayNumWorkers, dbQueryConnections))
+	}
+	if tlsConf := conf.GetServe().TLS; tlsConf.CertFile == "" && (tlsConf.KeyFile != "" || tlsConf.ClientCAFile != "") {
+		return errors.Wrap(errRequiredMissing, config.KeyServeTLSCertFile)
+	}
 	if parsed, err := url.Parse(conf.GetServe().DB.DSN); err != nil {
 		return errors.Wrap(err, "failed to parse serve.db.dsn")
 	} else {
@@ -273,6 +336,20 @@ func Initialize(conf config.Provider) error {
 	mainLog := log.WithField("reporter", "main")
 	mainLog.Infof("starting optimus %s", config.Version)
 
+	telemetryConf := conf.GetServe().Telemetry
+	shutdownTracing, err := telemetry.Init(context.Background(), telemetry.Config{
+		ServiceName:    "optimus",
+		ServiceVersion: config.Version,
+		OTLPEndpoint:   telemetryConf.OTLPEndpoint,
+		Insecure:       telemetryConf.Insecure,
+	})
+	if err != nil {
+		return errors.Wrap(err, "telemetry.Init")
+	}
+	if telemetryConf.OTLPEndpoint != "" {
+		mainLog.Infof("exporting traces to %s", telemetryConf.OTLPEndpoint)
+	}
+
 	progressObs := &pipelineLogObserver{
 		log: log.WithField("reporter", "pipeline"),
 	}
@@ -286,17 +363,19 @@ func Initialize(conf config.Provider) error {
 		return errors.Wrap(err, "postgres.Connect")
 	}
 
-	// init default scheduler
+	// init default scheduler, its calls are traced as part of the request
+	// making them
+	schedulerClient := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
 	switch conf.GetScheduler().Name {
 	case "airflow":
 		models.Scheduler = airflow.NewScheduler(
 			&objectWriterFactory{},
-			&http.Client{},
+			schedulerClient,
 		)
 	case "airflow2":
 		models.Scheduler = airflow2.NewScheduler(
 			&objectWriterFactory{},
-			&http.Client{},
+			schedulerClient,
 		)
 	default:
 		return errors.Errorf("unsupported scheduler: %s", conf.GetScheduler().Name)
@@ -314,25 +393,15 @@ func Initialize(conf config.Provider) error {
 		db:   dbConn,
 		hash: appHash,
 	}
-	registeredProjects, err := projectRepoFac.New().GetAll()
-	if err != nil {
-		return errors.Wrap(err, "projectRepoFactory.GetAll()")
-	}
-	// bootstrap scheduler for registered projects
-	for _, proj := range registeredProjects {
-		func() {
-			bootstrapCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
-			defer cancel()
-
-			logger.I("bootstrapping project ", proj.Name)
-			if err := models.Scheduler.Bootstrap(bootstrapCtx, proj); err != nil {
-				// Major ERROR, but we can't make this fatal
-				// other projects might be working fine though
-				logger.E(err)
-			}
-			logger.I("bootstrapped project ", proj.Name)
-		}()
-	}
+	// singleton duties run only on the server holding the leader lock, while
+	// every server serves requests
+	elector := leader.NewElector(postgres.NewAdvisoryLock(dbConn, postgres.LeaderLockKey), leader.Config{
+		Interval: conf.GetServe().LeaderElectionInterval,
+		Timeout:  conf.GetServe().LeaderElectionInterval,
+	})
+	elector.Register("bootstrap", func(ctx context.Context) {
+		bootstrapProjects(ctx, projectRepoFac)
+	})
 
 	projectSecretRepoFac := &projectSecretRepoFactory{
 		db:   dbConn,
@@ -351,9 +420,31 @@ func Initialize(conf config.Provider) error {
 		db:                    dbConn,
 		projectJobSpecRepoFac: projectJobSpecRepoFac,
 	}
+	// cache results of plugins used in resolving dependencies
+	if cacheSize := conf.GetServe().DependencyCacheSize; cacheSize > 0 {
+		for _, unit := range models.PluginRegistry.GetAll() {
+			if unit.DependencyMod == nil {
+				continue
+			}
+			cachedMod, err := dependencyresolver.NewCachedClient(unit.DependencyMod, cacheSize)
+			if err != nil {
+				return errors.Wrap(err, "dependencyresolver.NewCachedClient")
+			}
+			unit.DependencyMod = cachedMod
+		}
+	}
+
 	jobCompiler := job.NewCompiler(models.Scheduler.GetTemplate(), conf.GetServe().IngressHost)
 	dependencyResolver := job.NewDependencyResolver()
-	priorityResolver := job.NewPriorityResolver()
+	// projects choose how priority weights are computed, run durations used
+	// by critical path are refreshed by the leader
+	criticalPathResolver := job.NewCriticalPathPriorityResolver(models.Scheduler, projectRepoFac, &projectJobSpecRepoFac,
+		&runDurationRepoFactory{db: dbConn}, time.Now)
+	elector.Register("run-durations", leader.Every(job.RunDurationRefreshInterval, criticalPathResolver.RefreshRunDurations))
+	priorityResolver := job.NewProjectPriorityResolver(map[string]job.PriorityResolver{
+		job.PriorityResolverDepth:        job.NewPriorityResolver(),
+		job.PriorityResolverCriticalPath: criticalPathResolver,
+	})
 
 	// Logrus entry is used, allowing pre-definition of certain fields by the user.
 	logrusEntry := logrus.NewEntry(log)
@@ -365,18 +456,48 @@ func Initialize(conf config.Provider) error {
 	grpc_logrus.ReplaceGrpcLogger(logrusEntry)
 
 	grpcAddr := fmt.Sprintf("%s:%d", conf.GetServe().Host, conf.GetServe().Port)
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		otelgrpc.UnaryServerInterceptor(),
+		metricsUnaryInterceptor(),
+		grpctags.UnaryServerInterceptor(grpctags.WithFieldExtractor(grpctags.CodeGenRequestFieldExtractor)),
+		telemetry.UnaryServerInterceptor(),
+		grpc_logrus.UnaryServerInterceptor(logrusEntry, opts...),
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		otelgrpc.StreamServerInterceptor(),
+		metricsStreamInterceptor(),
+		telemetry.StreamServerInterceptor(),
+	}
+	// audit runs before authentication to record requests it rejects as well
+	auditEventRepo := postgres.NewAuditEventRepository(dbConn)
+	auditInterceptor := audit.NewInterceptor(auditEventRepo, postgres.NewDeploymentRepository(dbConn), appHash.GetKey()[:], func() time.Time {
+		return time.Now().UTC()
+	})
+	unaryInterceptors = append(unaryInterceptors, auditInterceptor.Unary())
+	streamInterceptors = append(streamInterceptors, auditInterceptor.Stream())
+	if conf.GetServe().Auth.Enabled {
+		authenticator, err := newAuthenticator(conf.GetServe().Auth, dbConn)
+		if err != nil {
+			return errors.Wrap(err, "failed to configure authentication")
+		}
+		authInterceptor := auth.NewInterceptor(authenticator, postgres.NewDeploymentRepository(dbConn))
+		unaryInterceptors = append(unaryInterceptors, authInterceptor.Unary())
+		streamInterceptors = append(streamInterceptors, authInterceptor.Stream())
+		mainLog.Info("authentication of api callers is enabled")
+	}
 	grpcOpts := []grpc.ServerOption{
-		grpc_middleware.WithUnaryServerChain(
-			grpctags.UnaryServerInterceptor(grpctags.WithFieldExtractor(grpctags.CodeGenRequestFieldExtractor)),
-			grpc_logrus.UnaryServerInterceptor(logrusEntry, opts...),
-		),
+		grpc_middleware.WithUnaryServerChain(unaryInterceptors...),
+		grpc_middleware.WithStreamServerChain(streamInterceptors...),
 		grpc.MaxRecvMsgSize(GRPCMaxRecvMsgSize),
 	}
 	grpcServer := grpc.NewServer(grpcOpts...)
 	reflection.Register(grpcServer)
 
 	// prepare factory writer for metadata
-	var metaSvcFactory meta.MetaSvcFactory
+	var (
+		metaSvcFactory meta.MetaSvcFactory
+		metaWriter     *meta.Writer
+	)
 	kafkaWriter := NewKafkaWriter(conf.GetServe().Metadata.KafkaJobTopic, strings.Split(conf.GetServe().Metadata.KafkaBrokers, ","), conf.GetServe().Metadata.KafkaBatchSize)
 	mainLog.WithFields(logrus.Fields{
 		"topic":   conf.GetServe().Metadata.KafkaJobTopic,
@@ -384,8 +505,7 @@ func Initialize(conf config.Provider) error {
 	}).Debug("kafka metadata writer config received")
 	if kafkaWriter != nil {
 		mainLog.Infof("job metadata publishing is enabled with brokers %s to topic %s", conf.GetServe().Metadata.KafkaBrokers, conf.GetServe().Metadata.KafkaJobTopic)
-		metaWriter := meta.NewWriter(kafkaWriter, conf.GetServe().Metadata.WriterBatchSize)
-		defer kafkaWriter.Close()
+		metaWriter = meta.NewWriter(kafkaWriter, conf.GetServe().Metadata.WriterBatchSize)
 		metaSvcFactory = &metadataServiceFactory{
 			writer: metaWriter,
 		}
@@ -405,42 +525,72 @@ func Initialize(conf config.Provider) error {
 		db:             dbConn,
 		jobSpecRepoFac: jobSpecRepoFac,
 	}
+	// work every server takes on, like replays and deployments, is kept from
+	// overlapping among servers with advisory locks
+	keyLocker := postgres.NewKeyedAdvisoryLocker(dbConn)
 	replayWorker := job.NewReplayWorker(replaySpecRepoFac, models.Scheduler)
-	replayManager := job.NewManager(replayWorker, replaySpecRepoFac, utils.NewUUIDProvider(), job.ReplayManagerConfig{
-		NumWorkers:    conf.GetServe().ReplayNumWorkers,
-		WorkerTimeout: conf.GetServe().ReplayWorkerTimeoutSecs,
-		RunTimeout:    conf.GetServe().ReplayRunTimeoutSecs,
+	replayManager := job.NewManager(replayWorker, replaySpecRepoFac, projectRepoFac, keyLocker, utils.NewUUIDProvider(), job.ReplayManagerConfig{
+		NumWorkers:       conf.GetServe().ReplayNumWorkers,
+		WorkerTimeout:    conf.GetServe().ReplayWorkerTimeoutSecs,
+		RunTimeout:       conf.GetServe().ReplayRunTimeoutSecs,
+		DispatchInterval: replayDispatchInterval,
 	}, models.Scheduler)
 
 	notificationContext, cancelNotifiers := context.WithCancel(context.Background())
 	defer cancelNotifiers()
-	eventService := job.NewEventService(map[string]models.Notifier{
+	notifiers := map[string]models.Notifier{
 		"slack": slack.NewNotifier(notificationContext, slackapi.APIURL,
 			slack.DefaultEventBatchInterval,
 			func(err error) {
 				logger.E(err)
 			},
+			keyLocker,
 		),
-	})
+	}
+	eventService := job.NewEventService(notifiers)
+
+	resourceSvc := datastore.NewService(&resourceSpecRepoFac, models.DatastoreRegistry)
+	driftMonitor := datastore.NewDriftMonitor(datastore.DriftMonitorConfig{
+		Interval: conf.GetServe().ResourceDriftInterval,
+		Timeout:  resourceDriftTimeout,
+	}, resourceSvc, models.DatastoreRegistry, projectRepoFac, namespaceSpecRepoFac, notifiers)
+	elector.Register("resource-drift", driftMonitor.Monitor)
+	elector.Register("timed-out-replays", leader.Every(unfinishedWorkCheckInterval, func(context.Context) {
+		replayManager.FailTimedOutReplays()
+	}))
 
 	// runtime service instance over grpc
-	pb.RegisterRuntimeServiceServer(grpcServer, v1handler.NewRuntimeServiceServer(
+	jobSvc := job.NewService(
+		&jobSpecRepoFac,
+		&jobRepoFactory{
+			schd: models.Scheduler,
+		},
+		jobCompiler,
+		jobSpecAssetDump(),
+		dependencyResolver,
+		priorityResolver,
+		metaSvcFactory,
+		&projectJobSpecRepoFac,
+		replayManager,
+	)
+	// replays accepted by any server are processed by workers of the leader
+	elector.Register("replays", func(ctx context.Context) {
+		replayManager.Dispatch(ctx, jobSvc)
+	})
+	deployManager := job.NewDeployManager(jobSvc, postgres.NewDeploymentRepository(dbConn), auditEventRepo, keyLocker, utils.NewUUIDProvider(), job.DeployManagerConfig{
+		NumWorkers:    conf.GetServe().DeployNumWorkers,
+		WorkerTimeout: conf.GetServe().DeployWorkerTimeoutSecs,
+		QueueSize:     conf.GetServe().DeployQueueSize,
+	})
+	elector.Register("abandoned-deployments", leader.Every(unfinishedWorkCheckInterval, func(context.Context) {
+		deployManager.FailAbandonedDeployments()
+	}))
+	elector.Init()
+	runtimeService := v1handler.NewRuntimeServiceServer(
 		config.Version,
-		job.NewService(
-			&jobSpecRepoFac,
-			&jobRepoFactory{
-				schd: models.Scheduler,
-			},
-			jobCompiler,
-			jobSpecAssetDump(),
-			dependencyResolver,
-			priorityResolver,
-			metaSvcFactory,
-			&projectJobSpecRepoFac,
-			replayManager,
-		),
+		jobSvc,
 		eventService,
-		datastore.NewService(&resourceSpecRepoFac, models.DatastoreRegistry),
+		resourceSvc,
 		projectRepoFac,
 		namespaceSpecRepoFac,
 		projectSecretRepoFac,
@@ -456,11 +606,43 @@ func Initialize(conf config.Provider) error {
 			instance.NewGoEngine(),
 		),
 		models.Scheduler,
-	))
+		auditEventRepo,
+		deployManager,
+		job.NewArchiveManager(jobSvc, resourceSvc, models.DatastoreRegistry, projectRepoFac, namespaceSpecRepoFac,
+			deployManager, keyLocker, job.ArchiveManagerConfig{
+				Retention: conf.GetServe().ArchiveRetention,
+			}, func() time.Time {
+				return time.Now().UTC()
+			}),
+	)
+	pb.RegisterRuntimeServiceServer(grpcServer, runtimeService)
+
+	// probes of server, a dead plugin can't be launched again so it needs a
+	// restart, while unreachable dependencies only keep requests away
+	healthChecker := health.NewChecker(healthCheckTimeout, pb.RuntimeService_ServiceDesc.ServiceName)
+	healthChecker.AddLivenessCheck("plugins", func(context.Context) error {
+		return plugin.CheckProcesses()
+	})
+	healthChecker.AddReadinessCheck("database", func(ctx context.Context) error {
+		return dbConn.DB().PingContext(ctx)
+	})
+	healthChecker.AddReadinessCheck("scheduler", func(ctx context.Context) error {
+		return pingSchedulers(ctx, projectRepoFac)
+	})
+	healthpb.RegisterHealthServer(grpcServer, healthChecker)
 
 	timeoutGrpcDialCtx, grpcDialCancel := context.WithTimeout(context.Background(), time.Second*5)
 	defer grpcDialCancel()
 
+	// http proxy reaches grpc server over an in-memory listener, so proxied
+	// requests never leave the process and need no tls of their own
+	gatewayListener := bufconn.Listen(gatewayListenerBufSize)
+	go func() {
+		if err := grpcServer.Serve(gatewayListener); err != nil {
+			mainLog.Errorf("gateway listener error: %v", err)
+		}
+	}()
+
 	// prepare http proxy
 	gwmux := runtime.NewServeMux(
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
@@ -468,6 +650,11 @@ func Initialize(conf config.Provider) error {
 	// gRPC dialup options to proxy http connections
 	grpcConn, err := grpc.DialContext(timeoutGrpcDialCtx, grpcAddr, []grpc.DialOption{
 		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return gatewayListener.Dial()
+		}),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
 	}...)
 	if err != nil {
 		return errors.Wrap(err, "grpc.DialContext")
@@ -483,20 +670,39 @@ func Initialize(conf config.Provider) error {
 	baseMux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprintf(w, "pong")
 	})
-	baseMux.Handle("/api/", http.StripPrefix("/api", gwmux))
+	baseMux.Handle("/api/", http.StripPrefix("/api", otelhttp.NewHandler(gwmux, "gateway")))
+	baseMux.Handle("/metrics", promhttp.Handler())
+	baseMux.Handle("/healthz", healthChecker.LivenessHandler())
+	baseMux.Handle("/readyz", healthChecker.ReadinessHandler())
 
+	inFlightGRPC := &inFlightRequests{}
 	srv := &http.Server{
-		Handler:      grpcHandlerFunc(grpcServer, baseMux),
+		Handler:      grpcHandlerFunc(grpcServer, inFlightGRPC, baseMux),
 		Addr:         grpcAddr,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
 		IdleTimeout:  120 * time.Second,
 	}
+	if tlsConf := conf.GetServe().TLS; tlsConf.CertFile != "" {
+		if srv.TLSConfig, err = auth.NewServerTLSConfig(tlsConf.CertFile, tlsConf.KeyFile, tlsConf.ClientCAFile); err != nil {
+			return errors.Wrap(err, "auth.NewServerTLSConfig")
+		}
+		mainLog.Info("tls is enabled")
+		if tlsConf.ClientCAFile != "" {
+			mainLog.Info("client certificates are required")
+		}
+	}
 
 	// run our server in a goroutine so that it doesn't block to wait for termination requests
 	go func() {
 		mainLog.Infoln("starting listening at ", grpcAddr)
-		if err := srv.ListenAndServe(); err != nil {
+		var err error
+		if srv.TLSConfig != nil {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil {
 			if err != http.ErrServerClosed {
 				mainLog.Fatalf("server error: %v\n", err)
 			}
@@ -513,31 +719,151 @@ func Initialize(conf config.Provider) error {
 	mainLog.Info("termination request received")
 	var terminalError error
 
-	if err = replayManager.Close(); err != nil {
-		terminalError = multierror.Append(terminalError, errors.Wrap(err, "replayManager.Close"))
+	// probes fail so no more requests are routed here, requests keep being
+	// served till load balancers notice
+	healthChecker.Shutdown()
+	// stops singleton duties and lets another server take over
+	if err = elector.Close(); err != nil {
+		terminalError = multierror.Append(terminalError, errors.Wrap(err, "elector.Close"))
 	}
+	time.Sleep(conf.GetServe().ShutdownReadinessDelay)
 
-	// Create a deadline to wait for server
+	// Create a deadline to wait for requests in flight
 	ctxProxy, cancelProxy := context.WithTimeout(context.Background(), shutdownWait)
 	defer cancelProxy()
 
-	// Doesn't block if no connections, but will otherwise wait
+	// drain requests and streams in flight, http server closes its listener
+	// and waits for http requests, grpc requests over http2 are waited for
+	// separately. Doesn't block if there are none, but will otherwise wait
 	// until the timeout deadline.
 	if err := srv.Shutdown(ctxProxy); err != nil {
 		terminalError = multierror.Append(terminalError, errors.Wrap(err, "srv.Shutdown"))
 	}
-	grpcServer.GracefulStop()
+	if err := inFlightGRPC.Drain(ctxProxy); err != nil {
+		terminalError = multierror.Append(terminalError, errors.Wrap(err, "inFlightGRPC.Drain"))
+		grpcServer.Stop()
+	} else {
+		grpcServer.GracefulStop()
+	}
+
+	// no more deployments can be requested, wait for accepted ones with a
+	// deadline of their own. replays were waited for with the duties of
+	// elector, deployments still unfinished at the deadline are failed by the
+	// leader
+	ctxDeploy, cancelDeploy := context.WithTimeout(context.Background(), conf.GetServe().ShutdownDeployWait)
+	defer cancelDeploy()
+	if err = deployManager.Close(ctxDeploy); err != nil {
+		terminalError = multierror.Append(terminalError, errors.Wrap(err, "deployManager.Close"))
+	}
 
 	// gracefully shutdown event service, e.g. slack notifiers flush in memory batches
 	cancelNotifiers()
 	if err := eventService.Close(); err != nil && len(err.Error()) != 0 {
 		terminalError = multierror.Append(terminalError, errors.Wrap(err, "eventService.Close"))
 	}
+	// publish metadata still buffered
+	if metaWriter != nil {
+		if err := metaWriter.Flush(); err != nil {
+			terminalError = multierror.Append(terminalError, errors.Wrap(err, "metaWriter.Flush"))
+		}
+		if err := kafkaWriter.Close(); err != nil {
+			terminalError = multierror.Append(terminalError, errors.Wrap(err, "kafkaWriter.Close"))
+		}
+	}
+
+	// flush spans of requests served till now, earlier stages may have used
+	// up their deadlines
+	ctxTracing, cancelTracing := context.WithTimeout(context.Background(), tracingFlushWait)
+	defer cancelTracing()
+	if err := shutdownTracing(ctxTracing); err != nil {
+		terminalError = multierror.Append(terminalError, errors.Wrap(err, "shutdownTracing"))
+	}
+	if err := dbConn.Close(); err != nil {
+		terminalError = multierror.Append(terminalError, errors.Wrap(err, "dbConn.Close"))
+	}
+
+	// nothing calls plugins anymore
+	plugin.Kill()
 
 	mainLog.Info("bye")
 	return terminalError
 }
 
+// pingSchedulers checks schedulers of registered projects can be reached,
+// projects sharing a scheduler host are checked once. It fails only if none
+// of them can be reached, an unreachable scheduler of one project shouldn't
+// take the server away from the rest.
+func pingSchedulers(ctx context.Context, projectRepoFac *projectRepoFactory) error {
+	projects, err := projectRepoFac.New().GetAll()
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve projects")
+	}
+	var (
+		pinged  = map[string]bool{}
+		pingErr error
+	)
+	for _, proj := range projects {
+		host, ok := proj.Config[models.ProjectSchedulerHost]
+		if !ok || pinged[host] {
+			continue
+		}
+		pinged[host] = true
+		err := models.Scheduler.Ping(ctx, proj)
+		if err == nil {
+			return nil
+		}
+		pingErr = multierror.Append(pingErr, err)
+	}
+	return pingErr
+}
+
+// bootstrapProjects prepares scheduler for every registered project
+func bootstrapProjects(ctx context.Context, projectRepoFac *projectRepoFactory) {
+	registeredProjects, err := projectRepoFac.New().GetAll()
+	if err != nil {
+		logger.E(errors.Wrap(err, "projectRepoFactory.GetAll()"))
+		return
+	}
+	for _, proj := range registeredProjects {
+		func() {
+			bootstrapCtx, cancel := context.WithTimeout(ctx, time.Second*10)
+			defer cancel()
+
+			logger.I("bootstrapping project ", proj.Name)
+			if err := models.Scheduler.Bootstrap(bootstrapCtx, proj); err != nil {
+				// Major ERROR, but we can't make this fatal
+				// other projects might be working fine though
+				logger.E(err)
+			}
+			logger.I("bootstrapped project ", proj.Name)
+		}()
+	}
+}
+
+// newAuthenticator verifies API tokens stored in db, along with JWTs if an
+// OIDC provider is configured
+func newAuthenticator(conf config.ServerAuthConfig, dbConn *gorm.DB) (auth.Authenticator, error) {
+	now := func() time.Time {
+		return time.Now().UTC()
+	}
+	authenticators := []auth.Authenticator{
+		auth.NewTokenAuthenticator(postgres.NewAuthTokenRepository(dbConn), now),
+	}
+	if conf.OIDC.JWKSURL != "" {
+		oidcAuthenticator, err := auth.NewOIDCAuthenticator(auth.OIDCConfig{
+			Issuer:     conf.OIDC.Issuer,
+			Audience:   conf.OIDC.Audience,
+			JWKSURL:    conf.OIDC.JWKSURL,
+			RolesClaim: conf.OIDC.RolesClaim,
+		}, &http.Client{Timeout: 10 * time.Second}, now)
+		if err != nil {
+			return nil, err
+		}
+		authenticators = append(authenticators, oidcAuthenticator)
+	}
+	return auth.NewChainAuthenticator(authenticators...), nil
+}
+
 // grpcHandlerFunc routes http1 calls to baseMux and http2 with grpc header to grpcServer.
 // Using a single port for proxying both http1 & 2 protocols will degrade http performance
 // but for our usecase the convenience per performance tradeoff is better suited
@@ -545,10 +871,10 @@ func Initialize(conf config.Provider) error {
 // into two ports, default port for grpc and default+1 for grpc-gateway proxy.
 // We can also use something like a connection multiplexer
 // https://github.com/soheilhy/cmux to achieve the same.
-func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handler {
+func grpcHandlerFunc(grpcServer *grpc.Server, inFlight *inFlightRequests, otherHandler http.Handler) http.Handler {
 	return h2c.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.ProtoMajor == 2 && strings.Contains(r.Header.Get("Content-Type"), "application/grpc") {
-			grpcServer.ServeHTTP(w, r)
+			inFlight.serve(grpcServer, w, r)
 		} else {
 			otherHandler.ServeHTTP(w, r)
 		}
@@ -114,12 +114,14 @@ func validateJobSpecificationRequest(l logger, projectName string, namespace str
 			if err == io.EOF {
 				break
 			}
+			printCheckErrors(l, totalErrors)
 			return errors.Wrapf(err, "failed to receive check ack")
 		}
 		if resp.Ack {
 			// ack for the job spec
 			if !resp.GetSuccess() {
 				totalErrors = append(totalErrors, fmt.Sprintf("unable to check: %s, %s\n", resp.GetJobName(), resp.GetMessage()))
+				continue
 			}
 			jobCounter++
 			l.Printf("%d/%d. %s successfully checked\n", jobCounter, totalJobs, resp.GetJobName())
@@ -128,11 +130,16 @@ func validateJobSpecificationRequest(l logger, projectName string, namespace str
 			l.Printf("info '%s': %s\n", resp.GetJobName(), resp.GetMessage())
 		}
 	}
-	if len(totalErrors) > 0 {
-		l.Println("errors:")
-		for i, reqErr := range totalErrors {
-			l.Printf("%d. %s", i, reqErr)
-		}
-	}
+	printCheckErrors(l, totalErrors)
 	return nil
 }
+
+func printCheckErrors(l logger, checkErrors []string) {
+	if len(checkErrors) == 0 {
+		return
+	}
+	l.Println("errors:")
+	for i, checkErr := range checkErrors {
+		l.Printf("%d. %s", i, checkErr)
+	}
+}
@@ -36,10 +36,37 @@ var (
 	KeyServeReplayNumWorkers        = "serve.replay_num_workers"
 	KeyServeReplayWorkerTimeoutSecs = "serve.replay_worker_timeout_secs"
 	KeyServeReplayRunTimeoutSecs    = "serve.replay_run_timeout_secs"
+	KeyServeDeployNumWorkers        = "serve.deploy_num_workers"
+	KeyServeDeployWorkerTimeoutSecs = "serve.deploy_worker_timeout_secs"
+	KeyServeDeployQueueSize         = "serve.deploy_queue_size"
+	KeyServeDependencyCacheSize     = "serve.dependency_cache_size"
+	KeyServeResourceDriftInterval   = "serve.resource_drift_interval_secs"
+	KeyServeArchiveRetentionDays    = "serve.archive_retention_days"
+	KeyServeLeaderElectionInterval  = "serve.leader_election_interval_secs"
+	KeyServeShutdownReadinessDelay  = "serve.shutdown_readiness_delay_secs"
+	KeyServeShutdownDeployWait      = "serve.shutdown_deploy_wait_secs"
+	KeyServeAuthEnabled             = "serve.auth.enabled"
+	KeyServeAuthOIDCIssuer          = "serve.auth.oidc.issuer"
+	KeyServeAuthOIDCAudience        = "serve.auth.oidc.audience"
+	KeyServeAuthOIDCJWKSURL         = "serve.auth.oidc.jwks_url"
+	KeyServeAuthOIDCRolesClaim      = "serve.auth.oidc.roles_claim"
+	KeyServeTLSCertFile             = "serve.tls.cert_file"
+	KeyServeTLSKeyFile              = "serve.tls.key_file"
+	KeyServeTLSClientCAFile         = "serve.tls.client_ca_file"
+	KeyServeTelemetryOTLPEndpoint   = "serve.telemetry.otlp_endpoint"
+	KeyServeTelemetryInsecure       = "serve.telemetry.insecure"
 
 	KeySchedulerName = "scheduler.name"
 
 	KeyAdminEnabled = "admin.enabled"
+
+	KeyAuthToken = "auth.token"
+
+	KeyTLSEnabled    = "tls.enabled"
+	KeyTLSCAFile     = "tls.ca_file"
+	KeyTLSCertFile   = "tls.cert_file"
+	KeyTLSKeyFile    = "tls.key_file"
+	KeyTLSServerName = "tls.server_name"
 )
 
 type Optimus struct {
@@ -105,6 +132,86 @@ type ServerConfig struct {
 	ReplayNumWorkers        int            `yaml:"replay_num_workers"`
 	ReplayWorkerTimeoutSecs time.Duration  `yaml:"replay_worker_timeout_secs"`
 	ReplayRunTimeoutSecs    time.Duration  `yaml:"replay_run_timeout_secs"`
+
+	// job deployments carried out in parallel, deployments of a namespace
+	// are carried out one at a time
+	DeployNumWorkers int `yaml:"deploy_num_workers"`
+	// deployments taking longer are cancelled
+	DeployWorkerTimeoutSecs time.Duration `yaml:"deploy_worker_timeout_secs"`
+	// deployments waiting for a worker, more are rejected till queue drains
+	DeployQueueSize int `yaml:"deploy_queue_size"`
+
+	// number of plugin dependency resolution results cached per plugin, 0 disables caching
+	DependencyCacheSize int `yaml:"dependency_cache_size"`
+
+	// how often resources are checked for drift from their specs, 0 disables checks
+	ResourceDriftInterval time.Duration `yaml:"resource_drift_interval_secs"`
+
+	// archived projects and namespaces can be restored for this long, their
+	// names are freed for registration afterwards
+	ArchiveRetention time.Duration `yaml:"archive_retention_days"`
+
+	// how often a server tries to become leader and the leader checks it
+	// still is, singleton duties like scheduler bootstrap and drift checks run
+	// on the leader only
+	LeaderElectionInterval time.Duration `yaml:"leader_election_interval_secs"`
+
+	// how long a shutting down server keeps serving once its readiness fails,
+	// so load balancers stop routing requests to it before it stops accepting
+	ShutdownReadinessDelay time.Duration `yaml:"shutdown_readiness_delay_secs"`
+
+	// how long a shutting down server waits for deployments it accepted once
+	// requests in flight are drained, unfinished ones are failed by the leader
+	ShutdownDeployWait time.Duration `yaml:"shutdown_deploy_wait_secs"`
+
+	Auth ServerAuthConfig `yaml:"auth"`
+
+	TLS ServerTLSConfig `yaml:"tls"`
+
+	Telemetry TelemetryConfig `yaml:"telemetry"`
+}
+
+type TelemetryConfig struct {
+	// host:port of OpenTelemetry collector traces are exported to over
+	// OTLP grpc, leave empty to disable tracing
+	OTLPEndpoint string `yaml:"otlp_endpoint"`
+
+	// connect to collector without tls
+	Insecure bool `yaml:"insecure"`
+}
+
+type ServerTLSConfig struct {
+	// pem encoded certificate and private key served on grpc and http
+	// listener, leave empty to serve plaintext
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+
+	// pem encoded CAs client certificates are verified with, leave empty
+	// to not require client certificates
+	ClientCAFile string `yaml:"client_ca_file"`
+}
+
+type ServerAuthConfig struct {
+	// require callers of optimus APIs to authenticate, with an API token
+	// or a JWT of the OIDC provider
+	Enabled bool `yaml:"enabled"`
+
+	OIDC OIDCConfig `yaml:"oidc"`
+}
+
+type OIDCConfig struct {
+	// expected issuer of JWTs, e.g. https://accounts.google.com
+	Issuer string `yaml:"issuer"`
+
+	// expected audience of JWTs, usually client id of optimus, required
+	// along with jwks_url
+	Audience string `yaml:"audience"`
+
+	// url serving keys JWTs are signed with, leave empty to disable OIDC
+	JWKSURL string `yaml:"jwks_url"`
+
+	// claim listing role bindings of user, e.g. ["my-project:deployer"]
+	RolesClaim string `yaml:"roles_claim"`
 }
 
 type DBConfig struct {
@@ -141,6 +248,28 @@ type AdminConfig struct {
 	Enabled bool `yaml:"enabled"`
 }
 
+type AuthConfig struct {
+	// API token or JWT sent as credentials to optimus server
+	Token string `yaml:"token"`
+}
+
+type TLSConfig struct {
+	// connect to optimus server over tls, implied if any file is set
+	Enabled bool `yaml:"enabled"`
+
+	// pem encoded CAs server certificate is verified with, system CAs
+	// are used if empty
+	CAFile string `yaml:"ca_file"`
+
+	// pem encoded certificate and private key sent to servers requiring
+	// client certificates
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+
+	// name expected in server certificate if it differs from host
+	ServerName string `yaml:"server_name"`
+}
+
 func (o Optimus) GetVersion() string {
 	return o.k.String(KeyVersion)
 }
@@ -195,6 +324,33 @@ func (o Optimus) GetServe() ServerConfig {
 		ReplayNumWorkers:        o.k.Int(KeyServeReplayNumWorkers),
 		ReplayWorkerTimeoutSecs: time.Second * time.Duration(o.k.Int(KeyServeReplayWorkerTimeoutSecs)),
 		ReplayRunTimeoutSecs:    time.Second * time.Duration(o.k.Int(KeyServeReplayRunTimeoutSecs)),
+		DeployNumWorkers:        o.k.Int(KeyServeDeployNumWorkers),
+		DeployWorkerTimeoutSecs: time.Second * time.Duration(o.k.Int(KeyServeDeployWorkerTimeoutSecs)),
+		DeployQueueSize:         o.k.Int(KeyServeDeployQueueSize),
+		DependencyCacheSize:     o.k.Int(KeyServeDependencyCacheSize),
+		ResourceDriftInterval:   time.Second * time.Duration(o.k.Int(KeyServeResourceDriftInterval)),
+		ArchiveRetention:        24 * time.Hour * time.Duration(o.k.Int(KeyServeArchiveRetentionDays)),
+		LeaderElectionInterval:  time.Second * time.Duration(o.k.Int(KeyServeLeaderElectionInterval)),
+		ShutdownReadinessDelay:  time.Second * time.Duration(o.k.Int(KeyServeShutdownReadinessDelay)),
+		ShutdownDeployWait:      time.Second * time.Duration(o.k.Int(KeyServeShutdownDeployWait)),
+		Auth: ServerAuthConfig{
+			Enabled: o.k.Bool(KeyServeAuthEnabled),
+			OIDC: OIDCConfig{
+				Issuer:     o.k.String(KeyServeAuthOIDCIssuer),
+				Audience:   o.k.String(KeyServeAuthOIDCAudience),
+				JWKSURL:    o.eKs(KeyServeAuthOIDCJWKSURL),
+				RolesClaim: o.eKs(KeyServeAuthOIDCRolesClaim),
+			},
+		},
+		TLS: ServerTLSConfig{
+			CertFile:     o.eKs(KeyServeTLSCertFile),
+			KeyFile:      o.eKs(KeyServeTLSKeyFile),
+			ClientCAFile: o.eKs(KeyServeTLSClientCAFile),
+		},
+		Telemetry: TelemetryConfig{
+			OTLPEndpoint: o.eKs(KeyServeTelemetryOTLPEndpoint),
+			Insecure:     o.k.Bool(KeyServeTelemetryInsecure),
+		},
 	}
 }
 
@@ -210,6 +366,22 @@ func (o Optimus) GetAdmin() AdminConfig {
 	}
 }
 
+func (o Optimus) GetAuth() AuthConfig {
+	return AuthConfig{
+		Token: o.k.String(KeyAuthToken),
+	}
+}
+
+func (o Optimus) GetTLS() TLSConfig {
+	return TLSConfig{
+		Enabled:    o.k.Bool(KeyTLSEnabled),
+		CAFile:     o.eKs(KeyTLSCAFile),
+		CertFile:   o.eKs(KeyTLSCertFile),
+		KeyFile:    o.eKs(KeyTLSKeyFile),
+		ServerName: o.eKs(KeyTLSServerName),
+	}
+}
+
 // eKs replaces . with _ to support buggy koanf config loader from ENV
 // this should be used in all keys where underscore is used
 func (o Optimus) eKs(e string) string {
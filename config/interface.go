@@ -11,4 +11,6 @@ type Provider interface {
 	GetServe() ServerConfig
 	GetScheduler() SchedulerConfig
 	GetAdmin() AdminConfig
+	GetAuth() AuthConfig
+	GetTLS() TLSConfig
 }
@@ -61,6 +61,14 @@ func InitOptimus() (*Optimus, error) {
 		KeySchedulerName:                "airflow2",
 		KeyServeReplayNumWorkers:        1,
 		KeyServeReplayWorkerTimeoutSecs: 120,
+		KeyServeDeployNumWorkers:        4,
+		KeyServeDeployWorkerTimeoutSecs: 1800,
+		KeyServeDeployQueueSize:         100,
+		KeyServeDependencyCacheSize:     10000,
+		KeyServeArchiveRetentionDays:    30,
+		KeyServeLeaderElectionInterval:  10,
+		KeyServeShutdownReadinessDelay:  10,
+		KeyServeShutdownDeployWait:      60,
 	}, "."), nil); err != nil {
 		return nil, errors.Wrap(err, "k.Load: error loading config defaults")
 	}
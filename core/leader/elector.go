@@ -0,0 +1,182 @@
+package leader
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/odpf/optimus/core/logger"
+	"github.com/pkg/errors"
+)
+
+// Lock is held by at most one server at a time
+type Lock interface {
+	// TryAcquire takes the lock without waiting, true if it is held
+	TryAcquire(ctx context.Context) (bool, error)
+	// Check fails if a held lock is lost
+	Check(ctx context.Context) error
+	// Release gives up a held lock
+	Release(ctx context.Context) error
+}
+
+// Duty is work that must not run on more than one server at a time. It runs
+// once a server becomes leader and its ctx is cancelled as soon as leadership
+// is lost, long running duties return once ctx is done
+type Duty func(ctx context.Context)
+
+// Every returns a duty running fn right away and then every interval
+func Every(interval time.Duration, fn func(ctx context.Context)) Duty {
+	return func(ctx context.Context) {
+		fn(ctx)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				fn(ctx)
+			}
+		}
+	}
+}
+
+type Config struct {
+	// how often a follower tries to become leader and a leader checks it
+	// still holds the lock
+	Interval time.Duration
+	// time given to each lock operation
+	Timeout time.Duration
+}
+
+// Elector campaigns for leadership among servers sharing lock and runs
+// duties while the server is leader. A leader notices a lost lock within an
+// interval and stops its duties before campaigning again, so duties of two
+// servers can overlap for at most an interval after a leader is cut off.
+type Elector struct {
+	config Config
+	lock   Lock
+	duties map[string]Duty
+
+	mu     sync.RWMutex
+	leader bool
+
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// Register adds a duty run whenever server becomes leader, duties need to
+// be registered before Init
+func (e *Elector) Register(name string, duty Duty) {
+	e.duties[name] = duty
+}
+
+// IsLeader tells whether duties are running on this server
+func (e *Elector) IsLeader() bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.leader
+}
+
+// Init starts campaigning in background
+func (e *Elector) Init() {
+	var ctx context.Context
+	ctx, e.cancel = context.WithCancel(context.Background())
+	e.wg.Add(1)
+	go func() {
+		defer e.wg.Done()
+		ticker := time.NewTicker(e.config.Interval)
+		defer ticker.Stop()
+		for {
+			if e.tryAcquire(ctx) {
+				e.lead(ctx, ticker)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (e *Elector) tryAcquire(ctx context.Context) bool {
+	lockCtx, cancel := context.WithTimeout(ctx, e.config.Timeout)
+	defer cancel()
+	acquired, err := e.lock.TryAcquire(lockCtx)
+	if err != nil {
+		logger.E(errors.Wrap(err, "failed to campaign for leadership"))
+		return false
+	}
+	return acquired
+}
+
+// lead runs duties till lock is lost or ctx is done
+func (e *Elector) lead(ctx context.Context, ticker *time.Ticker) {
+	logger.I("became leader, starting singleton duties")
+	e.setLeader(true)
+	dutyCtx, stopDuties := context.WithCancel(ctx)
+	var duties sync.WaitGroup
+	for name, duty := range e.duties {
+		duties.Add(1)
+		go func(name string, duty Duty) {
+			defer duties.Done()
+			logger.D("starting duty ", name)
+			duty(dutyCtx)
+		}(name, duty)
+	}
+
+	for held := true; held; {
+		select {
+		case <-ctx.Done():
+			held = false
+		case <-ticker.C:
+			lockCtx, cancel := context.WithTimeout(ctx, e.config.Timeout)
+			if err := e.lock.Check(lockCtx); err != nil {
+				logger.E(errors.Wrap(err, "lost leadership"))
+				held = false
+			}
+			cancel()
+		}
+	}
+
+	stopDuties()
+	duties.Wait()
+	e.setLeader(false)
+
+	// duties are stopped, so the lock can be given up for another server
+	releaseCtx, cancel := context.WithTimeout(context.Background(), e.config.Timeout)
+	defer cancel()
+	if err := e.lock.Release(releaseCtx); err != nil {
+		logger.E(errors.Wrap(err, "failed to give up leadership"))
+	}
+	logger.I("stopped singleton duties")
+}
+
+func (e *Elector) setLeader(leader bool) {
+	e.mu.Lock()
+	e.leader = leader
+	e.mu.Unlock()
+	if leader {
+		isLeader.Set(1)
+	} else {
+		isLeader.Set(0)
+	}
+}
+
+// Close stops duties and gives up leadership if server is leader
+func (e *Elector) Close() error {
+	if e.cancel != nil {
+		e.cancel()
+	}
+	e.wg.Wait()
+	return nil
+}
+
+func NewElector(lock Lock, config Config) *Elector {
+	return &Elector{
+		config: config,
+		lock:   lock,
+		duties: map[string]Duty{},
+	}
+}
@@ -0,0 +1,186 @@
+package leader_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/core/leader"
+	"github.com/odpf/optimus/core/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeLock struct {
+	mu       sync.Mutex
+	free     bool
+	held     bool
+	lost     bool
+	released int
+}
+
+func (l *fakeLock) TryAcquire(context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.held {
+		return true, nil
+	}
+	if !l.free {
+		return false, nil
+	}
+	l.held, l.lost = true, false
+	return true, nil
+}
+
+func (l *fakeLock) Check(context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.lost {
+		l.held = false
+		return errors.New("lock is lost")
+	}
+	return nil
+}
+
+func (l *fakeLock) Release(context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.held = false
+	l.released++
+	return nil
+}
+
+func (l *fakeLock) set(free, lost bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.free, l.lost = free, lost
+}
+
+func (l *fakeLock) releasedCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.released
+}
+
+// dutyRecorder counts duty runs and tells whether one is running
+type dutyRecorder struct {
+	mu      sync.Mutex
+	runs    int
+	running bool
+}
+
+func (d *dutyRecorder) duty(ctx context.Context) {
+	d.mu.Lock()
+	d.runs++
+	d.running = true
+	d.mu.Unlock()
+
+	<-ctx.Done()
+
+	d.mu.Lock()
+	d.running = false
+	d.mu.Unlock()
+}
+
+func (d *dutyRecorder) state() (int, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.runs, d.running
+}
+
+func TestElector(t *testing.T) {
+	logger.InitWithWriter(logger.DEBUG, ioutil.Discard)
+	config := leader.Config{
+		Interval: 10 * time.Millisecond,
+		Timeout:  time.Second,
+	}
+	waitFor := time.Second
+	tick := 5 * time.Millisecond
+
+	t.Run("should not run duties while lock is held by another server", func(t *testing.T) {
+		lock := &fakeLock{}
+		recorder := &dutyRecorder{}
+		elector := leader.NewElector(lock, config)
+		elector.Register("duty", recorder.duty)
+		elector.Init()
+
+		time.Sleep(5 * config.Interval)
+		runs, _ := recorder.state()
+		assert.Equal(t, 0, runs)
+		assert.False(t, elector.IsLeader())
+		assert.Nil(t, elector.Close())
+	})
+	t.Run("should run duties once lock is acquired and stop them on close", func(t *testing.T) {
+		lock := &fakeLock{}
+		recorder := &dutyRecorder{}
+		elector := leader.NewElector(lock, config)
+		elector.Register("duty", recorder.duty)
+		elector.Init()
+
+		lock.set(true, false)
+		assert.Eventually(t, func() bool {
+			_, running := recorder.state()
+			return running && elector.IsLeader()
+		}, waitFor, tick)
+
+		assert.Nil(t, elector.Close())
+		runs, running := recorder.state()
+		assert.Equal(t, 1, runs)
+		assert.False(t, running)
+		assert.False(t, elector.IsLeader())
+		assert.Equal(t, 1, lock.releasedCount())
+	})
+	t.Run("should stop duties when lock is lost and run them again once reacquired", func(t *testing.T) {
+		lock := &fakeLock{}
+		lock.set(true, false)
+		recorder := &dutyRecorder{}
+		elector := leader.NewElector(lock, config)
+		elector.Register("duty", recorder.duty)
+		elector.Init()
+		defer elector.Close()
+
+		assert.Eventually(t, func() bool {
+			_, running := recorder.state()
+			return running
+		}, waitFor, tick)
+
+		// another server takes over
+		lock.set(false, true)
+		assert.Eventually(t, func() bool {
+			_, running := recorder.state()
+			return !running && !elector.IsLeader()
+		}, waitFor, tick)
+
+		lock.set(true, false)
+		assert.Eventually(t, func() bool {
+			runs, running := recorder.state()
+			return runs == 2 && running && elector.IsLeader()
+		}, waitFor, tick)
+	})
+	t.Run("Every should run function right away and then every interval", func(t *testing.T) {
+		var (
+			mu    sync.Mutex
+			calls int
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			leader.Every(config.Interval, func(context.Context) {
+				mu.Lock()
+				calls++
+				mu.Unlock()
+			})(ctx)
+			close(done)
+		}()
+
+		assert.Eventually(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return calls >= 3
+		}, waitFor, tick)
+		cancel()
+		<-done
+	})
+}
@@ -0,0 +1,13 @@
+package leader
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var isLeader = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: "optimus",
+	Subsystem: "server",
+	Name:      "leader",
+	Help:      "1 if singleton duties run on this server, 0 otherwise",
+})
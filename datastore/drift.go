@@ -0,0 +1,285 @@
+package datastore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/kushsharma/parallel"
+	"github.com/odpf/optimus/core/logger"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/telemetry"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// MaxDriftFieldsToNotify limits fields listed per resource in a notification
+	MaxDriftFieldsToNotify = 10
+)
+
+// DetectDrift reads each stored resource spec back from its datastore and
+// compares them field by field. Resource types without a models.DatastoreSpecDiffer
+// are skipped.
+func (srv Service) DetectDrift(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	names []string) ([]models.ResourceDrift, error) {
+	ds, err := srv.dsRepo.GetByName(datastoreName)
+	if err != nil {
+		return nil, err
+	}
+	repo := srv.resourceRepoFactory.New(namespace, ds)
+
+	var resourceSpecs []models.ResourceSpec
+	if len(names) == 0 {
+		if resourceSpecs, err = repo.GetAll(); err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch resources for namespace %s", namespace.Name)
+		}
+	} else {
+		for _, name := range names {
+			resourceSpec, err := repo.GetByName(name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to fetch resource %s", name)
+			}
+			resourceSpecs = append(resourceSpecs, resourceSpec)
+		}
+	}
+
+	runner := parallel.NewRunner(parallel.WithLimit(ConcurrentLimit), parallel.WithTicket(ConcurrentTicketPerSec))
+	for _, resourceSpec := range resourceSpecs {
+		typeController, ok := ds.Types()[resourceSpec.Type]
+		if !ok {
+			continue
+		}
+		differ, ok := typeController.(models.DatastoreSpecDiffer)
+		if !ok {
+			continue
+		}
+
+		currentSpec := resourceSpec
+		runner.Add(func() (interface{}, error) {
+			return srv.detectResourceDrift(ctx, namespace, currentSpec, differ), nil
+		})
+	}
+
+	var drifts []models.ResourceDrift
+	for _, result := range runner.Run() {
+		drifts = append(drifts, result.Val.(models.ResourceDrift))
+	}
+	sort.Slice(drifts, func(i, j int) bool {
+		return drifts[i].Spec.Name < drifts[j].Spec.Name
+	})
+	return drifts, nil
+}
+
+func (srv Service) detectResourceDrift(ctx context.Context, namespace models.NamespaceSpec, resourceSpec models.ResourceSpec,
+	differ models.DatastoreSpecDiffer) models.ResourceDrift {
+	drift := models.ResourceDrift{
+		Spec: resourceSpec,
+	}
+	spanCtx, span := startResourceSpan(ctx, "ReadResource", resourceSpec)
+	liveResource, err := resourceSpec.Datastore.ReadResource(spanCtx, models.ReadResourceRequest{
+		Resource: resourceSpec,
+		Project:  namespace.ProjectSpec,
+	})
+	telemetry.EndSpan(span, err)
+	if err != nil {
+		if errors.Is(err, models.ErrResourceNotFoundInDatastore) {
+			drift.Missing = true
+		} else {
+			drift.Err = err
+		}
+		return drift
+	}
+	drift.Fields, drift.Err = differ.Diff(resourceSpec, liveResource.Resource)
+	return drift
+}
+
+// DriftMonitorConfig configures periodic drift detection
+type DriftMonitorConfig struct {
+	// Interval between two detections, monitor is disabled if zero
+	Interval time.Duration
+	// Timeout of detection for a namespace
+	Timeout time.Duration
+}
+
+// DriftMonitor periodically detects drift of resources in every namespace of
+// registered projects and notifies channels configured by the project with
+// models.ProjectResourceDriftNotify
+type DriftMonitor struct {
+	config DriftMonitorConfig
+
+	resourceSvc       models.DatastoreService
+	dsRepo            models.DatastoreRepo
+	projectRepoFac    ProjectRepoFactory
+	namespaceRepoFac  NamespaceRepoFactory
+	notifyChannels    map[string]models.Notifier
+	lastNotifiedDrift map[string]string
+}
+
+type ProjectRepoFactory interface {
+	New() store.ProjectRepository
+}
+
+type NamespaceRepoFactory interface {
+	New(spec models.ProjectSpec) store.NamespaceRepository
+}
+
+// Monitor detects drift every interval till ctx is done, it returns right
+// away if monitor is disabled
+func (m *DriftMonitor) Monitor(ctx context.Context) {
+	if m.config.Interval <= 0 {
+		return
+	}
+	logger.I("starting resource drift monitor every ", m.config.Interval)
+	ticker := time.NewTicker(m.config.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := m.Run(ctx); err != nil {
+				logger.E(errors.Wrap(err, "resource drift monitor"))
+			}
+		}
+	}
+}
+
+// Run detects drift once across all projects, notifying a drift only
+// if it changed since the last notification
+func (m *DriftMonitor) Run(ctx context.Context) error {
+	projects, err := m.projectRepoFac.New().GetAll()
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch projects")
+	}
+
+	var errorSet error
+	for _, proj := range projects {
+		channels := driftNotifyChannels(proj)
+		if len(channels) == 0 {
+			continue
+		}
+		namespaces, err := m.namespaceRepoFac.New(proj).GetAll()
+		if err != nil {
+			errorSet = multierror.Append(errorSet, errors.Wrapf(err, "failed to fetch namespaces of %s", proj.Name))
+			continue
+		}
+		for _, namespace := range namespaces {
+			for _, ds := range m.dsRepo.GetAll() {
+				if err := m.checkNamespace(ctx, namespace, ds.Name(), channels); err != nil {
+					errorSet = multierror.Append(errorSet, err)
+				}
+			}
+		}
+	}
+	return errorSet
+}
+
+func (m *DriftMonitor) checkNamespace(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	channels []string) error {
+	timeoutCtx := ctx
+	if m.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		timeoutCtx, cancel = context.WithTimeout(ctx, m.config.Timeout)
+		defer cancel()
+	}
+
+	drifts, err := m.resourceSvc.DetectDrift(timeoutCtx, namespace, datastoreName, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to detect drift in %s/%s", namespace.ProjectSpec.Name, namespace.Name)
+	}
+
+	var errorSet error
+	for _, drift := range drifts {
+		key := fmt.Sprintf("%s/%s/%s/%s", namespace.ProjectSpec.Name, namespace.Name, datastoreName, drift.Spec.Name)
+		summary := DescribeDrift(drift)
+		if !drift.Drifted() {
+			delete(m.lastNotifiedDrift, key)
+			continue
+		}
+		if m.lastNotifiedDrift[key] == summary {
+			continue
+		}
+
+		event := models.JobEvent{
+			Type: models.JobEventTypeResourceDrift,
+			Value: map[string]*structpb.Value{
+				"resource":  structpb.NewStringValue(drift.Spec.Name),
+				"datastore": structpb.NewStringValue(datastoreName),
+				"drift":     structpb.NewStringValue(summary),
+			},
+		}
+		notified := true
+		for _, channel := range channels {
+			chanParts := strings.SplitN(channel, "://", 2)
+			notifier, ok := m.notifyChannels[chanParts[0]]
+			if !ok || len(chanParts) != 2 {
+				errorSet = multierror.Append(errorSet, errors.Errorf("unsupported drift notification channel %s", channel))
+				continue
+			}
+			if err := notifier.Notify(ctx, models.NotifyAttrs{
+				Namespace: namespace,
+				JobEvent:  event,
+				Route:     chanParts[1],
+			}); err != nil {
+				notified = false
+				errorSet = multierror.Append(errorSet, errors.Wrapf(err, "failed to notify drift to %s", channel))
+			}
+		}
+		if notified {
+			m.lastNotifiedDrift[key] = summary
+		}
+	}
+	return errorSet
+}
+
+func driftNotifyChannels(proj models.ProjectSpec) []string {
+	var channels []string
+	for _, channel := range strings.Split(proj.Config[models.ProjectResourceDriftNotify], ",") {
+		if channel = strings.TrimSpace(channel); channel != "" {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// DescribeDrift summarises drift of a resource in human readable form
+func DescribeDrift(drift models.ResourceDrift) string {
+	switch {
+	case drift.Err != nil:
+		return fmt.Sprintf("failed to compare: %s", drift.Err)
+	case drift.Missing:
+		return "resource doesn't exist in datastore"
+	case len(drift.Fields) == 0:
+		return "in sync"
+	}
+
+	var lines []string
+	for idx, field := range drift.Fields {
+		if idx == MaxDriftFieldsToNotify {
+			lines = append(lines, fmt.Sprintf("and %d more field(s)", len(drift.Fields)-idx))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s: expected %q, found %q", field.Field, field.Expected, field.Actual))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NewDriftMonitor creates an instance of DriftMonitor sending notifications
+// through notifyChannels keyed by scheme
+func NewDriftMonitor(config DriftMonitorConfig, resourceSvc models.DatastoreService, dsRepo models.DatastoreRepo,
+	projectRepoFac ProjectRepoFactory, namespaceRepoFac NamespaceRepoFactory, notifyChannels map[string]models.Notifier) *DriftMonitor {
+	return &DriftMonitor{
+		config:            config,
+		resourceSvc:       resourceSvc,
+		dsRepo:            dsRepo,
+		projectRepoFac:    projectRepoFac,
+		namespaceRepoFac:  namespaceRepoFac,
+		notifyChannels:    notifyChannels,
+		lastNotifiedDrift: map[string]string{},
+	}
+}
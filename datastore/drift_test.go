@@ -0,0 +1,134 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+
+	"github.com/odpf/optimus/datastore"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+)
+
+func TestDrift(t *testing.T) {
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "a-data-project",
+		Config: map[string]string{
+			models.ProjectResourceDriftNotify: "slack://#data-alerts",
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "dev-team-1",
+		ProjectSpec: projectSpec,
+	}
+
+	t.Run("DetectDrift", func(t *testing.T) {
+		t.Run("should compare stored specs with live resources", func(t *testing.T) {
+			differ := new(mock.DatastoreTypeDiffer)
+			defer differ.AssertExpectations(t)
+			viewController := new(mock.DatastoreTypeController)
+
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+				models.ResourceTypeTable: differ,
+				models.ResourceTypeView:  viewController,
+			})
+			defer datastorer.AssertExpectations(t)
+
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetByName", "bq").Return(datastorer, nil)
+			defer dsRepo.AssertExpectations(t)
+
+			drifted := models.ResourceSpec{Name: "proj.datas.drifted", Type: models.ResourceTypeTable, Datastore: datastorer}
+			missing := models.ResourceSpec{Name: "proj.datas.missing", Type: models.ResourceTypeTable, Datastore: datastorer}
+			unreadable := models.ResourceSpec{Name: "proj.datas.unreadable", Type: models.ResourceTypeTable, Datastore: datastorer}
+			view := models.ResourceSpec{Name: "proj.datas.view", Type: models.ResourceTypeView, Datastore: datastorer}
+
+			liveDrifted := drifted
+			liveDrifted.Spec = "live"
+			fieldDrifts := []models.ResourceFieldDrift{{Field: "description", Expected: "orders", Actual: "all orders"}}
+			datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: drifted, Project: projectSpec}).
+				Return(models.ReadResourceResponse{Resource: liveDrifted}, nil)
+			datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: missing, Project: projectSpec}).
+				Return(models.ReadResourceResponse{}, errors.Wrap(models.ErrResourceNotFoundInDatastore, "404"))
+			datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: unreadable, Project: projectSpec}).
+				Return(models.ReadResourceResponse{}, errors.New("permission denied"))
+			differ.On("Diff", drifted, liveDrifted).Return(fieldDrifts, nil)
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("GetAll").Return([]models.ResourceSpec{view, unreadable, missing, drifted}, nil)
+			defer resourceRepo.AssertExpectations(t)
+
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+			defer resourceRepoFac.AssertExpectations(t)
+
+			service := datastore.NewService(resourceRepoFac, dsRepo)
+			drifts, err := service.DetectDrift(context.TODO(), namespaceSpec, "bq", nil)
+			assert.Nil(t, err)
+			assert.Len(t, drifts, 3)
+
+			assert.Equal(t, drifted.Name, drifts[0].Spec.Name)
+			assert.Equal(t, fieldDrifts, drifts[0].Fields)
+			assert.True(t, drifts[0].Drifted())
+
+			assert.Equal(t, missing.Name, drifts[1].Spec.Name)
+			assert.True(t, drifts[1].Missing)
+
+			assert.Equal(t, unreadable.Name, drifts[2].Spec.Name)
+			assert.NotNil(t, drifts[2].Err)
+			assert.False(t, drifts[2].Drifted())
+		})
+	})
+	t.Run("DriftMonitor", func(t *testing.T) {
+		drift := models.ResourceDrift{
+			Spec:   models.ResourceSpec{Name: "proj.datas.drifted", Type: models.ResourceTypeTable},
+			Fields: []models.ResourceFieldDrift{{Field: "description", Expected: "orders", Actual: "all orders"}},
+		}
+
+		t.Run("should notify a drift once till it changes", func(t *testing.T) {
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Name").Return("bq")
+
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetAll").Return([]models.Datastorer{datastorer})
+
+			projectRepo := new(mock.ProjectRepository)
+			projectRepo.On("GetAll").Return([]models.ProjectSpec{projectSpec, {Name: "silent-project"}}, nil)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepo)
+
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetAll").Return([]models.NamespaceSpec{namespaceSpec}, nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+			defer namespaceRepoFac.AssertExpectations(t)
+
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("DetectDrift", testMock.Anything, namespaceSpec, "bq", []string(nil)).
+				Return([]models.ResourceDrift{drift}, nil)
+			defer resourceSvc.AssertExpectations(t)
+
+			notifier := new(mock.Notifier)
+			notifier.On("Notify", context.TODO(), testMock.MatchedBy(func(attr models.NotifyAttrs) bool {
+				return attr.Route == "#data-alerts" && attr.Namespace.Name == namespaceSpec.Name &&
+					attr.JobEvent.Type == models.JobEventTypeResourceDrift &&
+					attr.JobEvent.Value["resource"].GetStringValue() == drift.Spec.Name
+			})).Return(nil).Once()
+			defer notifier.AssertExpectations(t)
+
+			monitor := datastore.NewDriftMonitor(datastore.DriftMonitorConfig{}, resourceSvc, dsRepo, projectRepoFac,
+				namespaceRepoFac, map[string]models.Notifier{"slack": notifier})
+			assert.Nil(t, monitor.Run(context.TODO()))
+			// unchanged drift is not notified again
+			assert.Nil(t, monitor.Run(context.TODO()))
+		})
+	})
+}
@@ -0,0 +1,155 @@
+package datastore
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/kushsharma/parallel"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// resourceGraph orders resources being deployed together by their dependencies,
+// dependencies on resources outside of the deployment are ignored
+type resourceGraph struct {
+	// waves of resources, resources of a wave only depend on earlier waves
+	waves [][]models.ResourceSpec
+	// upstreams of each resource among deployed resources
+	upstreams map[string][]string
+	// failed resources which couldn't be ordered
+	failed map[string]error
+}
+
+func newResourceGraph(resourceSpecs []models.ResourceSpec) *resourceGraph {
+	graph := &resourceGraph{
+		upstreams: map[string][]string{},
+		failed:    map[string]error{},
+	}
+	deployed := map[string]bool{}
+	for _, resourceSpec := range resourceSpecs {
+		deployed[resourceSpec.Name] = true
+	}
+
+	pending := map[string]models.ResourceSpec{}
+	for _, resourceSpec := range resourceSpecs {
+		dependencies, err := resourceDependencies(resourceSpec)
+		if err != nil {
+			graph.failed[resourceSpec.Name] = errors.Wrapf(err, "failed to resolve dependencies of %s", resourceSpec.Name)
+			continue
+		}
+		for _, dependency := range dependencies {
+			if deployed[dependency] && dependency != resourceSpec.Name {
+				graph.upstreams[resourceSpec.Name] = append(graph.upstreams[resourceSpec.Name], dependency)
+			}
+		}
+		pending[resourceSpec.Name] = resourceSpec
+	}
+
+	// resources whose upstreams are all ordered form the next wave, failed
+	// resources are considered ordered to skip their downstreams later
+	ordered := map[string]bool{}
+	for name := range graph.failed {
+		ordered[name] = true
+	}
+	for len(pending) > 0 {
+		var wave []models.ResourceSpec
+		for _, resourceSpec := range pending {
+			ready := true
+			for _, upstream := range graph.upstreams[resourceSpec.Name] {
+				if !ordered[upstream] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				wave = append(wave, resourceSpec)
+			}
+		}
+		if len(wave) == 0 {
+			break
+		}
+		sort.Slice(wave, func(i, j int) bool {
+			return wave[i].Name < wave[j].Name
+		})
+		for _, resourceSpec := range wave {
+			ordered[resourceSpec.Name] = true
+			delete(pending, resourceSpec.Name)
+		}
+		graph.waves = append(graph.waves, wave)
+	}
+
+	// remaining resources are either part of a cycle or depend on one
+	for name := range pending {
+		graph.failed[name] = errors.Wrapf(models.ErrCyclicResourceDependency, "%s depends on %s", name,
+			strings.Join(graph.upstreams[name], ", "))
+	}
+	return graph
+}
+
+// failedUpstream returns an upstream of resource which failed
+func (g *resourceGraph) failedUpstream(name string, failed map[string]bool) (string, bool) {
+	for _, upstream := range g.upstreams[name] {
+		if failed[upstream] {
+			return upstream, true
+		}
+	}
+	return "", false
+}
+
+// deployInWaves deploys resources wave by wave, resources of a wave are deployed
+// in parallel. Resources which couldn't be ordered or whose upstream failed are
+// skipped and reported through skip.
+func deployInWaves(resourceSpecs []models.ResourceSpec, deploy func(models.ResourceSpec) error,
+	skip func(models.ResourceSpec, error)) error {
+	graph := newResourceGraph(resourceSpecs)
+
+	var errorSet error
+	failed := map[string]bool{}
+	for _, resourceSpec := range resourceSpecs {
+		if err, ok := graph.failed[resourceSpec.Name]; ok {
+			failed[resourceSpec.Name] = true
+			skip(resourceSpec, err)
+			errorSet = multierror.Append(errorSet, err)
+		}
+	}
+
+	for _, wave := range graph.waves {
+		runner := parallel.NewRunner(parallel.WithLimit(ConcurrentLimit), parallel.WithTicket(ConcurrentTicketPerSec))
+		var running []string
+		for _, resourceSpec := range wave {
+			if upstream, ok := graph.failedUpstream(resourceSpec.Name, failed); ok {
+				err := errors.Wrapf(models.ErrUpstreamResourceFailed, "skipped %s as %s failed", resourceSpec.Name, upstream)
+				failed[resourceSpec.Name] = true
+				skip(resourceSpec, err)
+				errorSet = multierror.Append(errorSet, err)
+				continue
+			}
+
+			currentSpec := resourceSpec
+			running = append(running, currentSpec.Name)
+			runner.Add(func() (interface{}, error) {
+				return nil, deploy(currentSpec)
+			})
+		}
+		for idx, result := range runner.Run() {
+			if result.Err != nil {
+				failed[running[idx]] = true
+				errorSet = multierror.Append(errorSet, result.Err)
+			}
+		}
+	}
+	return errorSet
+}
+
+func resourceDependencies(resourceSpec models.ResourceSpec) ([]string, error) {
+	typeController, ok := resourceSpec.Datastore.Types()[resourceSpec.Type]
+	if !ok {
+		return nil, nil
+	}
+	resolver, ok := typeController.(models.DatastoreDependencyResolver)
+	if !ok {
+		return nil, nil
+	}
+	return resolver.Dependencies(resourceSpec)
+}
@@ -0,0 +1,97 @@
+package datastore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/kushsharma/parallel"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/telemetry"
+	"github.com/pkg/errors"
+)
+
+// PlanResource reads each resource back from datastore to classify what
+// deploying its spec would do. Existing resources of types without a
+// models.DatastoreSpecDiffer are always planned for update.
+func (srv Service) PlanResource(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	resourceSpecs []models.ResourceSpec, prune bool) ([]models.ResourcePlan, error) {
+	runner := parallel.NewRunner(parallel.WithLimit(ConcurrentLimit), parallel.WithTicket(ConcurrentTicketPerSec))
+	for _, resourceSpec := range resourceSpecs {
+		currentSpec := resourceSpec
+		runner.Add(func() (interface{}, error) {
+			return srv.planResource(ctx, namespace, currentSpec), nil
+		})
+	}
+
+	var plans []models.ResourcePlan
+	for _, result := range runner.Run() {
+		plans = append(plans, result.Val.(models.ResourcePlan))
+	}
+	sort.Slice(plans, func(i, j int) bool {
+		return plans[i].Spec.Name < plans[j].Spec.Name
+	})
+	if !prune {
+		return plans, nil
+	}
+
+	ds, err := srv.dsRepo.GetByName(datastoreName)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := srv.resourceRepoFactory.New(namespace, ds).GetAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch resources for namespace %s", namespace.Name)
+	}
+	for _, resourceSpec := range PrunableResources(registered, resourceSpecs) {
+		plans = append(plans, models.ResourcePlan{
+			Spec:   resourceSpec,
+			Action: models.ResourcePlanDelete,
+		})
+	}
+	return plans, nil
+}
+
+func (srv Service) planResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpec models.ResourceSpec) models.ResourcePlan {
+	plan := models.ResourcePlan{
+		Spec: resourceSpec,
+	}
+	spanCtx, span := startResourceSpan(ctx, "ReadResource", resourceSpec)
+	current, err := resourceSpec.Datastore.ReadResource(spanCtx, models.ReadResourceRequest{
+		Resource: resourceSpec,
+		Project:  namespace.ProjectSpec,
+	})
+	telemetry.EndSpan(span, err)
+	if err != nil {
+		if errors.Is(err, models.ErrResourceNotFoundInDatastore) {
+			plan.Action = models.ResourcePlanCreate
+		} else {
+			plan.Err = err
+		}
+		return plan
+	}
+
+	typeController := resourceSpec.Datastore.Types()[resourceSpec.Type]
+	differ, ok := typeController.(models.DatastoreSpecDiffer)
+	if !ok {
+		plan.Action = models.ResourcePlanUpdate
+		return plan
+	}
+	if plan.Fields, plan.Err = differ.Diff(resourceSpec, current.Resource); plan.Err != nil {
+		if errors.Is(plan.Err, models.ErrResourceCompareUnsupported) {
+			plan.Action = models.ResourcePlanUnsupported
+			plan.Err = nil
+		}
+		return plan
+	}
+	if checker, ok := typeController.(models.DatastoreSchemaChecker); ok {
+		if plan.SchemaChanges, plan.Err = checker.CheckSchemaChange(current.Resource, resourceSpec); plan.Err != nil {
+			return plan
+		}
+	}
+
+	plan.Action = models.ResourcePlanNoop
+	if len(plan.Fields) > 0 || len(plan.SchemaChanges) > 0 {
+		plan.Action = models.ResourcePlanUpdate
+	}
+	return plan
+}
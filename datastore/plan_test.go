@@ -0,0 +1,106 @@
+package datastore_test
+
+import (
+	"context"
+	testMock "github.com/stretchr/testify/mock"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/odpf/optimus/datastore"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+)
+
+func TestPlan(t *testing.T) {
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "a-data-project",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "dev-team-1",
+		ProjectSpec: projectSpec,
+	}
+
+	t.Run("PlanResource", func(t *testing.T) {
+		differ := new(mock.DatastoreTypeDiffer)
+		viewController := new(mock.DatastoreTypeController)
+		opaqueDiffer := new(mock.DatastoreTypeDiffer)
+
+		datastorer := new(mock.Datastorer)
+		datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+			models.ResourceTypeTable:   differ,
+			models.ResourceTypeView:    viewController,
+			models.ResourceTypeDataset: opaqueDiffer,
+		})
+
+		created := models.ResourceSpec{Name: "proj.datas.created", Type: models.ResourceTypeTable, Datastore: datastorer}
+		updated := models.ResourceSpec{Name: "proj.datas.updated", Type: models.ResourceTypeTable, Datastore: datastorer}
+		unchanged := models.ResourceSpec{Name: "proj.datas.unchanged", Type: models.ResourceTypeTable, Datastore: datastorer}
+		unreadable := models.ResourceSpec{Name: "proj.datas.unreadable", Type: models.ResourceTypeTable, Datastore: datastorer}
+		view := models.ResourceSpec{Name: "proj.datas.view", Type: models.ResourceTypeView, Datastore: datastorer}
+		opaque := models.ResourceSpec{Name: "proj.datas", Type: models.ResourceTypeDataset, Datastore: datastorer}
+		removed := models.ResourceSpec{Name: "proj.datas.removed", Type: models.ResourceTypeTable, Datastore: datastorer}
+		resourceSpecs := []models.ResourceSpec{view, unreadable, unchanged, updated, created, opaque}
+
+		currentUpdated := updated
+		currentUpdated.Spec = "current"
+		fieldDrifts := []models.ResourceFieldDrift{{Field: "description", Expected: "all orders", Actual: "orders"}}
+		datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: created, Project: projectSpec}).
+			Return(models.ReadResourceResponse{}, errors.Wrap(models.ErrResourceNotFoundInDatastore, "404"))
+		datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: updated, Project: projectSpec}).
+			Return(models.ReadResourceResponse{Resource: currentUpdated}, nil)
+		datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: unchanged, Project: projectSpec}).
+			Return(models.ReadResourceResponse{Resource: unchanged}, nil)
+		datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: unreadable, Project: projectSpec}).
+			Return(models.ReadResourceResponse{}, errors.New("permission denied"))
+		datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: view, Project: projectSpec}).
+			Return(models.ReadResourceResponse{Resource: view}, nil)
+		differ.On("Diff", updated, currentUpdated).Return(fieldDrifts, nil)
+		differ.On("Diff", unchanged, unchanged).Return([]models.ResourceFieldDrift(nil), nil)
+		datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: opaque, Project: projectSpec}).
+			Return(models.ReadResourceResponse{Resource: opaque}, nil)
+		opaqueDiffer.On("Diff", opaque, opaque).
+			Return([]models.ResourceFieldDrift(nil), errors.Wrap(models.ErrResourceCompareUnsupported, "pg"))
+
+		t.Run("should classify each resource without changing anything", func(t *testing.T) {
+			service := datastore.NewService(new(mock.ResourceSpecRepoFactory), new(mock.SupportedDatastoreRepo))
+			plans, err := service.PlanResource(context.TODO(), namespaceSpec, "bq", resourceSpecs, false)
+			assert.Nil(t, err)
+			assert.Len(t, plans, 6)
+
+			assert.Equal(t, models.ResourcePlan{Spec: opaque, Action: models.ResourcePlanUnsupported}, plans[0])
+			plans = plans[1:]
+			assert.Equal(t, models.ResourcePlan{Spec: created, Action: models.ResourcePlanCreate}, plans[0])
+			assert.Equal(t, models.ResourcePlan{Spec: unchanged, Action: models.ResourcePlanNoop}, plans[1])
+			assert.Equal(t, unreadable.Name, plans[2].Spec.Name)
+			assert.NotNil(t, plans[2].Err)
+			assert.Equal(t, models.ResourcePlan{Spec: updated, Action: models.ResourcePlanUpdate, Fields: fieldDrifts}, plans[3])
+			assert.Equal(t, models.ResourcePlan{Spec: view, Action: models.ResourcePlanUpdate}, plans[4])
+			datastorer.AssertNotCalled(t, "UpdateResource")
+		})
+		t.Run("should plan deletion of resources missing from specs if pruned", func(t *testing.T) {
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetByName", "bq").Return(datastorer, nil)
+			defer dsRepo.AssertExpectations(t)
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("GetAll").Return(append([]models.ResourceSpec{removed}, resourceSpecs...), nil)
+			defer resourceRepo.AssertExpectations(t)
+
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+			defer resourceRepoFac.AssertExpectations(t)
+
+			service := datastore.NewService(resourceRepoFac, dsRepo)
+			plans, err := service.PlanResource(context.TODO(), namespaceSpec, "bq", resourceSpecs, true)
+			assert.Nil(t, err)
+			assert.Len(t, plans, 7)
+			assert.Equal(t, models.ResourcePlan{Spec: removed, Action: models.ResourcePlanDelete}, plans[6])
+			resourceRepo.AssertNotCalled(t, "Delete", removed.Name)
+		})
+	})
+}
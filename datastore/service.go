@@ -3,20 +3,30 @@ package datastore
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/odpf/optimus/core/progress"
 
 	"github.com/hashicorp/go-multierror"
-	"github.com/kushsharma/parallel"
 
 	"github.com/odpf/optimus/store"
 
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/telemetry"
+	"github.com/pkg/errors"
 )
 
 const (
 	ConcurrentTicketPerSec = 5
 	ConcurrentLimit        = 20
+
+	// PersistResourcePrefix protects resources from being pruned, it is
+	// matched against the full name and the last part of a dotted name
+	PersistResourcePrefix = "__"
+
+	// ResourceLabelProtected protects resources labeled with it as "true"
+	// from being pruned
+	ResourceLabelProtected = "optimus-protected"
 )
 
 type ResourceSpecRepoFactory interface {
@@ -40,66 +50,137 @@ func (srv Service) GetAll(namespace models.NamespaceSpec, datastoreName string)
 	return srv.resourceRepoFactory.New(namespace, ds).GetAll()
 }
 
-func (srv Service) CreateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec, obs progress.Observer) error {
-	runner := parallel.NewRunner(parallel.WithLimit(ConcurrentLimit), parallel.WithTicket(ConcurrentTicketPerSec))
-	for _, resourceSpec := range resourceSpecs {
-		currentSpec := resourceSpec
-		repo := srv.resourceRepoFactory.New(namespace, currentSpec.Datastore)
-		runner.Add(func() (interface{}, error) {
-			if err := repo.Save(currentSpec); err != nil {
-				return nil, err
-			}
-
-			err := currentSpec.Datastore.CreateResource(ctx, models.CreateResourceRequest{
-				Resource: currentSpec,
-				Project:  namespace.ProjectSpec,
-			})
-			srv.notifyProgress(obs, &EventResourceCreated{
-				Spec: currentSpec,
-				Err:  err,
-			})
-			return nil, err
-		})
+func (srv Service) GetByFilter(namespace models.NamespaceSpec, datastoreName string, filter models.ResourceSpecFilter) ([]models.ResourceSpec, error) {
+	ds, err := srv.dsRepo.GetByName(datastoreName)
+	if err != nil {
+		return nil, err
 	}
+	return srv.resourceRepoFactory.New(namespace, ds).GetByFilter(filter)
+}
 
-	var errorSet error
-	for _, result := range runner.Run() {
-		if result.Err != nil {
-			errorSet = multierror.Append(errorSet, result.Err)
+// CreateResource saves and creates resources in datastore, a resource is
+// created only after resources it depends on and skipped if any of them failed
+func (srv Service) CreateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec, obs progress.Observer) error {
+	return deployInWaves(resourceSpecs, func(currentSpec models.ResourceSpec) error {
+		repo := srv.resourceRepoFactory.New(namespace, currentSpec.Datastore)
+		if err := repo.Save(currentSpec); err != nil {
+			return err
 		}
-	}
-	return errorSet
+
+		spanCtx, span := startResourceSpan(ctx, "CreateResource", currentSpec)
+		err := currentSpec.Datastore.CreateResource(spanCtx, models.CreateResourceRequest{
+			Resource: currentSpec,
+			Project:  namespace.ProjectSpec,
+		})
+		telemetry.EndSpan(span, err)
+		srv.notifyProgress(obs, &EventResourceCreated{
+			Spec: currentSpec,
+			Err:  err,
+		})
+		return err
+	}, func(currentSpec models.ResourceSpec, err error) {
+		srv.notifyProgress(obs, &EventResourceCreated{
+			Spec: currentSpec,
+			Err:  err,
+		})
+	})
 }
 
-func (srv Service) UpdateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec, obs progress.Observer) error {
-	runner := parallel.NewRunner(parallel.WithLimit(ConcurrentLimit), parallel.WithTicket(ConcurrentTicketPerSec))
-	for _, resourceSpec := range resourceSpecs {
-		currentSpec := resourceSpec
-		repo := srv.resourceRepoFactory.New(namespace, currentSpec.Datastore)
-		runner.Add(func() (interface{}, error) {
-			if err := repo.Save(currentSpec); err != nil {
-				return nil, err
-			}
-
-			err := currentSpec.Datastore.UpdateResource(ctx, models.UpdateResourceRequest{
-				Resource: currentSpec,
-				Project:  namespace.ProjectSpec,
-			})
+// UpdateResource saves and updates resources in datastore in order of their
+// dependencies like CreateResource. Schema changes are checked against current
+// resources for types implementing models.DatastoreSchemaChecker, resources with
+// breaking changes are left untouched unless allowBreakingChanges is set
+func (srv Service) UpdateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec,
+	allowBreakingChanges bool, obs progress.Observer) error {
+	return deployInWaves(resourceSpecs, func(currentSpec models.ResourceSpec) error {
+		if err := srv.checkSchemaChange(ctx, namespace, currentSpec, allowBreakingChanges, obs); err != nil {
 			srv.notifyProgress(obs, &EventResourceUpdated{
 				Spec: currentSpec,
 				Err:  err,
 			})
-			return nil, err
+			return err
+		}
+		repo := srv.resourceRepoFactory.New(namespace, currentSpec.Datastore)
+		if err := repo.Save(currentSpec); err != nil {
+			return err
+		}
+
+		spanCtx, span := startResourceSpan(ctx, "UpdateResource", currentSpec)
+		err := currentSpec.Datastore.UpdateResource(spanCtx, models.UpdateResourceRequest{
+			Resource: currentSpec,
+			Project:  namespace.ProjectSpec,
+		})
+		telemetry.EndSpan(span, err)
+		srv.notifyProgress(obs, &EventResourceUpdated{
+			Spec: currentSpec,
+			Err:  err,
 		})
+		return err
+	}, func(currentSpec models.ResourceSpec, err error) {
+		srv.notifyProgress(obs, &EventResourceUpdated{
+			Spec: currentSpec,
+			Err:  err,
+		})
+	})
+}
+
+func (srv Service) checkSchemaChange(ctx context.Context, namespace models.NamespaceSpec, resourceSpec models.ResourceSpec,
+	allowBreakingChanges bool, obs progress.Observer) error {
+	typeController, ok := resourceSpec.Datastore.Types()[resourceSpec.Type]
+	if !ok {
+		return nil
+	}
+	checker, ok := typeController.(models.DatastoreSchemaChecker)
+	if !ok {
+		return nil
 	}
 
-	var errorSet error
-	for _, result := range runner.Run() {
-		if result.Err != nil {
-			errorSet = multierror.Append(errorSet, result.Err)
+	spanCtx, span := startResourceSpan(ctx, "ReadResource", resourceSpec)
+	current, err := resourceSpec.Datastore.ReadResource(spanCtx, models.ReadResourceRequest{
+		Resource: resourceSpec,
+		Project:  namespace.ProjectSpec,
+	})
+	telemetry.EndSpan(span, err)
+	if err != nil {
+		if errors.Is(err, models.ErrResourceNotFoundInDatastore) {
+			// will be created
+			return nil
 		}
+		return errors.Wrapf(err, "failed to read %s for checking schema changes", resourceSpec.Name)
 	}
-	return errorSet
+	changes, err := checker.CheckSchemaChange(current.Resource, resourceSpec)
+	if errors.Is(err, models.ErrResourceCompareUnsupported) {
+		// changes could be breaking, only apply them when allowed
+		if allowBreakingChanges {
+			return nil
+		}
+		return errors.Wrapf(models.ErrBreakingSchemaChange, "schema changes of %s can't be checked, %s, allow breaking changes to apply them",
+			resourceSpec.Name, err)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to check schema changes of %s", resourceSpec.Name)
+	}
+	if len(changes) == 0 {
+		return nil
+	}
+
+	breakingChanges := 0
+	for _, change := range changes {
+		if change.Kind == models.SchemaChangeBreaking {
+			breakingChanges++
+		}
+	}
+	blocked := breakingChanges > 0 && !allowBreakingChanges
+	srv.notifyProgress(obs, &EventResourceSchemaChanged{
+		Spec:    resourceSpec,
+		Changes: changes,
+		Blocked: blocked,
+	})
+	if blocked {
+		return errors.Wrapf(models.ErrBreakingSchemaChange, "%d breaking change(s) in %s, allow breaking changes to apply them",
+			breakingChanges, resourceSpec.Name)
+	}
+	return nil
 }
 
 func (srv Service) ReadResource(ctx context.Context, namespace models.NamespaceSpec, datastoreName, name string) (models.ResourceSpec, error) {
@@ -113,10 +194,12 @@ func (srv Service) ReadResource(ctx context.Context, namespace models.NamespaceS
 		return models.ResourceSpec{}, err
 	}
 
-	infoResponse, err := dbSpec.Datastore.ReadResource(ctx, models.ReadResourceRequest{
+	spanCtx, span := startResourceSpan(ctx, "ReadResource", dbSpec)
+	infoResponse, err := dbSpec.Datastore.ReadResource(spanCtx, models.ReadResourceRequest{
 		Resource: dbSpec,
 		Project:  namespace.ProjectSpec,
 	})
+	telemetry.EndSpan(span, err)
 	if err != nil {
 		return models.ResourceSpec{}, err
 	}
@@ -133,16 +216,122 @@ func (srv Service) DeleteResource(ctx context.Context, namespace models.Namespac
 	if err != nil {
 		return err
 	}
+	return srv.deleteResource(ctx, namespace, repo, resourceSpec)
+}
 
+// KeepOnly deletes registered resources of a datastore in namespace which are
+// missing from specsToKeep. Only resources named in confirmed are deleted and
+// protected ones are always kept
+func (srv Service) KeepOnly(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	specsToKeep []models.ResourceSpec, confirmed []string, obs progress.Observer) error {
+	ds, err := srv.dsRepo.GetByName(datastoreName)
+	if err != nil {
+		return err
+	}
+	repo := srv.resourceRepoFactory.New(namespace, ds)
+	registeredSpecs, err := repo.GetAll()
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch resources for namespace %s", namespace.Name)
+	}
+
+	confirmedNames := map[string]bool{}
+	for _, name := range confirmed {
+		confirmedNames[name] = true
+	}
+
+	var errorSet error
+	for _, resourceSpec := range PrunableResources(registeredSpecs, specsToKeep) {
+		if !confirmedNames[resourceSpec.Name] {
+			srv.notifyProgress(obs, &EventResourceDeleted{
+				Spec:    resourceSpec,
+				Skipped: true,
+			})
+			continue
+		}
+		err := srv.deleteResource(ctx, namespace, repo, resourceSpec)
+		srv.notifyProgress(obs, &EventResourceDeleted{
+			Spec: resourceSpec,
+			Err:  err,
+		})
+		if err != nil {
+			errorSet = multierror.Append(errorSet, err)
+		}
+	}
+	return errorSet
+}
+
+// DropResources deletes resources from their datastores, protected ones are
+// always kept. Specs are left as they are, they are archived along with the
+// namespace
+func (srv Service) DropResources(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec,
+	obs progress.Observer) error {
+	var errorSet error
+	for _, resourceSpec := range resourceSpecs {
+		if isResourceProtected(resourceSpec) {
+			srv.notifyProgress(obs, &EventResourceDeleted{
+				Spec:      resourceSpec,
+				Skipped:   true,
+				Protected: true,
+			})
+			continue
+		}
+		err := srv.dropResource(ctx, namespace, resourceSpec)
+		srv.notifyProgress(obs, &EventResourceDeleted{
+			Spec: resourceSpec,
+			Err:  err,
+		})
+		if err != nil {
+			errorSet = multierror.Append(errorSet, err)
+		}
+	}
+	return errorSet
+}
+
+func (srv Service) deleteResource(ctx context.Context, namespace models.NamespaceSpec, repo store.ResourceSpecRepository,
+	resourceSpec models.ResourceSpec) error {
 	// migrate the deleted resource
-	if err := resourceSpec.Datastore.DeleteResource(ctx, models.DeleteResourceRequest{
+	if err := srv.dropResource(ctx, namespace, resourceSpec); err != nil {
+		return err
+	}
+
+	return repo.Delete(resourceSpec.Name)
+}
+
+func (srv Service) dropResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpec models.ResourceSpec) error {
+	spanCtx, span := startResourceSpan(ctx, "DeleteResource", resourceSpec)
+	err := resourceSpec.Datastore.DeleteResource(spanCtx, models.DeleteResourceRequest{
 		Resource: resourceSpec,
 		Project:  namespace.ProjectSpec,
-	}); err != nil {
-		return err
+	})
+	telemetry.EndSpan(span, err)
+	return err
+}
+
+// PrunableResources returns registered resources which are missing from
+// deployed ones and aren't protected from pruning
+func PrunableResources(registered, deployed []models.ResourceSpec) []models.ResourceSpec {
+	deployedNames := map[string]bool{}
+	for _, spec := range deployed {
+		deployedNames[spec.Name] = true
 	}
 
-	return repo.Delete(name)
+	var prunable []models.ResourceSpec
+	for _, spec := range registered {
+		if deployedNames[spec.Name] || isResourceProtected(spec) {
+			continue
+		}
+		prunable = append(prunable, spec)
+	}
+	return prunable
+}
+
+func isResourceProtected(spec models.ResourceSpec) bool {
+	if strings.EqualFold(spec.Labels[ResourceLabelProtected], "true") {
+		return true
+	}
+	nameParts := strings.Split(spec.Name, ".")
+	return strings.HasPrefix(spec.Name, PersistResourcePrefix) ||
+		strings.HasPrefix(nameParts[len(nameParts)-1], PersistResourcePrefix)
 }
 
 func (srv *Service) notifyProgress(po progress.Observer, event progress.Event) {
@@ -171,8 +360,50 @@ type (
 		Spec models.ResourceSpec
 		Err  error
 	}
+
+	// EventResourceSchemaChanged represents schema changes of a resource
+	// being updated, Blocked if they are breaking and weren't allowed
+	EventResourceSchemaChanged struct {
+		Spec    models.ResourceSpec
+		Changes []models.ResourceSchemaChange
+		Blocked bool
+	}
+
+	// EventResourceDeleted represents the resource being pruned from datastore,
+	// Skipped if it wasn't confirmed for deletion or is Protected
+	EventResourceDeleted struct {
+		Spec      models.ResourceSpec
+		Skipped   bool
+		Protected bool
+		Err       error
+	}
 )
 
+func (e *EventResourceSchemaChanged) String() string {
+	var lines []string
+	for _, change := range e.Changes {
+		lines = append(lines, change.String())
+	}
+	prefix := "schema changes"
+	if e.Blocked {
+		prefix = "blocked breaking schema changes"
+	}
+	return fmt.Sprintf("%s of %s:\n%s", prefix, e.Spec.Name, strings.Join(lines, "\n"))
+}
+
+func (e *EventResourceDeleted) String() string {
+	if e.Skipped && e.Protected {
+		return fmt.Sprintf("skipped deleting: %s, protected", e.Spec.Name)
+	}
+	if e.Skipped {
+		return fmt.Sprintf("skipped deleting: %s, not confirmed", e.Spec.Name)
+	}
+	if e.Err != nil {
+		return fmt.Sprintf("deleting: %s, failed with error): %s", e.Spec.Name, e.Err.Error())
+	}
+	return fmt.Sprintf("deleted: %s", e.Spec.Name)
+}
+
 func (e *EventResourceUpdated) String() string {
 	if e.Err != nil {
 		return fmt.Sprintf("updating: %s, failed with error): %s", e.Spec.Name, e.Err.Error())
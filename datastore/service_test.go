@@ -2,13 +2,16 @@ package datastore_test
 
 import (
 	"context"
+	"sync"
 	"testing"
 
 	"github.com/pkg/errors"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
 
+	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/datastore"
 	"github.com/odpf/optimus/mock"
 	"github.com/odpf/optimus/models"
@@ -84,11 +87,12 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("CreateResource", context.TODO(), models.CreateResourceRequest{
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{})
+			datastorer.On("CreateResource", testMock.Anything, models.CreateResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec1,
 			}).Return(nil)
-			datastorer.On("CreateResource", context.TODO(), models.CreateResourceRequest{
+			datastorer.On("CreateResource", testMock.Anything, models.CreateResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec2,
 			}).Return(nil)
@@ -128,7 +132,8 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("CreateResource", context.TODO(), models.CreateResourceRequest{
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{})
+			datastorer.On("CreateResource", testMock.Anything, models.CreateResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec2,
 			}).Return(nil)
@@ -149,6 +154,77 @@ func TestService(t *testing.T) {
 			err := service.CreateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec1, resourceSpec2}, nil)
 			assert.NotNil(t, err)
 		})
+		t.Run("should create resources in order of their dependencies", func(t *testing.T) {
+			datastorer, dataset, table, view := dependentResources()
+			defer datastorer.AssertExpectations(t)
+
+			var mu sync.Mutex
+			var created []string
+			for _, resourceSpec := range []models.ResourceSpec{dataset, table, view} {
+				datastorer.On("CreateResource", testMock.Anything, models.CreateResourceRequest{
+					Project:  projectSpec,
+					Resource: resourceSpec,
+				}).Run(func(args testMock.Arguments) {
+					mu.Lock()
+					defer mu.Unlock()
+					created = append(created, args.Get(1).(models.CreateResourceRequest).Resource.Name)
+				}).Return(nil)
+			}
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("Save", testMock.Anything).Return(nil)
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+
+			service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+			err := service.CreateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{view, table, dataset}, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{dataset.Name, table.Name, view.Name}, created)
+		})
+		t.Run("should skip resources whose upstream failed", func(t *testing.T) {
+			datastorer, dataset, table, view := dependentResources()
+			defer datastorer.AssertExpectations(t)
+			datastorer.On("CreateResource", testMock.Anything, models.CreateResourceRequest{
+				Project:  projectSpec,
+				Resource: dataset,
+			}).Return(errors.New("quota exceeded"))
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("Save", dataset).Return(nil)
+			defer resourceRepo.AssertExpectations(t)
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+
+			obs := new(eventCollector)
+			service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+			err := service.CreateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{view, table, dataset}, obs)
+			assert.NotNil(t, err)
+
+			assert.Len(t, obs.events, 3)
+			for _, evt := range obs.events[1:] {
+				assert.True(t, errors.Is(evt.(*datastore.EventResourceCreated).Err, models.ErrUpstreamResourceFailed))
+			}
+			assert.Equal(t, table.Name, obs.events[1].(*datastore.EventResourceCreated).Spec.Name)
+			assert.Equal(t, view.Name, obs.events[2].(*datastore.EventResourceCreated).Spec.Name)
+		})
+		t.Run("should fail resources depending on each other", func(t *testing.T) {
+			resolver := new(mock.DatastoreTypeDependencyResolver)
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+				models.ResourceTypeView: resolver,
+			})
+			view1 := models.ResourceSpec{Name: "proj.datas.view1", Type: models.ResourceTypeView, Datastore: datastorer}
+			view2 := models.ResourceSpec{Name: "proj.datas.view2", Type: models.ResourceTypeView, Datastore: datastorer}
+			resolver.On("Dependencies", view1).Return([]string{view2.Name}, nil)
+			resolver.On("Dependencies", view2).Return([]string{view1.Name}, nil)
+
+			obs := new(eventCollector)
+			service := datastore.NewService(new(mock.ResourceSpecRepoFactory), new(mock.SupportedDatastoreRepo))
+			err := service.CreateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{view1, view2}, obs)
+			assert.True(t, errors.Is(err, models.ErrCyclicResourceDependency))
+			assert.Len(t, obs.events, 2)
+			datastorer.AssertNotCalled(t, "CreateResource", testMock.Anything, testMock.Anything)
+		})
 	})
 	t.Run("UpdateResource", func(t *testing.T) {
 		t.Run("should successfully call datastore update resource individually for reach resource and save in persistent repository", func(t *testing.T) {
@@ -170,11 +246,12 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("UpdateResource", context.TODO(), models.UpdateResourceRequest{
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{})
+			datastorer.On("UpdateResource", testMock.Anything, models.UpdateResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec1,
 			}).Return(nil)
-			datastorer.On("UpdateResource", context.TODO(), models.UpdateResourceRequest{
+			datastorer.On("UpdateResource", testMock.Anything, models.UpdateResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec2,
 			}).Return(nil)
@@ -192,7 +269,7 @@ func TestService(t *testing.T) {
 			defer projectResourceRepoFac.AssertExpectations(t)
 
 			service := datastore.NewService(resourceRepoFac, dsRepo)
-			err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec1, resourceSpec2}, nil)
+			err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec1, resourceSpec2}, false, nil)
 			assert.Nil(t, err)
 		})
 		t.Run("should not call update in datastore if failed to save in repository", func(t *testing.T) {
@@ -214,7 +291,8 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("UpdateResource", context.TODO(), models.UpdateResourceRequest{
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{})
+			datastorer.On("UpdateResource", testMock.Anything, models.UpdateResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec2,
 			}).Return(nil)
@@ -232,9 +310,138 @@ func TestService(t *testing.T) {
 			defer projectResourceRepoFac.AssertExpectations(t)
 
 			service := datastore.NewService(resourceRepoFac, dsRepo)
-			err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec1, resourceSpec2}, nil)
+			err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec1, resourceSpec2}, false, nil)
 			assert.NotNil(t, err)
 		})
+		t.Run("should check schema changes against current resource before updating", func(t *testing.T) {
+			breakingChange := models.ResourceSchemaChange{Field: "schema.id", Kind: models.SchemaChangeBreaking, From: "INTEGER REQUIRED"}
+			additiveChange := models.ResourceSchemaChange{Field: "schema.name", Kind: models.SchemaChangeAdditive, To: "STRING NULLABLE"}
+
+			setup := func(changes []models.ResourceSchemaChange) (*mock.Datastorer, models.ResourceSpec, *mock.ResourceSpecRepoFactory, *mock.ResourceSpecRepository) {
+				checker := new(mock.DatastoreTypeSchemaChecker)
+				datastorer := new(mock.Datastorer)
+				datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+					models.ResourceTypeTable: checker,
+				})
+
+				resourceSpec := models.ResourceSpec{
+					Version:   1,
+					Name:      "proj.datas.table",
+					Type:      models.ResourceTypeTable,
+					Datastore: datastorer,
+				}
+				current := resourceSpec
+				current.Spec = "current"
+				datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: resourceSpec, Project: projectSpec}).
+					Return(models.ReadResourceResponse{Resource: current}, nil)
+				checker.On("CheckSchemaChange", current, resourceSpec).Return(changes, nil)
+
+				resourceRepo := new(mock.ResourceSpecRepository)
+				resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+				resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+				return datastorer, resourceSpec, resourceRepoFac, resourceRepo
+			}
+
+			t.Run("should block breaking changes unless allowed", func(t *testing.T) {
+				datastorer, resourceSpec, resourceRepoFac, resourceRepo := setup([]models.ResourceSchemaChange{additiveChange, breakingChange})
+				defer datastorer.AssertExpectations(t)
+				defer resourceRepo.AssertExpectations(t)
+
+				obs := new(eventCollector)
+				service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+				err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec}, false, obs)
+				assert.True(t, errors.Is(err, models.ErrBreakingSchemaChange))
+				assert.Len(t, obs.events, 2)
+				assert.Equal(t, &datastore.EventResourceSchemaChanged{
+					Spec:    resourceSpec,
+					Changes: []models.ResourceSchemaChange{additiveChange, breakingChange},
+					Blocked: true,
+				}, obs.events[0])
+				assert.True(t, errors.Is(obs.events[1].(*datastore.EventResourceUpdated).Err, models.ErrBreakingSchemaChange))
+			})
+			t.Run("should update with breaking changes if allowed", func(t *testing.T) {
+				datastorer, resourceSpec, resourceRepoFac, resourceRepo := setup([]models.ResourceSchemaChange{breakingChange})
+				defer datastorer.AssertExpectations(t)
+				resourceRepo.On("Save", resourceSpec).Return(nil)
+				defer resourceRepo.AssertExpectations(t)
+				datastorer.On("UpdateResource", testMock.Anything, models.UpdateResourceRequest{
+					Project:  projectSpec,
+					Resource: resourceSpec,
+				}).Return(nil)
+
+				service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+				err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec}, true, nil)
+				assert.Nil(t, err)
+			})
+		})
+		t.Run("should block updates whose schema changes can't be checked unless allowed", func(t *testing.T) {
+			checker := new(mock.DatastoreTypeSchemaChecker)
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+				models.ResourceTypeTable: checker,
+			})
+			defer datastorer.AssertExpectations(t)
+
+			resourceSpec := models.ResourceSpec{
+				Version:   1,
+				Name:      "proj.datas.table",
+				Type:      models.ResourceTypeTable,
+				Datastore: datastorer,
+			}
+			datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: resourceSpec, Project: projectSpec}).
+				Return(models.ReadResourceResponse{Resource: resourceSpec}, nil)
+			checker.On("CheckSchemaChange", resourceSpec, resourceSpec).
+				Return([]models.ResourceSchemaChange(nil), errors.Wrap(models.ErrResourceCompareUnsupported, "pg"))
+			datastorer.On("UpdateResource", testMock.Anything, models.UpdateResourceRequest{
+				Project:  projectSpec,
+				Resource: resourceSpec,
+			}).Return(nil).Once()
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("Save", resourceSpec).Return(nil).Once()
+			defer resourceRepo.AssertExpectations(t)
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+
+			service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+			err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec}, false, nil)
+			assert.True(t, errors.Is(err, models.ErrBreakingSchemaChange))
+
+			err = service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec}, true, nil)
+			assert.Nil(t, err)
+		})
+		t.Run("should skip checking schema changes of resources yet to be created", func(t *testing.T) {
+			checker := new(mock.DatastoreTypeSchemaChecker)
+			defer checker.AssertExpectations(t)
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+				models.ResourceTypeTable: checker,
+			})
+			defer datastorer.AssertExpectations(t)
+
+			resourceSpec := models.ResourceSpec{
+				Version:   1,
+				Name:      "proj.datas.table",
+				Type:      models.ResourceTypeTable,
+				Datastore: datastorer,
+			}
+			datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{Resource: resourceSpec, Project: projectSpec}).
+				Return(models.ReadResourceResponse{}, errors.Wrap(models.ErrResourceNotFoundInDatastore, "404"))
+			datastorer.On("UpdateResource", testMock.Anything, models.UpdateResourceRequest{
+				Project:  projectSpec,
+				Resource: resourceSpec,
+			}).Return(nil)
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("Save", resourceSpec).Return(nil)
+			defer resourceRepo.AssertExpectations(t)
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+
+			service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+			err := service.UpdateResource(context.TODO(), namespaceSpec, []models.ResourceSpec{resourceSpec}, false, nil)
+			assert.Nil(t, err)
+		})
 	})
 	t.Run("ReadResource", func(t *testing.T) {
 		t.Run("should successfully call datastore read operation by reading from persistent repository", func(t *testing.T) {
@@ -251,7 +458,7 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("ReadResource", context.TODO(), models.ReadResourceRequest{
+			datastorer.On("ReadResource", testMock.Anything, models.ReadResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec1,
 			}).Return(models.ReadResourceResponse{Resource: resourceSpec1}, nil)
@@ -318,7 +525,7 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("DeleteResource", context.TODO(), models.DeleteResourceRequest{
+			datastorer.On("DeleteResource", testMock.Anything, models.DeleteResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec1,
 			}).Return(nil)
@@ -353,7 +560,7 @@ func TestService(t *testing.T) {
 				Type:      models.ResourceTypeDataset,
 				Datastore: datastorer,
 			}
-			datastorer.On("DeleteResource", context.TODO(), models.DeleteResourceRequest{
+			datastorer.On("DeleteResource", testMock.Anything, models.DeleteResourceRequest{
 				Project:  projectSpec,
 				Resource: resourceSpec1,
 			}).Return(errors.New("failed to delete"))
@@ -374,4 +581,135 @@ func TestService(t *testing.T) {
 			assert.NotNil(t, err)
 		})
 	})
+	t.Run("KeepOnly", func(t *testing.T) {
+		t.Run("should delete only confirmed resources missing from deployed specs", func(t *testing.T) {
+			datastorer := new(mock.Datastorer)
+			defer datastorer.AssertExpectations(t)
+
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetByName", "bq").Return(datastorer, nil)
+			defer dsRepo.AssertExpectations(t)
+
+			keptSpec := models.ResourceSpec{Name: "proj.datas.kept", Type: models.ResourceTypeTable, Datastore: datastorer}
+			confirmedSpec := models.ResourceSpec{Name: "proj.datas.removed", Type: models.ResourceTypeTable, Datastore: datastorer}
+			unconfirmedSpec := models.ResourceSpec{Name: "proj.datas.unconfirmed", Type: models.ResourceTypeTable, Datastore: datastorer}
+			protectedSpec := models.ResourceSpec{Name: "proj.datas.__backup", Type: models.ResourceTypeTable, Datastore: datastorer}
+
+			datastorer.On("DeleteResource", testMock.Anything, models.DeleteResourceRequest{
+				Project:  projectSpec,
+				Resource: confirmedSpec,
+			}).Return(nil)
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("GetAll").Return([]models.ResourceSpec{keptSpec, confirmedSpec, unconfirmedSpec, protectedSpec}, nil)
+			resourceRepo.On("Delete", confirmedSpec.Name).Return(nil)
+			defer resourceRepo.AssertExpectations(t)
+
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+			defer resourceRepoFac.AssertExpectations(t)
+
+			service := datastore.NewService(resourceRepoFac, dsRepo)
+			err := service.KeepOnly(context.TODO(), namespaceSpec, "bq", []models.ResourceSpec{keptSpec},
+				[]string{confirmedSpec.Name, protectedSpec.Name}, nil)
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if failed to delete a confirmed resource", func(t *testing.T) {
+			datastorer := new(mock.Datastorer)
+			defer datastorer.AssertExpectations(t)
+
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetByName", "bq").Return(datastorer, nil)
+			defer dsRepo.AssertExpectations(t)
+
+			confirmedSpec := models.ResourceSpec{Name: "proj.datas.removed", Type: models.ResourceTypeTable, Datastore: datastorer}
+			datastorer.On("DeleteResource", testMock.Anything, models.DeleteResourceRequest{
+				Project:  projectSpec,
+				Resource: confirmedSpec,
+			}).Return(errors.New("failed to delete"))
+
+			resourceRepo := new(mock.ResourceSpecRepository)
+			resourceRepo.On("GetAll").Return([]models.ResourceSpec{confirmedSpec}, nil)
+			defer resourceRepo.AssertExpectations(t)
+
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			resourceRepoFac.On("New", namespaceSpec, datastorer).Return(resourceRepo)
+			defer resourceRepoFac.AssertExpectations(t)
+
+			service := datastore.NewService(resourceRepoFac, dsRepo)
+			err := service.KeepOnly(context.TODO(), namespaceSpec, "bq", nil, []string{confirmedSpec.Name}, nil)
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("DropResources", func(t *testing.T) {
+		t.Run("should drop resources from datastore except protected ones", func(t *testing.T) {
+			datastorer := new(mock.Datastorer)
+			defer datastorer.AssertExpectations(t)
+
+			tableSpec := models.ResourceSpec{Name: "proj.datas.table", Type: models.ResourceTypeTable, Datastore: datastorer}
+			protectedSpec := models.ResourceSpec{Name: "proj.datas.__backup", Type: models.ResourceTypeTable, Datastore: datastorer}
+
+			datastorer.On("DeleteResource", testMock.Anything, models.DeleteResourceRequest{
+				Project:  projectSpec,
+				Resource: tableSpec,
+			}).Return(nil)
+
+			// specs are archived along with their namespace
+			resourceRepoFac := new(mock.ResourceSpecRepoFactory)
+			defer resourceRepoFac.AssertExpectations(t)
+
+			obs := new(eventCollector)
+			service := datastore.NewService(resourceRepoFac, new(mock.SupportedDatastoreRepo))
+			err := service.DropResources(context.TODO(), namespaceSpec, []models.ResourceSpec{tableSpec, protectedSpec}, obs)
+			assert.Nil(t, err)
+			assert.Equal(t, []progress.Event{
+				&datastore.EventResourceDeleted{Spec: tableSpec},
+				&datastore.EventResourceDeleted{Spec: protectedSpec, Skipped: true, Protected: true},
+			}, obs.events)
+			assert.Equal(t, "skipped deleting: proj.datas.__backup, protected", obs.events[1].String())
+		})
+	})
+	t.Run("PrunableResources", func(t *testing.T) {
+		t.Run("should skip deployed and protected resources", func(t *testing.T) {
+			registered := []models.ResourceSpec{
+				{Name: "proj.datas.kept"},
+				{Name: "proj.datas.removed"},
+				{Name: "proj.datas.__backup"},
+				{Name: "__proj.datas.audit"},
+				{Name: "proj.datas.labelled", Labels: map[string]string{datastore.ResourceLabelProtected: "true"}},
+			}
+			prunable := datastore.PrunableResources(registered, []models.ResourceSpec{{Name: "proj.datas.kept"}})
+			assert.Equal(t, []models.ResourceSpec{{Name: "proj.datas.removed"}}, prunable)
+		})
+	})
+}
+
+// eventCollector keeps progress events notified by a service
+type eventCollector struct {
+	mu     sync.Mutex
+	events []progress.Event
+}
+
+func (c *eventCollector) Notify(e progress.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.events = append(c.events, e)
+}
+
+// dependentResources creates a dataset, a table in it and a view reading the table
+func dependentResources() (*mock.Datastorer, models.ResourceSpec, models.ResourceSpec, models.ResourceSpec) {
+	resolver := new(mock.DatastoreTypeDependencyResolver)
+	datastorer := new(mock.Datastorer)
+	datastorer.On("Types").Return(map[models.ResourceType]models.DatastoreTypeController{
+		models.ResourceTypeDataset: new(mock.DatastoreTypeController),
+		models.ResourceTypeTable:   resolver,
+		models.ResourceTypeView:    resolver,
+	})
+
+	dataset := models.ResourceSpec{Name: "proj.datas", Type: models.ResourceTypeDataset, Datastore: datastorer}
+	table := models.ResourceSpec{Name: "proj.datas.table", Type: models.ResourceTypeTable, Datastore: datastorer}
+	view := models.ResourceSpec{Name: "proj.datas.view", Type: models.ResourceTypeView, Datastore: datastorer}
+	resolver.On("Dependencies", table).Return([]string{dataset.Name}, nil)
+	resolver.On("Dependencies", view).Return([]string{dataset.Name, table.Name, "proj.other.table"}, nil)
+	return datastorer, dataset, table, view
 }
@@ -0,0 +1,20 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/odpf/optimus/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/odpf/optimus/datastore")
+
+// startResourceSpan traces an operation made on a resource in its datastore
+func startResourceSpan(ctx context.Context, operation string, resourceSpec models.ResourceSpec) (context.Context, trace.Span) {
+	return tracer.Start(ctx, operation, trace.WithAttributes(
+		attribute.String("resource.name", resourceSpec.Name),
+		attribute.String("resource.type", string(resourceSpec.Type)),
+	))
+}
@@ -30,6 +30,9 @@ module.exports = {
         "guides/create-bigquery-dataset",
         "guides/create-bigquery-table",
         "guides/create-bigquery-view",
+        "guides/create-bigquery-materialized-view",
+        "guides/create-bigquery-routine",
+        "guides/create-postgres-resources",
         "guides/organising-specifications",
         "guides/optimus-serve",
         "guides/task-bq2bq"
@@ -0,0 +1,447 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	bqapi "cloud.google.com/go/bigquery"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	// AccessModeAdditive only grants access missing from resource
+	AccessModeAdditive = "additive"
+	// AccessModeAuthoritative also revokes access not present in spec
+	AccessModeAuthoritative = "authoritative"
+)
+
+var (
+	legacyDatasetRoles = map[string]bool{"OWNER": true, "READER": true, "WRITER": true}
+
+	// bigquery reads back basic dataset roles granted with their predefined
+	// names in legacy form
+	predefinedDatasetRoles = map[string]string{
+		"roles/bigquery.dataowner":  "OWNER",
+		"roles/bigquery.dataeditor": "WRITER",
+		"roles/bigquery.dataviewer": "READER",
+	}
+)
+
+// BQAccessEntry grants a role on dataset to exactly one entity, authorized
+// views are granted access without a role
+type BQAccessEntry struct {
+	Role         string `yaml:",omitempty" structs:"role,omitempty"`
+	UserByEmail  string `yaml:"user_by_email,omitempty" structs:"user_by_email,omitempty"`
+	GroupByEmail string `yaml:"group_by_email,omitempty" structs:"group_by_email,omitempty"`
+	Domain       string `yaml:",omitempty" structs:"domain,omitempty"`
+	SpecialGroup string `yaml:"special_group,omitempty" structs:"special_group,omitempty"` // projectOwners, projectReaders, projectWriters or allAuthenticatedUsers
+	View         string `yaml:",omitempty" structs:"view,omitempty"`                       // project.dataset.view
+	IAMMember    string `yaml:"iam_member,omitempty" structs:"iam_member,omitempty"`
+}
+
+// entity returns type and name of the entity granted access
+func (e BQAccessEntry) entity() (bqapi.EntityType, string) {
+	switch {
+	case e.UserByEmail != "":
+		return bqapi.UserEmailEntity, e.UserByEmail
+	case e.GroupByEmail != "":
+		return bqapi.GroupEmailEntity, e.GroupByEmail
+	case e.Domain != "":
+		return bqapi.DomainEntity, e.Domain
+	case e.SpecialGroup != "":
+		return bqapi.SpecialGroupEntity, e.SpecialGroup
+	case e.View != "":
+		return bqapi.ViewEntity, e.View
+	case e.IAMMember != "":
+		return bqapi.IAMMemberEntity, e.IAMMember
+	}
+	return 0, ""
+}
+
+// role returns role of entry in the form bigquery reads it back
+func (e BQAccessEntry) role() string {
+	if legacyDatasetRoles[strings.ToUpper(e.Role)] {
+		return strings.ToUpper(e.Role)
+	}
+	if legacyRole, ok := predefinedDatasetRoles[strings.ToLower(e.Role)]; ok {
+		return legacyRole
+	}
+	return e.Role
+}
+
+// String describes entry uniquely, used to match entries of spec with dataset
+func (e BQAccessEntry) String() string {
+	entityType, entity := e.entity()
+	switch entityType {
+	case bqapi.UserEmailEntity:
+		return fmt.Sprintf("%s user:%s", e.role(), strings.ToLower(entity))
+	case bqapi.GroupEmailEntity:
+		return fmt.Sprintf("%s group:%s", e.role(), strings.ToLower(entity))
+	case bqapi.DomainEntity:
+		return fmt.Sprintf("%s domain:%s", e.role(), strings.ToLower(entity))
+	case bqapi.SpecialGroupEntity:
+		return fmt.Sprintf("%s special_group:%s", e.role(), entity)
+	case bqapi.ViewEntity:
+		return fmt.Sprintf("view:%s", entity)
+	case bqapi.IAMMemberEntity:
+		return fmt.Sprintf("%s iam_member:%s", e.role(), entity)
+	}
+	return e.role()
+}
+
+func (e BQAccessEntry) validate() error {
+	entityCount := 0
+	for _, entity := range []string{e.UserByEmail, e.GroupByEmail, e.Domain, e.SpecialGroup, e.View, e.IAMMember} {
+		if entity != "" {
+			entityCount++
+		}
+	}
+	if entityCount != 1 {
+		return fmt.Errorf("access entry %q should grant access to exactly one entity", e.String())
+	}
+	if e.View != "" {
+		if e.Role != "" {
+			return fmt.Errorf("authorized view %s can't have a role", e.View)
+		}
+		if !tableNameParseRegex.MatchString(e.View) {
+			return fmt.Errorf("invalid authorized view %s, for example 'project_name.dataset_name.view_name'", e.View)
+		}
+		return nil
+	}
+	if e.Role == "" {
+		return fmt.Errorf("access entry %q requires a role", e.String())
+	}
+	return nil
+}
+
+// BQIAMBinding grants a role on table to members, members are prefixed with
+// their type like user:, group:, serviceAccount: or domain:
+type BQIAMBinding struct {
+	Role    string   `yaml:"role" structs:"role"`
+	Members []string `yaml:"members" structs:"members"`
+}
+
+func validateAccessMode(mode string) error {
+	switch strings.ToLower(mode) {
+	case "", AccessModeAdditive, AccessModeAuthoritative:
+		return nil
+	}
+	return fmt.Errorf("invalid access mode %s (must be one of %s, %s)", mode, AccessModeAdditive, AccessModeAuthoritative)
+}
+
+func isAuthoritative(mode string) bool {
+	return strings.ToLower(mode) == AccessModeAuthoritative
+}
+
+func validateIAMBindings(bindings []BQIAMBinding) error {
+	for _, binding := range bindings {
+		if binding.Role == "" || len(binding.Members) == 0 {
+			return fmt.Errorf("iam binding %q requires a role and members", binding.Role)
+		}
+		for _, member := range binding.Members {
+			if !strings.Contains(member, ":") {
+				return fmt.Errorf("iam member %s of role %s should be prefixed with its type, for example 'user:'", member, binding.Role)
+			}
+		}
+	}
+	return nil
+}
+
+// validateTableAccess validates iam bindings of table like resources
+func validateTableAccess(spec models.ResourceSpec) error {
+	bqResource, ok := spec.Spec.(BQTable)
+	if !ok {
+		return nil
+	}
+	if err := validateAccessMode(bqResource.Metadata.AccessMode); err != nil {
+		return err
+	}
+	return validateIAMBindings(bqResource.Metadata.IAM)
+}
+
+// ensureDatasetAccess grants access entries of spec missing from dataset,
+// entries not in spec are revoked if access is authoritative and it is
+// an upsert call. Dataset is left untouched if spec has no access entries.
+func ensureDatasetAccess(ctx context.Context, client bqiface.Client, datasetHandle bqiface.Dataset, bqResource BQDataset, upsert bool) error {
+	if len(bqResource.Metadata.Access) == 0 {
+		return nil
+	}
+	datasetMutex.Lock()
+	defer datasetMutex.Unlock()
+
+	meta, err := datasetHandle.Metadata(ctx)
+	if err != nil {
+		return err
+	}
+	desired := map[string]bool{}
+	for _, entry := range bqResource.Metadata.Access {
+		desired[entry.String()] = true
+	}
+
+	changed := false
+	current := map[string]bool{}
+	var entries []*bqiface.AccessEntry
+	for _, entry := range meta.Access {
+		key := bqAccessEntryFrom(entry).String()
+		current[key] = true
+		if upsert && isAuthoritative(bqResource.Metadata.AccessMode) && !desired[key] {
+			changed = true
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	for _, entry := range bqResource.Metadata.Access {
+		if current[entry.String()] {
+			continue
+		}
+		accessEntry, err := bqAccessEntryTo(client, entry)
+		if err != nil {
+			return err
+		}
+		current[entry.String()] = true
+		entries = append(entries, accessEntry)
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+
+	_, err = datasetHandle.Update(ctx, bqiface.DatasetMetadataToUpdate{
+		Access: entries,
+	}, meta.ETag)
+	return err
+}
+
+func bqAccessEntryTo(client bqiface.Client, entry BQAccessEntry) (*bqiface.AccessEntry, error) {
+	entityType, entity := entry.entity()
+	accessEntry := &bqiface.AccessEntry{
+		AccessEntry: bqapi.AccessEntry{
+			Role:       bqapi.AccessRole(entry.role()),
+			EntityType: entityType,
+		},
+	}
+	if entityType != bqapi.ViewEntity {
+		accessEntry.Entity = entity
+		return accessEntry, nil
+	}
+
+	parsedNames := tableNameParseRegex.FindStringSubmatch(entity)
+	if len(parsedNames) < 4 {
+		return nil, fmt.Errorf("invalid authorized view %s", entity)
+	}
+	accessEntry.View = client.DatasetInProject(parsedNames[1], parsedNames[2]).Table(parsedNames[3])
+	return accessEntry, nil
+}
+
+func bqAccessEntryFrom(accessEntry *bqiface.AccessEntry) BQAccessEntry {
+	entry := BQAccessEntry{
+		Role: string(accessEntry.Role),
+	}
+	switch accessEntry.EntityType {
+	case bqapi.UserEmailEntity:
+		entry.UserByEmail = accessEntry.Entity
+	case bqapi.GroupEmailEntity:
+		entry.GroupByEmail = accessEntry.Entity
+	case bqapi.DomainEntity:
+		entry.Domain = accessEntry.Entity
+	case bqapi.SpecialGroupEntity:
+		entry.SpecialGroup = accessEntry.Entity
+	case bqapi.IAMMemberEntity:
+		entry.IAMMember = accessEntry.Entity
+	case bqapi.ViewEntity:
+		if accessEntry.View != nil {
+			entry.View = fmt.Sprintf("%s.%s.%s", accessEntry.View.ProjectID(), accessEntry.View.DatasetID(), accessEntry.View.TableID())
+		}
+	}
+	return entry
+}
+
+// ensureTableIAM grants iam bindings of spec missing from table, bindings
+// not in spec are revoked if access is authoritative and it is an upsert
+// call. Bigquery client in use can't manage iam policies of tables so
+// they are managed with DCL statements.
+func ensureTableIAM(ctx context.Context, client bqiface.Client, tableHandle bqiface.Table, resourceType models.ResourceType,
+	bqResource BQTable, upsert bool) error {
+	if len(bqResource.Metadata.IAM) == 0 {
+		return nil
+	}
+	meta, err := tableHandle.Metadata(ctx)
+	if err != nil {
+		return err
+	}
+	current, err := getTableIAM(ctx, client, bqResource, meta.Location)
+	if err != nil {
+		return err
+	}
+	currentMembers := iamMembersByRole(current)
+	desiredMembers := iamMembersByRole(bqResource.Metadata.IAM)
+
+	objectType := tableObjectType(resourceType)
+	objectName := fmt.Sprintf("%s.%s.%s", bqResource.Project, bqResource.Dataset, bqResource.Table)
+	var statements []string
+	for _, role := range sortedRoles(desiredMembers) {
+		var grants []string
+		for _, member := range desiredMembers[role] {
+			if !containsString(currentMembers[role], member) {
+				grants = append(grants, strconv.Quote(member))
+			}
+		}
+		if len(grants) > 0 {
+			statements = append(statements, fmt.Sprintf("GRANT `%s` ON %s `%s` TO %s", role, objectType,
+				objectName, strings.Join(grants, ", ")))
+		}
+	}
+	if upsert && isAuthoritative(bqResource.Metadata.AccessMode) {
+		for _, role := range sortedRoles(currentMembers) {
+			var revokes []string
+			for _, member := range currentMembers[role] {
+				if !containsString(desiredMembers[role], member) {
+					revokes = append(revokes, strconv.Quote(member))
+				}
+			}
+			if len(revokes) > 0 {
+				statements = append(statements, fmt.Sprintf("REVOKE `%s` ON %s `%s` FROM %s", role, objectType,
+					objectName, strings.Join(revokes, ", ")))
+			}
+		}
+	}
+	for _, statement := range statements {
+		if err := runStatement(ctx, client, statement, nil); err != nil {
+			return errors.Wrapf(err, "failed to update iam bindings of %s", bqResource.FullyQualifiedName())
+		}
+	}
+	return nil
+}
+
+// getTableIAM reads iam bindings set directly on the table, bindings
+// inherited from dataset or project are not included
+func getTableIAM(ctx context.Context, client bqiface.Client, bqResource BQTable, location string) ([]BQIAMBinding, error) {
+	privilegeQuery := fmt.Sprintf("SELECT privilege_type, grantee "+
+		"FROM `%s.region-%s.INFORMATION_SCHEMA.OBJECT_PRIVILEGES` WHERE object_schema = @dataset AND object_name = @table",
+		bqResource.Project, strings.ToLower(location))
+	it, err := readStatement(ctx, client, privilegeQuery, []bqapi.QueryParameter{
+		{Name: "dataset", Value: bqResource.Dataset},
+		{Name: "table", Value: bqResource.Table},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := map[string][]string{}
+	for {
+		var row objectPrivilegeRow
+		if err := it.Next(&row); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return nil, err
+		}
+		members[row.PrivilegeType] = append(members[row.PrivilegeType], row.Grantee)
+	}
+	var bindings []BQIAMBinding
+	for _, role := range sortedRoles(members) {
+		sort.Strings(members[role])
+		bindings = append(bindings, BQIAMBinding{Role: role, Members: members[role]})
+	}
+	return bindings, nil
+}
+
+// objectPrivilegeRow is a binding read from INFORMATION_SCHEMA.OBJECT_PRIVILEGES
+type objectPrivilegeRow struct {
+	PrivilegeType string `bigquery:"privilege_type"`
+	Grantee       string `bigquery:"grantee"`
+}
+
+func tableObjectType(resourceType models.ResourceType) string {
+	switch resourceType {
+	case models.ResourceTypeView:
+		return "VIEW"
+	case models.ResourceTypeExternalTable:
+		return "EXTERNAL TABLE"
+	}
+	return "TABLE"
+}
+
+func iamMembersByRole(bindings []BQIAMBinding) map[string][]string {
+	members := map[string][]string{}
+	for _, binding := range bindings {
+		for _, member := range binding.Members {
+			if !containsString(members[binding.Role], member) {
+				members[binding.Role] = append(members[binding.Role], member)
+			}
+		}
+	}
+	return members
+}
+
+func sortedRoles(members map[string][]string) []string {
+	var roles []string
+	for role := range members {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func extractAccessEntriesFromProtoStruct(protoVal *structpb.Value) []BQAccessEntry {
+	var entries []BQAccessEntry
+	for _, entryValue := range protoVal.GetListValue().GetValues() {
+		entry := BQAccessEntry{}
+		for entryAttr, entryAttrVal := range entryValue.GetStructValue().GetFields() {
+			switch entryAttr {
+			case "role":
+				entry.Role = entryAttrVal.GetStringValue()
+			case "user_by_email":
+				entry.UserByEmail = entryAttrVal.GetStringValue()
+			case "group_by_email":
+				entry.GroupByEmail = entryAttrVal.GetStringValue()
+			case "domain":
+				entry.Domain = entryAttrVal.GetStringValue()
+			case "special_group":
+				entry.SpecialGroup = entryAttrVal.GetStringValue()
+			case "view":
+				entry.View = entryAttrVal.GetStringValue()
+			case "iam_member":
+				entry.IAMMember = entryAttrVal.GetStringValue()
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+func extractIAMBindingsFromProtoStruct(protoVal *structpb.Value) []BQIAMBinding {
+	var bindings []BQIAMBinding
+	for _, bindingValue := range protoVal.GetListValue().GetValues() {
+		binding := BQIAMBinding{}
+		for bindingAttr, bindingAttrVal := range bindingValue.GetStructValue().GetFields() {
+			switch bindingAttr {
+			case "role":
+				binding.Role = bindingAttrVal.GetStringValue()
+			case "members":
+				for _, memberValue := range bindingAttrVal.GetListValue().GetValues() {
+					binding.Members = append(binding.Members, memberValue.GetStringValue())
+				}
+			}
+		}
+		bindings = append(bindings, binding)
+	}
+	return bindings
+}
@@ -0,0 +1,251 @@
+package bigquery
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAccess(t *testing.T) {
+	testingContext := context.Background()
+	eTag := "etag-0000"
+	ownerEntry := &bqiface.AccessEntry{AccessEntry: bigquery.AccessEntry{
+		Role:       bigquery.OwnerRole,
+		EntityType: bigquery.SpecialGroupEntity,
+		Entity:     "projectOwners",
+	}}
+	analystEntry := &bqiface.AccessEntry{AccessEntry: bigquery.AccessEntry{
+		Role:       bigquery.ReaderRole,
+		EntityType: bigquery.GroupEmailEntity,
+		Entity:     "analysts@example.com",
+	}}
+
+	t.Run("ensureDatasetAccess", func(t *testing.T) {
+		bqResource := BQDataset{
+			Project: "project",
+			Dataset: "dataset",
+			Metadata: BQDatasetMetadata{
+				Access: []BQAccessEntry{
+					{Role: "reader", GroupByEmail: "Analysts@example.com"},
+					{View: "project.reporting.orders_view"},
+				},
+			},
+		}
+		t.Run("should grant missing entries keeping existing ones", func(t *testing.T) {
+			viewTable := new(BqTableMock)
+			viewDataset := new(BqDatasetMock)
+			viewDataset.On("Table", "orders_view").Return(viewTable)
+			bQClient := new(BqClientMock)
+			defer bQClient.AssertExpectations(t)
+			bQClient.On("DatasetInProject", "project", "reporting").Return(viewDataset)
+
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{
+				DatasetMetadata: bigquery.DatasetMetadata{ETag: eTag},
+				Access:          []*bqiface.AccessEntry{ownerEntry, analystEntry},
+			}, nil)
+			bQDatasetHandle.On("Update", testingContext, bqiface.DatasetMetadataToUpdate{
+				Access: []*bqiface.AccessEntry{ownerEntry, analystEntry, {
+					AccessEntry: bigquery.AccessEntry{EntityType: bigquery.ViewEntity},
+					View:        viewTable,
+				}},
+			}, eTag).Return(&bqiface.DatasetMetadata{}, nil)
+
+			err := ensureDatasetAccess(testingContext, bQClient, bQDatasetHandle, bqResource, true)
+			assert.Nil(t, err)
+		})
+		t.Run("should revoke entries missing from spec if authoritative", func(t *testing.T) {
+			authoritative := bqResource
+			authoritative.Metadata.Access = bqResource.Metadata.Access[:1]
+			authoritative.Metadata.AccessMode = AccessModeAuthoritative
+
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{
+				DatasetMetadata: bigquery.DatasetMetadata{ETag: eTag},
+				Access:          []*bqiface.AccessEntry{ownerEntry, analystEntry},
+			}, nil)
+			bQDatasetHandle.On("Update", testingContext, bqiface.DatasetMetadataToUpdate{
+				Access: []*bqiface.AccessEntry{analystEntry},
+			}, eTag).Return(&bqiface.DatasetMetadata{}, nil)
+
+			err := ensureDatasetAccess(testingContext, new(BqClientMock), bQDatasetHandle, authoritative, true)
+			assert.Nil(t, err)
+		})
+		t.Run("should not revoke entries while creating dataset", func(t *testing.T) {
+			authoritative := bqResource
+			authoritative.Metadata.Access = bqResource.Metadata.Access[:1]
+			authoritative.Metadata.AccessMode = AccessModeAuthoritative
+
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{
+				Access: []*bqiface.AccessEntry{ownerEntry, analystEntry},
+			}, nil)
+
+			err := ensureDatasetAccess(testingContext, new(BqClientMock), bQDatasetHandle, authoritative, false)
+			assert.Nil(t, err)
+			bQDatasetHandle.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		})
+		t.Run("should match predefined roles with their legacy form read back", func(t *testing.T) {
+			predefined := bqResource
+			predefined.Metadata.Access = []BQAccessEntry{
+				{Role: "roles/bigquery.dataOwner", SpecialGroup: "projectOwners"},
+				{Role: "roles/bigquery.dataViewer", GroupByEmail: "analysts@example.com"},
+			}
+			predefined.Metadata.AccessMode = AccessModeAuthoritative
+
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{
+				Access: []*bqiface.AccessEntry{ownerEntry, analystEntry},
+			}, nil)
+
+			err := ensureDatasetAccess(testingContext, new(BqClientMock), bQDatasetHandle, predefined, true)
+			assert.Nil(t, err)
+			bQDatasetHandle.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		})
+		t.Run("should leave dataset untouched if spec has no access entries", func(t *testing.T) {
+			bQDatasetHandle := new(BqDatasetMock)
+			err := ensureDatasetAccess(testingContext, new(BqClientMock), bQDatasetHandle, BQDataset{}, true)
+			assert.Nil(t, err)
+			bQDatasetHandle.AssertNotCalled(t, "Metadata", mock.Anything)
+		})
+	})
+	t.Run("ensureTableIAM", func(t *testing.T) {
+		bqResource := BQTable{
+			Project: "project",
+			Dataset: "dataset",
+			Table:   "orders",
+			Metadata: BQTableMetadata{
+				IAM: []BQIAMBinding{
+					{Role: "roles/bigquery.dataViewer", Members: []string{"group:analysts@example.com", "user:jane@example.com"}},
+				},
+			},
+		}
+		privilegeQuery := "SELECT privilege_type, grantee FROM `project.region-eu.INFORMATION_SCHEMA.OBJECT_PRIVILEGES` " +
+			"WHERE object_schema = @dataset AND object_name = @table"
+		newClient := func(statements ...string) (*BqClientMock, *BqTableMock) {
+			bQTable := new(BqTableMock)
+			bQTable.On("Metadata", testingContext).Return(&bigquery.TableMetadata{Location: "EU"}, nil)
+
+			readQuery := new(BqQueryMock)
+			readQuery.On("SetQueryConfig", mock.Anything).Return()
+			readQuery.On("Read", testingContext).Return(&BqRowIteratorMock{Rows: []interface{}{
+				objectPrivilegeRow{PrivilegeType: "roles/bigquery.dataViewer", Grantee: "group:analysts@example.com"},
+				objectPrivilegeRow{PrivilegeType: "roles/bigquery.dataEditor", Grantee: "user:bob@example.com"},
+			}}, nil)
+
+			bQClient := new(BqClientMock)
+			bQClient.On("Query", privilegeQuery).Return(readQuery)
+			for _, statement := range statements {
+				bQJob := new(BqJobMock)
+				bQJob.On("Wait", testingContext).Return(&bigquery.JobStatus{State: bigquery.Done}, nil)
+				statementQuery := new(BqQueryMock)
+				statementQuery.On("SetQueryConfig", bqiface.QueryConfig{QueryConfig: bigquery.QueryConfig{Q: statement}}).Return()
+				statementQuery.On("Run", testingContext).Return(bQJob, nil)
+				bQClient.On("Query", statement).Return(statementQuery).Once()
+			}
+			return bQClient, bQTable
+		}
+		t.Run("should grant members missing from table", func(t *testing.T) {
+			bQClient, bQTable := newClient(
+				"GRANT `roles/bigquery.dataViewer` ON TABLE `project.dataset.orders` TO \"user:jane@example.com\"",
+			)
+			defer bQClient.AssertExpectations(t)
+
+			err := ensureTableIAM(testingContext, bQClient, bQTable, models.ResourceTypeTable, bqResource, true)
+			assert.Nil(t, err)
+		})
+		t.Run("should revoke members missing from spec if authoritative", func(t *testing.T) {
+			authoritative := bqResource
+			authoritative.Metadata.AccessMode = AccessModeAuthoritative
+			bQClient, bQTable := newClient(
+				"GRANT `roles/bigquery.dataViewer` ON VIEW `project.dataset.orders` TO \"user:jane@example.com\"",
+				"REVOKE `roles/bigquery.dataEditor` ON VIEW `project.dataset.orders` FROM \"user:bob@example.com\"",
+			)
+			defer bQClient.AssertExpectations(t)
+
+			err := ensureTableIAM(testingContext, bQClient, bQTable, models.ResourceTypeView, authoritative, true)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("Diff", func(t *testing.T) {
+		t.Run("should report access entries missing from dataset", func(t *testing.T) {
+			expected := BQDataset{Metadata: BQDatasetMetadata{
+				Access:     []BQAccessEntry{{Role: "READER", GroupByEmail: "analysts@example.com"}},
+				AccessMode: AccessModeAuthoritative,
+			}}
+			actual := BQDataset{Metadata: BQDatasetMetadata{
+				Access: []BQAccessEntry{bqAccessEntryFrom(ownerEntry)},
+			}}
+			drifts, err := datasetSpec{}.Diff(models.ResourceSpec{Spec: expected}, models.ResourceSpec{Spec: actual})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ResourceFieldDrift{
+				{Field: "access", Expected: "READER group:analysts@example.com", Actual: ""},
+				{Field: "access", Expected: "", Actual: "OWNER special_group:projectOwners"},
+			}, drifts)
+		})
+		t.Run("should not report access granted with predefined role as drift", func(t *testing.T) {
+			expected := BQDataset{Metadata: BQDatasetMetadata{
+				Access:     []BQAccessEntry{{Role: "roles/bigquery.dataViewer", GroupByEmail: "analysts@example.com"}},
+				AccessMode: AccessModeAuthoritative,
+			}}
+			actual := BQDataset{Metadata: BQDatasetMetadata{
+				Access: []BQAccessEntry{bqAccessEntryFrom(analystEntry)},
+			}}
+			drifts, err := datasetSpec{}.Diff(models.ResourceSpec{Spec: expected}, models.ResourceSpec{Spec: actual})
+			assert.Nil(t, err)
+			assert.Empty(t, drifts)
+		})
+		t.Run("should only report missing iam members if additive", func(t *testing.T) {
+			expected := BQTable{Metadata: BQTableMetadata{
+				IAM: []BQIAMBinding{{Role: "roles/bigquery.dataViewer", Members: []string{"user:jane@example.com"}}},
+			}}
+			actual := BQTable{Metadata: BQTableMetadata{
+				IAM: []BQIAMBinding{{Role: "roles/bigquery.dataEditor", Members: []string{"user:bob@example.com"}}},
+			}}
+			drifts, err := tableSpec{}.Diff(models.ResourceSpec{Spec: expected}, models.ResourceSpec{Spec: actual})
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ResourceFieldDrift{
+				{Field: "iam.roles/bigquery.dataViewer", Expected: "user:jane@example.com", Actual: ""},
+			}, drifts)
+		})
+	})
+	t.Run("Validator", func(t *testing.T) {
+		datasetValidator := datasetSpec{}.Validator()
+		invalidEntries := []BQAccessEntry{
+			{Role: "READER"},
+			{Role: "READER", UserByEmail: "jane@example.com", Domain: "example.com"},
+			{UserByEmail: "jane@example.com"},
+			{Role: "READER", View: "project.dataset.view"},
+			{View: "view"},
+		}
+		for _, entry := range invalidEntries {
+			err := datasetValidator(models.ResourceSpec{Name: "project.dataset", Spec: BQDataset{
+				Metadata: BQDatasetMetadata{Access: []BQAccessEntry{entry}},
+			}})
+			assert.NotNil(t, err, entry)
+		}
+		err := datasetValidator(models.ResourceSpec{Name: "project.dataset", Spec: BQDataset{
+			Metadata: BQDatasetMetadata{AccessMode: "replace"},
+		}})
+		assert.NotNil(t, err)
+
+		tableValidator := tableSpec{}.Validator()
+		err = tableValidator(models.ResourceSpec{Name: "project.dataset.table", Spec: BQTable{
+			Metadata: BQTableMetadata{IAM: []BQIAMBinding{{Role: "roles/bigquery.dataViewer", Members: []string{"jane@example.com"}}}},
+		}})
+		assert.NotNil(t, err)
+		err = tableValidator(models.ResourceSpec{Name: "project.dataset.table", Spec: BQTable{
+			Metadata: BQTableMetadata{IAM: []BQIAMBinding{{Role: "roles/bigquery.dataViewer", Members: []string{"user:jane@example.com"}}}},
+		}})
+		assert.Nil(t, err)
+	})
+}
@@ -3,8 +3,10 @@ package bigquery
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/pkg/errors"
+	"google.golang.org/api/googleapi"
 
 	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
 	"github.com/odpf/optimus/models"
@@ -41,10 +43,12 @@ func (b BigQuery) Description() string {
 
 func (b BigQuery) Types() map[models.ResourceType]models.DatastoreTypeController {
 	return map[models.ResourceType]models.DatastoreTypeController{
-		models.ResourceTypeTable:         &tableSpec{},
-		models.ResourceTypeView:          &standardViewSpec{},
-		models.ResourceTypeDataset:       &datasetSpec{},
-		models.ResourceTypeExternalTable: &externalTableSpec{},
+		models.ResourceTypeTable:            &tableSpec{},
+		models.ResourceTypeView:             &standardViewSpec{},
+		models.ResourceTypeDataset:          &datasetSpec{},
+		models.ResourceTypeExternalTable:    &externalTableSpec{},
+		models.ResourceTypeMaterializedView: &materializedViewSpec{},
+		models.ResourceTypeRoutine:          &routineSpec{},
 	}
 }
 
@@ -68,6 +72,10 @@ func (b *BigQuery) CreateResource(ctx context.Context, request models.CreateReso
 		return createDataset(ctx, request.Resource, client, false)
 	case models.ResourceTypeExternalTable:
 		return createExternalTable(ctx, request.Resource, client, false)
+	case models.ResourceTypeMaterializedView:
+		return createMaterializedView(ctx, request.Resource, client, false)
+	case models.ResourceTypeRoutine:
+		return createRoutine(ctx, request.Resource, client, false)
 	}
 	return fmt.Errorf("unsupported resource type %s", request.Resource.Type)
 }
@@ -92,6 +100,10 @@ func (b *BigQuery) UpdateResource(ctx context.Context, request models.UpdateReso
 		return createDataset(ctx, request.Resource, client, true)
 	case models.ResourceTypeExternalTable:
 		return createExternalTable(ctx, request.Resource, client, true)
+	case models.ResourceTypeMaterializedView:
+		return createMaterializedView(ctx, request.Resource, client, true)
+	case models.ResourceTypeRoutine:
+		return createRoutine(ctx, request.Resource, client, true)
 	}
 	return fmt.Errorf("unsupported resource type %s", request.Resource.Type)
 }
@@ -111,7 +123,7 @@ func (b *BigQuery) ReadResource(ctx context.Context, request models.ReadResource
 	case models.ResourceTypeTable:
 		info, err := getTable(ctx, request.Resource, client)
 		if err != nil {
-			return models.ReadResourceResponse{}, err
+			return models.ReadResourceResponse{}, readResourceErr(err)
 		}
 		return models.ReadResourceResponse{
 			Resource: info,
@@ -119,7 +131,7 @@ func (b *BigQuery) ReadResource(ctx context.Context, request models.ReadResource
 	case models.ResourceTypeView:
 		info, err := getTable(ctx, request.Resource, client)
 		if err != nil {
-			return models.ReadResourceResponse{}, err
+			return models.ReadResourceResponse{}, readResourceErr(err)
 		}
 		return models.ReadResourceResponse{
 			Resource: info,
@@ -127,7 +139,31 @@ func (b *BigQuery) ReadResource(ctx context.Context, request models.ReadResource
 	case models.ResourceTypeDataset:
 		info, err := getDataset(ctx, request.Resource, client)
 		if err != nil {
-			return models.ReadResourceResponse{}, err
+			return models.ReadResourceResponse{}, readResourceErr(err)
+		}
+		return models.ReadResourceResponse{
+			Resource: info,
+		}, nil
+	case models.ResourceTypeMaterializedView:
+		info, err := getTable(ctx, request.Resource, client)
+		if err != nil {
+			return models.ReadResourceResponse{}, readResourceErr(err)
+		}
+		return models.ReadResourceResponse{
+			Resource: info,
+		}, nil
+	case models.ResourceTypeExternalTable:
+		info, err := getTable(ctx, request.Resource, client)
+		if err != nil {
+			return models.ReadResourceResponse{}, readResourceErr(err)
+		}
+		return models.ReadResourceResponse{
+			Resource: info,
+		}, nil
+	case models.ResourceTypeRoutine:
+		info, err := getRoutine(ctx, request.Resource, client)
+		if err != nil {
+			return models.ReadResourceResponse{}, readResourceErr(err)
 		}
 		return models.ReadResourceResponse{
 			Resource: info,
@@ -154,10 +190,23 @@ func (b *BigQuery) DeleteResource(ctx context.Context, request models.DeleteReso
 		return deleteTable(ctx, request.Resource, client)
 	case models.ResourceTypeDataset:
 		return deleteDataset(ctx, request.Resource, client)
+	case models.ResourceTypeMaterializedView:
+		return deleteTable(ctx, request.Resource, client)
+	case models.ResourceTypeRoutine:
+		return deleteRoutine(ctx, request.Resource, client)
 	}
 	return fmt.Errorf("unsupported resource type %s", request.Resource.Type)
 }
 
+// readResourceErr marks resources missing from bigquery with
+// models.ErrResourceNotFoundInDatastore
+func readResourceErr(err error) error {
+	if metaErr, ok := err.(*googleapi.Error); ok && metaErr.Code == http.StatusNotFound {
+		return errors.Wrap(models.ErrResourceNotFoundInDatastore, metaErr.Error())
+	}
+	return err
+}
+
 func init() {
 	if err := models.DatastoreRegistry.Add(This); err != nil {
 		panic(err)
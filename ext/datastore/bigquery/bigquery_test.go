@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"cloud.google.com/go/bigquery"
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
 	"github.com/odpf/optimus/models"
 	"github.com/stretchr/testify/assert"
 )
@@ -305,6 +307,47 @@ func TestBigquery(t *testing.T) {
 			assert.Equal(t, models.ResourceSpec{}, resp.Resource)
 			assert.NotNil(t, err)
 		})
+		t.Run("should read external tables", func(t *testing.T) {
+			bQResource := BQTable{
+				Project: testingProject,
+				Dataset: testingDataset,
+				Table:   "sheet",
+			}
+			resourceSpec := models.ResourceSpec{
+				Spec: bQResource,
+				Type: models.ResourceTypeExternalTable,
+			}
+			resourceRequest := models.ReadResourceRequest{
+				Resource: resourceSpec,
+				Project:  projectSpec,
+			}
+
+			bQClient := new(BqClientMock)
+			defer bQClient.AssertExpectations(t)
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQTable := new(BqTableMock)
+			defer bQTable.AssertExpectations(t)
+			bQClientFactory := new(BQClientFactoryMock)
+			defer bQClientFactory.AssertExpectations(t)
+
+			bQClientFactory.On("New", testingContext, secret).Return(bQClient, nil)
+			bQClient.On("DatasetInProject", bQResource.Project, bQResource.Dataset).Return(bQDatasetHandle)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{}, nil)
+			bQDatasetHandle.On("Table", bQResource.Table).Return(bQTable)
+			bQTable.On("Metadata", testingContext).Return(&bigquery.TableMetadata{
+				Description: "sheet of orders",
+			}, nil)
+
+			bq := BigQuery{
+				ClientFac: bQClientFactory,
+			}
+			resp, err := bq.ReadResource(testingContext, resourceRequest)
+
+			assert.Nil(t, err)
+			assert.Equal(t, models.ResourceTypeExternalTable, resp.Resource.Type)
+			assert.Equal(t, "sheet of orders", resp.Resource.Spec.(BQTable).Metadata.Description)
+		})
 		t.Run("should return error when the resource type is unsupported", func(t *testing.T) {
 			datasetLabels := map[string]string{
 				"application": "optimus",
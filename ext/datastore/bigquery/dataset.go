@@ -32,7 +32,7 @@ func createDataset(ctx context.Context, spec models.ResourceSpec, client bqiface
 	if err := ensureDataset(ctx, dataset, bqResource, upsert); err != nil {
 		return err
 	}
-	return nil
+	return ensureDatasetAccess(ctx, client, dataset, bqResource, upsert)
 }
 
 func ensureDataset(ctx context.Context, datasetHandle bqiface.Dataset, bqResource BQDataset, upsert bool) error {
@@ -97,6 +97,9 @@ func getDataset(ctx context.Context, resourceSpec models.ResourceSpec, client bq
 		DefaultTableExpiration: int64(datasetMeta.DefaultTableExpiration.Hours()),
 		Location:               datasetMeta.Location,
 	}
+	for _, accessEntry := range datasetMeta.Access {
+		bqResource.Metadata.Access = append(bqResource.Metadata.Access, bqAccessEntryFrom(accessEntry))
+	}
 	resourceSpec.Spec = bqResource
 	return resourceSpec, nil
 }
@@ -41,6 +41,10 @@ type BQDatasetMetadata struct {
 	Labels                 map[string]string `yaml:"-" structs:"-"` // will be inherited by base resource
 
 	Location string `yaml:",omitempty" structs:"location,omitempty"`
+
+	// access entries of dataset, reconciled as per access mode
+	Access     []BQAccessEntry `yaml:",omitempty" structs:"access,omitempty"`
+	AccessMode string          `yaml:"access_mode,omitempty" structs:"access_mode,omitempty"` // additive or authoritative, default additive
 }
 
 // datasetSpecHandler helps serializing/deserializing datastore resource for dataset
@@ -137,6 +141,14 @@ func (s datasetSpecHandler) FromProtobuf(b []byte) (models.ResourceSpec, error)
 		if protoSpecField, ok := baseSpec.Spec.Fields["table_expiration"]; ok {
 			bqMeta.DefaultTableExpiration = int64(protoSpecField.GetNumberValue())
 		}
+
+		if protoSpecField, ok := baseSpec.Spec.Fields["access"]; ok {
+			bqMeta.Access = extractAccessEntriesFromProtoStruct(protoSpecField)
+		}
+
+		if protoSpecField, ok := baseSpec.Spec.Fields["access_mode"]; ok {
+			bqMeta.AccessMode = protoSpecField.GetStringValue()
+		}
 	}
 
 	optResource := models.ResourceSpec{
@@ -170,6 +182,16 @@ func (s datasetSpec) Validator() models.DatastoreSpecValidator {
 		if len(parsedNames) < 3 || len(parsedNames[1]) == 0 || len(parsedNames[2]) == 0 {
 			return fmt.Errorf("for example 'project_name.dataset_name'")
 		}
+		if bqResource, ok := spec.Spec.(BQDataset); ok {
+			if err := validateAccessMode(bqResource.Metadata.AccessMode); err != nil {
+				return err
+			}
+			for _, entry := range bqResource.Metadata.Access {
+				if err := entry.validate(); err != nil {
+					return err
+				}
+			}
+		}
 		return nil
 	}
 }
@@ -177,3 +199,8 @@ func (s datasetSpec) Validator() models.DatastoreSpecValidator {
 func (s datasetSpec) DefaultAssets() map[string]string {
 	return map[string]string{}
 }
+
+// Diff compares spec of dataset with the one read from bigquery
+func (s datasetSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffDataset(expected, actual)
+}
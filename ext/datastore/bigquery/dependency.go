@@ -0,0 +1,72 @@
+package bigquery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	// tables referenced in a query either quoted as `project.dataset.table`
+	// or unquoted right after FROM or JOIN
+	queryQuotedTableRegex   = regexp.MustCompile("`([\\w-]+\\.\\w+\\.[\\w-]+)`")
+	queryUnquotedTableRegex = regexp.MustCompile(`(?i)\b(?:from|join)\s+([\w-]+\.\w+\.\w+)\b`)
+)
+
+// tableDependencies lists the dataset of a table, along with tables a view
+// reads from if it is a view or a materialized view
+func tableDependencies(spec models.ResourceSpec) ([]string, error) {
+	bqResource, ok := spec.Spec.(BQTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for bigquery")
+	}
+	dependencies := []string{fmt.Sprintf("%s.%s", bqResource.Project, bqResource.Dataset)}
+	if spec.Type != models.ResourceTypeView && spec.Type != models.ResourceTypeMaterializedView {
+		return dependencies, nil
+	}
+
+	return append(dependencies, queryDependencies(spec.Name, viewQueryOf(spec, bqResource.Metadata))...), nil
+}
+
+// routineDependencies lists the dataset of a routine along with tables its
+// body reads from
+func routineDependencies(spec models.ResourceSpec) ([]string, error) {
+	bqResource, ok := spec.Spec.(BQRoutine)
+	if !ok {
+		return nil, errors.New("failed to read routine spec for bigquery")
+	}
+	dependencies := []string{fmt.Sprintf("%s.%s", bqResource.Project, bqResource.Dataset)}
+	return append(dependencies, queryDependencies(spec.Name, routineBodyOf(spec))...), nil
+}
+
+// queryDependencies lists tables referenced in query other than the resource itself
+func queryDependencies(name, query string) []string {
+	var dependencies []string
+	seen := map[string]bool{name: true}
+	for _, tableRegex := range []*regexp.Regexp{queryQuotedTableRegex, queryUnquotedTableRegex} {
+		for _, match := range tableRegex.FindAllStringSubmatch(query, -1) {
+			if seen[match[1]] {
+				continue
+			}
+			seen[match[1]] = true
+			dependencies = append(dependencies, match[1])
+		}
+	}
+	return dependencies
+}
+
+// viewQueryOf returns query of a view, preferring the one set in spec over
+// the one kept as an asset
+func viewQueryOf(spec models.ResourceSpec, metadata BQTableMetadata) string {
+	assetName := ViewQueryFile
+	if spec.Type == models.ResourceTypeMaterializedView {
+		assetName = MaterializedViewQueryFile
+	}
+	if query, ok := spec.Assets.GetByName(assetName); ok && len(strings.TrimSpace(metadata.ViewQuery)) == 0 {
+		return query
+	}
+	return metadata.ViewQuery
+}
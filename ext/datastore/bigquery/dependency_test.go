@@ -0,0 +1,36 @@
+package bigquery
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencies(t *testing.T) {
+	t.Run("should depend on dataset of table", func(t *testing.T) {
+		dependencies, err := tableSpec{}.Dependencies(models.ResourceSpec{
+			Name: "proj.datas.t1",
+			Type: models.ResourceTypeTable,
+			Spec: BQTable{Project: "proj", Dataset: "datas", Table: "t1"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"proj.datas"}, dependencies)
+	})
+	t.Run("should depend on tables referenced in view query", func(t *testing.T) {
+		dependencies, err := standardViewSpec{}.Dependencies(models.ResourceSpec{
+			Name: "proj.datas.v1",
+			Type: models.ResourceTypeView,
+			Spec: BQTable{Project: "proj", Dataset: "datas", Table: "v1"},
+			Assets: map[string]string{ViewQueryFile: "select o.id from `data-proj.sales.orders` o\n" +
+				"JOIN proj.datas.customers c on o.customer = c.id\n" +
+				"left join `data-proj.sales.orders` r on r.id = o.id"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"proj.datas", "data-proj.sales.orders", "proj.datas.customers"}, dependencies)
+	})
+	t.Run("should return error if spec is malformed", func(t *testing.T) {
+		_, err := standardViewSpec{}.Dependencies(models.ResourceSpec{Type: models.ResourceTypeView})
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,313 @@
+package bigquery
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+var (
+	// standard sql aliases of legacy types returned by bigquery api
+	fieldTypeAliases = map[string]string{
+		"INT64":   "INTEGER",
+		"FLOAT64": "FLOAT",
+		"BOOL":    "BOOLEAN",
+		"STRUCT":  "RECORD",
+	}
+)
+
+// fieldDrifts collects fields of a resource which differ from the spec
+type fieldDrifts []models.ResourceFieldDrift
+
+func (d *fieldDrifts) compare(field, expected, actual string) {
+	if expected != actual {
+		*d = append(*d, models.ResourceFieldDrift{
+			Field:    field,
+			Expected: expected,
+			Actual:   actual,
+		})
+	}
+}
+
+func (d *fieldDrifts) compareLabels(expected, actual map[string]string) {
+	var keys []string
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	for key := range actual {
+		if _, ok := expected[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		d.compare("labels."+key, expected[key], actual[key])
+	}
+}
+
+// compareAccess reports access entries of spec missing from dataset, along
+// with entries not in spec if access is authoritative. Access isn't
+// compared if spec doesn't manage it.
+func (d *fieldDrifts) compareAccess(expected, actual []BQAccessEntry, authoritative bool) {
+	if len(expected) == 0 {
+		return
+	}
+	actualEntries := map[string]bool{}
+	for _, entry := range actual {
+		actualEntries[entry.String()] = true
+	}
+	expectedEntries := map[string]bool{}
+	for _, entry := range expected {
+		expectedEntries[entry.String()] = true
+		if !actualEntries[entry.String()] {
+			d.compare("access", entry.String(), "")
+		}
+	}
+	if !authoritative {
+		return
+	}
+	for _, entry := range actual {
+		if !expectedEntries[entry.String()] {
+			d.compare("access", "", entry.String())
+		}
+	}
+}
+
+// compareIAM reports iam members of spec missing from table, along with
+// members not in spec if access is authoritative
+func (d *fieldDrifts) compareIAM(expected, actual []BQIAMBinding, authoritative bool) {
+	if len(expected) == 0 {
+		return
+	}
+	expectedMembers, actualMembers := iamMembersByRole(expected), iamMembersByRole(actual)
+	for _, role := range sortedRoles(expectedMembers) {
+		for _, member := range expectedMembers[role] {
+			if !containsString(actualMembers[role], member) {
+				d.compare("iam."+role, member, "")
+			}
+		}
+	}
+	if !authoritative {
+		return
+	}
+	for _, role := range sortedRoles(actualMembers) {
+		for _, member := range actualMembers[role] {
+			if !containsString(expectedMembers[role], member) {
+				d.compare("iam."+role, "", member)
+			}
+		}
+	}
+}
+
+// compareSchema matches columns by name, reporting columns missing from
+// either side along with changes in type, mode and description
+func (d *fieldDrifts) compareSchema(prefix string, expected, actual BQSchema) {
+	actualFields := map[string]BQField{}
+	for _, field := range actual {
+		actualFields[strings.ToLower(field.Name)] = field
+	}
+	expectedFields := map[string]bool{}
+	for _, expectedField := range expected {
+		name := strings.ToLower(expectedField.Name)
+		expectedFields[name] = true
+		fieldPath := prefix + "." + expectedField.Name
+
+		actualField, ok := actualFields[name]
+		if !ok {
+			d.compare(fieldPath, describeField(expectedField), "")
+			continue
+		}
+		d.compare(fieldPath+".type", normaliseFieldType(expectedField.Type), normaliseFieldType(actualField.Type))
+		d.compare(fieldPath+".mode", normaliseFieldMode(expectedField.Mode), normaliseFieldMode(actualField.Mode))
+		d.compare(fieldPath+".description", expectedField.Description, actualField.Description)
+		d.compareSchema(fieldPath, expectedField.Schema, actualField.Schema)
+	}
+	for _, actualField := range actual {
+		if !expectedFields[strings.ToLower(actualField.Name)] {
+			d.compare(prefix+"."+actualField.Name, "", describeField(actualField))
+		}
+	}
+}
+
+func (d *fieldDrifts) comparePartition(expected, actual *BQPartitionInfo) {
+	if expected == nil || actual == nil {
+		d.compare("partition", describePartition(expected), describePartition(actual))
+		return
+	}
+	d.compare("partition.field", expected.Field, actual.Field)
+	if expected.Range != nil || actual.Range != nil {
+		d.compare("partition.range", describePartition(&BQPartitionInfo{Range: expected.Range}),
+			describePartition(&BQPartitionInfo{Range: actual.Range}))
+		return
+	}
+	d.compare("partition.type", normalisePartitionType(expected.Type), normalisePartitionType(actual.Type))
+	d.compare("partition.expiration", strconv.FormatInt(expected.Expiration, 10), strconv.FormatInt(actual.Expiration, 10))
+}
+
+// diffTable compares specs of tables and views
+func diffTable(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	expectedTable, ok := expected.Spec.(BQTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for bigquery")
+	}
+	actualTable, ok := actual.Spec.(BQTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for bigquery")
+	}
+	e, a := expectedTable.Metadata, actualTable.Metadata
+
+	// view query could be in an external asset
+	expectedQuery := viewQueryOf(expected, e)
+
+	var drifts fieldDrifts
+	drifts.compare("description", e.Description, a.Description)
+	// schema of views is derived from their query, and a table spec without
+	// schema leaves it to whoever writes to the table
+	if len(e.Schema) > 0 && expected.Type != models.ResourceTypeView &&
+		expected.Type != models.ResourceTypeMaterializedView {
+		drifts.compareSchema("schema", e.Schema, a.Schema)
+	}
+	drifts.comparePartition(e.Partition, a.Partition)
+	drifts.compare("cluster", describeClustering(e.Cluster), describeClustering(a.Cluster))
+	// expiration could be inherited from dataset if not set explicitly
+	if e.ExpirationTime != "" {
+		drifts.compare("expiration_time", normaliseTimestamp(e.ExpirationTime), normaliseTimestamp(a.ExpirationTime))
+	}
+	drifts.compare("view_query", normaliseQuery(expectedQuery), normaliseQuery(a.ViewQuery))
+	if expected.Type == models.ResourceTypeMaterializedView {
+		drifts.compare("refresh", describeRefresh(e.Refresh), describeRefresh(a.Refresh))
+	}
+	// labels of spec are inherited by the resource
+	drifts.compareLabels(expected.Labels, a.Labels)
+	drifts.compareIAM(e.IAM, a.IAM, isAuthoritative(e.AccessMode))
+	return drifts, nil
+}
+
+// diffRoutine compares specs of routines, description and imported libraries
+// are not compared as they can't be read back from bigquery
+func diffRoutine(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	expectedRoutine, ok := expected.Spec.(BQRoutine)
+	if !ok {
+		return nil, errors.New("failed to read routine spec for bigquery")
+	}
+	actualRoutine, ok := actual.Spec.(BQRoutine)
+	if !ok {
+		return nil, errors.New("failed to read routine spec for bigquery")
+	}
+	e, a := expectedRoutine.Metadata, actualRoutine.Metadata
+
+	var drifts fieldDrifts
+	drifts.compare("type", strings.ToUpper(e.Type), strings.ToUpper(a.Type))
+	drifts.compare("language", e.language(), a.language())
+	drifts.compare("arguments", describeRoutineArguments(e.Arguments), describeRoutineArguments(a.Arguments))
+	drifts.compare("return_type", strings.ToUpper(e.ReturnType), strings.ToUpper(a.ReturnType))
+	drifts.compare("body", normaliseQuery(routineBodyOf(expected)), normaliseQuery(routineBodyOf(actual)))
+	return drifts, nil
+}
+
+func describeRoutineArguments(arguments []BQRoutineArgument) string {
+	var described []string
+	for _, argument := range arguments {
+		mode := strings.ToUpper(argument.Mode)
+		if mode == "" {
+			mode = "IN"
+		}
+		described = append(described, fmt.Sprintf("%s %s %s", mode, argument.Name, strings.ToUpper(argument.Type)))
+	}
+	return strings.Join(described, ", ")
+}
+
+// describeRefresh formats refresh settings, refresh is enabled if not set
+func describeRefresh(refresh *BQRefreshInfo) string {
+	if refresh == nil {
+		return "enabled"
+	}
+	if !refresh.Enabled {
+		return "disabled"
+	}
+	if refresh.Interval == 0 {
+		return "enabled"
+	}
+	return fmt.Sprintf("enabled every %dm", refresh.Interval)
+}
+
+func diffDataset(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	expectedDataset, ok := expected.Spec.(BQDataset)
+	if !ok {
+		return nil, errors.New("failed to read dataset spec for bigquery")
+	}
+	actualDataset, ok := actual.Spec.(BQDataset)
+	if !ok {
+		return nil, errors.New("failed to read dataset spec for bigquery")
+	}
+	e, a := expectedDataset.Metadata, actualDataset.Metadata
+
+	var drifts fieldDrifts
+	drifts.compare("description", e.Description, a.Description)
+	drifts.compare("table_expiration", strconv.FormatInt(e.DefaultTableExpiration, 10), strconv.FormatInt(a.DefaultTableExpiration, 10))
+	drifts.compareLabels(expected.Labels, a.Labels)
+	drifts.compareAccess(e.Access, a.Access, isAuthoritative(e.AccessMode))
+	return drifts, nil
+}
+
+func describeField(field BQField) string {
+	return fmt.Sprintf("%s %s", normaliseFieldType(field.Type), normaliseFieldMode(field.Mode))
+}
+
+func describePartition(partition *BQPartitionInfo) string {
+	switch {
+	case partition == nil:
+		return ""
+	case partition.Range != nil:
+		return strings.TrimSpace(fmt.Sprintf("%s range [%d, %d) every %d", partition.Field, partition.Range.Start,
+			partition.Range.End, partition.Range.Interval))
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s expiring in %dh", partition.Field,
+		normalisePartitionType(partition.Type), partition.Expiration))
+}
+
+func describeClustering(cluster *BQClusteringInfo) string {
+	if cluster == nil {
+		return ""
+	}
+	return strings.Join(cluster.Using, ",")
+}
+
+func normaliseFieldType(fieldType string) string {
+	fieldType = strings.ToUpper(fieldType)
+	if alias, ok := fieldTypeAliases[fieldType]; ok {
+		return alias
+	}
+	return fieldType
+}
+
+func normaliseFieldMode(mode string) string {
+	if mode == "" {
+		return "NULLABLE"
+	}
+	return strings.ToUpper(mode)
+}
+
+func normalisePartitionType(partitionType string) string {
+	if partitionType == "" {
+		return "DAY"
+	}
+	return strings.ToUpper(partitionType)
+}
+
+func normaliseTimestamp(timestamp string) string {
+	if parsed, err := time.Parse(time.RFC3339, timestamp); err == nil {
+		return parsed.UTC().Format(time.RFC3339)
+	}
+	return timestamp
+}
+
+// normaliseQuery ignores whitespace differences in queries
+func normaliseQuery(query string) string {
+	return strings.Join(strings.Fields(query), " ")
+}
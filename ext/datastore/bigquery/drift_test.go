@@ -0,0 +1,164 @@
+package bigquery
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDrift(t *testing.T) {
+	t.Run("table", func(t *testing.T) {
+		expected := models.ResourceSpec{
+			Name: "proj.datas.t1",
+			Type: models.ResourceTypeTable,
+			Spec: BQTable{
+				Project: "proj",
+				Dataset: "datas",
+				Table:   "t1",
+				Metadata: BQTableMetadata{
+					Description: "orders",
+					Schema: BQSchema{
+						{Name: "id", Type: "INT64", Mode: "required"},
+						{Name: "meta", Type: "STRUCT", Schema: BQSchema{
+							{Name: "source", Type: "STRING"},
+						}},
+					},
+					Partition:      &BQPartitionInfo{Field: "event_timestamp"},
+					Cluster:        &BQClusteringInfo{Using: []string{"id"}},
+					ExpirationTime: "2022-01-01T00:00:00Z",
+				},
+			},
+			Labels: map[string]string{"owner": "optimus"},
+		}
+
+		t.Run("should report no drift for equivalent table", func(t *testing.T) {
+			actual := expected
+			actual.Spec = BQTable{
+				Project: "proj",
+				Dataset: "datas",
+				Table:   "t1",
+				Metadata: BQTableMetadata{
+					Description: "orders",
+					Schema: BQSchema{
+						{Name: "id", Type: "INTEGER", Mode: "required"},
+						{Name: "meta", Type: "RECORD", Mode: "nullable", Schema: BQSchema{
+							{Name: "source", Type: "STRING", Mode: "nullable"},
+						}},
+					},
+					Partition:      &BQPartitionInfo{Field: "event_timestamp", Type: "DAY"},
+					Cluster:        &BQClusteringInfo{Using: []string{"id"}},
+					ExpirationTime: "2022-01-01T07:00:00+07:00",
+					Labels:         map[string]string{"owner": "optimus"},
+				},
+			}
+
+			drifts, err := tableSpec{}.Diff(expected, actual)
+			assert.Nil(t, err)
+			assert.Empty(t, drifts)
+		})
+		t.Run("should report every drifted field", func(t *testing.T) {
+			actual := expected
+			actual.Spec = BQTable{
+				Project: "proj",
+				Dataset: "datas",
+				Table:   "t1",
+				Metadata: BQTableMetadata{
+					Description: "all orders",
+					Schema: BQSchema{
+						{Name: "id", Type: "STRING", Mode: "required"},
+						{Name: "meta", Type: "RECORD", Schema: BQSchema{}},
+						{Name: "added_by_hand", Type: "STRING"},
+					},
+					Cluster:        &BQClusteringInfo{Using: []string{"id", "meta"}},
+					ExpirationTime: "2022-02-01T00:00:00Z",
+					Labels:         map[string]string{"owner": "someone"},
+				},
+			}
+
+			drifts, err := tableSpec{}.Diff(expected, actual)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ResourceFieldDrift{
+				{Field: "description", Expected: "orders", Actual: "all orders"},
+				{Field: "schema.id.type", Expected: "INTEGER", Actual: "STRING"},
+				{Field: "schema.meta.source", Expected: "STRING NULLABLE", Actual: ""},
+				{Field: "schema.added_by_hand", Expected: "", Actual: "STRING NULLABLE"},
+				{Field: "partition", Expected: "event_timestamp DAY expiring in 0h", Actual: ""},
+				{Field: "cluster", Expected: "id", Actual: "id,meta"},
+				{Field: "expiration_time", Expected: "2022-01-01T00:00:00Z", Actual: "2022-02-01T00:00:00Z"},
+				{Field: "labels.owner", Expected: "optimus", Actual: "someone"},
+			}, drifts)
+		})
+	})
+	t.Run("table without schema", func(t *testing.T) {
+		t.Run("should not compare schema of table", func(t *testing.T) {
+			expected := models.ResourceSpec{
+				Name: "proj.datas.t2",
+				Type: models.ResourceTypeTable,
+				Spec: BQTable{Project: "proj", Dataset: "datas", Table: "t2"},
+			}
+			actual := expected
+			actual.Spec = BQTable{Project: "proj", Dataset: "datas", Table: "t2", Metadata: BQTableMetadata{
+				Schema: BQSchema{{Name: "id", Type: "INTEGER", Mode: "nullable"}},
+			}}
+
+			drifts, err := tableSpec{}.Diff(expected, actual)
+			assert.Nil(t, err)
+			assert.Empty(t, drifts)
+		})
+	})
+	t.Run("view", func(t *testing.T) {
+		t.Run("should compare query from asset ignoring whitespace", func(t *testing.T) {
+			expected := models.ResourceSpec{
+				Name:   "proj.datas.v1",
+				Type:   models.ResourceTypeView,
+				Spec:   BQTable{Project: "proj", Dataset: "datas", Table: "v1"},
+				Assets: map[string]string{ViewQueryFile: "select *\n  from `proj.datas.t1`"},
+			}
+			// schema of a view is read back from bigquery but isn't part of spec
+			viewSchema := BQSchema{{Name: "id", Type: "INTEGER", Mode: "nullable"}}
+			actual := expected
+			actual.Spec = BQTable{Project: "proj", Dataset: "datas", Table: "v1", Metadata: BQTableMetadata{
+				ViewQuery: "select * from `proj.datas.t1`",
+				Schema:    viewSchema,
+			}}
+
+			drifts, err := standardViewSpec{}.Diff(expected, actual)
+			assert.Nil(t, err)
+			assert.Empty(t, drifts)
+
+			actual.Spec = BQTable{Project: "proj", Dataset: "datas", Table: "v1", Metadata: BQTableMetadata{
+				ViewQuery: "select id from `proj.datas.t1`",
+				Schema:    viewSchema,
+			}}
+			drifts, err = standardViewSpec{}.Diff(expected, actual)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ResourceFieldDrift{
+				{Field: "view_query", Expected: "select * from `proj.datas.t1`", Actual: "select id from `proj.datas.t1`"},
+			}, drifts)
+		})
+	})
+	t.Run("dataset", func(t *testing.T) {
+		t.Run("should report drifted labels and expiration", func(t *testing.T) {
+			expected := models.ResourceSpec{
+				Name: "proj.datas",
+				Type: models.ResourceTypeDataset,
+				Spec: BQDataset{Project: "proj", Dataset: "datas", Metadata: BQDatasetMetadata{
+					DefaultTableExpiration: 24,
+				}},
+				Labels: map[string]string{"owner": "optimus"},
+			}
+			actual := expected
+			actual.Spec = BQDataset{Project: "proj", Dataset: "datas", Metadata: BQDatasetMetadata{
+				Labels: map[string]string{"owner": "optimus", "env": "dev"},
+			}}
+
+			drifts, err := datasetSpec{}.Diff(expected, actual)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ResourceFieldDrift{
+				{Field: "table_expiration", Expected: "24", Actual: "0"},
+				{Field: "labels.env", Expected: "", Actual: "dev"},
+			}, drifts)
+		})
+	})
+}
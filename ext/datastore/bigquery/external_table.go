@@ -33,7 +33,10 @@ func createExternalTable(ctx context.Context, spec models.ResourceSpec, client b
 		return err
 	}
 	table := dataset.Table(bqResource.Table)
-	return ensureExternalTable(ctx, table, bqResource, upsert)
+	if err := ensureExternalTable(ctx, table, bqResource, upsert); err != nil {
+		return err
+	}
+	return ensureTableIAM(ctx, client, table, spec.Type, bqResource, upsert)
 }
 
 func ensureExternalTable(ctx context.Context, tableHandle bqiface.Table, t BQTable, upsert bool) error {
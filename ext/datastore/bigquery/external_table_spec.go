@@ -40,6 +40,9 @@ func (s externalTableSpec) Validator() models.DatastoreSpecValidator {
 		if len(parsedNames) < 3 || len(parsedNames[1]) == 0 || len(parsedNames[2]) == 0 || len(parsedNames[3]) == 0 {
 			return fmt.Errorf("for example 'project_name.dataset_name.table_name'")
 		}
+		if err := validateTableAccess(spec); err != nil {
+			return err
+		}
 		return nil
 	}
 }
@@ -70,3 +73,8 @@ func extractTableSourceFromProtoStruct(protoVal *structpb.Value) *BQExternalSour
 	}
 	return sInfo
 }
+
+// Dependencies of external table is its dataset
+func (s externalTableSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return tableDependencies(spec)
+}
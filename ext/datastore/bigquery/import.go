@@ -0,0 +1,172 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	bqapi "cloud.google.com/go/bigquery"
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+)
+
+const (
+	importedSpecVersion = 1
+)
+
+// ImportResources generates specs of a dataset and its tables and views
+// whose names match the pattern, e.g. `orders_*`
+func (b *BigQuery) ImportResources(ctx context.Context, request models.ImportResourcesRequest) ([]models.ResourceSpec, error) {
+	parsedNames := datasetNameParseRegex.FindStringSubmatch(request.Parent)
+	if len(parsedNames) < 3 {
+		return nil, fmt.Errorf("invalid dataset name %s, for example 'project_name.dataset_name'", request.Parent)
+	}
+	if _, err := path.Match(request.Pattern, ""); err != nil {
+		return nil, errors.Wrapf(err, "invalid table pattern %s", request.Pattern)
+	}
+
+	client, err := b.ClientFac.New(ctx, request.Secret)
+	if err != nil {
+		return nil, err
+	}
+
+	dataset := client.DatasetInProject(parsedNames[1], parsedNames[2])
+	datasetSpec, err := importDataset(ctx, b, dataset, parsedNames[1], parsedNames[2])
+	if err != nil {
+		return nil, err
+	}
+
+	var tableSpecs []models.ResourceSpec
+	tables := dataset.Tables(ctx)
+	for {
+		table, err := tables.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list tables of %s", request.Parent)
+		}
+		if request.Pattern != "" {
+			if matched, _ := path.Match(request.Pattern, table.TableID()); !matched {
+				continue
+			}
+		}
+
+		tableSpec, ok, err := importTable(ctx, b, table, parsedNames[1], parsedNames[2])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			tableSpecs = append(tableSpecs, tableSpec)
+		}
+	}
+	sort.Slice(tableSpecs, func(i, j int) bool {
+		return tableSpecs[i].Name < tableSpecs[j].Name
+	})
+	return append([]models.ResourceSpec{datasetSpec}, tableSpecs...), nil
+}
+
+func importDataset(ctx context.Context, ds models.Datastorer, dataset bqiface.Dataset, project, datasetName string) (models.ResourceSpec, error) {
+	datasetMeta, err := dataset.Metadata(ctx)
+	if err != nil {
+		return models.ResourceSpec{}, readResourceErr(err)
+	}
+	return models.ResourceSpec{
+		Version:   importedSpecVersion,
+		Name:      fmt.Sprintf("%s.%s", project, datasetName),
+		Type:      models.ResourceTypeDataset,
+		Datastore: ds,
+		Spec: BQDataset{
+			Project: project,
+			Dataset: datasetName,
+			Metadata: BQDatasetMetadata{
+				Description:            datasetMeta.Description,
+				DefaultTableExpiration: int64(datasetMeta.DefaultTableExpiration.Hours()),
+				Location:               datasetMeta.Location,
+			},
+		},
+		Labels: importedLabels(datasetMeta.Labels),
+	}, nil
+}
+
+// importTable generates spec of a table or view, other kind of tables
+// are not supported yet and skipped
+func importTable(ctx context.Context, ds models.Datastorer, table bqiface.Table, project, dataset string) (models.ResourceSpec, bool, error) {
+	tableMeta, err := table.Metadata(ctx)
+	if err != nil {
+		return models.ResourceSpec{}, false, errors.Wrapf(err, "failed to read table %s", table.TableID())
+	}
+
+	var resourceType models.ResourceType
+	switch tableMeta.Type {
+	case bqapi.RegularTable:
+		resourceType = models.ResourceTypeTable
+	case bqapi.ViewTable:
+		resourceType = models.ResourceTypeView
+	case bqapi.MaterializedView:
+		resourceType = models.ResourceTypeMaterializedView
+	default:
+		return models.ResourceSpec{}, false, nil
+	}
+
+	metadata, err := bqTableMetadataFrom(tableMeta)
+	if err != nil {
+		return models.ResourceSpec{}, false, err
+	}
+	// labels are inherited from base spec and location from dataset
+	metadata.Labels = nil
+	metadata.Location = ""
+	metadata.Schema = importedSchema(metadata.Schema)
+
+	resourceSpec := models.ResourceSpec{
+		Version:   importedSpecVersion,
+		Name:      fmt.Sprintf("%s.%s.%s", project, dataset, table.TableID()),
+		Type:      resourceType,
+		Datastore: ds,
+		Labels:    importedLabels(tableMeta.Labels),
+	}
+
+	// view query is kept as an asset like views created by optimus
+	switch resourceType {
+	case models.ResourceTypeView:
+		resourceSpec.Assets = models.ResourceAssets{
+			ViewQueryFile: metadata.ViewQuery,
+		}
+		metadata.ViewQuery = ""
+	case models.ResourceTypeMaterializedView:
+		resourceSpec.Assets = models.ResourceAssets{
+			MaterializedViewQueryFile: metadata.ViewQuery,
+		}
+		metadata.ViewQuery = ""
+		// schema is derived from query
+		metadata.Schema = nil
+	}
+	resourceSpec.Spec = BQTable{
+		Project:  project,
+		Dataset:  dataset,
+		Table:    table.TableID(),
+		Metadata: metadata,
+	}
+	return resourceSpec, true, nil
+}
+
+// importedSchema drops empty nested schemas which are omitted in yaml specs
+func importedSchema(schema BQSchema) BQSchema {
+	if len(schema) == 0 {
+		return nil
+	}
+	for idx := range schema {
+		schema[idx].Schema = importedSchema(schema[idx].Schema)
+	}
+	return schema
+}
+
+func importedLabels(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	return labels
+}
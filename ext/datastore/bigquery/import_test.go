@@ -0,0 +1,178 @@
+package bigquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	bqapi "cloud.google.com/go/bigquery"
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportResources(t *testing.T) {
+	ctx := context.Background()
+	secret := "some_secret"
+
+	newTable := func(name string, meta *bqapi.TableMetadata) *BqTableMock {
+		table := new(BqTableMock)
+		table.On("TableID").Return(name)
+		table.On("Metadata", ctx).Return(meta, nil)
+		return table
+	}
+	orders := newTable("orders", &bqapi.TableMetadata{
+		Type:        bqapi.RegularTable,
+		Description: "all orders",
+		Schema: bqapi.Schema{
+			{Name: "id", Type: bqapi.IntegerFieldType, Required: true},
+			{Name: "event_timestamp", Type: bqapi.TimestampFieldType},
+		},
+		TimePartitioning: &bqapi.TimePartitioning{Field: "event_timestamp", Type: bqapi.DayPartitioningType},
+		Clustering:       &bqapi.Clustering{Fields: []string{"id"}},
+		Labels:           map[string]string{"owner": "sales"},
+		Location:         "EU",
+	})
+	ordersView := newTable("orders_view", &bqapi.TableMetadata{
+		Type:      bqapi.ViewTable,
+		ViewQuery: "select * from `proj.datas.orders`",
+	})
+	external := newTable("orders_sheet", &bqapi.TableMetadata{
+		Type: bqapi.ExternalTable,
+	})
+	payments := newTable("payments", &bqapi.TableMetadata{
+		Type: bqapi.RegularTable,
+	})
+
+	newClient := func(tables ...bqiface.Table) *BQClientFactoryMock {
+		dataset := new(BqDatasetMock)
+		dataset.On("Metadata", ctx).Return(&bqiface.DatasetMetadata{
+			DatasetMetadata: bqapi.DatasetMetadata{
+				Description:            "sales data",
+				DefaultTableExpiration: 48 * time.Hour,
+				Location:               "EU",
+				Labels:                 map[string]string{"team": "sales"},
+			},
+		}, nil)
+		dataset.On("Tables", ctx).Return(&BqTableIteratorMock{Tables: tables})
+
+		client := new(BqClientMock)
+		client.On("DatasetInProject", "proj", "datas").Return(dataset)
+
+		clientFac := new(BQClientFactoryMock)
+		clientFac.On("New", ctx, secret).Return(client, nil)
+		return clientFac
+	}
+
+	t.Run("should generate specs of dataset with its tables and views", func(t *testing.T) {
+		bq := BigQuery{ClientFac: newClient(payments, orders, ordersView, external)}
+		resourceSpecs, err := bq.ImportResources(ctx, models.ImportResourcesRequest{
+			Secret: secret,
+			Parent: "proj.datas",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ResourceSpec{
+			{
+				Version:   importedSpecVersion,
+				Name:      "proj.datas",
+				Type:      models.ResourceTypeDataset,
+				Datastore: &bq,
+				Spec: BQDataset{
+					Project: "proj",
+					Dataset: "datas",
+					Metadata: BQDatasetMetadata{
+						Description:            "sales data",
+						DefaultTableExpiration: 48,
+						Location:               "EU",
+					},
+				},
+				Labels: map[string]string{"team": "sales"},
+			},
+			{
+				Version:   importedSpecVersion,
+				Name:      "proj.datas.orders",
+				Type:      models.ResourceTypeTable,
+				Datastore: &bq,
+				Spec: BQTable{
+					Project: "proj",
+					Dataset: "datas",
+					Table:   "orders",
+					Metadata: BQTableMetadata{
+						Description: "all orders",
+						Schema: BQSchema{
+							{Name: "id", Type: "INTEGER", Mode: "required"},
+							{Name: "event_timestamp", Type: "TIMESTAMP", Mode: "nullable"},
+						},
+						Partition: &BQPartitionInfo{Field: "event_timestamp", Type: "DAY"},
+						Cluster:   &BQClusteringInfo{Using: []string{"id"}},
+					},
+				},
+				Labels: map[string]string{"owner": "sales"},
+			},
+			{
+				Version:   importedSpecVersion,
+				Name:      "proj.datas.orders_view",
+				Type:      models.ResourceTypeView,
+				Datastore: &bq,
+				Spec: BQTable{
+					Project: "proj",
+					Dataset: "datas",
+					Table:   "orders_view",
+				},
+				Assets: models.ResourceAssets{ViewQueryFile: "select * from `proj.datas.orders`"},
+			},
+			{
+				Version:   importedSpecVersion,
+				Name:      "proj.datas.payments",
+				Type:      models.ResourceTypeTable,
+				Datastore: &bq,
+				Spec: BQTable{
+					Project: "proj",
+					Dataset: "datas",
+					Table:   "payments",
+				},
+			},
+		}, resourceSpecs)
+	})
+	t.Run("should only import tables matching the pattern", func(t *testing.T) {
+		bq := BigQuery{ClientFac: newClient(payments, orders, ordersView)}
+		resourceSpecs, err := bq.ImportResources(ctx, models.ImportResourcesRequest{
+			Secret:  secret,
+			Parent:  "proj.datas",
+			Pattern: "orders*",
+		})
+		assert.Nil(t, err)
+		var names []string
+		for _, resourceSpec := range resourceSpecs {
+			names = append(names, resourceSpec.Name)
+		}
+		assert.Equal(t, []string{"proj.datas", "proj.datas.orders", "proj.datas.orders_view"}, names)
+	})
+	t.Run("should return error if dataset name is invalid", func(t *testing.T) {
+		bq := BigQuery{ClientFac: new(BQClientFactoryMock)}
+		_, err := bq.ImportResources(ctx, models.ImportResourcesRequest{Parent: "datas"})
+		assert.NotNil(t, err)
+	})
+	t.Run("should return error if pattern is malformed", func(t *testing.T) {
+		bq := BigQuery{ClientFac: new(BQClientFactoryMock)}
+		_, err := bq.ImportResources(ctx, models.ImportResourcesRequest{Parent: "proj.datas", Pattern: "orders["})
+		assert.NotNil(t, err)
+	})
+	t.Run("should generate table specs equivalent after round trip through yaml", func(t *testing.T) {
+		bq := BigQuery{ClientFac: newClient(orders)}
+		resourceSpecs, err := bq.ImportResources(ctx, models.ImportResourcesRequest{
+			Secret: secret,
+			Parent: "proj.datas",
+		})
+		assert.Nil(t, err)
+		imported := resourceSpecs[1]
+		imported.Datastore = This
+
+		handler := tableSpecHandler{}
+		raw, err := handler.ToYaml(imported)
+		assert.Nil(t, err)
+		parsed, err := handler.FromYaml(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, imported, parsed)
+	})
+}
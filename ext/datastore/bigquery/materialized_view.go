@@ -0,0 +1,100 @@
+package bigquery
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	bqapi "cloud.google.com/go/bigquery"
+	"github.com/pkg/errors"
+
+	"google.golang.org/api/googleapi"
+
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+)
+
+func createMaterializedView(ctx context.Context, spec models.ResourceSpec, client bqiface.Client, upsert bool) error {
+	bqResource, ok := spec.Spec.(BQTable)
+	if !ok {
+		return errors.New("failed to read table spec for bigquery")
+	}
+
+	// view query could be in an external asset
+	bqResource.Metadata.ViewQuery = viewQueryOf(spec, bqResource.Metadata)
+
+	// inherit from base
+	bqResource.Metadata.Labels = spec.Labels
+
+	dataset := client.DatasetInProject(bqResource.Project, bqResource.Dataset)
+	if err := ensureDataset(ctx, dataset, BQDataset{
+		Project:  bqResource.Project,
+		Dataset:  bqResource.Dataset,
+		Metadata: BQDatasetMetadata{},
+	}, false); err != nil {
+		return err
+	}
+	table := dataset.Table(bqResource.Table)
+	if err := ensureMaterializedView(ctx, table, bqResource, upsert); err != nil {
+		return err
+	}
+	return ensureTableIAM(ctx, client, table, spec.Type, bqResource, upsert)
+}
+
+// ensureMaterializedView make sures materialized view exists with provided
+// config, query of an existing view can't be changed in place
+func ensureMaterializedView(ctx context.Context, tableHandle bqiface.Table, t BQTable, upsert bool) error {
+	meta, err := tableHandle.Metadata(ctx)
+	if err != nil {
+		if metaErr, ok := err.(*googleapi.Error); !ok || metaErr.Code != http.StatusNotFound {
+			return err
+		}
+		m, err := bqCreateTableMetaAdapter(t)
+		if err != nil {
+			return err
+		}
+		m.MaterializedView = bqMaterializedViewTo(t.Metadata)
+		return tableHandle.Create(ctx, m)
+	}
+	if !upsert {
+		return nil
+	}
+
+	if meta.MaterializedView != nil &&
+		strings.TrimSpace(meta.MaterializedView.Query) != strings.TrimSpace(t.Metadata.ViewQuery) {
+		return errors.Errorf("query of materialized view %s can't be changed in place, it needs to be recreated", t.FullyQualifiedName())
+	}
+
+	// update if already exists
+	m, err := bqUpdateTableMetaAdapter(t)
+	if err != nil {
+		return err
+	}
+	// schema and partitioning of materialized view are derived from its query
+	m.Schema = nil
+	m.TimePartitioning = nil
+	m.MaterializedView = bqMaterializedViewTo(t.Metadata)
+	_, err = tableHandle.Update(ctx, m, meta.ETag)
+	return err
+}
+
+// bqMaterializedViewTo builds view definition, refresh is enabled by default
+func bqMaterializedViewTo(metadata BQTableMetadata) *bqapi.MaterializedViewDefinition {
+	definition := &bqapi.MaterializedViewDefinition{
+		Query:         metadata.ViewQuery,
+		EnableRefresh: true,
+	}
+	if metadata.Refresh != nil {
+		definition.EnableRefresh = metadata.Refresh.Enabled
+		definition.RefreshInterval = time.Duration(metadata.Refresh.Interval) * time.Minute
+	}
+	return definition
+}
+
+func bqRefreshFrom(definition *bqapi.MaterializedViewDefinition) *BQRefreshInfo {
+	return &BQRefreshInfo{
+		Enabled:  definition.EnableRefresh,
+		Interval: int64(definition.RefreshInterval / time.Minute),
+	}
+}
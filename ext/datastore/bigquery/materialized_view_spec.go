@@ -0,0 +1,57 @@
+package bigquery
+
+import (
+	"fmt"
+
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	MaterializedViewQueryFile = "materialized_view.sql"
+)
+
+type materializedViewSpec struct{}
+
+func (s materializedViewSpec) Adapter() models.DatastoreSpecAdapter {
+	return &tableSpecHandler{}
+}
+
+func (s materializedViewSpec) Validator() models.DatastoreSpecValidator {
+	return func(spec models.ResourceSpec) error {
+		if !tableNameParseRegex.MatchString(spec.Name) {
+			return fmt.Errorf("for example 'project_name.dataset_name.table_name'")
+		}
+		parsedNames := tableNameParseRegex.FindStringSubmatch(spec.Name)
+		if len(parsedNames) < 3 || len(parsedNames[1]) == 0 || len(parsedNames[2]) == 0 || len(parsedNames[3]) == 0 {
+			return fmt.Errorf("for example 'project_name.dataset_name.table_name'")
+		}
+		if err := validateTableAccess(spec); err != nil {
+			return err
+		}
+		if bqResource, ok := spec.Spec.(BQTable); ok {
+			if len(bqResource.Metadata.Schema) > 0 {
+				return fmt.Errorf("schema of materialized view is derived from its query")
+			}
+			if refresh := bqResource.Metadata.Refresh; refresh != nil && refresh.Interval < 0 {
+				return fmt.Errorf("refresh interval of materialized view can't be negative")
+			}
+		}
+		return nil
+	}
+}
+
+func (s materializedViewSpec) DefaultAssets() map[string]string {
+	return map[string]string{
+		MaterializedViewQueryFile: `-- materialized view query goes here`,
+	}
+}
+
+// Diff compares spec of materialized view with the one read from bigquery
+func (s materializedViewSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffTable(expected, actual)
+}
+
+// Dependencies of materialized view are its dataset and tables referenced in its query
+func (s materializedViewSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return tableDependencies(spec)
+}
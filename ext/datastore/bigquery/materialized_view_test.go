@@ -0,0 +1,172 @@
+package bigquery
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+)
+
+func TestMaterializedView(t *testing.T) {
+	testingContext := context.Background()
+	eTag := "etag-0000"
+	errNotFound := &googleapi.Error{
+		Code: 404,
+	}
+	viewQuery := "select id, count(*) from `project.dataset.orders` group by id"
+	bQResource := BQTable{
+		Project: "project",
+		Dataset: "dataset",
+		Table:   "orders_count",
+		Metadata: BQTableMetadata{
+			ViewQuery: viewQuery,
+			Refresh:   &BQRefreshInfo{Enabled: true, Interval: 30},
+		},
+	}
+
+	t.Run("ensureMaterializedView", func(t *testing.T) {
+		t.Run("should create view with refresh settings if it does not exist", func(t *testing.T) {
+			bQTable := new(BqTableMock)
+			defer bQTable.AssertExpectations(t)
+
+			bQTable.On("Metadata", testingContext).Return((*bigquery.TableMetadata)(nil), errNotFound)
+			bQTable.On("Create", testingContext, &bigquery.TableMetadata{
+				Name: bQResource.Table,
+				MaterializedView: &bigquery.MaterializedViewDefinition{
+					Query:           viewQuery,
+					EnableRefresh:   true,
+					RefreshInterval: 30 * time.Minute,
+				},
+			}).Return(nil)
+
+			err := ensureMaterializedView(testingContext, bQTable, bQResource, false)
+			assert.Nil(t, err)
+		})
+		t.Run("should enable refresh by default", func(t *testing.T) {
+			definition := bqMaterializedViewTo(BQTableMetadata{ViewQuery: viewQuery})
+			assert.Equal(t, &bigquery.MaterializedViewDefinition{Query: viewQuery, EnableRefresh: true}, definition)
+		})
+		t.Run("should not update view if it exists and not an upsert call", func(t *testing.T) {
+			bQTable := new(BqTableMock)
+			defer bQTable.AssertExpectations(t)
+
+			bQTable.On("Metadata", testingContext).Return(&bigquery.TableMetadata{}, nil)
+
+			err := ensureMaterializedView(testingContext, bQTable, bQResource, false)
+			assert.Nil(t, err)
+		})
+		t.Run("should update refresh settings of existing view", func(t *testing.T) {
+			bQTable := new(BqTableMock)
+			defer bQTable.AssertExpectations(t)
+
+			disabled := bQResource
+			disabled.Metadata.Refresh = &BQRefreshInfo{Enabled: false}
+			bQTable.On("Metadata", testingContext).Return(&bigquery.TableMetadata{
+				ETag:             eTag,
+				MaterializedView: &bigquery.MaterializedViewDefinition{Query: viewQuery, EnableRefresh: true},
+			}, nil)
+			bQTable.On("Update", testingContext, bigquery.TableMetadataToUpdate{
+				Name:             bQResource.Table,
+				MaterializedView: &bigquery.MaterializedViewDefinition{Query: viewQuery},
+			}, eTag).Return(&bigquery.TableMetadata{}, nil)
+
+			err := ensureMaterializedView(testingContext, bQTable, disabled, true)
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if query of existing view is changed", func(t *testing.T) {
+			bQTable := new(BqTableMock)
+			defer bQTable.AssertExpectations(t)
+
+			bQTable.On("Metadata", testingContext).Return(&bigquery.TableMetadata{
+				ETag:             eTag,
+				MaterializedView: &bigquery.MaterializedViewDefinition{Query: "select 1", EnableRefresh: true},
+			}, nil)
+
+			err := ensureMaterializedView(testingContext, bQTable, bQResource, true)
+			assert.NotNil(t, err)
+			bQTable.AssertNotCalled(t, "Update")
+		})
+	})
+	t.Run("createMaterializedView", func(t *testing.T) {
+		t.Run("should read query from asset if not set in spec", func(t *testing.T) {
+			withoutQuery := bQResource
+			withoutQuery.Metadata.ViewQuery = ""
+			resourceSpec := models.ResourceSpec{
+				Type:   models.ResourceTypeMaterializedView,
+				Spec:   withoutQuery,
+				Assets: models.ResourceAssets{MaterializedViewQueryFile: viewQuery},
+			}
+
+			bQTable := new(BqTableMock)
+			defer bQTable.AssertExpectations(t)
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQClient := new(BqClientMock)
+			defer bQClient.AssertExpectations(t)
+
+			bQClient.On("DatasetInProject", bQResource.Project, bQResource.Dataset).Return(bQDatasetHandle)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{}, nil)
+			bQDatasetHandle.On("Table", bQResource.Table).Return(bQTable)
+			bQTable.On("Metadata", testingContext).Return((*bigquery.TableMetadata)(nil), errNotFound)
+			bQTable.On("Create", testingContext, &bigquery.TableMetadata{
+				Name: bQResource.Table,
+				MaterializedView: &bigquery.MaterializedViewDefinition{
+					Query:           viewQuery,
+					EnableRefresh:   true,
+					RefreshInterval: 30 * time.Minute,
+				},
+			}).Return(nil)
+
+			err := createMaterializedView(testingContext, resourceSpec, bQClient, false)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("bqTableMetadataFrom", func(t *testing.T) {
+		t.Run("should read query and refresh settings of materialized view", func(t *testing.T) {
+			metadata, err := bqTableMetadataFrom(&bigquery.TableMetadata{
+				Type: bigquery.MaterializedView,
+				MaterializedView: &bigquery.MaterializedViewDefinition{
+					Query:           viewQuery,
+					EnableRefresh:   true,
+					RefreshInterval: time.Hour,
+				},
+			})
+			assert.Nil(t, err)
+			assert.Equal(t, viewQuery, metadata.ViewQuery)
+			assert.Equal(t, &BQRefreshInfo{Enabled: true, Interval: 60}, metadata.Refresh)
+		})
+	})
+	t.Run("Validator", func(t *testing.T) {
+		validator := materializedViewSpec{}.Validator()
+		t.Run("should reject schema set in spec", func(t *testing.T) {
+			withSchema := bQResource
+			withSchema.Metadata.Schema = BQSchema{{Name: "id", Type: "INTEGER"}}
+			err := validator(models.ResourceSpec{Name: "project.dataset.orders_count", Spec: withSchema})
+			assert.NotNil(t, err)
+		})
+		t.Run("should accept a valid spec", func(t *testing.T) {
+			err := validator(models.ResourceSpec{Name: "project.dataset.orders_count", Spec: bQResource})
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("Diff", func(t *testing.T) {
+		t.Run("should report changed refresh settings without comparing schema", func(t *testing.T) {
+			actual := bQResource
+			actual.Metadata.Refresh = &BQRefreshInfo{Enabled: true, Interval: 60}
+			actual.Metadata.Schema = BQSchema{{Name: "id", Type: "INTEGER"}}
+			drifts, err := materializedViewSpec{}.Diff(
+				models.ResourceSpec{Type: models.ResourceTypeMaterializedView, Spec: bQResource},
+				models.ResourceSpec{Type: models.ResourceTypeMaterializedView, Spec: actual},
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, []models.ResourceFieldDrift{
+				{Field: "refresh", Expected: "enabled every 30m", Actual: "enabled every 60m"},
+			}, drifts)
+		})
+	})
+}
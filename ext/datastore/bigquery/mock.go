@@ -2,11 +2,13 @@ package bigquery
 
 import (
 	"context"
+	"reflect"
 
 	"cloud.google.com/go/bigquery"
 	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
 	"github.com/odpf/optimus/models"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/api/iterator"
 )
 
 type BqClientMock struct {
@@ -43,7 +45,7 @@ func (cli *BqClientMock) DatasetsInProject(context.Context, string) bqiface.Data
 }
 
 func (cli *BqClientMock) Query(q string) bqiface.Query {
-	panic("not implemented")
+	return cli.Called(q).Get(0).(bqiface.Query)
 }
 
 func (cli *BqClientMock) JobFromID(context.Context, string) (bqiface.Job, error) {
@@ -97,8 +99,22 @@ func (ds *BqDatasetMock) Table(name string) bqiface.Table {
 	return ds.Called(name).Get(0).(bqiface.Table)
 }
 
-func (ds *BqDatasetMock) Tables(context.Context) bqiface.TableIterator {
-	panic("not implemented")
+func (ds *BqDatasetMock) Tables(ctx context.Context) bqiface.TableIterator {
+	return ds.Called(ctx).Get(0).(bqiface.TableIterator)
+}
+
+type BqTableIteratorMock struct {
+	bqiface.TableIterator
+	Tables []bqiface.Table
+}
+
+func (it *BqTableIteratorMock) Next() (bqiface.Table, error) {
+	if len(it.Tables) == 0 {
+		return nil, iterator.Done
+	}
+	table := it.Tables[0]
+	it.Tables = it.Tables[1:]
+	return table, nil
 }
 
 type BqTableMock struct {
@@ -116,7 +132,7 @@ func (table *BqTableMock) Create(ctx context.Context, meta *bigquery.TableMetada
 }
 
 func (table *BqTableMock) DatasetID() string {
-	panic("not implemented")
+	return table.Called().Get(0).(string)
 }
 
 func (table *BqTableMock) Delete(ctx context.Context) error {
@@ -141,7 +157,7 @@ func (table *BqTableMock) Metadata(ctx context.Context) (*bigquery.TableMetadata
 }
 
 func (table *BqTableMock) ProjectID() string {
-	panic("not implemented")
+	return table.Called().Get(0).(string)
 }
 
 func (table *BqTableMock) Read(ctx context.Context) bqiface.RowIterator {
@@ -149,7 +165,7 @@ func (table *BqTableMock) Read(ctx context.Context) bqiface.RowIterator {
 }
 
 func (table *BqTableMock) TableID() string {
-	panic("not implemented")
+	return table.Called().Get(0).(string)
 }
 
 func (table *BqTableMock) Update(ctx context.Context, meta bigquery.TableMetadataToUpdate, etag string) (*bigquery.TableMetadata, error) {
@@ -161,6 +177,51 @@ func (table *BqTableMock) Uploader() bqiface.Uploader {
 	panic("not implemented")
 }
 
+type BqQueryMock struct {
+	mock.Mock
+	bqiface.Query
+}
+
+func (query *BqQueryMock) SetQueryConfig(config bqiface.QueryConfig) {
+	query.Called(config)
+}
+
+func (query *BqQueryMock) Run(ctx context.Context) (bqiface.Job, error) {
+	args := query.Called(ctx)
+	return args.Get(0).(bqiface.Job), args.Error(1)
+}
+
+func (query *BqQueryMock) Read(ctx context.Context) (bqiface.RowIterator, error) {
+	args := query.Called(ctx)
+	return args.Get(0).(bqiface.RowIterator), args.Error(1)
+}
+
+type BqJobMock struct {
+	mock.Mock
+	bqiface.Job
+}
+
+func (job *BqJobMock) Wait(ctx context.Context) (*bigquery.JobStatus, error) {
+	args := job.Called(ctx)
+	return args.Get(0).(*bigquery.JobStatus), args.Error(1)
+}
+
+// BqRowIteratorMock returns Rows one by one, each row should be of the
+// type passed to Next
+type BqRowIteratorMock struct {
+	bqiface.RowIterator
+	Rows []interface{}
+}
+
+func (it *BqRowIteratorMock) Next(dst interface{}) error {
+	if len(it.Rows) == 0 {
+		return iterator.Done
+	}
+	reflect.ValueOf(dst).Elem().Set(reflect.ValueOf(it.Rows[0]))
+	it.Rows = it.Rows[1:]
+	return nil
+}
+
 type BQClientFactoryMock struct {
 	mock.Mock
 }
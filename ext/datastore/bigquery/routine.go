@@ -0,0 +1,273 @@
+package bigquery
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	bqapi "cloud.google.com/go/bigquery"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+)
+
+var (
+	// body of procedures read from bigquery could be wrapped in a block
+	procedureBlockRegex = regexp.MustCompile(`(?is)^\s*BEGIN\s(.*)\sEND\s*;?\s*$`)
+)
+
+// routineRow is a routine read from INFORMATION_SCHEMA.ROUTINES
+type routineRow struct {
+	RoutineType       string           `bigquery:"routine_type"`
+	ExternalLanguage  bqapi.NullString `bigquery:"external_language"`
+	DataType          bqapi.NullString `bigquery:"data_type"`
+	RoutineDefinition bqapi.NullString `bigquery:"routine_definition"`
+}
+
+// routineParameterRow is an argument read from INFORMATION_SCHEMA.PARAMETERS
+type routineParameterRow struct {
+	ParameterName bqapi.NullString `bigquery:"parameter_name"`
+	DataType      bqapi.NullString `bigquery:"data_type"`
+	ParameterMode bqapi.NullString `bigquery:"parameter_mode"`
+}
+
+// createRoutine creates or replaces a routine with DDL statements, the
+// bigquery client in use doesn't manage routines directly
+func createRoutine(ctx context.Context, spec models.ResourceSpec, client bqiface.Client, upsert bool) error {
+	bqResource, ok := spec.Spec.(BQRoutine)
+	if !ok {
+		return errors.New("failed to read routine spec for bigquery")
+	}
+
+	dataset := client.DatasetInProject(bqResource.Project, bqResource.Dataset)
+	if err := ensureDataset(ctx, dataset, BQDataset{
+		Project:  bqResource.Project,
+		Dataset:  bqResource.Dataset,
+		Metadata: BQDatasetMetadata{},
+	}, false); err != nil {
+		return err
+	}
+
+	statement, err := routineCreateStatement(bqResource, routineBodyOf(spec), upsert)
+	if err != nil {
+		return err
+	}
+	return runStatement(ctx, client, statement, nil)
+}
+
+// getRoutine retrieves bq routine information, body of routine is returned as an asset
+func getRoutine(ctx context.Context, resourceSpec models.ResourceSpec, client bqiface.Client) (models.ResourceSpec, error) {
+	bqResource, ok := resourceSpec.Spec.(BQRoutine)
+	if !ok {
+		return models.ResourceSpec{}, errors.New("failed to read routine spec for bigquery")
+	}
+
+	dataset := client.DatasetInProject(bqResource.Project, bqResource.Dataset)
+	if _, err := dataset.Metadata(ctx); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	params := []bqapi.QueryParameter{{Name: "routine", Value: bqResource.Routine}}
+	routineQuery := fmt.Sprintf("SELECT routine_type, external_language, data_type, routine_definition "+
+		"FROM `%s.%s.INFORMATION_SCHEMA.ROUTINES` WHERE routine_name = @routine", bqResource.Project, bqResource.Dataset)
+	it, err := readStatement(ctx, client, routineQuery, params)
+	if err != nil {
+		return models.ResourceSpec{}, err
+	}
+	var row routineRow
+	if err := it.Next(&row); err != nil {
+		if err == iterator.Done {
+			return models.ResourceSpec{}, errors.Wrapf(models.ErrResourceNotFoundInDatastore, "routine %s not found", bqResource.FullyQualifiedName())
+		}
+		return models.ResourceSpec{}, err
+	}
+
+	parameterQuery := fmt.Sprintf("SELECT parameter_name, data_type, parameter_mode "+
+		"FROM `%s.%s.INFORMATION_SCHEMA.PARAMETERS` WHERE specific_name = @routine AND NOT is_result "+
+		"ORDER BY ordinal_position", bqResource.Project, bqResource.Dataset)
+	if it, err = readStatement(ctx, client, parameterQuery, params); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	var arguments []BQRoutineArgument
+	for {
+		var parameter routineParameterRow
+		if err := it.Next(&parameter); err != nil {
+			if err == iterator.Done {
+				break
+			}
+			return models.ResourceSpec{}, err
+		}
+		argument := BQRoutineArgument{
+			Name: parameter.ParameterName.StringVal,
+			Type: parameter.DataType.StringVal,
+		}
+		// arguments of procedures are IN unless set otherwise
+		if row.RoutineType == RoutineTypeProcedure && parameter.ParameterMode.StringVal != "IN" {
+			argument.Mode = parameter.ParameterMode.StringVal
+		}
+		arguments = append(arguments, argument)
+	}
+
+	// description and imported libraries aren't part of INFORMATION_SCHEMA.ROUTINES
+	bqResource.Metadata = BQRoutineMetadata{
+		Type:       RoutineTypeScalarFunction,
+		Language:   RoutineLanguageSQL,
+		Arguments:  arguments,
+		ReturnType: row.DataType.StringVal,
+	}
+	body, bodyFile := row.RoutineDefinition.StringVal, RoutineBodyFile
+	if row.RoutineType == RoutineTypeProcedure {
+		bqResource.Metadata.Type = RoutineTypeProcedure
+		if match := procedureBlockRegex.FindStringSubmatch(body); match != nil {
+			body = match[1]
+		}
+	}
+	if strings.EqualFold(row.ExternalLanguage.StringVal, "js") || strings.EqualFold(row.ExternalLanguage.StringVal, RoutineLanguageJavascript) {
+		bqResource.Metadata.Language = RoutineLanguageJavascript
+		bodyFile = RoutineJavascriptBodyFile
+	}
+	resourceSpec.Spec = bqResource
+	resourceSpec.Assets = models.ResourceAssets{
+		bodyFile: strings.TrimSpace(body),
+	}
+	return resourceSpec, nil
+}
+
+func deleteRoutine(ctx context.Context, resourceSpec models.ResourceSpec, client bqiface.Client) error {
+	bqResource, ok := resourceSpec.Spec.(BQRoutine)
+	if !ok {
+		return errors.New("failed to read routine spec for bigquery")
+	}
+	dataset := client.DatasetInProject(bqResource.Project, bqResource.Dataset)
+	if _, err := dataset.Metadata(ctx); err != nil {
+		return err
+	}
+
+	routineKind := "FUNCTION"
+	if strings.EqualFold(bqResource.Metadata.Type, RoutineTypeProcedure) {
+		routineKind = "PROCEDURE"
+	}
+	return runStatement(ctx, client, fmt.Sprintf("DROP %s `%s`", routineKind, bqResource.FullyQualifiedName()), nil)
+}
+
+// routineBodyOf returns body of routine kept as an asset, javascript
+// functions keep their body in a .js file if present
+func routineBodyOf(spec models.ResourceSpec) string {
+	if bqResource, ok := spec.Spec.(BQRoutine); ok && bqResource.Metadata.language() == RoutineLanguageJavascript {
+		if body, ok := spec.Assets.GetByName(RoutineJavascriptBodyFile); ok {
+			return body
+		}
+	}
+	body, _ := spec.Assets.GetByName(RoutineBodyFile)
+	return body
+}
+
+// routineCreateStatement builds DDL to create routine, existing routine is
+// replaced if upsert is set
+func routineCreateStatement(r BQRoutine, body string, upsert bool) (string, error) {
+	if err := r.Metadata.validate(); err != nil {
+		return "", err
+	}
+	if len(strings.TrimSpace(body)) == 0 {
+		return "", errors.Errorf("body of routine %s is empty", r.FullyQualifiedName())
+	}
+	isProcedure := strings.EqualFold(r.Metadata.Type, RoutineTypeProcedure)
+	isJavascript := r.Metadata.language() == RoutineLanguageJavascript
+
+	var statement strings.Builder
+	statement.WriteString("CREATE ")
+	if upsert {
+		statement.WriteString("OR REPLACE ")
+	}
+	if isProcedure {
+		statement.WriteString("PROCEDURE ")
+	} else {
+		statement.WriteString("FUNCTION ")
+	}
+	if !upsert {
+		statement.WriteString("IF NOT EXISTS ")
+	}
+	fmt.Fprintf(&statement, "`%s`(", r.FullyQualifiedName())
+	for idx, argument := range r.Metadata.Arguments {
+		if idx > 0 {
+			statement.WriteString(", ")
+		}
+		if argument.Mode != "" {
+			statement.WriteString(strings.ToUpper(argument.Mode) + " ")
+		}
+		fmt.Fprintf(&statement, "%s %s", argument.Name, argument.Type)
+	}
+	statement.WriteString(")")
+	if r.Metadata.ReturnType != "" {
+		statement.WriteString(" RETURNS " + r.Metadata.ReturnType)
+	}
+	if isJavascript {
+		statement.WriteString(" LANGUAGE js")
+	}
+
+	var options []string
+	if r.Metadata.Description != "" {
+		options = append(options, "description="+strconv.Quote(r.Metadata.Description))
+	}
+	if len(r.Metadata.ImportedLibraries) > 0 {
+		var libraries []string
+		for _, library := range r.Metadata.ImportedLibraries {
+			libraries = append(libraries, strconv.Quote(library))
+		}
+		options = append(options, fmt.Sprintf("library=[%s]", strings.Join(libraries, ", ")))
+	}
+	if len(options) > 0 {
+		fmt.Fprintf(&statement, " OPTIONS(%s)", strings.Join(options, ", "))
+	}
+
+	body = strings.TrimSpace(body)
+	switch {
+	case isProcedure:
+		if procedureBlockRegex.MatchString(body) {
+			fmt.Fprintf(&statement, "\n%s", body)
+		} else {
+			fmt.Fprintf(&statement, "\nBEGIN\n%s\nEND", body)
+		}
+	case isJavascript:
+		if strings.Contains(body, `"""`) {
+			return "", errors.Errorf("body of javascript function %s can't contain \"\"\"", r.FullyQualifiedName())
+		}
+		fmt.Fprintf(&statement, "\nAS r\"\"\"\n%s\n\"\"\"", body)
+	default:
+		fmt.Fprintf(&statement, "\nAS (\n%s\n)", body)
+	}
+	return statement.String(), nil
+}
+
+// runStatement executes a statement and waits for it to finish
+func runStatement(ctx context.Context, client bqiface.Client, statement string, params []bqapi.QueryParameter) error {
+	job, err := newQuery(client, statement, params).Run(ctx)
+	if err != nil {
+		return err
+	}
+	status, err := job.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	return status.Err()
+}
+
+// readStatement executes a statement returning rows it selects
+func readStatement(ctx context.Context, client bqiface.Client, statement string, params []bqapi.QueryParameter) (bqiface.RowIterator, error) {
+	return newQuery(client, statement, params).Read(ctx)
+}
+
+func newQuery(client bqiface.Client, statement string, params []bqapi.QueryParameter) bqiface.Query {
+	query := client.Query(statement)
+	query.SetQueryConfig(bqiface.QueryConfig{
+		QueryConfig: bqapi.QueryConfig{
+			Q:          statement,
+			Parameters: params,
+		},
+	})
+	return query
+}
@@ -0,0 +1,313 @@
+package bigquery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kushsharma/structs"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	RoutineBodyFile           = "routine.sql"
+	RoutineJavascriptBodyFile = "routine.js"
+
+	RoutineTypeScalarFunction = "SCALAR_FUNCTION"
+	RoutineTypeProcedure      = "PROCEDURE"
+
+	RoutineLanguageSQL        = "SQL"
+	RoutineLanguageJavascript = "JAVASCRIPT"
+)
+
+var (
+	validRoutineName  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+	validArgumentMode = map[string]bool{"": true, "IN": true, "OUT": true, "INOUT": true}
+)
+
+// RoutineResourceSpec is how routine will be represented in yaml
+type RoutineResourceSpec struct {
+	Version int
+	Name    string
+	Type    models.ResourceType
+	Spec    BQRoutineMetadata
+	Labels  map[string]string
+}
+
+// BQRoutine is a specification for a BigQuery user defined function
+// or stored procedure, body of routine is kept as an asset
+type BQRoutine struct {
+	Project string
+	Dataset string
+	Routine string
+
+	Metadata BQRoutineMetadata
+}
+
+// FullyQualifiedName returns the "full name" for a routine
+func (r BQRoutine) FullyQualifiedName() string {
+	return fmt.Sprintf("%s.%s.%s", r.Project, r.Dataset, r.Routine)
+}
+
+func (r BQRoutine) Validate() error {
+	switch {
+	case validProjectName.MatchString(r.Project) == false:
+		return fmt.Errorf("invalid project name (must match %q)", validProjectName.String())
+	case validDatasetName.MatchString(r.Dataset) == false:
+		return fmt.Errorf("invalid dataset name (must match %q)", validDatasetName.String())
+	case validRoutineName.MatchString(r.Routine) == false:
+		return fmt.Errorf("invalid routine name (must match %q)", validRoutineName.String())
+	}
+	return r.Metadata.validate()
+}
+
+// BQRoutineMetadata holds configuration for a routine
+type BQRoutineMetadata struct {
+	Type              string              `yaml:"type" structs:"type"`                     // SCALAR_FUNCTION or PROCEDURE
+	Language          string              `yaml:",omitempty" structs:"language,omitempty"` // SQL or JAVASCRIPT, default SQL
+	Arguments         []BQRoutineArgument `yaml:",omitempty" structs:"arguments,omitempty"`
+	ReturnType        string              `yaml:"return_type,omitempty" structs:"return_type,omitempty"`
+	ImportedLibraries []string            `yaml:"imported_libraries,omitempty" structs:"imported_libraries,omitempty"`
+	Description       string              `yaml:",omitempty" structs:"description,omitempty"`
+}
+
+func (m BQRoutineMetadata) language() string {
+	if m.Language == "" {
+		return RoutineLanguageSQL
+	}
+	return strings.ToUpper(m.Language)
+}
+
+func (m BQRoutineMetadata) validate() error {
+	routineType := strings.ToUpper(m.Type)
+	if routineType != RoutineTypeScalarFunction && routineType != RoutineTypeProcedure {
+		return fmt.Errorf("invalid routine type %s (must be one of %s, %s)", m.Type, RoutineTypeScalarFunction, RoutineTypeProcedure)
+	}
+	language := m.language()
+	if language != RoutineLanguageSQL && language != RoutineLanguageJavascript {
+		return fmt.Errorf("invalid routine language %s (must be one of %s, %s)", m.Language, RoutineLanguageSQL, RoutineLanguageJavascript)
+	}
+	if routineType == RoutineTypeProcedure {
+		if language != RoutineLanguageSQL {
+			return errors.New("procedures can only be written in SQL")
+		}
+		if m.ReturnType != "" {
+			return errors.New("procedures can't have a return type")
+		}
+	}
+	if language == RoutineLanguageJavascript && m.ReturnType == "" {
+		return errors.New("javascript functions require a return type")
+	}
+	if language != RoutineLanguageJavascript && len(m.ImportedLibraries) > 0 {
+		return errors.New("libraries can only be imported by javascript functions")
+	}
+	for _, argument := range m.Arguments {
+		if !validRoutineName.MatchString(argument.Name) || argument.Type == "" {
+			return fmt.Errorf("invalid routine argument %q, requires a name and type", argument.Name)
+		}
+		if !validArgumentMode[strings.ToUpper(argument.Mode)] {
+			return fmt.Errorf("invalid mode %s of routine argument %s", argument.Mode, argument.Name)
+		}
+		if argument.Mode != "" && routineType != RoutineTypeProcedure {
+			return fmt.Errorf("mode of argument %s can only be set for procedures", argument.Name)
+		}
+	}
+	return nil
+}
+
+// BQRoutineArgument describes an argument of routine, mode only applies to procedures
+type BQRoutineArgument struct {
+	Name string `yaml:",omitempty" structs:"name"`
+	Type string `yaml:",omitempty" structs:"type"`
+	Mode string `yaml:",omitempty" structs:"mode,omitempty"` // IN, OUT or INOUT
+}
+
+// routineSpecHandler helps serializing/deserializing datastore resource for routine
+type routineSpecHandler struct {
+}
+
+func (s routineSpecHandler) ToYaml(optResource models.ResourceSpec) ([]byte, error) {
+	if optResource.Spec == nil {
+		// usually happens when resource is requested to be created for the first time via optimus cli
+		optResource.Spec = BQRoutine{
+			Metadata: BQRoutineMetadata{
+				Type:     RoutineTypeScalarFunction,
+				Language: RoutineLanguageSQL,
+			},
+		}
+	}
+	spec, ok := optResource.Spec.(BQRoutine)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+
+	yamlResource := RoutineResourceSpec{
+		Version: optResource.Version,
+		Name:    optResource.Name,
+		Type:    optResource.Type,
+		Spec:    spec.Metadata,
+	}
+	if len(optResource.Labels) > 0 {
+		yamlResource.Labels = optResource.Labels
+	}
+	return yaml.Marshal(yamlResource)
+}
+
+func (s routineSpecHandler) FromYaml(b []byte) (models.ResourceSpec, error) {
+	var yamlResource RoutineResourceSpec
+	if err := yaml.Unmarshal(b, &yamlResource); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	parsedRoutineName := tableNameParseRegex.FindStringSubmatch(yamlResource.Name)
+	if len(parsedRoutineName) < 4 {
+		return models.ResourceSpec{}, fmt.Errorf("invalid yamlResource name %s", yamlResource.Name)
+	}
+
+	optResource := models.ResourceSpec{
+		Version:   yamlResource.Version,
+		Name:      yamlResource.Name,
+		Type:      yamlResource.Type,
+		Datastore: This,
+		Spec: BQRoutine{
+			Project:  parsedRoutineName[1],
+			Dataset:  parsedRoutineName[2],
+			Routine:  parsedRoutineName[3],
+			Metadata: yamlResource.Spec,
+		},
+	}
+	if len(yamlResource.Labels) > 0 {
+		optResource.Labels = yamlResource.Labels
+	}
+	return optResource, nil
+}
+
+func (s routineSpecHandler) ToProtobuf(optResource models.ResourceSpec) ([]byte, error) {
+	bqResource, ok := optResource.Spec.(BQRoutine)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+	bqResourceProtoSpec, err := structpb.NewStruct(structs.Map(bqResource.Metadata))
+	if err != nil {
+		return nil, err
+	}
+	resSpec := &v1.ResourceSpecification{
+		Version: int32(optResource.Version),
+		Name:    optResource.Name,
+		Type:    optResource.Type.String(),
+		Spec:    bqResourceProtoSpec,
+		Assets:  optResource.Assets,
+		Labels:  optResource.Labels,
+	}
+	return proto.Marshal(resSpec)
+}
+
+func (s routineSpecHandler) FromProtobuf(b []byte) (models.ResourceSpec, error) {
+	protoSpec := &v1.ResourceSpecification{}
+	if err := proto.Unmarshal(b, protoSpec); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	parsedRoutineName := tableNameParseRegex.FindStringSubmatch(protoSpec.Name)
+	if len(parsedRoutineName) < 4 {
+		return models.ResourceSpec{}, fmt.Errorf("invalid resource name %s", protoSpec.Name)
+	}
+
+	bqRoutine := BQRoutine{
+		Project: parsedRoutineName[1],
+		Dataset: parsedRoutineName[2],
+		Routine: parsedRoutineName[3],
+	}
+	if protoSpec.Spec != nil {
+		if protoSpecField, ok := protoSpec.Spec.Fields["type"]; ok {
+			bqRoutine.Metadata.Type = protoSpecField.GetStringValue()
+		}
+		if protoSpecField, ok := protoSpec.Spec.Fields["language"]; ok {
+			bqRoutine.Metadata.Language = protoSpecField.GetStringValue()
+		}
+		if protoSpecField, ok := protoSpec.Spec.Fields["return_type"]; ok {
+			bqRoutine.Metadata.ReturnType = protoSpecField.GetStringValue()
+		}
+		if protoSpecField, ok := protoSpec.Spec.Fields["description"]; ok {
+			bqRoutine.Metadata.Description = protoSpecField.GetStringValue()
+		}
+		if protoSpecField, ok := protoSpec.Spec.Fields["imported_libraries"]; ok && protoSpecField.GetListValue() != nil {
+			for _, libraryValue := range protoSpecField.GetListValue().GetValues() {
+				bqRoutine.Metadata.ImportedLibraries = append(bqRoutine.Metadata.ImportedLibraries, libraryValue.GetStringValue())
+			}
+		}
+		if protoSpecField, ok := protoSpec.Spec.Fields["arguments"]; ok && protoSpecField.GetListValue() != nil {
+			for _, argumentValue := range protoSpecField.GetListValue().GetValues() {
+				bqRoutine.Metadata.Arguments = append(bqRoutine.Metadata.Arguments, extractRoutineArgumentFromProto(argumentValue))
+			}
+		}
+	}
+	return models.ResourceSpec{
+		Version:   int(protoSpec.Version),
+		Name:      protoSpec.Name,
+		Type:      models.ResourceType(protoSpec.Type),
+		Assets:    protoSpec.Assets,
+		Spec:      bqRoutine,
+		Datastore: This,
+		Labels:    protoSpec.Labels,
+	}, nil
+}
+
+func extractRoutineArgumentFromProto(argumentValue *structpb.Value) BQRoutineArgument {
+	argument := BQRoutineArgument{}
+	for argumentAttr, argumentAttrVal := range argumentValue.GetStructValue().GetFields() {
+		switch argumentAttr {
+		case "name":
+			argument.Name = argumentAttrVal.GetStringValue()
+		case "type":
+			argument.Type = argumentAttrVal.GetStringValue()
+		case "mode":
+			argument.Mode = argumentAttrVal.GetStringValue()
+		}
+	}
+	return argument
+}
+
+type routineSpec struct{}
+
+func (s routineSpec) Adapter() models.DatastoreSpecAdapter {
+	return &routineSpecHandler{}
+}
+
+func (s routineSpec) Validator() models.DatastoreSpecValidator {
+	return func(spec models.ResourceSpec) error {
+		if !tableNameParseRegex.MatchString(spec.Name) {
+			return fmt.Errorf("for example 'project_name.dataset_name.routine_name'")
+		}
+		parsedNames := tableNameParseRegex.FindStringSubmatch(spec.Name)
+		if len(parsedNames) < 3 || len(parsedNames[1]) == 0 || len(parsedNames[2]) == 0 || len(parsedNames[3]) == 0 {
+			return fmt.Errorf("for example 'project_name.dataset_name.routine_name'")
+		}
+		if bqResource, ok := spec.Spec.(BQRoutine); ok {
+			return bqResource.Metadata.validate()
+		}
+		return nil
+	}
+}
+
+func (s routineSpec) DefaultAssets() map[string]string {
+	return map[string]string{
+		RoutineBodyFile: `-- routine body goes here`,
+	}
+}
+
+// Diff compares spec of routine with the one read from bigquery
+func (s routineSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffRoutine(expected, actual)
+}
+
+// Dependencies of routine are its dataset and tables referenced in its body
+func (s routineSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return routineDependencies(spec)
+}
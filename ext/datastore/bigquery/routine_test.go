@@ -0,0 +1,272 @@
+package bigquery
+
+import (
+	"context"
+	"testing"
+
+	"cloud.google.com/go/bigquery"
+	"github.com/googleapis/google-cloud-go-testing/bigquery/bqiface"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRoutine(t *testing.T) {
+	testingContext := context.Background()
+	sqlFunction := BQRoutine{
+		Project: "project",
+		Dataset: "dataset",
+		Routine: "add_tax",
+		Metadata: BQRoutineMetadata{
+			Type:        RoutineTypeScalarFunction,
+			Arguments:   []BQRoutineArgument{{Name: "amount", Type: "NUMERIC"}},
+			ReturnType:  "NUMERIC",
+			Description: "adds tax to amount",
+		},
+	}
+	sqlFunctionSpec := models.ResourceSpec{
+		Name:   "project.dataset.add_tax",
+		Type:   models.ResourceTypeRoutine,
+		Spec:   sqlFunction,
+		Assets: models.ResourceAssets{RoutineBodyFile: "amount * 1.1"},
+	}
+
+	t.Run("routineCreateStatement", func(t *testing.T) {
+		t.Run("should create sql function if it does not exist", func(t *testing.T) {
+			statement, err := routineCreateStatement(sqlFunction, "amount * 1.1", false)
+			assert.Nil(t, err)
+			assert.Equal(t, "CREATE FUNCTION IF NOT EXISTS `project.dataset.add_tax`(amount NUMERIC) RETURNS NUMERIC "+
+				"OPTIONS(description=\"adds tax to amount\")\nAS (\namount * 1.1\n)", statement)
+		})
+		t.Run("should replace javascript function with its libraries", func(t *testing.T) {
+			jsFunction := BQRoutine{
+				Project: "project",
+				Dataset: "dataset",
+				Routine: "parse_ua",
+				Metadata: BQRoutineMetadata{
+					Type:              RoutineTypeScalarFunction,
+					Language:          RoutineLanguageJavascript,
+					Arguments:         []BQRoutineArgument{{Name: "ua", Type: "STRING"}},
+					ReturnType:        "STRING",
+					ImportedLibraries: []string{"gs://bucket/ua.js"},
+				},
+			}
+			statement, err := routineCreateStatement(jsFunction, "return parse(ua);", true)
+			assert.Nil(t, err)
+			assert.Equal(t, "CREATE OR REPLACE FUNCTION `project.dataset.parse_ua`(ua STRING) RETURNS STRING LANGUAGE js "+
+				"OPTIONS(library=[\"gs://bucket/ua.js\"])\nAS r\"\"\"\nreturn parse(ua);\n\"\"\"", statement)
+		})
+		t.Run("should wrap body of procedure in a block", func(t *testing.T) {
+			procedure := BQRoutine{
+				Project: "project",
+				Dataset: "dataset",
+				Routine: "refresh_orders",
+				Metadata: BQRoutineMetadata{
+					Type:      RoutineTypeProcedure,
+					Arguments: []BQRoutineArgument{{Name: "day", Type: "DATE"}, {Name: "total", Type: "INT64", Mode: "out"}},
+				},
+			}
+			statement, err := routineCreateStatement(procedure, "SET total = 1;", true)
+			assert.Nil(t, err)
+			assert.Equal(t, "CREATE OR REPLACE PROCEDURE `project.dataset.refresh_orders`(day DATE, OUT total INT64)\n"+
+				"BEGIN\nSET total = 1;\nEND", statement)
+
+			statement, err = routineCreateStatement(procedure, "BEGIN\nSET total = 1;\nEND", true)
+			assert.Nil(t, err)
+			assert.Equal(t, "CREATE OR REPLACE PROCEDURE `project.dataset.refresh_orders`(day DATE, OUT total INT64)\n"+
+				"BEGIN\nSET total = 1;\nEND", statement)
+		})
+		t.Run("should return error if body is empty", func(t *testing.T) {
+			_, err := routineCreateStatement(sqlFunction, "  ", false)
+			assert.NotNil(t, err)
+		})
+	})
+	t.Run("createRoutine", func(t *testing.T) {
+		t.Run("should run create statement in dataset of routine", func(t *testing.T) {
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQJob := new(BqJobMock)
+			defer bQJob.AssertExpectations(t)
+			bQQuery := new(BqQueryMock)
+			defer bQQuery.AssertExpectations(t)
+			bQClient := new(BqClientMock)
+			defer bQClient.AssertExpectations(t)
+
+			statement, _ := routineCreateStatement(sqlFunction, "amount * 1.1", true)
+			bQClient.On("DatasetInProject", "project", "dataset").Return(bQDatasetHandle)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{}, nil)
+			bQClient.On("Query", statement).Return(bQQuery)
+			bQQuery.On("SetQueryConfig", bqiface.QueryConfig{QueryConfig: bigquery.QueryConfig{Q: statement}}).Return()
+			bQQuery.On("Run", testingContext).Return(bQJob, nil)
+			bQJob.On("Wait", testingContext).Return(&bigquery.JobStatus{State: bigquery.Done}, nil)
+
+			err := createRoutine(testingContext, sqlFunctionSpec, bQClient, true)
+			assert.Nil(t, err)
+		})
+		t.Run("should return error if statement fails", func(t *testing.T) {
+			bQDatasetHandle := new(BqDatasetMock)
+			bQJob := new(BqJobMock)
+			bQQuery := new(BqQueryMock)
+			bQClient := new(BqClientMock)
+
+			bQClient.On("DatasetInProject", "project", "dataset").Return(bQDatasetHandle)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{}, nil)
+			bQClient.On("Query", mock.Anything).Return(bQQuery)
+			bQQuery.On("SetQueryConfig", mock.Anything).Return()
+			bQQuery.On("Run", testingContext).Return(bQJob, nil)
+			bQJob.On("Wait", testingContext).Return((*bigquery.JobStatus)(nil), errors.New("syntax error"))
+
+			err := createRoutine(testingContext, sqlFunctionSpec, bQClient, false)
+			assert.Equal(t, "syntax error", err.Error())
+		})
+	})
+	t.Run("getRoutine", func(t *testing.T) {
+		newClient := func(routineRows, parameterRows []interface{}) *BqClientMock {
+			bQDatasetHandle := new(BqDatasetMock)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{}, nil)
+
+			routineQuery := new(BqQueryMock)
+			routineQuery.On("SetQueryConfig", mock.Anything).Return()
+			routineQuery.On("Read", testingContext).Return(&BqRowIteratorMock{Rows: routineRows}, nil)
+			parameterQuery := new(BqQueryMock)
+			parameterQuery.On("SetQueryConfig", mock.Anything).Return()
+			parameterQuery.On("Read", testingContext).Return(&BqRowIteratorMock{Rows: parameterRows}, nil)
+
+			bQClient := new(BqClientMock)
+			bQClient.On("DatasetInProject", "project", "dataset").Return(bQDatasetHandle)
+			bQClient.On("Query", mock.MatchedBy(func(q string) bool {
+				return q == "SELECT routine_type, external_language, data_type, routine_definition "+
+					"FROM `project.dataset.INFORMATION_SCHEMA.ROUTINES` WHERE routine_name = @routine"
+			})).Return(routineQuery)
+			bQClient.On("Query", mock.Anything).Return(parameterQuery)
+			return bQClient
+		}
+		t.Run("should read routine with its body as an asset", func(t *testing.T) {
+			bQClient := newClient([]interface{}{
+				routineRow{
+					RoutineType:       "FUNCTION",
+					DataType:          bigquery.NullString{StringVal: "NUMERIC", Valid: true},
+					RoutineDefinition: bigquery.NullString{StringVal: "amount * 1.1", Valid: true},
+				},
+			}, []interface{}{
+				routineParameterRow{
+					ParameterName: bigquery.NullString{StringVal: "amount", Valid: true},
+					DataType:      bigquery.NullString{StringVal: "NUMERIC", Valid: true},
+					ParameterMode: bigquery.NullString{StringVal: "IN", Valid: true},
+				},
+			})
+
+			info, err := getRoutine(testingContext, sqlFunctionSpec, bQClient)
+			assert.Nil(t, err)
+			assert.Equal(t, BQRoutineMetadata{
+				Type:       RoutineTypeScalarFunction,
+				Language:   RoutineLanguageSQL,
+				Arguments:  []BQRoutineArgument{{Name: "amount", Type: "NUMERIC"}},
+				ReturnType: "NUMERIC",
+			}, info.Spec.(BQRoutine).Metadata)
+			assert.Equal(t, models.ResourceAssets{RoutineBodyFile: "amount * 1.1"}, info.Assets)
+
+			drifts, err := routineSpec{}.Diff(sqlFunctionSpec, info)
+			assert.Nil(t, err)
+			assert.Empty(t, drifts)
+		})
+		t.Run("should return not found error if routine doesn't exist", func(t *testing.T) {
+			bQClient := newClient(nil, nil)
+
+			_, err := getRoutine(testingContext, sqlFunctionSpec, bQClient)
+			assert.True(t, errors.Is(err, models.ErrResourceNotFoundInDatastore))
+		})
+	})
+	t.Run("deleteRoutine", func(t *testing.T) {
+		t.Run("should drop procedure", func(t *testing.T) {
+			bQDatasetHandle := new(BqDatasetMock)
+			defer bQDatasetHandle.AssertExpectations(t)
+			bQJob := new(BqJobMock)
+			defer bQJob.AssertExpectations(t)
+			bQQuery := new(BqQueryMock)
+			defer bQQuery.AssertExpectations(t)
+			bQClient := new(BqClientMock)
+			defer bQClient.AssertExpectations(t)
+
+			statement := "DROP PROCEDURE `project.dataset.refresh_orders`"
+			bQClient.On("DatasetInProject", "project", "dataset").Return(bQDatasetHandle)
+			bQDatasetHandle.On("Metadata", testingContext).Return(&bqiface.DatasetMetadata{}, nil)
+			bQClient.On("Query", statement).Return(bQQuery)
+			bQQuery.On("SetQueryConfig", bqiface.QueryConfig{QueryConfig: bigquery.QueryConfig{Q: statement}}).Return()
+			bQQuery.On("Run", testingContext).Return(bQJob, nil)
+			bQJob.On("Wait", testingContext).Return(&bigquery.JobStatus{State: bigquery.Done}, nil)
+
+			err := deleteRoutine(testingContext, models.ResourceSpec{
+				Spec: BQRoutine{
+					Project:  "project",
+					Dataset:  "dataset",
+					Routine:  "refresh_orders",
+					Metadata: BQRoutineMetadata{Type: RoutineTypeProcedure},
+				},
+			}, bQClient)
+			assert.Nil(t, err)
+		})
+	})
+}
+
+func TestRoutineSpec(t *testing.T) {
+	t.Run("Validator", func(t *testing.T) {
+		validator := routineSpec{}.Validator()
+		invalidMetadata := []BQRoutineMetadata{
+			{Type: "TABLE_FUNCTION"},
+			{Type: RoutineTypeScalarFunction, Language: "python"},
+			{Type: RoutineTypeProcedure, ReturnType: "INT64"},
+			{Type: RoutineTypeProcedure, Language: RoutineLanguageJavascript},
+			{Type: RoutineTypeScalarFunction, Language: RoutineLanguageJavascript},
+			{Type: RoutineTypeScalarFunction, ImportedLibraries: []string{"gs://bucket/lib.js"}},
+			{Type: RoutineTypeScalarFunction, Arguments: []BQRoutineArgument{{Name: "x"}}},
+			{Type: RoutineTypeScalarFunction, Arguments: []BQRoutineArgument{{Name: "x", Type: "INT64", Mode: "OUT"}}},
+		}
+		for _, metadata := range invalidMetadata {
+			err := validator(models.ResourceSpec{Name: "project.dataset.routine", Spec: BQRoutine{Metadata: metadata}})
+			assert.NotNil(t, err, metadata)
+		}
+		err := validator(models.ResourceSpec{Name: "routine", Spec: BQRoutine{}})
+		assert.NotNil(t, err)
+		err = validator(models.ResourceSpec{Name: "project.dataset.routine", Spec: BQRoutine{
+			Metadata: BQRoutineMetadata{Type: RoutineTypeProcedure, Arguments: []BQRoutineArgument{{Name: "x", Type: "INT64", Mode: "INOUT"}}},
+		}})
+		assert.Nil(t, err)
+	})
+	t.Run("should generate equivalent specs after round trip through yaml and proto", func(t *testing.T) {
+		resourceSpec := models.ResourceSpec{
+			Version:   1,
+			Name:      "project.dataset.parse_ua",
+			Type:      models.ResourceTypeRoutine,
+			Datastore: This,
+			Spec: BQRoutine{
+				Project: "project",
+				Dataset: "dataset",
+				Routine: "parse_ua",
+				Metadata: BQRoutineMetadata{
+					Type:              RoutineTypeScalarFunction,
+					Language:          RoutineLanguageJavascript,
+					Arguments:         []BQRoutineArgument{{Name: "ua", Type: "STRING"}},
+					ReturnType:        "STRING",
+					ImportedLibraries: []string{"gs://bucket/ua.js"},
+					Description:       "parses user agent",
+				},
+			},
+		}
+		handler := routineSpecHandler{}
+
+		raw, err := handler.ToYaml(resourceSpec)
+		assert.Nil(t, err)
+		parsed, err := handler.FromYaml(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, resourceSpec, parsed)
+
+		resourceSpec.Assets = models.ResourceAssets{RoutineJavascriptBodyFile: "return ua;"}
+		raw, err = handler.ToProtobuf(resourceSpec)
+		assert.Nil(t, err)
+		parsed, err = handler.FromProtobuf(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, resourceSpec, parsed)
+	})
+}
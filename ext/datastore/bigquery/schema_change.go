@@ -0,0 +1,108 @@
+package bigquery
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// schemaChanges collects changes of a table schema classified by their impact
+type schemaChanges []models.ResourceSchemaChange
+
+func (c *schemaChanges) add(field string, kind models.SchemaChangeKind, from, to string) {
+	*c = append(*c, models.ResourceSchemaChange{
+		Field: field,
+		Kind:  kind,
+		From:  from,
+		To:    to,
+	})
+}
+
+// compareSchema matches columns by name, dropped columns and columns added as
+// required can't be applied to existing rows, every change of mode is treated
+// as breaking as readers could rely on it. Updating a table can't change type
+// of an existing column, so every change of type is breaking too
+func (c *schemaChanges) compareSchema(prefix string, current, desired BQSchema) {
+	desiredFields := map[string]BQField{}
+	for _, field := range desired {
+		desiredFields[strings.ToLower(field.Name)] = field
+	}
+	currentFields := map[string]bool{}
+	for _, currentField := range current {
+		name := strings.ToLower(currentField.Name)
+		currentFields[name] = true
+		fieldPath := prefix + "." + currentField.Name
+
+		desiredField, ok := desiredFields[name]
+		if !ok {
+			c.add(fieldPath, models.SchemaChangeBreaking, describeField(currentField), "")
+			continue
+		}
+
+		currentType, desiredType := normaliseFieldType(currentField.Type), normaliseFieldType(desiredField.Type)
+		if currentType != desiredType {
+			c.add(fieldPath+".type", models.SchemaChangeBreaking, currentType, desiredType)
+		}
+		if currentMode, desiredMode := normaliseFieldMode(currentField.Mode), normaliseFieldMode(desiredField.Mode); currentMode != desiredMode {
+			c.add(fieldPath+".mode", models.SchemaChangeBreaking, currentMode, desiredMode)
+		}
+		if currentField.Description != desiredField.Description {
+			c.add(fieldPath+".description", models.SchemaChangeCompatible, currentField.Description, desiredField.Description)
+		}
+		c.compareSchema(fieldPath, currentField.Schema, desiredField.Schema)
+	}
+	for _, desiredField := range desired {
+		if currentFields[strings.ToLower(desiredField.Name)] {
+			continue
+		}
+		kind := models.SchemaChangeAdditive
+		if normaliseFieldMode(desiredField.Mode) == "REQUIRED" {
+			kind = models.SchemaChangeBreaking
+		}
+		c.add(prefix+"."+desiredField.Name, kind, "", describeField(desiredField))
+	}
+}
+
+// comparePartition reports changes of time partitioning, the only kind of
+// partitioning applied while updating a table
+func (c *schemaChanges) comparePartition(current, desired *BQPartitionInfo) {
+	if desired == nil || desired.Range != nil {
+		return
+	}
+	if current == nil || current.Range != nil {
+		c.add("partition", models.SchemaChangeBreaking, describePartition(current), describePartition(desired))
+		return
+	}
+	if current.Field != desired.Field {
+		c.add("partition.field", models.SchemaChangeBreaking, current.Field, desired.Field)
+	}
+	if currentType, desiredType := normalisePartitionType(current.Type), normalisePartitionType(desired.Type); currentType != desiredType {
+		c.add("partition.type", models.SchemaChangeBreaking, currentType, desiredType)
+	}
+	if current.Expiration != desired.Expiration {
+		c.add("partition.expiration", models.SchemaChangeCompatible, strconv.FormatInt(current.Expiration, 10),
+			strconv.FormatInt(desired.Expiration, 10))
+	}
+}
+
+// checkTableSchemaChange classifies changes desired spec of a table makes to
+// the current table, schema isn't touched while updating if desired spec has none
+func checkTableSchemaChange(current, desired models.ResourceSpec) ([]models.ResourceSchemaChange, error) {
+	currentTable, ok := current.Spec.(BQTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for bigquery")
+	}
+	desiredTable, ok := desired.Spec.(BQTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for bigquery")
+	}
+
+	var changes schemaChanges
+	if len(desiredTable.Metadata.Schema) > 0 {
+		changes.compareSchema("schema", currentTable.Metadata.Schema, desiredTable.Metadata.Schema)
+	}
+	changes.comparePartition(currentTable.Metadata.Partition, desiredTable.Metadata.Partition)
+	return changes, nil
+}
@@ -0,0 +1,99 @@
+package bigquery
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaChange(t *testing.T) {
+	current := models.ResourceSpec{
+		Name: "proj.datas.t1",
+		Type: models.ResourceTypeTable,
+		Spec: BQTable{
+			Project: "proj",
+			Dataset: "datas",
+			Table:   "t1",
+			Metadata: BQTableMetadata{
+				Schema: BQSchema{
+					{Name: "id", Type: "INTEGER", Mode: "required"},
+					{Name: "amount", Type: "INTEGER", Mode: "nullable"},
+					{Name: "code", Type: "STRING", Mode: "nullable"},
+					{Name: "meta", Type: "RECORD", Mode: "nullable", Schema: BQSchema{
+						{Name: "source", Type: "STRING", Mode: "nullable"},
+					}},
+				},
+				Partition: &BQPartitionInfo{Field: "event_timestamp", Type: "DAY"},
+			},
+		},
+	}
+
+	t.Run("should report no change for equivalent schema", func(t *testing.T) {
+		desired := current
+		desired.Spec = BQTable{
+			Project: "proj",
+			Dataset: "datas",
+			Table:   "t1",
+			Metadata: BQTableMetadata{
+				Schema: BQSchema{
+					{Name: "id", Type: "INT64", Mode: "REQUIRED"},
+					{Name: "amount", Type: "INT64"},
+					{Name: "code", Type: "STRING"},
+					{Name: "meta", Type: "STRUCT", Schema: BQSchema{
+						{Name: "source", Type: "STRING"},
+					}},
+				},
+				Partition: &BQPartitionInfo{Field: "event_timestamp"},
+			},
+		}
+
+		changes, err := tableSpec{}.CheckSchemaChange(current, desired)
+		assert.Nil(t, err)
+		assert.Empty(t, changes)
+	})
+	t.Run("should classify every change of schema", func(t *testing.T) {
+		desired := current
+		desired.Spec = BQTable{
+			Project: "proj",
+			Dataset: "datas",
+			Table:   "t1",
+			Metadata: BQTableMetadata{
+				Schema: BQSchema{
+					{Name: "id", Type: "INTEGER", Mode: "nullable"},
+					{Name: "amount", Type: "NUMERIC", Description: "in cents"},
+					{Name: "code", Type: "INTEGER"},
+					{Name: "meta", Type: "RECORD", Schema: BQSchema{
+						{Name: "medium", Type: "STRING"},
+					}},
+					{Name: "tags", Type: "STRING", Mode: "repeated"},
+					{Name: "owner", Type: "STRING", Mode: "required"},
+				},
+				Partition: &BQPartitionInfo{Field: "created_at", Type: "DAY", Expiration: 24},
+			},
+		}
+
+		changes, err := tableSpec{}.CheckSchemaChange(current, desired)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ResourceSchemaChange{
+			{Field: "schema.id.mode", Kind: models.SchemaChangeBreaking, From: "REQUIRED", To: "NULLABLE"},
+			{Field: "schema.amount.type", Kind: models.SchemaChangeBreaking, From: "INTEGER", To: "NUMERIC"},
+			{Field: "schema.amount.description", Kind: models.SchemaChangeCompatible, From: "", To: "in cents"},
+			{Field: "schema.code.type", Kind: models.SchemaChangeBreaking, From: "STRING", To: "INTEGER"},
+			{Field: "schema.meta.source", Kind: models.SchemaChangeBreaking, From: "STRING NULLABLE", To: ""},
+			{Field: "schema.meta.medium", Kind: models.SchemaChangeAdditive, From: "", To: "STRING NULLABLE"},
+			{Field: "schema.tags", Kind: models.SchemaChangeAdditive, From: "", To: "STRING REPEATED"},
+			{Field: "schema.owner", Kind: models.SchemaChangeBreaking, From: "", To: "STRING REQUIRED"},
+			{Field: "partition.field", Kind: models.SchemaChangeBreaking, From: "event_timestamp", To: "created_at"},
+			{Field: "partition.expiration", Kind: models.SchemaChangeCompatible, From: "0", To: "24"},
+		}, changes)
+	})
+	t.Run("should not check schema if spec has none", func(t *testing.T) {
+		desired := current
+		desired.Spec = BQTable{Project: "proj", Dataset: "datas", Table: "t1"}
+
+		changes, err := tableSpec{}.CheckSchemaChange(current, desired)
+		assert.Nil(t, err)
+		assert.Empty(t, changes)
+	})
+}
@@ -39,7 +39,10 @@ func createStandardView(ctx context.Context, spec models.ResourceSpec, client bq
 		return err
 	}
 	table := dataset.Table(bqResource.Table)
-	return ensureStandardView(ctx, table, bqResource, upsert)
+	if err := ensureStandardView(ctx, table, bqResource, upsert); err != nil {
+		return err
+	}
+	return ensureTableIAM(ctx, client, table, spec.Type, bqResource, upsert)
 }
 
 func ensureStandardView(ctx context.Context, tableHandle bqiface.Table, t BQTable, upsert bool) error {
@@ -25,6 +25,9 @@ func (s standardViewSpec) Validator() models.DatastoreSpecValidator {
 		if len(parsedNames) < 3 || len(parsedNames[1]) == 0 || len(parsedNames[2]) == 0 || len(parsedNames[3]) == 0 {
 			return fmt.Errorf("for example 'project_name.dataset_name.table_name'")
 		}
+		if err := validateTableAccess(spec); err != nil {
+			return err
+		}
 		return nil
 	}
 }
@@ -34,3 +37,13 @@ func (s standardViewSpec) DefaultAssets() map[string]string {
 		ViewQueryFile: `-- view query goes here`,
 	}
 }
+
+// Diff compares spec of view with the one read from bigquery
+func (s standardViewSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffTable(expected, actual)
+}
+
+// Dependencies of view are its dataset and tables referenced in its query
+func (s standardViewSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return tableDependencies(spec)
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"net/http"
 	"regexp"
+	"time"
 
+	bqapi "cloud.google.com/go/bigquery"
 	"github.com/pkg/errors"
 
 	"google.golang.org/api/googleapi"
@@ -35,7 +37,10 @@ func createTable(ctx context.Context, spec models.ResourceSpec, client bqiface.C
 		return err
 	}
 	table := dataset.Table(bqResource.Table)
-	return ensureTable(ctx, table, bqResource, upsert)
+	if err := ensureTable(ctx, table, bqResource, upsert); err != nil {
+		return err
+	}
+	return ensureTableIAM(ctx, client, table, spec.Type, bqResource, upsert)
 }
 
 // ensureTable make sures table exists with provided config and update if required
@@ -83,14 +88,28 @@ func getTable(ctx context.Context, resourceSpec models.ResourceSpec, client bqif
 		return models.ResourceSpec{}, err
 	}
 
-	// generate schema
+	// iam bindings are only read if spec manages them
+	bindings := bqResource.Metadata.IAM
+	if bqResource.Metadata, err = bqTableMetadataFrom(tableMeta); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	if len(bindings) > 0 {
+		if bqResource.Metadata.IAM, err = getTableIAM(ctx, client, bqResource, tableMeta.Location); err != nil {
+			return models.ResourceSpec{}, err
+		}
+	}
+	resourceSpec.Spec = bqResource
+	return resourceSpec, nil
+}
+
+// bqTableMetadataFrom converts metadata read from bigquery
+func bqTableMetadataFrom(tableMeta *bqapi.TableMetadata) (BQTableMetadata, error) {
 	tableSchema, err := bqSchemaFrom(tableMeta.Schema)
 	if err != nil {
-		return models.ResourceSpec{}, err
+		return BQTableMetadata{}, err
 	}
 
-	// update metadata
-	bqResource.Metadata = BQTableMetadata{
+	metadata := BQTableMetadata{
 		Description: tableMeta.Description,
 		Labels:      tableMeta.Labels,
 		Schema:      tableSchema,
@@ -98,19 +117,26 @@ func getTable(ctx context.Context, resourceSpec models.ResourceSpec, client bqif
 		ViewQuery:   tableMeta.ViewQuery,
 		Location:    tableMeta.Location,
 	}
+	if !tableMeta.ExpirationTime.IsZero() {
+		metadata.ExpirationTime = tableMeta.ExpirationTime.Format(time.RFC3339)
+	}
+
+	// query of materialized view is kept like the one of regular view
+	if tableMeta.MaterializedView != nil {
+		metadata.ViewQuery = tableMeta.MaterializedView.Query
+		metadata.Refresh = bqRefreshFrom(tableMeta.MaterializedView)
+	}
 
 	// if table is partitioned
 	if tableMeta.TimePartitioning != nil {
-		bqResource.Metadata.Partition = bqPartitioningFrom(tableMeta.TimePartitioning)
+		metadata.Partition = bqPartitioningFrom(tableMeta.TimePartitioning)
 	} else if tableMeta.RangePartitioning != nil {
-		bqResource.Metadata.Partition = &BQPartitionInfo{
+		metadata.Partition = &BQPartitionInfo{
 			Field: tableMeta.RangePartitioning.Field,
 			Range: bqPartitioningRangeFrom(tableMeta.RangePartitioning.Range),
 		}
 	}
-
-	resourceSpec.Spec = bqResource
-	return resourceSpec, nil
+	return metadata, nil
 }
 
 func deleteTable(ctx context.Context, resourceSpec models.ResourceSpec, client bqiface.Client) error {
@@ -74,8 +74,15 @@ type BQTableMetadata struct {
 	// regular view query
 	ViewQuery string `yaml:"view_query,omitempty" structs:"view_query,omitempty"`
 
+	// materialized view refresh settings
+	Refresh *BQRefreshInfo `yaml:",omitempty" structs:"refresh,omitempty"`
+
 	Location string            `yaml:",omitempty" structs:"location,omitempty"`
 	Labels   map[string]string `yaml:"-" structs:"-"` // inherited
+
+	// iam bindings set on table, reconciled as per access mode
+	IAM        []BQIAMBinding `yaml:"iam,omitempty" structs:"iam,omitempty"`
+	AccessMode string         `yaml:"access_mode,omitempty" structs:"access_mode,omitempty"` // additive or authoritative, default additive
 }
 
 // BQField describes an individual field/column in a bigquery schema
@@ -109,6 +116,12 @@ type BQPartitionInfo struct {
 	Range *BQPartitioningRange `yaml:",omitempty" structs:"range,omitempty"`
 }
 
+// BQRefreshInfo specifies how often a materialized view is refreshed
+type BQRefreshInfo struct {
+	Enabled  bool  `yaml:"enabled" structs:"enabled"`
+	Interval int64 `yaml:"interval,omitempty" structs:"interval,omitempty"` // in minutes
+}
+
 // BQPartitioningRange defines the boundaries and width of partitioned values.
 type BQPartitioningRange struct {
 	// The start value of defined range of values, inclusive of the specified value.
@@ -139,7 +152,7 @@ func (s tableSpecHandler) ToYaml(optResource models.ResourceSpec) ([]byte, error
 		Type:    optResource.Type,
 		Spec:    spec.Metadata,
 	}
-	if len(yamlResource.Labels) > 0 {
+	if len(optResource.Labels) > 0 {
 		yamlResource.Labels = optResource.Labels
 	}
 	return yaml.Marshal(yamlResource)
@@ -269,6 +282,18 @@ func (s tableSpecHandler) FromProtobuf(b []byte) (models.ResourceSpec, error) {
 		if protoSpecField, ok := protoSpec.Spec.Fields["partition"]; ok {
 			bqTable.Metadata.Partition = extractTablePartitionFromProtoStruct(protoSpecField)
 		}
+
+		if protoSpecField, ok := protoSpec.Spec.Fields["refresh"]; ok {
+			bqTable.Metadata.Refresh = extractTableRefreshFromProtoStruct(protoSpecField)
+		}
+
+		if protoSpecField, ok := protoSpec.Spec.Fields["iam"]; ok {
+			bqTable.Metadata.IAM = extractIAMBindingsFromProtoStruct(protoSpecField)
+		}
+
+		if protoSpecField, ok := protoSpec.Spec.Fields["access_mode"]; ok {
+			bqTable.Metadata.AccessMode = protoSpecField.GetStringValue()
+		}
 	}
 	return models.ResourceSpec{
 		Version:   int(protoSpec.Version),
@@ -341,6 +366,20 @@ func extractTablePartitionFromProtoStruct(protoVal *structpb.Value) *BQPartition
 	return pInfo
 }
 
+func extractTableRefreshFromProtoStruct(protoVal *structpb.Value) *BQRefreshInfo {
+	rInfo := &BQRefreshInfo{}
+	if protoVal.GetStructValue() == nil {
+		return rInfo
+	}
+	if f, ok := protoVal.GetStructValue().Fields["enabled"]; ok {
+		rInfo.Enabled = f.GetBoolValue()
+	}
+	if f, ok := protoVal.GetStructValue().Fields["interval"]; ok {
+		rInfo.Interval = int64(f.GetNumberValue())
+	}
+	return rInfo
+}
+
 type tableSpec struct{}
 
 func (s tableSpec) Adapter() models.DatastoreSpecAdapter {
@@ -356,6 +395,9 @@ func (s tableSpec) Validator() models.DatastoreSpecValidator {
 		if len(parsedNames) < 3 || len(parsedNames[1]) == 0 || len(parsedNames[2]) == 0 || len(parsedNames[3]) == 0 {
 			return fmt.Errorf("for example 'project_name.dataset_name.table_name'")
 		}
+		if err := validateTableAccess(spec); err != nil {
+			return err
+		}
 		return nil
 	}
 }
@@ -363,3 +405,18 @@ func (s tableSpec) Validator() models.DatastoreSpecValidator {
 func (s tableSpec) DefaultAssets() map[string]string {
 	return map[string]string{}
 }
+
+// Diff compares spec of table with the one read from bigquery
+func (s tableSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffTable(expected, actual)
+}
+
+// CheckSchemaChange classifies schema changes of table before updating it
+func (s tableSpec) CheckSchemaChange(current, desired models.ResourceSpec) ([]models.ResourceSchemaChange, error) {
+	return checkTableSchemaChange(current, desired)
+}
+
+// Dependencies of table is its dataset
+func (s tableSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return tableDependencies(spec)
+}
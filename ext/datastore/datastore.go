@@ -1,3 +1,6 @@
 package datastore
 
-import _ "github.com/odpf/optimus/ext/datastore/bigquery"
+import (
+	_ "github.com/odpf/optimus/ext/datastore/bigquery"
+	_ "github.com/odpf/optimus/ext/datastore/postgres"
+)
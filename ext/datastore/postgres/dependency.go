@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+var (
+	// relations referenced as schema.name, either part could be quoted
+	foreignKeyTableRegex = regexp.MustCompile(`(?i)\breferences\s+((?:"[^"]+"|\w+)\.(?:"[^"]+"|\w+))`)
+	queryTableRegex      = regexp.MustCompile(`(?i)\b(?:from|join)\s+((?:"[^"]+"|\w+)\.(?:"[^"]+"|\w+))`)
+)
+
+// tableDependencies lists the schema of a table along with tables its
+// foreign keys reference
+func tableDependencies(spec models.ResourceSpec) ([]string, error) {
+	pgResource, ok := spec.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for postgres")
+	}
+	var definitions []string
+	for _, constraint := range pgResource.Metadata.Constraints {
+		definitions = append(definitions, constraint.Definition)
+	}
+	return append([]string{pgResource.Schema},
+		referencedRelations(spec.Name, foreignKeyTableRegex, strings.Join(definitions, "\n"))...), nil
+}
+
+// viewDependencies lists the schema of a view along with relations its
+// query reads from
+func viewDependencies(spec models.ResourceSpec) ([]string, error) {
+	pgResource, ok := spec.Spec.(PGView)
+	if !ok {
+		return nil, errors.New("failed to read view spec for postgres")
+	}
+	query, _ := spec.Assets.GetByName(viewQueryFileOf(spec.Type))
+	return append([]string{pgResource.Schema}, referencedRelations(spec.Name, queryTableRegex, query)...), nil
+}
+
+// referencedRelations lists relations matched in text other than the
+// resource itself, named the way resources are
+func referencedRelations(name string, relationRegex *regexp.Regexp, text string) []string {
+	var relations []string
+	seen := map[string]bool{name: true}
+	for _, match := range relationRegex.FindAllStringSubmatch(text, -1) {
+		parts := splitQualifiedName(match[1])
+		relation := parts[0] + "." + parts[1]
+		if seen[relation] {
+			continue
+		}
+		seen[relation] = true
+		relations = append(relations, relation)
+	}
+	return relations
+}
+
+// splitQualifiedName splits schema.name where either part could be quoted
+// and contain dots
+func splitQualifiedName(qualified string) [2]string {
+	var parts [2]string
+	if strings.HasPrefix(qualified, `"`) {
+		end := strings.Index(qualified[1:], `"`) + 1
+		parts[0], parts[1] = qualified[1:end], qualified[end+2:]
+	} else {
+		dot := strings.Index(qualified, ".")
+		parts[0], parts[1] = qualified[:dot], qualified[dot+1:]
+	}
+	parts[1] = strings.Trim(parts[1], `"`)
+	return parts
+}
@@ -0,0 +1,40 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencies(t *testing.T) {
+	t.Run("should list schema and tables referenced by foreign keys", func(t *testing.T) {
+		dependencies, err := tableSpec{}.Dependencies(models.ResourceSpec{
+			Name: "sales.orders",
+			Spec: PGTable{Schema: "sales", Table: "orders", Metadata: PGTableMetadata{
+				Constraints: []PGConstraint{
+					{Name: "orders_customer_fk", Definition: "FOREIGN KEY (customer_id) REFERENCES crm.customers (id)"},
+					{Name: "orders_parent_fk", Definition: "FOREIGN KEY (parent_id) REFERENCES sales.orders (id)"},
+					{Name: "orders_amount_check", Definition: "CHECK (amount > 0)"},
+				},
+			}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"sales", "crm.customers"}, dependencies)
+	})
+	t.Run("should list schema and relations view reads from", func(t *testing.T) {
+		dependencies, err := viewSpec{}.Dependencies(models.ResourceSpec{
+			Name: "reporting.daily_orders",
+			Type: models.ResourceTypeMaterializedView,
+			Spec: PGView{Schema: "reporting", View: "daily_orders"},
+			Assets: map[string]string{
+				MaterializedViewQueryFile: `SELECT o.day, count(*) FROM sales.orders o
+JOIN "crm"."Customers" c ON c.id = o.customer_id
+LEFT JOIN sales.orders r ON r.parent_id = o.id
+GROUP BY 1`,
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"reporting", "sales.orders", "crm.Customers"}, dependencies)
+	})
+}
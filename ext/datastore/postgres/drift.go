@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+// fieldDrifts collects fields of a resource which differ from the spec
+type fieldDrifts []models.ResourceFieldDrift
+
+func (d *fieldDrifts) compare(field, expected, actual string) {
+	if expected != actual {
+		*d = append(*d, models.ResourceFieldDrift{
+			Field:    field,
+			Expected: expected,
+			Actual:   actual,
+		})
+	}
+}
+
+func diffSchema(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	expectedSchema, ok := expected.Spec.(PGSchema)
+	if !ok {
+		return nil, errors.New("failed to read schema spec for postgres")
+	}
+	actualSchema, ok := actual.Spec.(PGSchema)
+	if !ok {
+		return nil, errors.New("failed to read schema spec for postgres")
+	}
+
+	var drifts fieldDrifts
+	drifts.compare("comment", expectedSchema.Metadata.Comment, actualSchema.Metadata.Comment)
+	return drifts, nil
+}
+
+// diffTable compares table with its spec, columns aren't compared if spec
+// has none and constraints are matched by name as postgres rewrites their
+// definitions
+func diffTable(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	expectedTable, ok := expected.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for postgres")
+	}
+	actualTable, ok := actual.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for postgres")
+	}
+	expectedMeta, actualMeta := expectedTable.Metadata, actualTable.Metadata
+
+	var drifts fieldDrifts
+	drifts.compare("comment", expectedMeta.Comment, actualMeta.Comment)
+	if len(expectedMeta.Columns) > 0 {
+		drifts.compareColumns(expectedMeta.Columns, actualMeta.Columns)
+	}
+	drifts.compare("primary_key", strings.Join(expectedMeta.PrimaryKey, ", "), strings.Join(actualMeta.PrimaryKey, ", "))
+
+	actualConstraints := map[string]PGConstraint{}
+	for _, constraint := range actualMeta.Constraints {
+		actualConstraints[constraint.Name] = constraint
+	}
+	expectedConstraints := map[string]bool{}
+	for _, constraint := range expectedMeta.Constraints {
+		expectedConstraints[constraint.Name] = true
+		if _, ok := actualConstraints[constraint.Name]; !ok {
+			drifts.compare("constraints."+constraint.Name, constraint.Definition, "")
+		}
+	}
+	for _, constraint := range actualMeta.Constraints {
+		if !expectedConstraints[constraint.Name] {
+			drifts.compare("constraints."+constraint.Name, "", constraint.Definition)
+		}
+	}
+
+	actualIndexes := map[string]PGIndex{}
+	for _, index := range actualMeta.Indexes {
+		actualIndexes[index.Name] = index
+	}
+	expectedIndexes := map[string]bool{}
+	for _, index := range expectedMeta.Indexes {
+		expectedIndexes[index.Name] = true
+		actualIndex, ok := actualIndexes[index.Name]
+		if !ok {
+			drifts.compare("indexes."+index.Name, describeIndex(index), "")
+			continue
+		}
+		drifts.compare("indexes."+index.Name, describeIndex(index), describeIndex(actualIndex))
+	}
+	for _, index := range actualMeta.Indexes {
+		if !expectedIndexes[index.Name] {
+			drifts.compare("indexes."+index.Name, "", describeIndex(index))
+		}
+	}
+
+	drifts.compare("partition", describePartition(expectedMeta.Partition), describePartition(actualMeta.Partition))
+	if expectedMeta.Partition != nil && actualMeta.Partition != nil {
+		actualPartitions := map[string]bool{}
+		for _, partition := range actualMeta.Partition.Partitions {
+			actualPartitions[partition.Name] = true
+		}
+		for _, partition := range expectedMeta.Partition.Partitions {
+			if !actualPartitions[partition.Name] {
+				drifts.compare("partition.partitions."+partition.Name, partition.Bound, "")
+			}
+		}
+	}
+	return drifts, nil
+}
+
+func (d *fieldDrifts) compareColumns(expected, actual []PGColumn) {
+	actualColumns := map[string]PGColumn{}
+	for _, column := range actual {
+		actualColumns[column.Name] = column
+	}
+	expectedColumns := map[string]bool{}
+	for _, column := range expected {
+		expectedColumns[column.Name] = true
+		field := "columns." + column.Name
+		actualColumn, ok := actualColumns[column.Name]
+		if !ok {
+			d.compare(field, describeColumn(column), "")
+			continue
+		}
+		d.compare(field+".type", normaliseColumnType(column.Type), normaliseColumnType(actualColumn.Type))
+		d.compare(field+".not_null", strconv.FormatBool(column.NotNull), strconv.FormatBool(actualColumn.NotNull))
+		if defaultChanged(actualColumn, column) {
+			d.compare(field+".default", normaliseDefault(column.Default), normaliseDefault(actualColumn.Default))
+		}
+		d.compare(field+".comment", column.Comment, actualColumn.Comment)
+	}
+	for _, column := range actual {
+		if !expectedColumns[column.Name] {
+			d.compare("columns."+column.Name, "", describeColumn(column))
+		}
+	}
+}
+
+// diffView compares query of view as kept in the asset, query read back from
+// postgres is the spec query only if both deparse the same
+func diffView(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	expectedView, ok := expected.Spec.(PGView)
+	if !ok {
+		return nil, errors.New("failed to read view spec for postgres")
+	}
+	actualView, ok := actual.Spec.(PGView)
+	if !ok {
+		return nil, errors.New("failed to read view spec for postgres")
+	}
+
+	var drifts fieldDrifts
+	drifts.compare("type", expected.Type.String(), actual.Type.String())
+	queryFile := viewQueryFileOf(expected.Type)
+	drifts.compare("query", strings.TrimSpace(expected.Assets[queryFile]), strings.TrimSpace(actual.Assets[queryFile]))
+	drifts.compare("comment", expectedView.Metadata.Comment, actualView.Metadata.Comment)
+	return drifts, nil
+}
+
+func describeIndex(index PGIndex) string {
+	using := index.Using
+	if using == "" {
+		using = defaultIndexMethod
+	}
+	description := fmt.Sprintf("USING %s (%s)", strings.ToLower(using), strings.Join(index.Columns, ", "))
+	if index.Unique {
+		description = "UNIQUE " + description
+	}
+	return description
+}
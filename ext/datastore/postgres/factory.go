@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	// postgres driver
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const (
+	MaxOpenConnections = 5
+)
+
+// defaultClientFactory keeps a connection pool per database url as
+// *sql.DB is safe for concurrent use
+type defaultClientFactory struct {
+	cachedClients map[string]*sql.DB
+	mu            sync.Mutex
+}
+
+func (fac *defaultClientFactory) New(ctx context.Context, dbURL string) (*sql.DB, error) {
+	fac.mu.Lock()
+	defer fac.mu.Unlock()
+
+	if client, ok := fac.cachedClients[dbURL]; ok {
+		return client, nil
+	}
+
+	client, err := sql.Open("postgres", dbURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open postgres connection")
+	}
+	client.SetMaxOpenConns(MaxOpenConnections)
+	if err := client.PingContext(ctx); err != nil {
+		client.Close()
+		return nil, errors.Wrap(err, "failed to connect to postgres")
+	}
+
+	if fac.cachedClients == nil {
+		fac.cachedClients = map[string]*sql.DB{}
+	}
+	fac.cachedClients[dbURL] = client
+	return client, nil
+}
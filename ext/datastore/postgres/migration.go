@@ -0,0 +1,422 @@
+package postgres
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/lib/pq"
+)
+
+var (
+	// aliases of column types as returned by format_type
+	columnTypeAliases = map[string]string{
+		"int":         "integer",
+		"int4":        "integer",
+		"serial":      "integer",
+		"serial4":     "integer",
+		"int8":        "bigint",
+		"serial8":     "bigint",
+		"bigserial":   "bigint",
+		"int2":        "smallint",
+		"serial2":     "smallint",
+		"smallserial": "smallint",
+		"bool":        "boolean",
+		"float":       "double precision",
+		"float8":      "double precision",
+		"float4":      "real",
+		"decimal":     "numeric",
+		"varchar":     "character varying",
+		"char":        "character",
+		"varbit":      "bit varying",
+	}
+
+	// column types which can be changed in place without losing data or
+	// breaking readers
+	widenableColumnTypes = map[string][]string{
+		"smallint":          {"integer", "bigint", "numeric", "real", "double precision"},
+		"integer":           {"bigint", "numeric", "double precision"},
+		"bigint":            {"numeric"},
+		"real":              {"double precision"},
+		"character varying": {"text"},
+		"character":         {"character varying", "text"},
+	}
+
+	columnTypeRegex       = regexp.MustCompile(`^([a-z0-9 ]+?)(\(\d+(?:,\d+)?\))?((?: with(?:out)? time zone)?)$`)
+	columnTypeLengthRegex = regexp.MustCompile(`^(.+?)\((\d+)\)$`)
+	defaultCastRegex      = regexp.MustCompile(`(?i)::"?[a-z][a-z0-9_ ]*"?(\([0-9, ]+\))?(\[\])*`)
+)
+
+// tableMigration lists statements which migrate current table to desired
+// one, table is created if current is nil. Columns are left untouched if
+// desired spec has none.
+func tableMigration(current *PGTable, desired PGTable) ([]string, error) {
+	if current == nil {
+		return createTableStatements(desired), nil
+	}
+
+	name := desired.FullyQualifiedName()
+	currentMeta, desiredMeta := current.Metadata, desired.Metadata
+	var statements []string
+	alter := func(format string, args ...interface{}) {
+		statements = append(statements, fmt.Sprintf("ALTER TABLE %s ", name)+fmt.Sprintf(format, args...))
+	}
+
+	if !samePartitioning(currentMeta.Partition, desiredMeta.Partition) {
+		return nil, fmt.Errorf("partitioning of table %s can't be changed from %q to %q", desired.Table,
+			describePartition(currentMeta.Partition), describePartition(desiredMeta.Partition))
+	}
+
+	// drop constraints and indexes first as they could reference dropped columns
+	desiredConstraints := map[string]bool{}
+	for _, constraint := range desiredMeta.Constraints {
+		desiredConstraints[constraint.Name] = true
+	}
+	for _, constraint := range currentMeta.Constraints {
+		if !desiredConstraints[constraint.Name] {
+			alter("DROP CONSTRAINT %s", pq.QuoteIdentifier(constraint.Name))
+		}
+	}
+	desiredIndexes := map[string]bool{}
+	for _, index := range desiredMeta.Indexes {
+		desiredIndexes[index.Name] = true
+	}
+	for _, index := range currentMeta.Indexes {
+		if !desiredIndexes[index.Name] {
+			statements = append(statements, fmt.Sprintf("DROP INDEX %s", qualifiedName(desired.Schema, index.Name)))
+		}
+	}
+	primaryKeyChanged := !sameColumns(currentMeta.PrimaryKey, desiredMeta.PrimaryKey)
+	if primaryKeyChanged && len(currentMeta.PrimaryKey) > 0 {
+		alter("DROP CONSTRAINT %s", pq.QuoteIdentifier(currentMeta.primaryKeyName))
+	}
+
+	if len(desiredMeta.Columns) > 0 {
+		currentColumns := map[string]PGColumn{}
+		for _, column := range currentMeta.Columns {
+			currentColumns[column.Name] = column
+		}
+		desiredColumns := map[string]bool{}
+		for _, column := range desiredMeta.Columns {
+			desiredColumns[column.Name] = true
+		}
+		for _, column := range currentMeta.Columns {
+			if !desiredColumns[column.Name] {
+				alter("DROP COLUMN %s", pq.QuoteIdentifier(column.Name))
+			}
+		}
+		for _, column := range desiredMeta.Columns {
+			currentColumn, ok := currentColumns[column.Name]
+			if !ok {
+				alter("ADD COLUMN %s", columnDefinition(column))
+				if column.Comment != "" {
+					statements = append(statements, columnComment(name, column))
+				}
+				continue
+			}
+
+			columnName := pq.QuoteIdentifier(column.Name)
+			if desiredType := normaliseColumnType(column.Type); normaliseColumnType(currentColumn.Type) != desiredType {
+				alter("ALTER COLUMN %s TYPE %s USING %s::%s", columnName, column.Type, columnName, column.Type)
+			}
+			if currentColumn.NotNull != column.NotNull {
+				if column.NotNull {
+					alter("ALTER COLUMN %s SET NOT NULL", columnName)
+				} else {
+					alter("ALTER COLUMN %s DROP NOT NULL", columnName)
+				}
+			}
+			if defaultChanged(currentColumn, column) {
+				if column.Default == "" {
+					alter("ALTER COLUMN %s DROP DEFAULT", columnName)
+				} else {
+					alter("ALTER COLUMN %s SET DEFAULT %s", columnName, column.Default)
+				}
+			}
+			if currentColumn.Comment != column.Comment {
+				statements = append(statements, columnComment(name, column))
+			}
+		}
+	}
+
+	if primaryKeyChanged && len(desiredMeta.PrimaryKey) > 0 {
+		alter("ADD PRIMARY KEY (%s)", quoteColumns(desiredMeta.PrimaryKey))
+	}
+	currentConstraints := map[string]bool{}
+	for _, constraint := range currentMeta.Constraints {
+		currentConstraints[constraint.Name] = true
+	}
+	for _, constraint := range desiredMeta.Constraints {
+		if !currentConstraints[constraint.Name] {
+			alter("ADD %s", constraintDefinition(constraint))
+		}
+	}
+	currentIndexes := map[string]bool{}
+	for _, index := range currentMeta.Indexes {
+		currentIndexes[index.Name] = true
+	}
+	for _, index := range desiredMeta.Indexes {
+		if !currentIndexes[index.Name] {
+			statements = append(statements, indexDefinition(name, index))
+		}
+	}
+
+	// partitions missing from spec are left as is, they could have been
+	// created or detached outside of optimus
+	if desiredMeta.Partition != nil {
+		currentPartitions := map[string]bool{}
+		for _, partition := range currentMeta.Partition.Partitions {
+			currentPartitions[partition.Name] = true
+		}
+		for _, partition := range desiredMeta.Partition.Partitions {
+			if !currentPartitions[partition.Name] {
+				statements = append(statements, partitionDefinition(desired, partition))
+			}
+		}
+	}
+
+	if currentMeta.Comment != desiredMeta.Comment {
+		statements = append(statements, fmt.Sprintf("COMMENT ON TABLE %s IS %s", name, commentLiteral(desiredMeta.Comment)))
+	}
+	return statements, nil
+}
+
+func createTableStatements(desired PGTable) []string {
+	name := desired.FullyQualifiedName()
+	meta := desired.Metadata
+
+	var definitions []string
+	for _, column := range meta.Columns {
+		definitions = append(definitions, columnDefinition(column))
+	}
+	if len(meta.PrimaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", quoteColumns(meta.PrimaryKey)))
+	}
+	for _, constraint := range meta.Constraints {
+		definitions = append(definitions, constraintDefinition(constraint))
+	}
+	createTable := fmt.Sprintf("CREATE TABLE %s (\n\t%s\n)", name, strings.Join(definitions, ",\n\t"))
+	if meta.Partition != nil {
+		createTable += fmt.Sprintf(" PARTITION BY %s (%s)", strings.ToUpper(meta.Partition.Type),
+			quoteColumns(meta.Partition.Columns))
+	}
+
+	statements := []string{createTable}
+	if meta.Partition != nil {
+		for _, partition := range meta.Partition.Partitions {
+			statements = append(statements, partitionDefinition(desired, partition))
+		}
+	}
+	for _, index := range meta.Indexes {
+		statements = append(statements, indexDefinition(name, index))
+	}
+	if meta.Comment != "" {
+		statements = append(statements, fmt.Sprintf("COMMENT ON TABLE %s IS %s", name, commentLiteral(meta.Comment)))
+	}
+	for _, column := range meta.Columns {
+		if column.Comment != "" {
+			statements = append(statements, columnComment(name, column))
+		}
+	}
+	return statements
+}
+
+func columnDefinition(column PGColumn) string {
+	definition := fmt.Sprintf("%s %s", pq.QuoteIdentifier(column.Name), column.Type)
+	if column.NotNull {
+		definition += " NOT NULL"
+	}
+	if column.Default != "" {
+		definition += " DEFAULT " + column.Default
+	}
+	return definition
+}
+
+func columnComment(tableName string, column PGColumn) string {
+	return fmt.Sprintf("COMMENT ON COLUMN %s.%s IS %s", tableName, pq.QuoteIdentifier(column.Name),
+		commentLiteral(column.Comment))
+}
+
+func constraintDefinition(constraint PGConstraint) string {
+	return fmt.Sprintf("CONSTRAINT %s %s", pq.QuoteIdentifier(constraint.Name), constraint.Definition)
+}
+
+func indexDefinition(tableName string, index PGIndex) string {
+	statement := "CREATE INDEX"
+	if index.Unique {
+		statement = "CREATE UNIQUE INDEX"
+	}
+	statement += fmt.Sprintf(" %s ON %s", pq.QuoteIdentifier(index.Name), tableName)
+	if index.Using != "" {
+		statement += " USING " + index.Using
+	}
+	return statement + fmt.Sprintf(" (%s)", quoteColumns(index.Columns))
+}
+
+// partitionDefinition creates partition in schema of partitioned table
+func partitionDefinition(table PGTable, partition PGPartitionBound) string {
+	return fmt.Sprintf("CREATE TABLE %s PARTITION OF %s %s", qualifiedName(table.Schema, partition.Name),
+		table.FullyQualifiedName(), partition.Bound)
+}
+
+// quoteColumns quotes names of columns, expressions are kept as is
+func quoteColumns(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		if validIdentifier.MatchString(column) {
+			quoted[i] = pq.QuoteIdentifier(column)
+		} else {
+			quoted[i] = column
+		}
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func samePartitioning(current, desired *PGPartition) bool {
+	if current == nil || desired == nil {
+		return current == nil && desired == nil
+	}
+	return strings.EqualFold(current.Type, desired.Type) && sameColumns(current.Columns, desired.Columns)
+}
+
+func describePartition(partition *PGPartition) string {
+	if partition == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s (%s)", strings.ToUpper(partition.Type), strings.Join(partition.Columns, ", "))
+}
+
+func describeColumn(column PGColumn) string {
+	description := column.Name + " " + normaliseColumnType(column.Type)
+	if column.NotNull {
+		description += " NOT NULL"
+	}
+	if column.Default != "" {
+		description += " DEFAULT " + normaliseDefault(column.Default)
+	}
+	return description
+}
+
+// normaliseColumnType converts type of column to the way postgres formats it,
+// e.g. varchar(20) to character varying(20)
+func normaliseColumnType(columnType string) string {
+	normalised := strings.ToLower(strings.Join(strings.Fields(columnType), " "))
+	normalised = strings.NewReplacer(" (", "(", "( ", "(", " )", ")", ", ", ",", " ,", ",").Replace(normalised)
+
+	var array string
+	for strings.HasSuffix(normalised, "[]") {
+		normalised = strings.TrimSuffix(normalised, "[]")
+		array += "[]"
+	}
+
+	match := columnTypeRegex.FindStringSubmatch(normalised)
+	if match == nil {
+		return normalised + array
+	}
+	base, args, zone := match[1], match[2], match[3]
+	switch base {
+	case "timestamptz", "timetz":
+		base, zone = strings.TrimSuffix(base, "tz"), " with time zone"
+	case "timestamp", "time":
+		if zone == "" {
+			zone = " without time zone"
+		}
+	default:
+		if alias, ok := columnTypeAliases[base]; ok {
+			base = alias
+		}
+	}
+	return base + args + zone + array
+}
+
+// isColumnTypeWidened checks if values of normalised from type fit in to
+// type without any loss
+func isColumnTypeWidened(from, to string) bool {
+	fromBase, fromLength := splitColumnTypeLength(from)
+	toBase, toLength := splitColumnTypeLength(to)
+	if fromBase == toBase && fromLength > 0 {
+		return toLength == 0 || toLength >= fromLength
+	}
+	for _, widerType := range widenableColumnTypes[fromBase] {
+		if widerType == toBase && toLength == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func splitColumnTypeLength(columnType string) (string, int) {
+	match := columnTypeLengthRegex.FindStringSubmatch(columnType)
+	if match == nil {
+		return columnType, 0
+	}
+	length, _ := strconv.Atoi(match[2])
+	return match[1], length
+}
+
+// normaliseDefault removes casts postgres adds to default expressions,
+// e.g. 'active'::character varying
+func normaliseDefault(expression string) string {
+	return strings.TrimSpace(defaultCastRegex.ReplaceAllString(expression, ""))
+}
+
+// defaultChanged ignores sequence defaults of serial columns which aren't
+// part of spec
+func defaultChanged(current, desired PGColumn) bool {
+	if desired.Default == "" && strings.HasPrefix(current.Default, "nextval(") {
+		return false
+	}
+	return normaliseDefault(current.Default) != normaliseDefault(desired.Default)
+}
+
+// unquoteIdentifier reverses pq.QuoteIdentifier for identifiers read from
+// definitions generated by postgres
+func unquoteIdentifier(identifier string) string {
+	if len(identifier) < 2 || !strings.HasPrefix(identifier, `"`) || !strings.HasSuffix(identifier, `"`) {
+		return identifier
+	}
+	return strings.ReplaceAll(identifier[1:len(identifier)-1], `""`, `"`)
+}
+
+// splitExpressions splits comma separated expressions of a definition
+// skipping commas inside parentheses and quotes
+func splitExpressions(definition string) []string {
+	var expressions []string
+	var depth int
+	var quote rune
+	start := 0
+	for i, char := range definition {
+		switch {
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '\'' || char == '"':
+			quote = char
+		case char == '(':
+			depth++
+		case char == ')':
+			depth--
+		case char == ',' && depth == 0:
+			expressions = append(expressions, strings.TrimSpace(definition[start:i]))
+			start = i + 1
+		}
+	}
+	if rest := strings.TrimSpace(definition[start:]); rest != "" {
+		expressions = append(expressions, rest)
+	}
+	return expressions
+}
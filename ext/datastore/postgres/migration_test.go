@@ -0,0 +1,211 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableMigration(t *testing.T) {
+	orders := PGTable{
+		Schema: "sales",
+		Table:  "orders",
+		Metadata: PGTableMetadata{
+			Comment: "customer orders",
+			Columns: []PGColumn{
+				{Name: "id", Type: "bigint", NotNull: true},
+				{Name: "customer_id", Type: "int", NotNull: true},
+				{Name: "status", Type: "varchar(20)", Default: "'new'", Comment: "order status"},
+				{Name: "created_at", Type: "timestamptz", NotNull: true, Default: "now()"},
+			},
+			PrimaryKey: []string{"id", "created_at"},
+			Constraints: []PGConstraint{
+				{Name: "orders_customer_fk", Definition: "FOREIGN KEY (customer_id) REFERENCES sales.customers (id)"},
+			},
+			Indexes: []PGIndex{
+				{Name: "orders_status_idx", Columns: []string{"status", "lower(status)"}},
+				{Name: "orders_customer_idx", Columns: []string{"customer_id"}, Unique: true, Using: "hash"},
+			},
+			Partition: &PGPartition{
+				Type:    "range",
+				Columns: []string{"created_at"},
+				Partitions: []PGPartitionBound{
+					{Name: "orders_2021_01", Bound: "FOR VALUES FROM ('2021-01-01') TO ('2021-02-01')"},
+				},
+			},
+		},
+	}
+
+	t.Run("should create table if it doesn't exist", func(t *testing.T) {
+		statements, err := tableMigration(nil, orders)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			`CREATE TABLE "sales"."orders" (
+	"id" bigint NOT NULL,
+	"customer_id" int NOT NULL,
+	"status" varchar(20) DEFAULT 'new',
+	"created_at" timestamptz NOT NULL DEFAULT now(),
+	PRIMARY KEY ("id", "created_at"),
+	CONSTRAINT "orders_customer_fk" FOREIGN KEY (customer_id) REFERENCES sales.customers (id)
+) PARTITION BY RANGE ("created_at")`,
+			`CREATE TABLE "sales"."orders_2021_01" PARTITION OF "sales"."orders" FOR VALUES FROM ('2021-01-01') TO ('2021-02-01')`,
+			`CREATE INDEX "orders_status_idx" ON "sales"."orders" ("status", lower(status))`,
+			`CREATE UNIQUE INDEX "orders_customer_idx" ON "sales"."orders" USING hash ("customer_id")`,
+			`COMMENT ON TABLE "sales"."orders" IS 'customer orders'`,
+			`COMMENT ON COLUMN "sales"."orders"."status" IS 'order status'`,
+		}, statements)
+	})
+	t.Run("should not alter table matching spec as read from postgres", func(t *testing.T) {
+		current := PGTable{
+			Schema: "sales",
+			Table:  "orders",
+			Metadata: PGTableMetadata{
+				Comment: "customer orders",
+				Columns: []PGColumn{
+					{Name: "id", Type: "bigint", NotNull: true},
+					{Name: "customer_id", Type: "integer", NotNull: true},
+					{Name: "status", Type: "character varying(20)", Default: "'new'::character varying", Comment: "order status"},
+					{Name: "created_at", Type: "timestamp with time zone", NotNull: true, Default: "now()"},
+				},
+				PrimaryKey:     []string{"id", "created_at"},
+				primaryKeyName: "orders_pkey",
+				Constraints: []PGConstraint{
+					{Name: "orders_customer_fk", Definition: "FOREIGN KEY (customer_id) REFERENCES sales.customers(id)"},
+				},
+				Indexes: []PGIndex{
+					{Name: "orders_customer_idx", Columns: []string{"customer_id"}, Unique: true, Using: "hash"},
+					{Name: "orders_status_idx", Columns: []string{"status", "lower((status)::text)"}},
+				},
+				Partition: &PGPartition{
+					Type:    "RANGE",
+					Columns: []string{"created_at"},
+					Partitions: []PGPartitionBound{
+						{Name: "orders_2020_12", Bound: "FOR VALUES FROM ('2020-12-01') TO ('2021-01-01')"},
+						{Name: "orders_2021_01", Bound: "FOR VALUES FROM ('2021-01-01') TO ('2021-02-01')"},
+					},
+				},
+			},
+		}
+		statements, err := tableMigration(&current, orders)
+		assert.Nil(t, err)
+		assert.Empty(t, statements)
+	})
+	t.Run("should migrate existing table to spec", func(t *testing.T) {
+		current := PGTable{
+			Schema: "sales",
+			Table:  "orders",
+			Metadata: PGTableMetadata{
+				Columns: []PGColumn{
+					{Name: "id", Type: "integer", NotNull: true, Default: "nextval('sales.orders_id_seq'::regclass)"},
+					{Name: "customer_id", Type: "integer"},
+					{Name: "status", Type: "character varying(10)", Default: "'open'::character varying"},
+					{Name: "legacy_code", Type: "text"},
+				},
+				PrimaryKey:     []string{"id"},
+				primaryKeyName: "orders_pkey",
+				Constraints: []PGConstraint{
+					{Name: "orders_legacy_code_check", Definition: "CHECK (legacy_code <> '')"},
+				},
+				Indexes: []PGIndex{
+					{Name: "orders_legacy_idx", Columns: []string{"legacy_code"}},
+				},
+			},
+		}
+		desired := PGTable{
+			Schema: "sales",
+			Table:  "orders",
+			Metadata: PGTableMetadata{
+				Comment: "customer orders",
+				Columns: []PGColumn{
+					{Name: "id", Type: "bigint", NotNull: true},
+					{Name: "customer_id", Type: "int", NotNull: true},
+					{Name: "status", Type: "varchar(20)", Comment: "order status"},
+					{Name: "created_at", Type: "timestamptz", Default: "now()"},
+				},
+				PrimaryKey:  []string{"id", "created_at"},
+				Constraints: orders.Metadata.Constraints,
+				Indexes:     orders.Metadata.Indexes[:1],
+			},
+		}
+		statements, err := tableMigration(&current, desired)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			`ALTER TABLE "sales"."orders" DROP CONSTRAINT "orders_legacy_code_check"`,
+			`DROP INDEX "sales"."orders_legacy_idx"`,
+			`ALTER TABLE "sales"."orders" DROP CONSTRAINT "orders_pkey"`,
+			`ALTER TABLE "sales"."orders" DROP COLUMN "legacy_code"`,
+			`ALTER TABLE "sales"."orders" ALTER COLUMN "id" TYPE bigint USING "id"::bigint`,
+			`ALTER TABLE "sales"."orders" ALTER COLUMN "customer_id" SET NOT NULL`,
+			`ALTER TABLE "sales"."orders" ALTER COLUMN "status" TYPE varchar(20) USING "status"::varchar(20)`,
+			`ALTER TABLE "sales"."orders" ALTER COLUMN "status" DROP DEFAULT`,
+			`COMMENT ON COLUMN "sales"."orders"."status" IS 'order status'`,
+			`ALTER TABLE "sales"."orders" ADD COLUMN "created_at" timestamptz DEFAULT now()`,
+			`ALTER TABLE "sales"."orders" ADD PRIMARY KEY ("id", "created_at")`,
+			`ALTER TABLE "sales"."orders" ADD CONSTRAINT "orders_customer_fk" FOREIGN KEY (customer_id) REFERENCES sales.customers (id)`,
+			`CREATE INDEX "orders_status_idx" ON "sales"."orders" ("status", lower(status))`,
+			`COMMENT ON TABLE "sales"."orders" IS 'customer orders'`,
+		}, statements)
+	})
+	t.Run("should leave columns untouched if spec has none", func(t *testing.T) {
+		current := orders
+		desired := PGTable{
+			Schema:   "sales",
+			Table:    "orders",
+			Metadata: PGTableMetadata{Comment: "orders", PrimaryKey: orders.Metadata.PrimaryKey, Partition: orders.Metadata.Partition},
+		}
+		statements, err := tableMigration(&current, desired)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{
+			`ALTER TABLE "sales"."orders" DROP CONSTRAINT "orders_customer_fk"`,
+			`DROP INDEX "sales"."orders_status_idx"`,
+			`DROP INDEX "sales"."orders_customer_idx"`,
+			`COMMENT ON TABLE "sales"."orders" IS 'orders'`,
+		}, statements)
+	})
+	t.Run("should fail to change partitioning of existing table", func(t *testing.T) {
+		desired := orders
+		desired.Metadata.Partition = &PGPartition{Type: "LIST", Columns: []string{"status"}}
+		_, err := tableMigration(&orders, desired)
+		assert.Equal(t, `partitioning of table orders can't be changed from "RANGE (created_at)" to "LIST (status)"`, err.Error())
+	})
+}
+
+func TestNormaliseColumnType(t *testing.T) {
+	cases := map[string]string{
+		"int":                         "integer",
+		"INT8":                        "bigint",
+		"serial":                      "integer",
+		"varchar( 20 )":               "character varying(20)",
+		"varchar":                     "character varying",
+		"decimal(10, 2)":              "numeric(10,2)",
+		"timestamp":                   "timestamp without time zone",
+		"timestamptz":                 "timestamp with time zone",
+		"timestamp(3) with time zone": "timestamp(3) with time zone",
+		"timetz":                      "time with time zone",
+		"text[]":                      "text[]",
+		"int[]":                       "integer[]",
+		"jsonb":                       "jsonb",
+		"double precision":            "double precision",
+	}
+	for columnType, expected := range cases {
+		assert.Equal(t, expected, normaliseColumnType(columnType), columnType)
+	}
+}
+
+func TestIsColumnTypeWidened(t *testing.T) {
+	assert.True(t, isColumnTypeWidened("integer", "bigint"))
+	assert.True(t, isColumnTypeWidened("character varying(10)", "character varying(20)"))
+	assert.True(t, isColumnTypeWidened("character varying(10)", "character varying"))
+	assert.True(t, isColumnTypeWidened("character varying(10)", "text"))
+	assert.False(t, isColumnTypeWidened("character varying(20)", "character varying(10)"))
+	assert.False(t, isColumnTypeWidened("bigint", "integer"))
+	assert.False(t, isColumnTypeWidened("text", "integer"))
+	assert.False(t, isColumnTypeWidened("integer", "numeric(5,2)"))
+}
+
+func TestParseColumnList(t *testing.T) {
+	assert.Equal(t, []string{"id", "userId", "lower((email)::text)"},
+		parseColumnList(`(id, "userId", lower((email)::text)) WHERE (id > 0)`))
+	assert.Equal(t, []string{"created_at"}, parseColumnList("(created_at)"))
+	assert.Nil(t, parseColumnList("id"))
+}
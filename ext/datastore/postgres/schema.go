@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+func createSchema(ctx context.Context, spec models.ResourceSpec, db *sql.DB, upsert bool) error {
+	pgResource, ok := spec.Spec.(PGSchema)
+	if !ok {
+		return errors.New("failed to read schema spec for postgres")
+	}
+	return withTx(ctx, db, func(tx *sql.Tx) error {
+		return execStatements(ctx, tx, schemaStatements(pgResource, upsert))
+	})
+}
+
+// schemaStatements creates schema if missing, comment is only applied while
+// updating or along with a new schema
+func schemaStatements(pgResource PGSchema, upsert bool) []string {
+	name := pq.QuoteIdentifier(pgResource.Schema)
+	statements := []string{fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", name)}
+	if upsert || pgResource.Metadata.Comment != "" {
+		statements = append(statements, fmt.Sprintf("COMMENT ON SCHEMA %s IS %s", name,
+			commentLiteral(pgResource.Metadata.Comment)))
+	}
+	return statements
+}
+
+func getSchema(ctx context.Context, spec models.ResourceSpec, db *sql.DB) (models.ResourceSpec, error) {
+	pgResource, ok := spec.Spec.(PGSchema)
+	if !ok {
+		return models.ResourceSpec{}, errors.New("failed to read schema spec for postgres")
+	}
+
+	var comment sql.NullString
+	if err := db.QueryRowContext(ctx,
+		"SELECT obj_description(oid, 'pg_namespace') FROM pg_namespace WHERE nspname = $1",
+		pgResource.Schema,
+	).Scan(&comment); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	pgResource.Metadata = PGSchemaMetadata{
+		Comment: comment.String,
+	}
+	spec.Spec = pgResource
+	return spec, nil
+}
+
+// commentLiteral quotes comment as a literal, an empty comment removes it
+func commentLiteral(comment string) string {
+	if comment == "" {
+		return "NULL"
+	}
+	return pq.QuoteLiteral(comment)
+}
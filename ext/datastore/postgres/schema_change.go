@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+// schemaChanges collects changes of a table schema classified by their impact
+type schemaChanges []models.ResourceSchemaChange
+
+func (c *schemaChanges) add(field string, kind models.SchemaChangeKind, from, to string) {
+	*c = append(*c, models.ResourceSchemaChange{
+		Field: field,
+		Kind:  kind,
+		From:  from,
+		To:    to,
+	})
+}
+
+// compareColumns matches columns by name, dropped columns, columns made not
+// null and not null columns added without a default can't be applied to
+// existing rows
+func (c *schemaChanges) compareColumns(current, desired []PGColumn) {
+	desiredColumns := map[string]PGColumn{}
+	for _, column := range desired {
+		desiredColumns[column.Name] = column
+	}
+	currentColumns := map[string]bool{}
+	for _, currentColumn := range current {
+		currentColumns[currentColumn.Name] = true
+		field := "columns." + currentColumn.Name
+
+		desiredColumn, ok := desiredColumns[currentColumn.Name]
+		if !ok {
+			c.add(field, models.SchemaChangeBreaking, describeColumn(currentColumn), "")
+			continue
+		}
+
+		currentType, desiredType := normaliseColumnType(currentColumn.Type), normaliseColumnType(desiredColumn.Type)
+		if currentType != desiredType {
+			kind := models.SchemaChangeBreaking
+			if isColumnTypeWidened(currentType, desiredType) {
+				kind = models.SchemaChangeCompatible
+			}
+			c.add(field+".type", kind, currentType, desiredType)
+		}
+		if currentColumn.NotNull != desiredColumn.NotNull {
+			kind := models.SchemaChangeCompatible
+			if desiredColumn.NotNull {
+				kind = models.SchemaChangeBreaking
+			}
+			c.add(field+".not_null", kind, strconv.FormatBool(currentColumn.NotNull), strconv.FormatBool(desiredColumn.NotNull))
+		}
+		if defaultChanged(currentColumn, desiredColumn) {
+			c.add(field+".default", models.SchemaChangeCompatible, normaliseDefault(currentColumn.Default),
+				normaliseDefault(desiredColumn.Default))
+		}
+		if currentColumn.Comment != desiredColumn.Comment {
+			c.add(field+".comment", models.SchemaChangeCompatible, currentColumn.Comment, desiredColumn.Comment)
+		}
+	}
+	for _, desiredColumn := range desired {
+		if currentColumns[desiredColumn.Name] {
+			continue
+		}
+		kind := models.SchemaChangeAdditive
+		if desiredColumn.NotNull && desiredColumn.Default == "" {
+			kind = models.SchemaChangeBreaking
+		}
+		c.add("columns."+desiredColumn.Name, kind, "", describeColumn(desiredColumn))
+	}
+}
+
+// checkTableSchemaChange classifies changes desired spec of a table makes to
+// the current table, columns aren't touched while updating if desired spec
+// has none. Changing primary key or partitioning is always breaking.
+func checkTableSchemaChange(current, desired models.ResourceSpec) ([]models.ResourceSchemaChange, error) {
+	currentTable, ok := current.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for postgres")
+	}
+	desiredTable, ok := desired.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to read table spec for postgres")
+	}
+	currentMeta, desiredMeta := currentTable.Metadata, desiredTable.Metadata
+
+	var changes schemaChanges
+	if len(desiredMeta.Columns) > 0 {
+		changes.compareColumns(currentMeta.Columns, desiredMeta.Columns)
+	}
+	if !sameColumns(currentMeta.PrimaryKey, desiredMeta.PrimaryKey) {
+		changes.add("primary_key", models.SchemaChangeBreaking, strings.Join(currentMeta.PrimaryKey, ", "),
+			strings.Join(desiredMeta.PrimaryKey, ", "))
+	}
+	if !samePartitioning(currentMeta.Partition, desiredMeta.Partition) {
+		changes.add("partition", models.SchemaChangeBreaking, describePartition(currentMeta.Partition),
+			describePartition(desiredMeta.Partition))
+	}
+	return changes, nil
+}
@@ -0,0 +1,109 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckTableSchemaChange(t *testing.T) {
+	current := PGTable{Metadata: PGTableMetadata{
+		Columns: []PGColumn{
+			{Name: "id", Type: "integer", NotNull: true},
+			{Name: "code", Type: "character varying(10)"},
+			{Name: "amount", Type: "numeric"},
+			{Name: "note", Type: "text", NotNull: true},
+			{Name: "legacy", Type: "text"},
+		},
+		PrimaryKey: []string{"id"},
+	}}
+
+	t.Run("should classify changes of columns", func(t *testing.T) {
+		desired := PGTable{Metadata: PGTableMetadata{
+			Columns: []PGColumn{
+				{Name: "id", Type: "bigint", NotNull: true},
+				{Name: "code", Type: "varchar(20)", NotNull: true},
+				{Name: "amount", Type: "int", Default: "0"},
+				{Name: "note", Type: "text", Comment: "free text"},
+				{Name: "region", Type: "text"},
+				{Name: "country", Type: "text", NotNull: true},
+				{Name: "currency", Type: "text", NotNull: true, Default: "'EUR'"},
+			},
+			PrimaryKey: []string{"id", "code"},
+		}}
+		changes, err := tableSpec{}.CheckSchemaChange(models.ResourceSpec{Spec: current}, models.ResourceSpec{Spec: desired})
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ResourceSchemaChange{
+			{Field: "columns.id.type", Kind: models.SchemaChangeCompatible, From: "integer", To: "bigint"},
+			{Field: "columns.code.type", Kind: models.SchemaChangeCompatible, From: "character varying(10)", To: "character varying(20)"},
+			{Field: "columns.code.not_null", Kind: models.SchemaChangeBreaking, From: "false", To: "true"},
+			{Field: "columns.amount.type", Kind: models.SchemaChangeBreaking, From: "numeric", To: "integer"},
+			{Field: "columns.amount.default", Kind: models.SchemaChangeCompatible, From: "", To: "0"},
+			{Field: "columns.note.not_null", Kind: models.SchemaChangeCompatible, From: "true", To: "false"},
+			{Field: "columns.note.comment", Kind: models.SchemaChangeCompatible, From: "", To: "free text"},
+			{Field: "columns.legacy", Kind: models.SchemaChangeBreaking, From: "legacy text", To: ""},
+			{Field: "columns.region", Kind: models.SchemaChangeAdditive, From: "", To: "region text"},
+			{Field: "columns.country", Kind: models.SchemaChangeBreaking, From: "", To: "country text NOT NULL"},
+			{Field: "columns.currency", Kind: models.SchemaChangeAdditive, From: "", To: "currency text NOT NULL DEFAULT 'EUR'"},
+			{Field: "primary_key", Kind: models.SchemaChangeBreaking, From: "id", To: "id, code"},
+		}, changes)
+	})
+	t.Run("should not check columns if spec has none", func(t *testing.T) {
+		desired := PGTable{Metadata: PGTableMetadata{
+			PrimaryKey: []string{"id"},
+			Partition:  &PGPartition{Type: "HASH", Columns: []string{"id"}},
+		}}
+		changes, err := tableSpec{}.CheckSchemaChange(models.ResourceSpec{Spec: current}, models.ResourceSpec{Spec: desired})
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ResourceSchemaChange{
+			{Field: "partition", Kind: models.SchemaChangeBreaking, From: "", To: "HASH (id)"},
+		}, changes)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("should report fields of table differing from spec", func(t *testing.T) {
+		expected := PGTable{Metadata: PGTableMetadata{
+			Columns: []PGColumn{
+				{Name: "id", Type: "serial", NotNull: true},
+				{Name: "status", Type: "varchar(20)", Default: "'new'"},
+			},
+			Indexes: []PGIndex{{Name: "orders_status_idx", Columns: []string{"status"}}},
+		}}
+		actual := PGTable{Metadata: PGTableMetadata{
+			Columns: []PGColumn{
+				{Name: "id", Type: "integer", NotNull: true, Default: "nextval('orders_id_seq'::regclass)"},
+				{Name: "status", Type: "character varying(20)", Default: "'open'::character varying"},
+				{Name: "extra", Type: "text"},
+			},
+			Indexes: []PGIndex{{Name: "orders_status_idx", Columns: []string{"status"}, Unique: true}},
+		}}
+		drifts, err := tableSpec{}.Diff(models.ResourceSpec{Spec: expected}, models.ResourceSpec{Spec: actual})
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ResourceFieldDrift{
+			{Field: "columns.status.default", Expected: "'new'", Actual: "'open'"},
+			{Field: "columns.extra", Expected: "", Actual: "extra text"},
+			{Field: "indexes.orders_status_idx", Expected: "USING btree (status)", Actual: "UNIQUE USING btree (status)"},
+		}, drifts)
+	})
+	t.Run("should report query of view differing from spec", func(t *testing.T) {
+		expected := models.ResourceSpec{
+			Type:   models.ResourceTypeView,
+			Spec:   PGView{},
+			Assets: map[string]string{ViewQueryFile: "select id from sales.orders\n"},
+		}
+		actual := models.ResourceSpec{
+			Type:   models.ResourceTypeMaterializedView,
+			Spec:   PGView{Metadata: PGViewMetadata{Comment: "orders"}},
+			Assets: map[string]string{MaterializedViewQueryFile: " SELECT orders.id\n   FROM sales.orders;"},
+		}
+		drifts, err := viewSpec{}.Diff(expected, actual)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.ResourceFieldDrift{
+			{Field: "type", Expected: "view", Actual: "materialized_view"},
+			{Field: "query", Expected: "select id from sales.orders", Actual: ""},
+			{Field: "comment", Expected: "", Actual: "orders"},
+		}, drifts)
+	})
+}
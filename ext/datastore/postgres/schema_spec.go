@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+)
+
+// SchemaResourceSpec is how schema will be represented in yaml
+type SchemaResourceSpec struct {
+	Version int
+	Name    string
+	Type    models.ResourceType
+	Spec    PGSchemaMetadata
+	Labels  map[string]string
+}
+
+// PGSchema is a specification for a Postgres schema
+// The schema may or may not exist
+type PGSchema struct {
+	Schema string
+
+	Metadata PGSchemaMetadata
+}
+
+// PGSchemaMetadata holds configuration for a schema
+type PGSchemaMetadata struct {
+	Comment string `yaml:",omitempty" json:"comment,omitempty"`
+}
+
+// schemaSpecHandler helps serializing/deserializing datastore resource for schema
+type schemaSpecHandler struct {
+}
+
+func (s schemaSpecHandler) ToYaml(optResource models.ResourceSpec) ([]byte, error) {
+	if optResource.Spec == nil {
+		// usually happens when resource is requested to be created for the first time via optimus cli
+		optResource.Spec = PGSchema{}
+	}
+	spec, ok := optResource.Spec.(PGSchema)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+
+	yamlResource := SchemaResourceSpec{
+		Version: optResource.Version,
+		Name:    optResource.Name,
+		Type:    optResource.Type,
+		Spec:    spec.Metadata,
+	}
+	if len(optResource.Labels) > 0 {
+		yamlResource.Labels = optResource.Labels
+	}
+	return yaml.Marshal(yamlResource)
+}
+
+func (s schemaSpecHandler) FromYaml(b []byte) (models.ResourceSpec, error) {
+	var yamlResource SchemaResourceSpec
+	if err := yaml.Unmarshal(b, &yamlResource); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	if !schemaNameParseRegex.MatchString(yamlResource.Name) {
+		return models.ResourceSpec{}, fmt.Errorf("invalid yamlResource name %s", yamlResource.Name)
+	}
+
+	optResource := models.ResourceSpec{
+		Version:   yamlResource.Version,
+		Name:      yamlResource.Name,
+		Type:      yamlResource.Type,
+		Datastore: This,
+		Spec: PGSchema{
+			Schema:   yamlResource.Name,
+			Metadata: yamlResource.Spec,
+		},
+	}
+	if len(yamlResource.Labels) > 0 {
+		optResource.Labels = yamlResource.Labels
+	}
+	return optResource, nil
+}
+
+func (s schemaSpecHandler) ToProtobuf(optResource models.ResourceSpec) ([]byte, error) {
+	pgResource, ok := optResource.Spec.(PGSchema)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+	return specToProtobuf(optResource, pgResource.Metadata)
+}
+
+func (s schemaSpecHandler) FromProtobuf(b []byte) (models.ResourceSpec, error) {
+	protoSpec := &v1.ResourceSpecification{}
+	if err := proto.Unmarshal(b, protoSpec); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	if !schemaNameParseRegex.MatchString(protoSpec.Name) {
+		return models.ResourceSpec{}, fmt.Errorf("invalid resource name %s", protoSpec.Name)
+	}
+
+	pgSchema := PGSchema{
+		Schema: protoSpec.Name,
+	}
+	if err := specFromProtoStruct(protoSpec.Spec, &pgSchema.Metadata); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	return specFromProtobuf(protoSpec, pgSchema), nil
+}
+
+type schemaSpec struct{}
+
+func (s schemaSpec) Adapter() models.DatastoreSpecAdapter {
+	return &schemaSpecHandler{}
+}
+
+func (s schemaSpec) Validator() models.DatastoreSpecValidator {
+	return func(spec models.ResourceSpec) error {
+		if !schemaNameParseRegex.MatchString(spec.Name) {
+			return fmt.Errorf("for example 'schema_name'")
+		}
+		return nil
+	}
+}
+
+func (s schemaSpec) DefaultAssets() map[string]string {
+	return map[string]string{}
+}
+
+// Diff compares spec of schema with the one read from postgres
+func (s schemaSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffSchema(expected, actual)
+}
@@ -0,0 +1,227 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	tableQuery = `SELECT c.oid, c.relkind, COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('r', 'p')`
+
+	columnsQuery = `SELECT a.attname, format_type(a.atttypid, a.atttypmod), a.attnotnull,
+	COALESCE(pg_get_expr(d.adbin, d.adrelid), ''), COALESCE(col_description(a.attrelid, a.attnum), '')
+FROM pg_attribute a LEFT JOIN pg_attrdef d ON d.adrelid = a.attrelid AND d.adnum = a.attnum
+WHERE a.attrelid = $1 AND a.attnum > 0 AND NOT a.attisdropped
+ORDER BY a.attnum`
+
+	constraintsQuery = `SELECT conname, contype, pg_get_constraintdef(oid)
+FROM pg_constraint
+WHERE conrelid = $1 AND contype IN ('p', 'c', 'f', 'u', 'x')
+ORDER BY conname`
+
+	// indexes backing constraints are managed along with the constraint
+	indexesQuery = `SELECT i.relname, x.indisunique, am.amname, pg_get_indexdef(x.indexrelid)
+FROM pg_index x JOIN pg_class i ON i.oid = x.indexrelid JOIN pg_am am ON am.oid = i.relam
+WHERE x.indrelid = $1 AND NOT EXISTS (SELECT 1 FROM pg_constraint c WHERE c.conindid = x.indexrelid)
+ORDER BY i.relname`
+
+	partitionsQuery = `SELECT c.relname, pg_get_expr(c.relpartbound, c.oid)
+FROM pg_inherits i JOIN pg_class c ON c.oid = i.inhrelid
+WHERE i.inhparent = $1
+ORDER BY c.relname`
+
+	defaultIndexMethod = "btree"
+)
+
+// createTable migrates table to the spec in a single transaction, an
+// existing table is left as is unless upsert
+func createTable(ctx context.Context, spec models.ResourceSpec, db *sql.DB, upsert bool) error {
+	pgResource, ok := spec.Spec.(PGTable)
+	if !ok {
+		return errors.New("failed to read table spec for postgres")
+	}
+
+	return withTx(ctx, db, func(tx *sql.Tx) error {
+		current, err := readTable(ctx, tx, pgResource.Schema, pgResource.Table)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if current != nil && !upsert {
+			return nil
+		}
+
+		statements, err := tableMigration(current, pgResource)
+		if err != nil {
+			return err
+		}
+		return execStatements(ctx, tx, statements)
+	})
+}
+
+func getTable(ctx context.Context, spec models.ResourceSpec, db *sql.DB) (models.ResourceSpec, error) {
+	pgResource, ok := spec.Spec.(PGTable)
+	if !ok {
+		return models.ResourceSpec{}, errors.New("failed to read table spec for postgres")
+	}
+
+	table, err := readTable(ctx, db, pgResource.Schema, pgResource.Table)
+	if err != nil {
+		return models.ResourceSpec{}, err
+	}
+	spec.Spec = *table
+	return spec, nil
+}
+
+// readTable reads table from catalog, returns sql.ErrNoRows if table
+// doesn't exist
+func readTable(ctx context.Context, q querier, schema, name string) (*PGTable, error) {
+	var (
+		oid     int64
+		relkind string
+	)
+	table := &PGTable{
+		Schema: schema,
+		Table:  name,
+	}
+	if err := q.QueryRowContext(ctx, tableQuery, schema, name).Scan(&oid, &relkind, &table.Metadata.Comment); err != nil {
+		return nil, err
+	}
+
+	rows, err := q.QueryContext(ctx, columnsQuery, oid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var column PGColumn
+		if err := rows.Scan(&column.Name, &column.Type, &column.NotNull, &column.Default, &column.Comment); err != nil {
+			return nil, err
+		}
+		table.Metadata.Columns = append(table.Metadata.Columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := readConstraints(ctx, q, oid, &table.Metadata); err != nil {
+		return nil, err
+	}
+	if err := readIndexes(ctx, q, oid, &table.Metadata); err != nil {
+		return nil, err
+	}
+	if relkind == "p" {
+		if err := readPartition(ctx, q, oid, &table.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	return table, nil
+}
+
+func readConstraints(ctx context.Context, q querier, oid int64, metadata *PGTableMetadata) error {
+	rows, err := q.QueryContext(ctx, constraintsQuery, oid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var name, kind, definition string
+		if err := rows.Scan(&name, &kind, &definition); err != nil {
+			return err
+		}
+		if kind == "p" {
+			metadata.primaryKeyName = name
+			metadata.PrimaryKey = parseColumnList(strings.TrimPrefix(definition, "PRIMARY KEY "))
+			continue
+		}
+		metadata.Constraints = append(metadata.Constraints, PGConstraint{
+			Name:       name,
+			Definition: definition,
+		})
+	}
+	return rows.Err()
+}
+
+func readIndexes(ctx context.Context, q querier, oid int64, metadata *PGTableMetadata) error {
+	rows, err := q.QueryContext(ctx, indexesQuery, oid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var index PGIndex
+		var definition string
+		if err := rows.Scan(&index.Name, &index.Unique, &index.Using, &definition); err != nil {
+			return err
+		}
+		if usingAt := strings.Index(definition, " USING "+index.Using+" "); usingAt >= 0 {
+			index.Columns = parseColumnList(definition[usingAt+len(" USING "+index.Using+" "):])
+		}
+		if index.Using == defaultIndexMethod {
+			index.Using = ""
+		}
+		metadata.Indexes = append(metadata.Indexes, index)
+	}
+	return rows.Err()
+}
+
+func readPartition(ctx context.Context, q querier, oid int64, metadata *PGTableMetadata) error {
+	var partitionKey string
+	if err := q.QueryRowContext(ctx, "SELECT pg_get_partkeydef($1)", oid).Scan(&partitionKey); err != nil {
+		return err
+	}
+	partition := &PGPartition{}
+	if keyAt := strings.Index(partitionKey, " "); keyAt > 0 {
+		partition.Type = partitionKey[:keyAt]
+		partition.Columns = parseColumnList(partitionKey[keyAt+1:])
+	}
+
+	rows, err := q.QueryContext(ctx, partitionsQuery, oid)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bound PGPartitionBound
+		if err := rows.Scan(&bound.Name, &bound.Bound); err != nil {
+			return err
+		}
+		partition.Partitions = append(partition.Partitions, bound)
+	}
+	metadata.Partition = partition
+	return rows.Err()
+}
+
+// parseColumnList reads columns from a parenthesised list at the start of a
+// definition generated by postgres, e.g. (id, "userId") WHERE ...
+func parseColumnList(definition string) []string {
+	definition = strings.TrimSpace(definition)
+	if !strings.HasPrefix(definition, "(") {
+		return nil
+	}
+	depth := 0
+	for i, char := range definition {
+		switch char {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth == 0 {
+			definition = definition[1:i]
+			break
+		}
+	}
+
+	var columns []string
+	for _, column := range splitExpressions(definition) {
+		columns = append(columns, unquoteIdentifier(column))
+	}
+	return columns
+}
@@ -0,0 +1,304 @@
+package postgres
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+)
+
+var (
+	schemaNameParseRegex = regexp.MustCompile(`^(\w+)$`)
+	tableNameParseRegex  = regexp.MustCompile(`^(\w+)\.(\w+)$`)
+
+	validPartitionTypes = map[string]bool{"RANGE": true, "LIST": true, "HASH": true}
+)
+
+// TableResourceSpec is how table will be represented in yaml
+type TableResourceSpec struct {
+	Version int
+	Name    string
+	Type    models.ResourceType
+	Spec    PGTableMetadata
+	Labels  map[string]string
+}
+
+// PGTable is a specification for a Postgres table
+// The table may or may not exist
+type PGTable struct {
+	Schema string
+	Table  string
+
+	Metadata PGTableMetadata
+}
+
+// FullyQualifiedName returns quoted name of table to be used in statements
+func (t PGTable) FullyQualifiedName() string {
+	return qualifiedName(t.Schema, t.Table)
+}
+
+// PGTableMetadata holds configuration for a table
+type PGTableMetadata struct {
+	Comment     string         `yaml:",omitempty" json:"comment,omitempty"`
+	Columns     []PGColumn     `yaml:",omitempty" json:"columns,omitempty"`
+	PrimaryKey  []string       `yaml:"primary_key,omitempty" json:"primary_key,omitempty"`
+	Constraints []PGConstraint `yaml:",omitempty" json:"constraints,omitempty"`
+	Indexes     []PGIndex      `yaml:",omitempty" json:"indexes,omitempty"`
+	Partition   *PGPartition   `yaml:",omitempty" json:"partition,omitempty"`
+
+	// name of primary key constraint read from database
+	primaryKeyName string
+}
+
+// PGColumn describes an individual column of table
+type PGColumn struct {
+	Name    string `yaml:",omitempty" json:"name"`
+	Type    string `yaml:",omitempty" json:"type"`
+	NotNull bool   `yaml:"not_null,omitempty" json:"not_null,omitempty"`
+	Default string `yaml:",omitempty" json:"default,omitempty"` // sql expression
+	Comment string `yaml:",omitempty" json:"comment,omitempty"`
+}
+
+// PGConstraint is a named unique, check or foreign key constraint, constraints
+// are matched by name so changing a definition needs a new name
+type PGConstraint struct {
+	Name       string `yaml:",omitempty" json:"name"`
+	Definition string `yaml:",omitempty" json:"definition"` // for example CHECK (amount > 0)
+}
+
+// PGIndex is a named index over columns or expressions of table, indexes
+// are matched by name so changing an index needs a new name
+type PGIndex struct {
+	Name    string   `yaml:",omitempty" json:"name"`
+	Columns []string `yaml:",omitempty" json:"columns"`
+	Unique  bool     `yaml:",omitempty" json:"unique,omitempty"`
+	Using   string   `yaml:",omitempty" json:"using,omitempty"` // default btree
+}
+
+// PGPartition declares table as partitioned, partitioning can only be set
+// while creating the table
+type PGPartition struct {
+	Type       string             `yaml:",omitempty" json:"type"` // RANGE, LIST or HASH
+	Columns    []string           `yaml:",omitempty" json:"columns"`
+	Partitions []PGPartitionBound `yaml:",omitempty" json:"partitions,omitempty"`
+}
+
+// PGPartitionBound is a partition created in schema of partitioned table
+type PGPartitionBound struct {
+	Name  string `yaml:",omitempty" json:"name"`
+	Bound string `yaml:",omitempty" json:"bound"` // for example FOR VALUES FROM ('2021-01-01') TO ('2021-02-01')
+}
+
+func (m PGTableMetadata) validate() error {
+	columns := map[string]bool{}
+	for _, column := range m.Columns {
+		if !validIdentifier.MatchString(column.Name) || column.Type == "" {
+			return fmt.Errorf("invalid column %q, requires a name and type", column.Name)
+		}
+		if columns[column.Name] {
+			return fmt.Errorf("column %s is declared more than once", column.Name)
+		}
+		columns[column.Name] = true
+	}
+	for _, column := range m.PrimaryKey {
+		if !columns[column] {
+			return fmt.Errorf("primary key column %s is not a column of table", column)
+		}
+	}
+	for _, constraint := range m.Constraints {
+		if !validIdentifier.MatchString(constraint.Name) || strings.TrimSpace(constraint.Definition) == "" {
+			return fmt.Errorf("invalid constraint %q, requires a name and definition", constraint.Name)
+		}
+	}
+	for _, index := range m.Indexes {
+		if !validIdentifier.MatchString(index.Name) || len(index.Columns) == 0 {
+			return fmt.Errorf("invalid index %q, requires a name and columns", index.Name)
+		}
+	}
+	if m.Partition != nil {
+		if !validPartitionTypes[strings.ToUpper(m.Partition.Type)] || len(m.Partition.Columns) == 0 {
+			return fmt.Errorf("invalid partition, requires a type (RANGE, LIST or HASH) and columns")
+		}
+		for _, partition := range m.Partition.Partitions {
+			if !validIdentifier.MatchString(partition.Name) || strings.TrimSpace(partition.Bound) == "" {
+				return fmt.Errorf("invalid partition %q, requires a name and bound", partition.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// tableSpecHandler helps serializing/deserializing datastore resource for table
+type tableSpecHandler struct {
+}
+
+func (s tableSpecHandler) ToYaml(optResource models.ResourceSpec) ([]byte, error) {
+	if optResource.Spec == nil {
+		// usually happens when resource is requested to be created for the first time via optimus cli
+		optResource.Spec = PGTable{}
+	}
+	spec, ok := optResource.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+
+	yamlResource := TableResourceSpec{
+		Version: optResource.Version,
+		Name:    optResource.Name,
+		Type:    optResource.Type,
+		Spec:    spec.Metadata,
+	}
+	if len(optResource.Labels) > 0 {
+		yamlResource.Labels = optResource.Labels
+	}
+	return yaml.Marshal(yamlResource)
+}
+
+func (s tableSpecHandler) FromYaml(b []byte) (models.ResourceSpec, error) {
+	var yamlResource TableResourceSpec
+	if err := yaml.Unmarshal(b, &yamlResource); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	parsedTableName := tableNameParseRegex.FindStringSubmatch(yamlResource.Name)
+	if len(parsedTableName) < 3 {
+		return models.ResourceSpec{}, fmt.Errorf("invalid yamlResource name %s", yamlResource.Name)
+	}
+
+	optResource := models.ResourceSpec{
+		Version:   yamlResource.Version,
+		Name:      yamlResource.Name,
+		Type:      yamlResource.Type,
+		Datastore: This,
+		Spec: PGTable{
+			Schema:   parsedTableName[1],
+			Table:    parsedTableName[2],
+			Metadata: yamlResource.Spec,
+		},
+	}
+	if len(yamlResource.Labels) > 0 {
+		optResource.Labels = yamlResource.Labels
+	}
+	return optResource, nil
+}
+
+func (s tableSpecHandler) ToProtobuf(optResource models.ResourceSpec) ([]byte, error) {
+	pgResource, ok := optResource.Spec.(PGTable)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+	return specToProtobuf(optResource, pgResource.Metadata)
+}
+
+func (s tableSpecHandler) FromProtobuf(b []byte) (models.ResourceSpec, error) {
+	protoSpec := &v1.ResourceSpecification{}
+	if err := proto.Unmarshal(b, protoSpec); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	parsedTableName := tableNameParseRegex.FindStringSubmatch(protoSpec.Name)
+	if len(parsedTableName) < 3 {
+		return models.ResourceSpec{}, fmt.Errorf("invalid resource name %s", protoSpec.Name)
+	}
+
+	pgTable := PGTable{
+		Schema: parsedTableName[1],
+		Table:  parsedTableName[2],
+	}
+	if err := specFromProtoStruct(protoSpec.Spec, &pgTable.Metadata); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	return specFromProtobuf(protoSpec, pgTable), nil
+}
+
+// specToProtobuf converts resource along with its metadata, field names of
+// the proto struct match json tags of metadata
+func specToProtobuf(optResource models.ResourceSpec, metadata interface{}) ([]byte, error) {
+	raw, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, err
+	}
+	protoSpec := &structpb.Struct{}
+	if err := protoSpec.UnmarshalJSON(raw); err != nil {
+		return nil, err
+	}
+	resSpec := &v1.ResourceSpecification{
+		Version: int32(optResource.Version),
+		Name:    optResource.Name,
+		Type:    optResource.Type.String(),
+		Spec:    protoSpec,
+		Assets:  optResource.Assets,
+		Labels:  optResource.Labels,
+	}
+	return proto.Marshal(resSpec)
+}
+
+// specFromProtoStruct reads metadata from proto struct, field names of the
+// struct match json tags of metadata
+func specFromProtoStruct(protoStruct *structpb.Struct, metadata interface{}) error {
+	if protoStruct == nil {
+		return nil
+	}
+	raw, err := protoStruct.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	return errors.Wrap(json.Unmarshal(raw, metadata), "failed to read spec")
+}
+
+func specFromProtobuf(protoSpec *v1.ResourceSpecification, spec interface{}) models.ResourceSpec {
+	return models.ResourceSpec{
+		Version:   int(protoSpec.Version),
+		Name:      protoSpec.Name,
+		Type:      models.ResourceType(protoSpec.Type),
+		Assets:    protoSpec.Assets,
+		Spec:      spec,
+		Datastore: This,
+		Labels:    protoSpec.Labels,
+	}
+}
+
+type tableSpec struct{}
+
+func (s tableSpec) Adapter() models.DatastoreSpecAdapter {
+	return &tableSpecHandler{}
+}
+
+func (s tableSpec) Validator() models.DatastoreSpecValidator {
+	return func(spec models.ResourceSpec) error {
+		if !tableNameParseRegex.MatchString(spec.Name) {
+			return fmt.Errorf("for example 'schema_name.table_name'")
+		}
+		if pgResource, ok := spec.Spec.(PGTable); ok {
+			return pgResource.Metadata.validate()
+		}
+		return nil
+	}
+}
+
+func (s tableSpec) DefaultAssets() map[string]string {
+	return map[string]string{}
+}
+
+// Diff compares spec of table with the one read from postgres
+func (s tableSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffTable(expected, actual)
+}
+
+// CheckSchemaChange classifies changes of table columns before migrating it
+func (s tableSpec) CheckSchemaChange(current, desired models.ResourceSpec) ([]models.ResourceSchemaChange, error) {
+	return checkTableSchemaChange(current, desired)
+}
+
+// Dependencies of table are its schema and tables it references
+func (s tableSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return tableDependencies(spec)
+}
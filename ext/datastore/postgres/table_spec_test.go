@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableSpecHandler(t *testing.T) {
+	tableYaml := `version: 1
+name: sales.orders
+type: table
+spec:
+  comment: customer orders
+  columns:
+  - name: id
+    type: bigint
+    not_null: true
+  - name: status
+    type: varchar(20)
+    default: '''new'''
+    comment: order status
+  primary_key:
+  - id
+  constraints:
+  - name: orders_status_check
+    definition: CHECK (status <> '')
+  indexes:
+  - name: orders_status_idx
+    columns:
+    - status
+    unique: true
+  partition:
+    type: LIST
+    columns:
+    - status
+    partitions:
+    - name: orders_new
+      bound: FOR VALUES IN ('new')
+labels:
+  owner: sales
+`
+	expectedResource := models.ResourceSpec{
+		Version:   1,
+		Name:      "sales.orders",
+		Type:      models.ResourceTypeTable,
+		Datastore: This,
+		Spec: PGTable{
+			Schema: "sales",
+			Table:  "orders",
+			Metadata: PGTableMetadata{
+				Comment: "customer orders",
+				Columns: []PGColumn{
+					{Name: "id", Type: "bigint", NotNull: true},
+					{Name: "status", Type: "varchar(20)", Default: "'new'", Comment: "order status"},
+				},
+				PrimaryKey:  []string{"id"},
+				Constraints: []PGConstraint{{Name: "orders_status_check", Definition: "CHECK (status <> '')"}},
+				Indexes:     []PGIndex{{Name: "orders_status_idx", Columns: []string{"status"}, Unique: true}},
+				Partition: &PGPartition{
+					Type:       "LIST",
+					Columns:    []string{"status"},
+					Partitions: []PGPartitionBound{{Name: "orders_new", Bound: "FOR VALUES IN ('new')"}},
+				},
+			},
+		},
+		Labels: map[string]string{"owner": "sales"},
+	}
+
+	t.Run("should convert table between yaml and spec", func(t *testing.T) {
+		adapter := tableSpecHandler{}
+		resource, err := adapter.FromYaml([]byte(tableYaml))
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResource, resource)
+
+		raw, err := adapter.ToYaml(resource)
+		assert.Nil(t, err)
+		resource, err = adapter.FromYaml(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResource, resource)
+	})
+	t.Run("should convert table between protobuf and spec", func(t *testing.T) {
+		adapter := tableSpecHandler{}
+		raw, err := adapter.ToProtobuf(expectedResource)
+		assert.Nil(t, err)
+
+		resource, err := adapter.FromProtobuf(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedResource, resource)
+	})
+	t.Run("should fail to read table with invalid name", func(t *testing.T) {
+		_, err := tableSpecHandler{}.FromYaml([]byte("name: orders\ntype: table\n"))
+		assert.NotNil(t, err)
+	})
+	t.Run("should convert schema and view between protobuf and spec", func(t *testing.T) {
+		schemaResource := models.ResourceSpec{
+			Version:   1,
+			Name:      "sales",
+			Type:      models.ResourceTypeSchema,
+			Datastore: This,
+			Spec:      PGSchema{Schema: "sales", Metadata: PGSchemaMetadata{Comment: "sales data"}},
+		}
+		raw, err := schemaSpecHandler{}.ToProtobuf(schemaResource)
+		assert.Nil(t, err)
+		resource, err := schemaSpecHandler{}.FromProtobuf(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, schemaResource, resource)
+
+		viewResource := models.ResourceSpec{
+			Version:   1,
+			Name:      "sales.daily_orders",
+			Type:      models.ResourceTypeMaterializedView,
+			Datastore: This,
+			Spec:      PGView{Schema: "sales", View: "daily_orders", Metadata: PGViewMetadata{WithNoData: true}},
+			Assets:    map[string]string{MaterializedViewQueryFile: "select 1"},
+		}
+		raw, err = viewSpecHandler{}.ToProtobuf(viewResource)
+		assert.Nil(t, err)
+		resource, err = viewSpecHandler{}.FromProtobuf(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, viewResource, resource)
+	})
+}
+
+func TestTableSpecValidator(t *testing.T) {
+	validator := tableSpec{}.Validator()
+	validTable := PGTableMetadata{
+		Columns:    []PGColumn{{Name: "id", Type: "bigint"}},
+		PrimaryKey: []string{"id"},
+	}
+	assert.Nil(t, validator(models.ResourceSpec{Name: "sales.orders", Spec: PGTable{Metadata: validTable}}))
+	assert.NotNil(t, validator(models.ResourceSpec{Name: "orders", Spec: PGTable{Metadata: validTable}}))
+
+	invalidTables := []PGTableMetadata{
+		{Columns: []PGColumn{{Name: "id"}}},
+		{Columns: []PGColumn{{Name: "id", Type: "bigint"}, {Name: "id", Type: "text"}}},
+		{Columns: []PGColumn{{Name: "id", Type: "bigint"}}, PrimaryKey: []string{"code"}},
+		{Constraints: []PGConstraint{{Name: "orders_check"}}},
+		{Indexes: []PGIndex{{Name: "orders_idx"}}},
+		{Partition: &PGPartition{Type: "interval", Columns: []string{"id"}}},
+		{Partition: &PGPartition{Type: "hash", Columns: []string{"id"}, Partitions: []PGPartitionBound{{Name: "orders_0"}}}},
+	}
+	for _, metadata := range invalidTables {
+		err := validator(models.ResourceSpec{Name: "sales.orders", Spec: PGTable{Metadata: metadata}})
+		assert.NotNil(t, err, metadata)
+	}
+}
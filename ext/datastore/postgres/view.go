@@ -0,0 +1,200 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	viewQuery = `SELECT c.relkind, pg_get_viewdef(c.oid, true), COALESCE(obj_description(c.oid, 'pg_class'), '')
+FROM pg_class c JOIN pg_namespace n ON n.oid = c.relnamespace
+WHERE n.nspname = $1 AND c.relname = $2 AND c.relkind IN ('v', 'm')`
+
+	// temporary view used to deparse queries of spec the way postgres
+	// stores them
+	probeViewName = "optimus_view_probe"
+)
+
+var (
+	viewRelkinds = map[string]models.ResourceType{
+		"v": models.ResourceTypeView,
+		"m": models.ResourceTypeMaterializedView,
+	}
+)
+
+func createView(ctx context.Context, spec models.ResourceSpec, db *sql.DB, upsert bool) error {
+	pgResource, ok := spec.Spec.(PGView)
+	if !ok {
+		return errors.New("failed to read view spec for postgres")
+	}
+	query, err := viewQueryOf(spec)
+	if err != nil {
+		return err
+	}
+
+	return withTx(ctx, db, func(tx *sql.Tx) error {
+		if !upsert {
+			if _, err := readView(ctx, tx, pgResource); err == nil {
+				return nil
+			} else if !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+		}
+		return execStatements(ctx, tx, []string{
+			fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", pgResource.FullyQualifiedName(), query),
+			viewComment("VIEW", pgResource),
+		})
+	})
+}
+
+// createMaterializedView recreates materialized view only if its query
+// changed, as postgres can't alter query of a materialized view in place
+func createMaterializedView(ctx context.Context, spec models.ResourceSpec, db *sql.DB, upsert bool) error {
+	pgResource, ok := spec.Spec.(PGView)
+	if !ok {
+		return errors.New("failed to read materialized view spec for postgres")
+	}
+	query, err := viewQueryOf(spec)
+	if err != nil {
+		return err
+	}
+
+	return withTx(ctx, db, func(tx *sql.Tx) error {
+		current, err := readView(ctx, tx, pgResource)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		exists := err == nil
+		if exists && !upsert {
+			return nil
+		}
+
+		var statements []string
+		if exists {
+			if current.kind != models.ResourceTypeMaterializedView {
+				return fmt.Errorf("%s exists as a %s", pgResource.View, current.kind)
+			}
+			desiredQuery, err := deparseQuery(ctx, tx, query)
+			if err != nil {
+				return err
+			}
+			if desiredQuery != current.query {
+				statements = append(statements, fmt.Sprintf("DROP MATERIALIZED VIEW %s", pgResource.FullyQualifiedName()))
+				exists = false
+			}
+		}
+		if !exists {
+			statement := fmt.Sprintf("CREATE MATERIALIZED VIEW %s AS %s", pgResource.FullyQualifiedName(), query)
+			if pgResource.Metadata.WithNoData {
+				statement += " WITH NO DATA"
+			}
+			statements = append(statements, statement)
+		}
+		statements = append(statements, viewComment("MATERIALIZED VIEW", pgResource))
+		return execStatements(ctx, tx, statements)
+	})
+}
+
+// getView reads view from postgres, query of spec is kept as its asset if
+// postgres deparses it the same as the query of view
+func getView(ctx context.Context, spec models.ResourceSpec, db *sql.DB) (models.ResourceSpec, error) {
+	pgResource, ok := spec.Spec.(PGView)
+	if !ok {
+		return models.ResourceSpec{}, errors.New("failed to read view spec for postgres")
+	}
+	queryFile := viewQueryFileOf(spec.Type)
+
+	err := withTx(ctx, db, func(tx *sql.Tx) error {
+		current, err := readView(ctx, tx, pgResource)
+		if err != nil {
+			return err
+		}
+		query := current.query
+		if specQuery, err := viewQueryOf(spec); err == nil {
+			// a query failing to deparse differs anyway
+			if desiredQuery, err := deparseQuery(ctx, tx, specQuery); err == nil && desiredQuery == current.query {
+				query = spec.Assets[queryFile]
+			}
+		}
+
+		pgResource.Metadata.Comment = current.comment
+		spec.Type = current.kind
+		spec.Assets = models.ResourceAssets{queryFile: query}
+		return nil
+	})
+	if err != nil {
+		return models.ResourceSpec{}, err
+	}
+	spec.Spec = pgResource
+	return spec, nil
+}
+
+// viewInfo is a view as stored in catalog, query is deparsed by postgres
+type viewInfo struct {
+	kind    models.ResourceType
+	query   string
+	comment string
+}
+
+// readView reads view from catalog, returns sql.ErrNoRows if view doesn't exist
+func readView(ctx context.Context, q querier, pgResource PGView) (viewInfo, error) {
+	var relkind string
+	var info viewInfo
+	if err := q.QueryRowContext(ctx, viewQuery, pgResource.Schema, pgResource.View).
+		Scan(&relkind, &info.query, &info.comment); err != nil {
+		return viewInfo{}, err
+	}
+	info.kind = viewRelkinds[relkind]
+	return info, nil
+}
+
+// deparseQuery returns query the way postgres stores it by creating a
+// temporary view of it in the transaction, an invalid query is rolled back
+// to a savepoint keeping the transaction usable
+func deparseQuery(ctx context.Context, tx *sql.Tx, query string) (string, error) {
+	probe := pq.QuoteIdentifier(probeViewName)
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT "+probe); err != nil {
+		return "", err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("CREATE OR REPLACE TEMPORARY VIEW %s AS %s", probe, query)); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+probe); rollbackErr != nil {
+			return "", rollbackErr
+		}
+		return "", errors.Wrap(err, "failed to parse view query")
+	}
+	var deparsed string
+	if err := tx.QueryRowContext(ctx, fmt.Sprintf("SELECT pg_get_viewdef('%s'::regclass, true)", probe)).Scan(&deparsed); err != nil {
+		return "", err
+	}
+	_, err := tx.ExecContext(ctx, fmt.Sprintf("DROP VIEW %s", probe))
+	return deparsed, err
+}
+
+func viewQueryFileOf(resourceType models.ResourceType) string {
+	if resourceType == models.ResourceTypeMaterializedView {
+		return MaterializedViewQueryFile
+	}
+	return ViewQueryFile
+}
+
+// viewQueryOf reads query of view from its asset without a trailing semicolon
+func viewQueryOf(spec models.ResourceSpec) (string, error) {
+	queryFile := viewQueryFileOf(spec.Type)
+	query, ok := spec.Assets.GetByName(queryFile)
+	if !ok || strings.TrimSpace(query) == "" {
+		return "", fmt.Errorf("%s asset with view query is required", queryFile)
+	}
+	return strings.TrimSuffix(strings.TrimSpace(query), ";"), nil
+}
+
+func viewComment(kind string, pgResource PGView) string {
+	return fmt.Sprintf("COMMENT ON %s %s IS %s", kind, pgResource.FullyQualifiedName(),
+		commentLiteral(pgResource.Metadata.Comment))
+}
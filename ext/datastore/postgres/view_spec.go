@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v3"
+
+	v1 "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+)
+
+const (
+	ViewQueryFile             = "view.sql"
+	MaterializedViewQueryFile = "materialized_view.sql"
+)
+
+// ViewResourceSpec is how view or materialized view will be represented in yaml
+type ViewResourceSpec struct {
+	Version int
+	Name    string
+	Type    models.ResourceType
+	Spec    PGViewMetadata
+	Labels  map[string]string
+}
+
+// PGView is a specification for a Postgres view or materialized view,
+// query of view is kept as an asset
+type PGView struct {
+	Schema string
+	View   string
+
+	Metadata PGViewMetadata
+}
+
+// FullyQualifiedName returns quoted name of view to be used in statements
+func (v PGView) FullyQualifiedName() string {
+	return qualifiedName(v.Schema, v.View)
+}
+
+// PGViewMetadata holds configuration for a view
+type PGViewMetadata struct {
+	Comment string `yaml:",omitempty" json:"comment,omitempty"`
+
+	// materialized view is populated while creating unless set
+	WithNoData bool `yaml:"with_no_data,omitempty" json:"with_no_data,omitempty"`
+}
+
+// viewSpecHandler helps serializing/deserializing datastore resource for views
+type viewSpecHandler struct {
+}
+
+func (s viewSpecHandler) ToYaml(optResource models.ResourceSpec) ([]byte, error) {
+	if optResource.Spec == nil {
+		// usually happens when resource is requested to be created for the first time via optimus cli
+		optResource.Spec = PGView{}
+	}
+	spec, ok := optResource.Spec.(PGView)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+
+	yamlResource := ViewResourceSpec{
+		Version: optResource.Version,
+		Name:    optResource.Name,
+		Type:    optResource.Type,
+		Spec:    spec.Metadata,
+	}
+	if len(optResource.Labels) > 0 {
+		yamlResource.Labels = optResource.Labels
+	}
+	return yaml.Marshal(yamlResource)
+}
+
+func (s viewSpecHandler) FromYaml(b []byte) (models.ResourceSpec, error) {
+	var yamlResource ViewResourceSpec
+	if err := yaml.Unmarshal(b, &yamlResource); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	parsedViewName := tableNameParseRegex.FindStringSubmatch(yamlResource.Name)
+	if len(parsedViewName) < 3 {
+		return models.ResourceSpec{}, fmt.Errorf("invalid yamlResource name %s", yamlResource.Name)
+	}
+
+	optResource := models.ResourceSpec{
+		Version:   yamlResource.Version,
+		Name:      yamlResource.Name,
+		Type:      yamlResource.Type,
+		Datastore: This,
+		Spec: PGView{
+			Schema:   parsedViewName[1],
+			View:     parsedViewName[2],
+			Metadata: yamlResource.Spec,
+		},
+	}
+	if len(yamlResource.Labels) > 0 {
+		optResource.Labels = yamlResource.Labels
+	}
+	return optResource, nil
+}
+
+func (s viewSpecHandler) ToProtobuf(optResource models.ResourceSpec) ([]byte, error) {
+	pgResource, ok := optResource.Spec.(PGView)
+	if !ok {
+		return nil, errors.New("failed to convert resource, malformed spec")
+	}
+	return specToProtobuf(optResource, pgResource.Metadata)
+}
+
+func (s viewSpecHandler) FromProtobuf(b []byte) (models.ResourceSpec, error) {
+	protoSpec := &v1.ResourceSpecification{}
+	if err := proto.Unmarshal(b, protoSpec); err != nil {
+		return models.ResourceSpec{}, err
+	}
+
+	parsedViewName := tableNameParseRegex.FindStringSubmatch(protoSpec.Name)
+	if len(parsedViewName) < 3 {
+		return models.ResourceSpec{}, fmt.Errorf("invalid resource name %s", protoSpec.Name)
+	}
+
+	pgView := PGView{
+		Schema: parsedViewName[1],
+		View:   parsedViewName[2],
+	}
+	if err := specFromProtoStruct(protoSpec.Spec, &pgView.Metadata); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	return specFromProtobuf(protoSpec, pgView), nil
+}
+
+// viewSpec handles both views and materialized views which only differ in
+// the asset their query is kept in
+type viewSpec struct {
+	queryFile string
+}
+
+func (s viewSpec) Adapter() models.DatastoreSpecAdapter {
+	return &viewSpecHandler{}
+}
+
+func (s viewSpec) Validator() models.DatastoreSpecValidator {
+	return func(spec models.ResourceSpec) error {
+		if !tableNameParseRegex.MatchString(spec.Name) {
+			return fmt.Errorf("for example 'schema_name.view_name'")
+		}
+		return nil
+	}
+}
+
+func (s viewSpec) DefaultAssets() map[string]string {
+	return map[string]string{
+		s.queryFile: `-- view query goes here`,
+	}
+}
+
+// Diff compares spec of view with the one read from postgres, queries are
+// compared as deparsed by postgres
+func (s viewSpec) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return diffView(expected, actual)
+}
+
+// Dependencies of view are its schema and tables referenced in its query
+func (s viewSpec) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	return viewDependencies(spec)
+}
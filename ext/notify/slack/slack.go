@@ -12,6 +12,7 @@ import (
 	api "github.com/slack-go/slack"
 
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 )
 
 const (
@@ -32,6 +33,10 @@ type Notifier struct {
 	workerErrChan chan error
 
 	eventBatchInterval time.Duration
+
+	// optional, batches of a route are sent by one server at a time so
+	// servers sharing a database don't post to a route concurrently
+	locker store.KeyLocker
 }
 
 type route struct {
@@ -132,8 +137,10 @@ func buildMessageBlocks(events []event) []api.Block {
 	// core details related to event
 	for evtIdx, evt := range events {
 		fieldSlice := make([]*api.TextBlockObject, 0)
-		fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Job:*\n%s", evt.jobName), false, false))
-		fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Owner:*\n%s", evt.owner), false, false))
+		if evt.meta.Type != models.JobEventTypeResourceDrift {
+			fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Job:*\n%s", evt.jobName), false, false))
+			fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Owner:*\n%s", evt.owner), false, false))
+		}
 
 		switch evt.meta.Type {
 		case models.JobEventTypeSLAMiss:
@@ -178,6 +185,20 @@ func buildMessageBlocks(events []event) []api.Block {
 			if taskID, ok := evt.meta.Value["task_id"]; ok && taskID.GetStringValue() != "" {
 				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Task ID:*\n%s", taskID.GetStringValue()), false, false))
 			}
+		case models.JobEventTypeResourceDrift:
+			heading := api.NewTextBlockObject("plain_text",
+				fmt.Sprintf("[Resource] Drift Detected | %s/%s", evt.projectName, evt.namespaceName), true, false)
+			blocks = append(blocks, api.NewHeaderBlock(heading))
+
+			if resource, ok := evt.meta.Value["resource"]; ok && resource.GetStringValue() != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Resource:*\n%s", resource.GetStringValue()), false, false))
+			}
+			if datastore, ok := evt.meta.Value["datastore"]; ok && datastore.GetStringValue() != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Datastore:*\n%s", datastore.GetStringValue()), false, false))
+			}
+			if drift, ok := evt.meta.Value["drift"]; ok && drift.GetStringValue() != "" {
+				fieldSlice = append(fieldSlice, api.NewTextBlockObject("mrkdwn", fmt.Sprintf("*Drift:*\n%s", drift.GetStringValue()), false, false))
+			}
 		default:
 			// unknown event
 			continue
@@ -227,32 +248,19 @@ func buildMessageBlocks(events []event) []api.Block {
 func (s *Notifier) Worker(ctx context.Context) {
 	defer s.wg.Done()
 	for {
+		// take queued batches out, events keep being queued while they are sent
 		s.mu.Lock()
+		routeMsgBatch := s.routeMsgBatch
+		s.routeMsgBatch = map[route][]event{}
+		s.mu.Unlock()
+
 		// iterate over all queued routeMsgBatch and
-		for route, events := range s.routeMsgBatch {
+		for route, events := range routeMsgBatch {
 			if len(events) == 0 {
 				continue
 			}
-			var messageOptions []api.MsgOption
-			messageOptions = append(messageOptions, api.MsgOptionBlocks(buildMessageBlocks(events)...))
-			messageOptions = append(messageOptions, api.MsgOptionAsUser(true))
-
-			client := api.New(route.authToken, api.OptionAPIURL(s.slackUrl))
-			if _, _, _, err := client.SendMessage(route.receiverID,
-				messageOptions...,
-			); err != nil {
-				cleanedEvents := []event{}
-				for _, ev := range events {
-					ev.authToken = "*redacted*"
-					cleanedEvents = append(cleanedEvents, ev)
-				}
-				s.workerErrChan <- errors.Wrapf(err, "Worker_SendMessageContext: %v", cleanedEvents)
-			}
-
-			// clear events from map as they are processed
-			s.routeMsgBatch[route] = []event{}
+			s.send(route, events)
 		}
-		s.mu.Unlock()
 
 		select {
 		case <-ctx.Done():
@@ -265,18 +273,50 @@ func (s *Notifier) Worker(ctx context.Context) {
 	}
 }
 
+func (s *Notifier) send(route route, events []event) {
+	if s.locker != nil {
+		// batch is sent anyway if other servers hold the route for too long
+		lockCtx, cancel := context.WithTimeout(context.Background(), s.eventBatchInterval)
+		unlock, err := s.locker.Lock(lockCtx, "notify:slack:"+route.receiverID)
+		cancel()
+		if err != nil {
+			s.workerErrChan <- errors.Wrapf(err, "failed to wait for other servers sending to %s", route.receiverID)
+		} else {
+			defer unlock()
+		}
+	}
+
+	var messageOptions []api.MsgOption
+	messageOptions = append(messageOptions, api.MsgOptionBlocks(buildMessageBlocks(events)...))
+	messageOptions = append(messageOptions, api.MsgOptionAsUser(true))
+
+	client := api.New(route.authToken, api.OptionAPIURL(s.slackUrl))
+	if _, _, _, err := client.SendMessage(route.receiverID,
+		messageOptions...,
+	); err != nil {
+		cleanedEvents := []event{}
+		for _, ev := range events {
+			ev.authToken = "*redacted*"
+			cleanedEvents = append(cleanedEvents, ev)
+		}
+		s.workerErrChan <- errors.Wrapf(err, "Worker_SendMessageContext: %v", cleanedEvents)
+	}
+}
+
 func (s *Notifier) Close() error {
 	// drain batches
 	s.wg.Wait()
 	return nil
 }
 
-func NewNotifier(ctx context.Context, slackUrl string, eventBatchInterval time.Duration, errHandler func(error)) *Notifier {
+func NewNotifier(ctx context.Context, slackUrl string, eventBatchInterval time.Duration, errHandler func(error),
+	locker store.KeyLocker) *Notifier {
 	this := &Notifier{
 		slackUrl:           slackUrl,
 		routeMsgBatch:      map[route][]event{},
 		workerErrChan:      make(chan error, 0),
 		eventBatchInterval: eventBatchInterval,
+		locker:             locker,
 	}
 
 	this.wg.Add(1)
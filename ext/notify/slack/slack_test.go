@@ -14,8 +14,10 @@ import (
 
 	api "github.com/slack-go/slack"
 
+	"github.com/odpf/optimus/mock"
 	"github.com/odpf/optimus/models"
 	"github.com/stretchr/testify/assert"
+	tmock "github.com/stretchr/testify/mock"
 )
 
 func getTestUserProfile() api.UserProfile {
@@ -85,6 +87,7 @@ func TestSlack(t *testing.T) {
 			func(err error) {
 				sendErrors = append(sendErrors, err)
 			},
+			nil,
 		)
 		defer client.Close()
 		err := client.Notify(context.Background(), models.NotifyAttrs{
@@ -152,6 +155,11 @@ func TestSlack(t *testing.T) {
 			rw.Write(response)
 		})
 
+		// batches of a route are sent holding its lock
+		locker := new(mock.KeyLocker)
+		defer locker.AssertExpectations(t)
+		locker.On("Lock", tmock.Anything, "notify:slack:ABCD").Return(func() {}, nil)
+
 		ctx, cancel := context.WithCancel(context.Background())
 		var sendErrors []error
 		client := NewNotifier(
@@ -161,6 +169,7 @@ func TestSlack(t *testing.T) {
 			func(err error) {
 				sendErrors = append(sendErrors, err)
 			},
+			locker,
 		)
 
 		eventValues, _ := structpb.NewStruct(map[string]interface{}{
@@ -270,6 +279,51 @@ func TestBuildMessages(t *testing.T) {
             }
         ]
     }
+]`,
+		},
+		{
+			name: "should list drifted fields of resource_drift",
+			args: args{events: []event{
+				{
+					authToken:     "xx",
+					projectName:   "ss",
+					namespaceName: "bb",
+					meta: models.JobEvent{
+						Type: models.JobEventTypeResourceDrift,
+						Value: map[string]*structpb.Value{
+							"resource":  structpb.NewStringValue("proj.datas.t1"),
+							"datastore": structpb.NewStringValue("bigquery"),
+							"drift":     structpb.NewStringValue(`description: expected "a", found "b"`),
+						},
+					},
+				},
+			}},
+			want: `[
+    {
+        "type": "header",
+        "text": {
+            "type": "plain_text",
+            "text": "[Resource] Drift Detected | ss/bb",
+            "emoji": true
+        }
+    },
+    {
+        "type": "section",
+        "fields": [
+            {
+                "type": "mrkdwn",
+                "text": "*Resource:*\nproj.datas.t1"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Datastore:*\nbigquery"
+            },
+            {
+                "type": "mrkdwn",
+                "text": "*Drift:*\ndescription: expected \"a\", found \"b\""
+            }
+        ]
+    }
 ]`,
 		},
 	}
@@ -30,6 +30,7 @@ const (
 	baseLibFileName = "__lib.py"
 	dagStatusURL    = "api/experimental/dags/%s/dag_runs"
 	dagRunClearURL  = "clear&dag_id=%s&start_date=%s&end_date=%s"
+	healthURL       = "health"
 )
 
 type HTTPClient interface {
@@ -109,6 +110,28 @@ func (a *scheduler) migrateLibFileToWriter(ctx context.Context, objWriter store.
 	return
 }
 
+func (a *scheduler) Ping(ctx context.Context, projSpec models.ProjectSpec) error {
+	schdHost, ok := projSpec.Config[models.ProjectSchedulerHost]
+	if !ok {
+		return errors.Errorf("scheduler host not set for %s", projSpec.Name)
+	}
+	pingURL := fmt.Sprintf("%s/%s", strings.Trim(schdHost, "/"), healthURL)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, pingURL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", pingURL)
+	}
+
+	resp, err := a.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to reach airflow at %s", pingURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to reach airflow at %s: %d", pingURL, resp.StatusCode)
+	}
+	return nil
+}
+
 func (a *scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus,
 	error) {
 	schdHost, ok := projSpec.Config[models.ProjectSchedulerHost]
@@ -118,7 +141,7 @@ func (a *scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpe
 	schdHost = strings.Trim(schdHost, "/")
 
 	fetchURL := fmt.Sprintf(fmt.Sprintf("%s/%s", schdHost, dagStatusURL), jobName)
-	request, err := http.NewRequest(http.MethodGet, fetchURL, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fetchURL, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to build http request for %s", fetchURL)
 	}
@@ -186,7 +209,7 @@ func (a *scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobN
 		jobName,
 		startDate.In(utcTimezone).Format(airflowDateFormat),
 		endDate.In(utcTimezone).Format(airflowDateFormat))
-	request, err := http.NewRequest(http.MethodGet, clearDagRunURL, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, clearDagRunURL, nil)
 	if err != nil {
 		return errors.Wrapf(err, "failed to build http request for %s", clearDagRunURL)
 	}
@@ -33,6 +33,7 @@ const (
 	dagStatusBatchUrl = "api/v1/dags/~/dagRuns/list"
 	dagRunClearURL    = "api/v1/dags/%s/clearTaskInstances"
 	airflowDateFormat = "2006-01-02T15:04:05+00:00"
+	healthURL         = "health"
 )
 
 type HttpClient interface {
@@ -112,6 +113,28 @@ func (a *scheduler) migrateLibFileToWriter(ctx context.Context, objWriter store.
 	return
 }
 
+func (a *scheduler) Ping(ctx context.Context, projSpec models.ProjectSpec) error {
+	schdHost, ok := projSpec.Config[models.ProjectSchedulerHost]
+	if !ok {
+		return errors.Errorf("scheduler host not set for %s", projSpec.Name)
+	}
+	pingURL := fmt.Sprintf("%s/%s", strings.Trim(schdHost, "/"), healthURL)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, pingURL, nil)
+	if err != nil {
+		return errors.Wrapf(err, "failed to build http request for %s", pingURL)
+	}
+
+	resp, err := a.httpClient.Do(request)
+	if err != nil {
+		return errors.Wrapf(err, "failed to reach airflow at %s", pingURL)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to reach airflow at %s: %d", pingURL, resp.StatusCode)
+	}
+	return nil
+}
+
 func (a *scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus,
 	error) {
 	schdHost, ok := projSpec.Config[models.ProjectSchedulerHost]
@@ -125,7 +148,7 @@ func (a *scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpe
 	}
 
 	fetchURL := fmt.Sprintf(fmt.Sprintf("%s/%s", schdHost, dagStatusUrl), jobName)
-	request, err := http.NewRequest(http.MethodGet, fetchURL, nil)
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fetchURL, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to build http request for %s", fetchURL)
 	}
@@ -188,7 +211,7 @@ func (a *scheduler) Clear(ctx context.Context, projSpec models.ProjectSpec, jobN
 		fmt.Sprintf("%s/%s", schdHost, dagRunClearURL),
 		jobName)
 
-	request, err := http.NewRequest(http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
 	if err != nil {
 		return errors.Wrapf(err, "failed to build http request for %s", postURL)
 	}
@@ -236,7 +259,7 @@ func (a *scheduler) GetDagRunStatus(ctx context.Context, projSpec models.Project
 		"execution_date_lte": "%s"
 		}`, pageOffset, batchSize, jobName, startDate.UTC().Format(airflowDateFormat), endDate.UTC().Format(airflowDateFormat))
 		var jsonStr = []byte(dagRunBatchReq)
-		request, err := http.NewRequest(http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, postURL, bytes.NewBuffer(jsonStr))
 		if err != nil {
 			return nil, errors.Wrapf(err, "failed to build http request for %s", dagStatusBatchUrl)
 		}
@@ -291,7 +314,27 @@ func toJobStatus(dagRuns []map[string]interface{}, jobName string) ([]models.Job
 		jobStatus = append(jobStatus, models.JobStatus{
 			ScheduledAt: scheduledAt,
 			State:       models.JobStatusState(status["state"].(string)),
+			Duration:    toRunDuration(status),
 		})
 	}
 	return jobStatus, nil
 }
+
+// toRunDuration returns time taken by a finished dag run, zero if it is
+// still running or dates are missing
+func toRunDuration(dagRun map[string]interface{}) time.Duration {
+	startDate, ok1 := dagRun["start_date"].(string)
+	endDate, ok2 := dagRun["end_date"].(string)
+	if !ok1 || !ok2 {
+		return 0
+	}
+	startedAt, err := time.Parse(time.RFC3339Nano, startDate)
+	if err != nil {
+		return 0
+	}
+	endedAt, err := time.Parse(time.RFC3339Nano, endDate)
+	if err != nil || endedAt.Before(startedAt) {
+		return 0
+	}
+	return endedAt.Sub(startedAt)
+}
@@ -194,6 +194,53 @@ func TestAirflow2(t *testing.T) {
 			assert.NotNil(t, err)
 		})
 	})
+	t.Run("Ping", func(t *testing.T) {
+		host := "http://airflow.example.io/"
+		projSpec := models.ProjectSpec{
+			Name: "test-proj",
+			Config: map[string]string{
+				models.ProjectSchedulerHost: host,
+			},
+		}
+		t.Run("should succeed if scheduler health endpoint returns OK", func(t *testing.T) {
+			var requestedURL string
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					requestedURL = req.URL.String()
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte(`{}`))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client)
+			err := air.Ping(ctx, projSpec)
+
+			assert.Nil(t, err)
+			assert.Equal(t, "http://airflow.example.io/health", requestedURL)
+		})
+		t.Run("should fail if host fails to return OK", func(t *testing.T) {
+			client := &MockHttpClient{
+				DoFunc: func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusServiceUnavailable,
+						Body:       ioutil.NopCloser(bytes.NewReader([]byte("UNAVAILABLE"))),
+					}, nil
+				},
+			}
+
+			air := airflow2.NewScheduler(nil, client)
+			err := air.Ping(ctx, projSpec)
+
+			assert.NotNil(t, err)
+		})
+		t.Run("should fail if scheduler host is not set", func(t *testing.T) {
+			air := airflow2.NewScheduler(nil, nil)
+			err := air.Ping(ctx, models.ProjectSpec{Name: "test-proj"})
+			assert.NotNil(t, err)
+		})
+	})
 	t.Run("Clear", func(t *testing.T) {
 		host := "http://airflow.example.io"
 		startDate := "2021-05-20"
@@ -323,6 +370,7 @@ func TestAirflow2(t *testing.T) {
 				{
 					ScheduledAt: expectedExecutionTime0,
 					State:       models.JobStatusStateSuccess,
+					Duration:    time.Hour,
 				},
 				{
 					ScheduledAt: expectedExecutionTime1,
@@ -394,6 +442,7 @@ func TestAirflow2(t *testing.T) {
 				{
 					ScheduledAt: expectedExecutionTime0,
 					State:       models.JobStatusStateSuccess,
+					Duration:    time.Hour,
 				},
 				{
 					ScheduledAt: expectedExecutionTime1,
@@ -230,6 +230,11 @@ class SuperExternalTaskSensor(BaseSensorOperator):
 class OptimusAPIClient:
     def __init__(self, optimus_host):
         self.host = self._add_connection_adapter_if_absent(optimus_host)
+        # API token with deployer role in project, needed if optimus authenticates callers
+        self.headers = {}
+        auth_token = Variable.get("optimus_auth_token", default_var="")
+        if auth_token:
+            self.headers["Authorization"] = "Bearer " + auth_token
 
     def _add_connection_adapter_if_absent(self, host):
         if host.startswith("http://") or host.startswith("https://"):
@@ -242,7 +247,7 @@ class OptimusAPIClient:
             optimus_project=optimus_project,
             optimus_job=optimus_job,
         )
-        response = requests.get(url)
+        response = requests.get(url, headers=self.headers)
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -255,7 +260,7 @@ class OptimusAPIClient:
             window_offset=window_offset,
             window_truncate_upto=window_truncate_upto,
         )
-        response = requests.get(url)
+        response = requests.get(url, headers=self.headers)
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -268,7 +273,7 @@ class OptimusAPIClient:
             "instance_type": "TASK",
             "instance_name": "none"
         }
-        response = requests.post(url, data=json.dumps(request_data))
+        response = requests.post(url, data=json.dumps(request_data), headers=self.headers)
         self._raise_error_if_request_failed(response)
         return response.json()
 
@@ -282,7 +287,7 @@ class OptimusAPIClient:
         request_data = {
             "event": event
         }
-        response = requests.post(url, data=json.dumps(request_data))
+        response = requests.post(url, data=json.dumps(request_data), headers=self.headers)
         self._raise_error_if_request_failed(response)
         return response.json()
 
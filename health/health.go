@@ -0,0 +1,193 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	StatusOK     = "ok"
+	StatusFailed = "failed"
+
+	// shuttingDownCheck is reported by readiness once server is shutting down
+	shuttingDownCheck = "shutdown"
+)
+
+// Check fails when something server relies on is unhealthy
+type Check func(ctx context.Context) error
+
+// Result is the outcome of checks, keyed by check name
+type Result struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func (r Result) OK() bool {
+	return r.Status == StatusOK
+}
+
+// Checker runs liveness and readiness checks for http probes and the grpc
+// health service. Liveness checks are part of readiness as well, a server
+// isn't ready while it isn't live.
+type Checker struct {
+	healthpb.UnimplementedHealthServer
+
+	timeout  time.Duration
+	services map[string]bool
+
+	mu           sync.RWMutex
+	liveness     map[string]Check
+	readiness    map[string]Check
+	shuttingDown bool
+}
+
+// AddLivenessCheck adds a check failing of which needs server to be
+// restarted
+func (c *Checker) AddLivenessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.liveness[name] = check
+}
+
+// AddReadinessCheck adds a check failing of which keeps requests away from
+// server till it passes again
+func (c *Checker) AddReadinessCheck(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readiness[name] = check
+}
+
+// Shutdown fails readiness from now on, so no new requests are routed to
+// server while it drains
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shuttingDown = true
+}
+
+// Live runs liveness checks
+func (c *Checker) Live(ctx context.Context) Result {
+	c.mu.RLock()
+	checks := copyChecks(c.liveness)
+	c.mu.RUnlock()
+	return c.run(ctx, checks)
+}
+
+// Ready runs liveness and readiness checks
+func (c *Checker) Ready(ctx context.Context) Result {
+	c.mu.RLock()
+	checks := copyChecks(c.liveness)
+	for name, check := range c.readiness {
+		checks[name] = check
+	}
+	shuttingDown := c.shuttingDown
+	c.mu.RUnlock()
+
+	if shuttingDown {
+		return Result{
+			Status: StatusFailed,
+			Checks: map[string]string{shuttingDownCheck: "server is shutting down"},
+		}
+	}
+	return c.run(ctx, checks)
+}
+
+// run executes checks concurrently, each given the configured timeout
+func (c *Checker) run(ctx context.Context, checks map[string]Check) Result {
+	result := Result{
+		Status: StatusOK,
+		Checks: map[string]string{},
+	}
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func(name string, check Check) {
+			defer wg.Done()
+			checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			err := check(checkCtx)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				result.Status = StatusFailed
+				result.Checks[name] = err.Error()
+				return
+			}
+			result.Checks[name] = StatusOK
+		}(name, check)
+	}
+	wg.Wait()
+	return result
+}
+
+// LivenessHandler serves liveness, 503 is returned when a check fails
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeResult(w, c.Live(r.Context()))
+	})
+}
+
+// ReadinessHandler serves readiness, 503 is returned when a check fails
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeResult(w, c.Ready(r.Context()))
+	})
+}
+
+func writeResult(w http.ResponseWriter, result Result) {
+	w.Header().Set("Content-Type", "application/json")
+	if !result.OK() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	_ = json.NewEncoder(w).Encode(result)
+}
+
+// Check implements grpc health service, server as a whole and its services
+// are serving as long as server is ready. Watch isn't supported, as open
+// streams would hold back shutdown.
+func (c *Checker) Check(ctx context.Context, req *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if req.GetService() != "" && !c.services[req.GetService()] {
+		return nil, status.Errorf(codes.NotFound, "unknown service %s", req.GetService())
+	}
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if !c.Ready(ctx).OK() {
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	return &healthpb.HealthCheckResponse{
+		Status: servingStatus,
+	}, nil
+}
+
+func copyChecks(checks map[string]Check) map[string]Check {
+	copied := make(map[string]Check, len(checks))
+	for name, check := range checks {
+		copied[name] = check
+	}
+	return copied
+}
+
+// NewChecker constructs a checker giving each check timeout to finish,
+// services are names of grpc services health can be asked about
+func NewChecker(timeout time.Duration, services ...string) *Checker {
+	checker := &Checker{
+		timeout:   timeout,
+		services:  map[string]bool{},
+		liveness:  map[string]Check{},
+		readiness: map[string]Check{},
+	}
+	for _, service := range services {
+		checker.services[service] = true
+	}
+	return checker
+}
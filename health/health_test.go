@@ -0,0 +1,152 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/health"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestChecker(t *testing.T) {
+	ctx := context.Background()
+	passing := func(context.Context) error { return nil }
+	failing := func(context.Context) error { return errors.New("connection refused") }
+	service := "odpf.optimus.RuntimeService"
+
+	t.Run("Ready", func(t *testing.T) {
+		t.Run("should pass if all checks pass", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddLivenessCheck("plugins", passing)
+			checker.AddReadinessCheck("database", passing)
+
+			result := checker.Ready(ctx)
+
+			assert.True(t, result.OK())
+			assert.Equal(t, map[string]string{
+				"plugins":  health.StatusOK,
+				"database": health.StatusOK,
+			}, result.Checks)
+		})
+		t.Run("should fail if a liveness or readiness check fails", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddLivenessCheck("plugins", passing)
+			checker.AddReadinessCheck("database", failing)
+
+			result := checker.Ready(ctx)
+
+			assert.False(t, result.OK())
+			assert.Equal(t, "connection refused", result.Checks["database"])
+			assert.Equal(t, health.StatusOK, result.Checks["plugins"])
+		})
+		t.Run("should fail checks which don't finish in time", func(t *testing.T) {
+			checker := health.NewChecker(10 * time.Millisecond)
+			checker.AddReadinessCheck("scheduler", func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+
+			result := checker.Ready(ctx)
+
+			assert.False(t, result.OK())
+			assert.Equal(t, context.DeadlineExceeded.Error(), result.Checks["scheduler"])
+		})
+		t.Run("should fail once server is shutting down", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddReadinessCheck("database", passing)
+			checker.Shutdown()
+
+			result := checker.Ready(ctx)
+
+			assert.False(t, result.OK())
+			assert.Contains(t, result.Checks, "shutdown")
+		})
+	})
+	t.Run("Live", func(t *testing.T) {
+		t.Run("should only run liveness checks", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddLivenessCheck("plugins", passing)
+			checker.AddReadinessCheck("database", failing)
+			checker.Shutdown()
+
+			result := checker.Live(ctx)
+
+			assert.True(t, result.OK())
+			assert.Equal(t, map[string]string{"plugins": health.StatusOK}, result.Checks)
+		})
+	})
+	t.Run("ReadinessHandler", func(t *testing.T) {
+		t.Run("should respond with checks and status OK when ready", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddReadinessCheck("database", passing)
+
+			rec := httptest.NewRecorder()
+			checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			var result health.Result
+			assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, health.StatusOK, result.Status)
+			assert.Equal(t, health.StatusOK, result.Checks["database"])
+		})
+		t.Run("should respond with service unavailable when not ready", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddReadinessCheck("database", failing)
+
+			rec := httptest.NewRecorder()
+			checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+			var result health.Result
+			assert.Nil(t, json.Unmarshal(rec.Body.Bytes(), &result))
+			assert.Equal(t, health.StatusFailed, result.Status)
+		})
+	})
+	t.Run("LivenessHandler", func(t *testing.T) {
+		t.Run("should respond with service unavailable when a liveness check fails", func(t *testing.T) {
+			checker := health.NewChecker(time.Second)
+			checker.AddLivenessCheck("plugins", failing)
+
+			rec := httptest.NewRecorder()
+			checker.LivenessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+			assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		})
+	})
+	t.Run("Check", func(t *testing.T) {
+		t.Run("should report serving for server and its services when ready", func(t *testing.T) {
+			checker := health.NewChecker(time.Second, service)
+			checker.AddReadinessCheck("database", passing)
+
+			for _, name := range []string{"", service} {
+				resp, err := checker.Check(ctx, &healthpb.HealthCheckRequest{Service: name})
+				assert.Nil(t, err)
+				assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.GetStatus())
+			}
+		})
+		t.Run("should report not serving when not ready", func(t *testing.T) {
+			checker := health.NewChecker(time.Second, service)
+			checker.AddReadinessCheck("database", failing)
+
+			resp, err := checker.Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+
+			assert.Nil(t, err)
+			assert.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, resp.GetStatus())
+		})
+		t.Run("should fail for unknown services", func(t *testing.T) {
+			checker := health.NewChecker(time.Second, service)
+
+			_, err := checker.Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+	})
+}
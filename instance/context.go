@@ -43,6 +43,7 @@ type ContextManager struct {
 // returns a map of env variables and a map[fileName]fileContent
 // It compiles any templates/macros present in the config.
 func (fm *ContextManager) Generate(
+	ctx context.Context,
 	instanceSpec models.InstanceSpec,
 	runType models.InstanceType,
 	runName string,
@@ -72,7 +73,7 @@ func (fm *ContextManager) Generate(
 
 	// do the same for asset files
 	// check if task needs to override the compilation behaviour
-	compiledAssetResponse, err := fm.jobSpec.Task.Unit.CLIMod.CompileAssets(context.Background(), models.CompileAssetsRequest{
+	compiledAssetResponse, err := fm.jobSpec.Task.Unit.CLIMod.CompileAssets(ctx, models.CompileAssetsRequest{
 		Window:           fm.jobSpec.Task.Window,
 		Config:           models.PluginConfigs{}.FromJobSpec(fm.jobSpec.Task.Config),
 		Assets:           models.PluginAssets{}.FromJobSpec(fm.jobSpec.Assets),
@@ -261,10 +262,10 @@ func MergeInterfaceMapToString(mp1, mp2 map[string]interface{}) (mp3 map[string]
 }
 
 // DumpAssets used for dry run and does not effect actual execution of a job
-func DumpAssets(jobSpec models.JobSpec, scheduledAt time.Time, engine models.TemplateEngine, allowOverride bool) (map[string]string, error) {
+func DumpAssets(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time, engine models.TemplateEngine, allowOverride bool) (map[string]string, error) {
 	var jobDestination string
 	if jobSpec.Task.Unit.DependencyMod != nil {
-		jobDestinationResponse, err := jobSpec.Task.Unit.DependencyMod.GenerateDestination(context.TODO(), models.GenerateDestinationRequest{
+		jobDestinationResponse, err := jobSpec.Task.Unit.DependencyMod.GenerateDestination(ctx, models.GenerateDestinationRequest{
 			Config: models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 			Assets: models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
 			PluginOptions: models.PluginOptions{
@@ -281,7 +282,7 @@ func DumpAssets(jobSpec models.JobSpec, scheduledAt time.Time, engine models.Tem
 
 	if allowOverride {
 		// check if task needs to override the compilation behaviour
-		compiledAssetResponse, err := jobSpec.Task.Unit.CLIMod.CompileAssets(context.TODO(), models.CompileAssetsRequest{
+		compiledAssetResponse, err := jobSpec.Task.Unit.CLIMod.CompileAssets(ctx, models.CompileAssetsRequest{
 			Window:           jobSpec.Task.Window,
 			Config:           models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 			Assets:           models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
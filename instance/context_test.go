@@ -116,7 +116,7 @@ func TestContextManager(t *testing.T) {
 				},
 			}
 
-			cliMod.On("CompileAssets", context.TODO(), models.CompileAssetsRequest{
+			cliMod.On("CompileAssets", context.Background(), models.CompileAssetsRequest{
 				Window:           jobSpec.Task.Window,
 				Config:           models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 				Assets:           models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
@@ -130,7 +130,7 @@ func TestContextManager(t *testing.T) {
 			}}, nil)
 
 			envMap, fileMap, err := instance.NewContextManager(namespaceSpec, jobSpec,
-				instance.NewGoEngine()).Generate(instanceSpec, models.InstanceTypeTask, "bq")
+				instance.NewGoEngine()).Generate(context.Background(), instanceSpec, models.InstanceTypeTask, "bq")
 			assert.Nil(t, err)
 
 			assert.Equal(t, "2020-11-11T00:00:00Z", envMap["DEND"])
@@ -270,7 +270,7 @@ func TestContextManager(t *testing.T) {
 					},
 				},
 			}
-			cliMod.On("CompileAssets", context.TODO(), models.CompileAssetsRequest{
+			cliMod.On("CompileAssets", context.Background(), models.CompileAssetsRequest{
 				Window:           jobSpec.Task.Window,
 				Config:           models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 				Assets:           models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
@@ -284,6 +284,7 @@ func TestContextManager(t *testing.T) {
 			}}, nil)
 
 			envMap, fileMap, err := instance.NewContextManager(namespaceSpec, jobSpec, instance.NewGoEngine()).Generate(
+				context.Background(),
 				instanceSpec, models.InstanceTypeHook, transporterHook)
 			assert.Nil(t, err)
 
@@ -405,7 +406,7 @@ func TestContextManager(t *testing.T) {
 				},
 			}
 
-			cliMod.On("CompileAssets", context.TODO(), models.CompileAssetsRequest{
+			cliMod.On("CompileAssets", context.Background(), models.CompileAssetsRequest{
 				Window:           jobSpec.Task.Window,
 				Config:           models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 				Assets:           models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
@@ -418,7 +419,7 @@ func TestContextManager(t *testing.T) {
 				},
 			}}, nil)
 
-			envMap, fileMap, err := instance.NewContextManager(namespaceSpec, jobSpec, instance.NewGoEngine()).Generate(instanceSpec, models.InstanceTypeTask, "bq")
+			envMap, fileMap, err := instance.NewContextManager(namespaceSpec, jobSpec, instance.NewGoEngine()).Generate(context.Background(), instanceSpec, models.InstanceTypeTask, "bq")
 			assert.Nil(t, err)
 
 			assert.Equal(t, "2020-11-11T00:00:00Z", envMap["DEND"])
@@ -28,18 +28,18 @@ type Service struct {
 	templateEngine models.TemplateEngine
 }
 
-func (s *Service) Compile(namespace models.NamespaceSpec, jobSpec models.JobSpec, instanceSpec models.InstanceSpec,
+func (s *Service) Compile(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec, instanceSpec models.InstanceSpec,
 	runType models.InstanceType, runName string) (envMap map[string]string, fileMap map[string]string, err error) {
 	return NewContextManager(
 		namespace, jobSpec, s.templateEngine).Generate(
-		instanceSpec, runType, runName,
+		ctx, instanceSpec, runType, runName,
 	)
 }
 
-func (s *Service) Register(jobSpec models.JobSpec, scheduledAt time.Time,
+func (s *Service) Register(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time,
 	instanceType models.InstanceType) (models.InstanceSpec, error) {
 	jobRunRepo := s.repoFac.New(jobSpec)
-	instanceToSave, err := s.PrepInstance(jobSpec, scheduledAt)
+	instanceToSave, err := s.PrepInstance(ctx, jobSpec, scheduledAt)
 	if err != nil {
 		return models.InstanceSpec{}, errors.Wrap(err, "failed to register instance")
 	}
@@ -77,10 +77,10 @@ func (s *Service) Register(jobSpec models.JobSpec, scheduledAt time.Time,
 	return instanceSpec, nil
 }
 
-func (s *Service) PrepInstance(jobSpec models.JobSpec, scheduledAt time.Time) (models.InstanceSpec, error) {
+func (s *Service) PrepInstance(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time) (models.InstanceSpec, error) {
 	var jobDestination string
 	if jobSpec.Task.Unit.DependencyMod != nil {
-		jobDestinationResponse, err := jobSpec.Task.Unit.DependencyMod.GenerateDestination(context.TODO(), models.GenerateDestinationRequest{
+		jobDestinationResponse, err := jobSpec.Task.Unit.DependencyMod.GenerateDestination(ctx, models.GenerateDestinationRequest{
 			Config: models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 			Assets: models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
 		})
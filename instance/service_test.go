@@ -21,7 +21,7 @@ func TestService(t *testing.T) {
 	execUnit := new(mock.BasePlugin)
 	execUnit.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq"}, nil)
 	depMod := new(mock.DependencyResolverMod)
-	depMod.On("GenerateDestination", context.TODO(), mock2.AnythingOfType("models.GenerateDestinationRequest")).Return(
+	depMod.On("GenerateDestination", context.Background(), mock2.AnythingOfType("models.GenerateDestinationRequest")).Return(
 		&models.GenerateDestinationResponse{Destination: "proj.data.tab"}, nil)
 	jobSpec := models.JobSpec{
 		Name:  "foo",
@@ -91,7 +91,7 @@ func TestService(t *testing.T) {
 
 			instanceService := instance.NewService(jobRunSpecRep, mockedTimeFunc, nil)
 
-			returnedInstanceSpec, err := instanceService.Register(jobSpec, scheduledAt, models.InstanceTypeTask)
+			returnedInstanceSpec, err := instanceService.Register(context.Background(), jobSpec, scheduledAt, models.InstanceTypeTask)
 			assert.Nil(t, err)
 			assert.Equal(t, instanceSpec, returnedInstanceSpec)
 		})
@@ -137,7 +137,7 @@ func TestService(t *testing.T) {
 
 			instanceService := instance.NewService(jobRunSpecRep, mockedTimeFunc, nil)
 
-			returnedInstanceSpec, err := instanceService.Register(jobSpec, scheduledAt, models.InstanceTypeHook)
+			returnedInstanceSpec, err := instanceService.Register(context.Background(), jobSpec, scheduledAt, models.InstanceTypeHook)
 			assert.Nil(t, err)
 			assert.Equal(t, returnedInstanceSpec, instanceSpec)
 		})
@@ -182,7 +182,7 @@ func TestService(t *testing.T) {
 
 			instanceService := instance.NewService(jobRunSpecRep, mockedTimeFunc, nil)
 
-			returnedInstanceSpec, err := instanceService.Register(jobSpec, scheduledAt, models.InstanceTypeHook)
+			returnedInstanceSpec, err := instanceService.Register(context.Background(), jobSpec, scheduledAt, models.InstanceTypeHook)
 			assert.Nil(t, err)
 			assert.Equal(t, returnedInstanceSpec, instanceSpec)
 		})
@@ -227,7 +227,7 @@ func TestService(t *testing.T) {
 
 			instanceService := instance.NewService(jobRunSpecRep, mockedTimeFunc, nil)
 
-			returnedInstanceSpec, err := instanceService.Register(jobSpec, scheduledAt, models.InstanceTypeTask)
+			returnedInstanceSpec, err := instanceService.Register(context.Background(), jobSpec, scheduledAt, models.InstanceTypeTask)
 			assert.Equal(t, "a random error", err.Error())
 			assert.Equal(t, models.InstanceSpec{}, returnedInstanceSpec)
 		})
@@ -244,7 +244,7 @@ func TestService(t *testing.T) {
 
 			instanceService := instance.NewService(jobRunSpecRep, mockedTimeFunc, nil)
 
-			returnedInstanceSpec, err := instanceService.Register(jobSpec, scheduledAt,
+			returnedInstanceSpec, err := instanceService.Register(context.Background(), jobSpec, scheduledAt,
 				models.InstanceTypeHook)
 			assert.Equal(t, "a random error", err.Error())
 			assert.Equal(t, models.InstanceSpec{}, returnedInstanceSpec)
@@ -257,10 +257,10 @@ func TestService(t *testing.T) {
 			srv := instance.NewService(nil, func() time.Time {
 				return time.Now().UTC()
 			}, nil)
-			prep1, err := srv.PrepInstance(jobSpec, scheduledAt)
+			prep1, err := srv.PrepInstance(context.Background(), jobSpec, scheduledAt)
 			assert.Nil(t, err)
 			time.Sleep(time.Second)
-			prep2, err := srv.PrepInstance(jobSpec, scheduledAt)
+			prep2, err := srv.PrepInstance(context.Background(), jobSpec, scheduledAt)
 			assert.Nil(t, err)
 			assert.NotEqual(t, prep1.Data, prep2.Data)
 		})
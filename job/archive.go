@@ -0,0 +1,88 @@
+package job
+
+import (
+	"context"
+	"sort"
+
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// GetIncomingDependencies returns dependencies of jobs outside of namespaces
+// on jobs of namespaces, dependent jobs are looked up in provided projects
+func (srv *Service) GetIncomingDependencies(ctx context.Context, namespaces []models.NamespaceSpec,
+	projects []models.ProjectSpec) ([]models.JobGraphEdge, error) {
+	inside := map[string]bool{}
+	for _, namespace := range namespaces {
+		jobSpecs, err := srv.jobSpecRepoFactory.New(namespace).GetAll()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to retrieve jobs of namespace %s", namespace.Name)
+		}
+		for _, jobSpec := range jobSpecs {
+			inside[models.JobGraphID(namespace.ProjectSpec.Name, jobSpec.Name)] = true
+		}
+	}
+	if len(inside) == 0 {
+		return nil, nil
+	}
+
+	var edges []models.JobGraphEdge
+	for _, proj := range projects {
+		projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(proj)
+		jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, proj, projectJobSpecRepo, nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to resolve dependencies of project %s", proj.Name)
+		}
+		for _, jobSpec := range jobSpecs {
+			jobID := models.JobGraphID(proj.Name, jobSpec.Name)
+			if inside[jobID] {
+				continue
+			}
+			for depName, dep := range jobSpec.Dependencies {
+				depProject := proj.Name
+				if dep.Project != nil {
+					depProject = dep.Project.Name
+				}
+				if dep.Job != nil {
+					depName = dep.Job.Name
+				}
+				depID := models.JobGraphID(depProject, depName)
+				if inside[depID] {
+					edges = append(edges, models.JobGraphEdge{
+						Upstream:   depID,
+						Downstream: jobID,
+						Type:       dep.Type,
+					})
+				}
+			}
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Downstream == edges[j].Downstream {
+			return edges[i].Upstream < edges[j].Upstream
+		}
+		return edges[i].Downstream < edges[j].Downstream
+	})
+	return edges, nil
+}
+
+// DeleteCompiled removes compiled jobs of namespace from the scheduler,
+// specifications are left untouched
+func (srv *Service) DeleteCompiled(ctx context.Context, namespace models.NamespaceSpec, progressObserver progress.Observer) error {
+	jobRepo, err := srv.jobRepoFactory.New(ctx, namespace.ProjectSpec)
+	if err != nil {
+		return err
+	}
+	jobNames, err := jobRepo.ListNames(ctx, namespace)
+	if err != nil {
+		return errors.Wrapf(err, "failed to list compiled jobs of namespace %s", namespace.Name)
+	}
+	for _, jobName := range jobNames {
+		if err := jobRepo.Delete(ctx, namespace, jobName); err != nil {
+			return errors.Wrapf(err, "failed to delete compiled job %s", jobName)
+		}
+		srv.notifyProgress(progressObserver, &EventJobRemoteDelete{jobName})
+	}
+	return nil
+}
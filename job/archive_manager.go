@@ -0,0 +1,379 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+// DefaultArchiveRetention is how long archived projects and namespaces can be
+// restored unless configured otherwise
+const DefaultArchiveRetention = 30 * 24 * time.Hour
+
+var (
+	// ErrNotArchived is returned when restoring a project or namespace which
+	// isn't archived
+	ErrNotArchived = errors.New("not archived")
+	// ErrArchiveExpired is returned when restoring a project or namespace
+	// after its retention period is over
+	ErrArchiveExpired = errors.New("retention period of archive is over")
+	// ErrArchiveRetained is returned when registering a name taken by a
+	// project or namespace archived within its retention period
+	ErrArchiveRetained = errors.New("archived, restore it instead")
+	// ErrJobsInUse is returned when archiving jobs other jobs depend on
+	// without forcing it
+	ErrJobsInUse = errors.New("jobs being deleted are still in use, force to delete anyway")
+)
+
+// ProjectRepoFactory is used to manage projects
+type ProjectRepoFactory interface {
+	New() store.ProjectRepository
+}
+
+type ArchiveManagerConfig struct {
+	// archived projects and namespaces can be restored for this long,
+	// defaults to DefaultArchiveRetention
+	Retention time.Duration
+}
+
+// ArchiveOptions control what is left behind when archiving
+type ArchiveOptions struct {
+	// archive even if jobs outside depend on archived jobs
+	Force bool
+	// drop resources from datastores, specs are archived either way
+	DropResources bool
+}
+
+// ArchiveManager archives projects and namespaces so they can be restored
+// within a retention period. Archived ones are purged lazily, when their name
+// is registered again after retention is over
+type ArchiveManager interface {
+	// ArchiveNamespace archives a namespace and then removes its jobs from the
+	// scheduler, observer is notified of removed jobs and resources. Returns
+	// till when the namespace can be restored
+	ArchiveNamespace(context.Context, models.NamespaceSpec, ArchiveOptions, progress.Observer) (time.Time, error)
+	// ArchiveProject archives a project with all of its namespaces
+	ArchiveProject(context.Context, models.ProjectSpec, ArchiveOptions, progress.Observer) (time.Time, error)
+	// RestoreNamespace brings back an archived namespace of project and
+	// queues deployment of its jobs
+	RestoreNamespace(context.Context, models.ProjectSpec, string) (models.Deployment, error)
+	// RestoreProject brings back an archived project and queues deployment
+	// of jobs of each of its namespaces
+	RestoreProject(context.Context, string) ([]models.Deployment, error)
+	// PurgeExpiredProject makes a project name available for registration,
+	// fails with ErrArchiveRetained if it is archived within retention
+	PurgeExpiredProject(string) error
+	// PurgeExpiredNamespace makes a namespace name of project available for
+	// registration, fails with ErrArchiveRetained if it is archived within
+	// retention
+	PurgeExpiredNamespace(models.ProjectSpec, string) error
+}
+
+type archiveManager struct {
+	jobService           models.JobService
+	resourceService      models.DatastoreService
+	dsRepo               models.DatastoreRepo
+	projectRepoFactory   ProjectRepoFactory
+	namespaceRepoFactory NamespaceRepoFactory
+	deployManager        DeployManager
+	locker               store.KeyLocker
+	config               ArchiveManagerConfig
+	now                  func() time.Time
+}
+
+func (m *archiveManager) ArchiveNamespace(ctx context.Context, namespace models.NamespaceSpec, opts ArchiveOptions,
+	obs progress.Observer) (time.Time, error) {
+	unlock, err := m.lockNamespaces(ctx, []models.NamespaceSpec{namespace})
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer unlock()
+
+	projects, err := m.projectRepoFactory.New().GetAll()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to retrieve projects")
+	}
+	namespaces := []models.NamespaceSpec{namespace}
+	if err := m.checkIncomingDependencies(ctx, namespaces, projects, opts.Force); err != nil {
+		return time.Time{}, err
+	}
+	resources, err := m.resourcesToDrop(namespaces, opts.DropResources)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := m.namespaceRepoFactory.New(namespace.ProjectSpec).Archive(namespace.Name); err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to archive namespace %s", namespace.Name)
+	}
+	restorableUntil := m.now().Add(m.retention())
+	if err := m.cleanUp(ctx, namespaces, resources, obs); err != nil {
+		return restorableUntil, errors.Wrapf(err, "namespace %s archived", namespace.Name)
+	}
+	return restorableUntil, nil
+}
+
+func (m *archiveManager) ArchiveProject(ctx context.Context, project models.ProjectSpec, opts ArchiveOptions,
+	obs progress.Observer) (time.Time, error) {
+	namespaces, err := m.namespaceRepoFactory.New(project).GetAll()
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to retrieve namespaces of project %s", project.Name)
+	}
+	unlock, err := m.lockNamespaces(ctx, namespaces)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer unlock()
+
+	projectRepo := m.projectRepoFactory.New()
+	allProjects, err := projectRepo.GetAll()
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to retrieve projects")
+	}
+	// jobs of the project can't be left depending on each other
+	var otherProjects []models.ProjectSpec
+	for _, proj := range allProjects {
+		if proj.Name != project.Name {
+			otherProjects = append(otherProjects, proj)
+		}
+	}
+	if err := m.checkIncomingDependencies(ctx, namespaces, otherProjects, opts.Force); err != nil {
+		return time.Time{}, err
+	}
+	resources, err := m.resourcesToDrop(namespaces, opts.DropResources)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := projectRepo.Archive(project.Name); err != nil {
+		return time.Time{}, errors.Wrapf(err, "failed to archive project %s", project.Name)
+	}
+	restorableUntil := m.now().Add(m.retention())
+	if err := m.cleanUp(ctx, namespaces, resources, obs); err != nil {
+		return restorableUntil, errors.Wrapf(err, "project %s archived", project.Name)
+	}
+	return restorableUntil, nil
+}
+
+func (m *archiveManager) RestoreNamespace(ctx context.Context, project models.ProjectSpec, name string) (models.Deployment, error) {
+	namespaceRepo := m.namespaceRepoFactory.New(project)
+	if err := m.checkRestorable(namespaceRepo, "namespace", name); err != nil {
+		return models.Deployment{}, err
+	}
+	if err := namespaceRepo.Restore(name); err != nil {
+		return models.Deployment{}, errors.Wrapf(err, "failed to restore namespace %s", name)
+	}
+
+	namespace, err := namespaceRepo.GetByName(name)
+	if err != nil {
+		return models.Deployment{}, errors.Wrapf(err, "failed to find restored namespace %s", name)
+	}
+	deployment, err := m.deploy(ctx, namespace)
+	if err != nil {
+		return models.Deployment{}, errors.Wrapf(err, "namespace %s restored", name)
+	}
+	return deployment, nil
+}
+
+func (m *archiveManager) RestoreProject(ctx context.Context, name string) ([]models.Deployment, error) {
+	projectRepo := m.projectRepoFactory.New()
+	if err := m.checkRestorable(projectRepo, "project", name); err != nil {
+		return nil, err
+	}
+	if err := projectRepo.Restore(name); err != nil {
+		return nil, errors.Wrapf(err, "failed to restore project %s", name)
+	}
+
+	project, err := projectRepo.GetByName(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find restored project %s", name)
+	}
+	namespaces, err := m.namespaceRepoFactory.New(project).GetAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "project %s restored but failed to retrieve its namespaces", name)
+	}
+	var deployments []models.Deployment
+	for _, namespace := range namespaces {
+		deployment, err := m.deploy(ctx, namespace)
+		if err != nil {
+			return deployments, errors.Wrapf(err, "project %s restored", name)
+		}
+		deployments = append(deployments, deployment)
+	}
+	return deployments, nil
+}
+
+func (m *archiveManager) PurgeExpiredProject(name string) error {
+	return m.purgeExpired(m.projectRepoFactory.New(), "project", name)
+}
+
+func (m *archiveManager) PurgeExpiredNamespace(project models.ProjectSpec, name string) error {
+	return m.purgeExpired(m.namespaceRepoFactory.New(project), "namespace", name)
+}
+
+// lockNamespaces waits till deployments of namespaces are over and keeps new
+// ones waiting till unlocked, namespaces are locked in order of their names
+// so archiving overlapping namespaces doesn't deadlock
+func (m *archiveManager) lockNamespaces(ctx context.Context, namespaces []models.NamespaceSpec) (func(), error) {
+	sorted := append([]models.NamespaceSpec(nil), namespaces...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	var unlocks []func()
+	unlockAll := func() {
+		for i := len(unlocks) - 1; i >= 0; i-- {
+			unlocks[i]()
+		}
+	}
+	for _, namespace := range sorted {
+		unlock, err := m.locker.Lock(ctx, deploymentLockKey(namespace.ID))
+		if err != nil {
+			unlockAll()
+			return nil, errors.Wrapf(err, "failed to wait for deployments of namespace %s", namespace.Name)
+		}
+		unlocks = append(unlocks, unlock)
+	}
+	return unlockAll, nil
+}
+
+// checkIncomingDependencies fails unless forced if jobs outside of namespaces
+// depend on jobs of namespaces
+func (m *archiveManager) checkIncomingDependencies(ctx context.Context, namespaces []models.NamespaceSpec,
+	projects []models.ProjectSpec, force bool) error {
+	if force {
+		return nil
+	}
+	edges, err := m.jobService.GetIncomingDependencies(ctx, namespaces, projects)
+	if err != nil {
+		return errors.Wrap(err, "failed to check dependent jobs")
+	}
+	if len(edges) == 0 {
+		return nil
+	}
+	var dependencies []string
+	for _, edge := range edges {
+		dependencies = append(dependencies, fmt.Sprintf("%s depends on %s", edge.Downstream, edge.Upstream))
+	}
+	return errors.Wrap(ErrJobsInUse, strings.Join(dependencies, "\n"))
+}
+
+// resourcesToDrop reads resources of namespaces before they are archived
+// along with their namespace
+func (m *archiveManager) resourcesToDrop(namespaces []models.NamespaceSpec, dropResources bool) (map[string][]models.ResourceSpec, error) {
+	resources := map[string][]models.ResourceSpec{}
+	if !dropResources {
+		return resources, nil
+	}
+	for _, namespace := range namespaces {
+		for _, ds := range m.dsRepo.GetAll() {
+			resourceSpecs, err := m.resourceService.GetAll(namespace, ds.Name())
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to retrieve resources of namespace %s", namespace.Name)
+			}
+			resources[namespace.Name] = append(resources[namespace.Name], resourceSpecs...)
+		}
+	}
+	return resources, nil
+}
+
+// cleanUp removes jobs of archived namespaces from the scheduler and drops
+// their resources from datastores
+func (m *archiveManager) cleanUp(ctx context.Context, namespaces []models.NamespaceSpec,
+	resources map[string][]models.ResourceSpec, obs progress.Observer) error {
+	for _, namespace := range namespaces {
+		if err := m.jobService.DeleteCompiled(ctx, namespace, obs); err != nil {
+			return errors.Wrapf(err, "failed to delete jobs of namespace %s", namespace.Name)
+		}
+		if len(resources[namespace.Name]) == 0 {
+			continue
+		}
+		if err := m.resourceService.DropResources(ctx, namespace, resources[namespace.Name], obs); err != nil {
+			return errors.Wrapf(err, "failed to delete resources of namespace %s", namespace.Name)
+		}
+	}
+	return nil
+}
+
+// deploy queues deployment of registered jobs of a restored namespace
+func (m *archiveManager) deploy(ctx context.Context, namespace models.NamespaceSpec) (models.Deployment, error) {
+	jobSpecs, err := m.jobService.GetAll(namespace)
+	if err != nil {
+		return models.Deployment{}, errors.Wrapf(err, "failed to retrieve jobs of namespace %s", namespace.Name)
+	}
+	deployment, err := m.deployManager.Deploy(ctx, namespace, jobSpecs, nil)
+	if err != nil {
+		return models.Deployment{}, errors.Wrapf(err, "failed to deploy jobs of namespace %s", namespace.Name)
+	}
+	return deployment, nil
+}
+
+// archiver is implemented by repositories of archivable entities
+type archiver interface {
+	GetArchivedAt(string) (time.Time, error)
+	Purge(string) error
+}
+
+// checkRestorable fails if named entity isn't archived or its retention
+// period is over
+func (m *archiveManager) checkRestorable(repo archiver, kind, name string) error {
+	archivedAt, err := repo.GetArchivedAt(name)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return errors.Wrapf(ErrNotArchived, "%s %s", kind, name)
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to find archived %s %s", kind, name)
+	}
+	if m.now().After(archivedAt.Add(m.retention())) {
+		return errors.Wrapf(ErrArchiveExpired, "%s %s was archived at %s", kind, name, archivedAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// purgeExpired makes a name available for registration, an archived entity
+// is purged if its retention period is over and rejected otherwise
+func (m *archiveManager) purgeExpired(repo archiver, kind, name string) error {
+	archivedAt, err := repo.GetArchivedAt(name)
+	if errors.Is(err, store.ErrResourceNotFound) {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to find archived %s %s", kind, name)
+	}
+	if !m.now().After(archivedAt.Add(m.retention())) {
+		return errors.Wrapf(ErrArchiveRetained, "%s %s", kind, name)
+	}
+	if err := repo.Purge(name); err != nil {
+		return errors.Wrapf(err, "failed to purge archived %s %s", kind, name)
+	}
+	return nil
+}
+
+func (m *archiveManager) retention() time.Duration {
+	if m.config.Retention > 0 {
+		return m.config.Retention
+	}
+	return DefaultArchiveRetention
+}
+
+// NewArchiveManager constructs an ArchiveManager, namespaces are locked with
+// the same keys deployManager uses so they aren't archived mid deployment
+func NewArchiveManager(jobService models.JobService, resourceService models.DatastoreService, dsRepo models.DatastoreRepo,
+	projectRepoFactory ProjectRepoFactory, namespaceRepoFactory NamespaceRepoFactory, deployManager DeployManager,
+	locker store.KeyLocker, config ArchiveManagerConfig, now func() time.Time) ArchiveManager {
+	return &archiveManager{
+		jobService:           jobService,
+		resourceService:      resourceService,
+		dsRepo:               dsRepo,
+		projectRepoFactory:   projectRepoFactory,
+		namespaceRepoFactory: namespaceRepoFactory,
+		deployManager:        deployManager,
+		locker:               locker,
+		config:               config,
+		now:                  now,
+	}
+}
@@ -0,0 +1,241 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+func TestArchiveManager(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "a-data-project",
+	}
+	otherProjectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "b-data-project",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "dev-team-1",
+		ProjectSpec: projectSpec,
+	}
+	archivedAt := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	config := job.ArchiveManagerConfig{Retention: 24 * time.Hour}
+	nowAt := func(at time.Time) func() time.Time {
+		return func() time.Time { return at }
+	}
+
+	t.Run("ArchiveNamespace", func(t *testing.T) {
+		t.Run("should archive the namespace before deleting its jobs and dropping its resources", func(t *testing.T) {
+			var calls []string
+			record := func(call string) func(testMock.Arguments) {
+				return func(testMock.Arguments) { calls = append(calls, call) }
+			}
+
+			locker := new(mock.KeyLocker)
+			locker.On("Lock", ctx, "deployment:"+namespaceSpec.ID.String()).Run(record("lock")).
+				Return(func() { calls = append(calls, "unlock") }, nil)
+			defer locker.AssertExpectations(t)
+
+			projectRepo := new(mock.ProjectRepository)
+			projectRepo.On("GetAll").Return([]models.ProjectSpec{projectSpec, otherProjectSpec}, nil)
+			defer projectRepo.AssertExpectations(t)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepo)
+
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("Archive", namespaceSpec.Name).Run(record("archive")).Return(nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			datastorer := new(mock.Datastorer)
+			datastorer.On("Name").Return("bigquery")
+			dsRepo := new(mock.SupportedDatastoreRepo)
+			dsRepo.On("GetAll").Return([]models.Datastorer{datastorer})
+
+			tableSpec := models.ResourceSpec{Name: "proj.datas.table"}
+			resourceSvc := new(mock.DatastoreService)
+			resourceSvc.On("GetAll", namespaceSpec, "bigquery").Run(record("read resources")).
+				Return([]models.ResourceSpec{tableSpec}, nil)
+			resourceSvc.On("DropResources", ctx, namespaceSpec, []models.ResourceSpec{tableSpec}, nil).
+				Run(record("drop resources")).Return(nil)
+			defer resourceSvc.AssertExpectations(t)
+
+			jobSvc := new(mock.JobService)
+			jobSvc.On("GetIncomingDependencies", ctx, []models.NamespaceSpec{namespaceSpec},
+				[]models.ProjectSpec{projectSpec, otherProjectSpec}).Return([]models.JobGraphEdge(nil), nil)
+			jobSvc.On("DeleteCompiled", ctx, namespaceSpec, nil).Run(record("delete jobs")).Return(nil)
+			defer jobSvc.AssertExpectations(t)
+
+			manager := job.NewArchiveManager(jobSvc, resourceSvc, dsRepo, projectRepoFac, namespaceRepoFac, nil,
+				locker, config, nowAt(archivedAt))
+			restorableUntil, err := manager.ArchiveNamespace(ctx, namespaceSpec, job.ArchiveOptions{DropResources: true}, nil)
+			assert.Nil(t, err)
+			assert.Equal(t, archivedAt.Add(24*time.Hour), restorableUntil)
+			assert.Equal(t, []string{"lock", "read resources", "archive", "delete jobs", "drop resources", "unlock"}, calls)
+		})
+		t.Run("should not archive a namespace whose jobs are used by other jobs", func(t *testing.T) {
+			locker := new(mock.KeyLocker)
+			locker.On("Lock", ctx, "deployment:"+namespaceSpec.ID.String()).Return(func() {}, nil)
+
+			projectRepo := new(mock.ProjectRepository)
+			projectRepo.On("GetAll").Return([]models.ProjectSpec{projectSpec}, nil)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepo)
+
+			// nothing is archived
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			defer namespaceRepoFac.AssertExpectations(t)
+
+			jobSvc := new(mock.JobService)
+			jobSvc.On("GetIncomingDependencies", ctx, []models.NamespaceSpec{namespaceSpec},
+				[]models.ProjectSpec{projectSpec}).Return([]models.JobGraphEdge{
+				{Upstream: "a-data-project/job-a", Downstream: "a-data-project/job-b", Type: models.JobSpecDependencyTypeIntra},
+			}, nil)
+			defer jobSvc.AssertExpectations(t)
+
+			manager := job.NewArchiveManager(jobSvc, nil, nil, projectRepoFac, namespaceRepoFac, nil,
+				locker, config, nowAt(archivedAt))
+			_, err := manager.ArchiveNamespace(ctx, namespaceSpec, job.ArchiveOptions{}, nil)
+			assert.True(t, errors.Is(err, job.ErrJobsInUse))
+			assert.Contains(t, err.Error(), "a-data-project/job-b depends on a-data-project/job-a")
+		})
+		t.Run("should not archive a namespace while it is being deployed", func(t *testing.T) {
+			locker := new(mock.KeyLocker)
+			locker.On("Lock", ctx, "deployment:"+namespaceSpec.ID.String()).Return(nil, context.DeadlineExceeded)
+
+			manager := job.NewArchiveManager(nil, nil, nil, nil, nil, nil, locker, config, nowAt(archivedAt))
+			_, err := manager.ArchiveNamespace(ctx, namespaceSpec, job.ArchiveOptions{}, nil)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		})
+	})
+	t.Run("ArchiveProject", func(t *testing.T) {
+		t.Run("should lock every namespace and check dependents in other projects", func(t *testing.T) {
+			otherNamespaceSpec := models.NamespaceSpec{
+				ID:          uuid.Must(uuid.NewRandom()),
+				Name:        "dev-team-2",
+				ProjectSpec: projectSpec,
+			}
+
+			locker := new(mock.KeyLocker)
+			locker.On("Lock", ctx, "deployment:"+namespaceSpec.ID.String()).Return(func() {}, nil)
+			locker.On("Lock", ctx, "deployment:"+otherNamespaceSpec.ID.String()).Return(func() {}, nil)
+			defer locker.AssertExpectations(t)
+
+			projectRepo := new(mock.ProjectRepository)
+			projectRepo.On("GetAll").Return([]models.ProjectSpec{projectSpec, otherProjectSpec}, nil)
+			projectRepo.On("Archive", projectSpec.Name).Return(nil)
+			defer projectRepo.AssertExpectations(t)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepo)
+
+			namespaces := []models.NamespaceSpec{otherNamespaceSpec, namespaceSpec}
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetAll").Return(namespaces, nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			jobSvc := new(mock.JobService)
+			jobSvc.On("GetIncomingDependencies", ctx, namespaces,
+				[]models.ProjectSpec{otherProjectSpec}).Return([]models.JobGraphEdge(nil), nil)
+			jobSvc.On("DeleteCompiled", ctx, namespaceSpec, nil).Return(nil)
+			jobSvc.On("DeleteCompiled", ctx, otherNamespaceSpec, nil).Return(nil)
+			defer jobSvc.AssertExpectations(t)
+
+			manager := job.NewArchiveManager(jobSvc, nil, nil, projectRepoFac, namespaceRepoFac, nil,
+				locker, config, nowAt(archivedAt))
+			_, err := manager.ArchiveProject(ctx, projectSpec, job.ArchiveOptions{}, nil)
+			assert.Nil(t, err)
+		})
+	})
+	t.Run("RestoreNamespace", func(t *testing.T) {
+		t.Run("should restore the namespace and queue deployment of its jobs", func(t *testing.T) {
+			jobSpecs := []models.JobSpec{{Name: "job-a"}}
+			deployment := models.Deployment{ID: uuid.Must(uuid.NewRandom()), Namespace: namespaceSpec}
+
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetArchivedAt", namespaceSpec.Name).Return(archivedAt, nil)
+			namespaceRepo.On("Restore", namespaceSpec.Name).Return(nil)
+			namespaceRepo.On("GetByName", namespaceSpec.Name).Return(namespaceSpec, nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			jobSvc := new(mock.JobService)
+			jobSvc.On("GetAll", namespaceSpec).Return(jobSpecs, nil)
+			defer jobSvc.AssertExpectations(t)
+
+			deployManager := new(mock.DeployManager)
+			deployManager.On("Deploy", ctx, namespaceSpec, jobSpecs, nil).Return(deployment, nil)
+			defer deployManager.AssertExpectations(t)
+
+			manager := job.NewArchiveManager(jobSvc, nil, nil, nil, namespaceRepoFac, deployManager,
+				nil, config, nowAt(archivedAt.Add(time.Hour)))
+			restored, err := manager.RestoreNamespace(ctx, projectSpec, namespaceSpec.Name)
+			assert.Nil(t, err)
+			assert.Equal(t, deployment, restored)
+		})
+		t.Run("should not restore the namespace once retention period is over", func(t *testing.T) {
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetArchivedAt", namespaceSpec.Name).Return(archivedAt, nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			manager := job.NewArchiveManager(nil, nil, nil, nil, namespaceRepoFac, nil,
+				nil, config, nowAt(archivedAt.Add(25*time.Hour)))
+			_, err := manager.RestoreNamespace(ctx, projectSpec, namespaceSpec.Name)
+			assert.True(t, errors.Is(err, job.ErrArchiveExpired))
+		})
+		t.Run("should not restore a namespace which isn't archived", func(t *testing.T) {
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetArchivedAt", namespaceSpec.Name).Return(time.Time{}, store.ErrResourceNotFound)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			manager := job.NewArchiveManager(nil, nil, nil, nil, namespaceRepoFac, nil,
+				nil, config, nowAt(archivedAt))
+			_, err := manager.RestoreNamespace(ctx, projectSpec, namespaceSpec.Name)
+			assert.True(t, errors.Is(err, job.ErrNotArchived))
+		})
+	})
+	t.Run("PurgeExpiredNamespace", func(t *testing.T) {
+		t.Run("should purge a namespace archived beyond retention period", func(t *testing.T) {
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetArchivedAt", namespaceSpec.Name).Return(archivedAt, nil)
+			namespaceRepo.On("Purge", namespaceSpec.Name).Return(nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			manager := job.NewArchiveManager(nil, nil, nil, nil, namespaceRepoFac, nil,
+				nil, job.ArchiveManagerConfig{}, nowAt(archivedAt.Add(job.DefaultArchiveRetention+time.Hour)))
+			assert.Nil(t, manager.PurgeExpiredNamespace(projectSpec, namespaceSpec.Name))
+		})
+		t.Run("should reject a namespace archived within retention period", func(t *testing.T) {
+			namespaceRepo := new(mock.NamespaceRepository)
+			namespaceRepo.On("GetArchivedAt", namespaceSpec.Name).Return(archivedAt, nil)
+			defer namespaceRepo.AssertExpectations(t)
+			namespaceRepoFac := new(mock.NamespaceRepoFactory)
+			namespaceRepoFac.On("New", projectSpec).Return(namespaceRepo)
+
+			manager := job.NewArchiveManager(nil, nil, nil, nil, namespaceRepoFac, nil,
+				nil, config, nowAt(archivedAt.Add(time.Hour)))
+			err := manager.PurgeExpiredNamespace(projectSpec, namespaceSpec.Name)
+			assert.True(t, errors.Is(err, job.ErrArchiveRetained))
+		})
+	})
+}
@@ -0,0 +1,126 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+func TestGetIncomingDependencies(t *testing.T) {
+	dumpAssets := func(_ context.Context, jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
+		return jobSpec.Assets, nil
+	}
+
+	projSpec := models.ProjectSpec{
+		Name: "proj",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		Name:        "ns",
+		ProjectSpec: projSpec,
+	}
+
+	basePlugin := new(mock.BasePlugin)
+	basePlugin.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq"}, nil).Maybe()
+
+	newJobSpec := func(name string) models.JobSpec {
+		depMod := new(mock.DependencyResolverMod)
+		depMod.On("GenerateDestination", testMock.Anything, testMock.Anything).
+			Return(&models.GenerateDestinationResponse{Destination: "proj.ds." + name}, nil).Maybe()
+		depMod.On("GenerateDependencies", testMock.Anything, testMock.Anything).
+			Return(&models.GenerateDependenciesResponse{}, nil).Maybe()
+		return models.JobSpec{
+			Name: name,
+			Schedule: models.JobSpecSchedule{
+				StartDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Interval:  "@daily",
+			},
+			Task: models.JobSpecTask{
+				Unit: &models.Plugin{Base: basePlugin, DependencyMod: depMod},
+			},
+			Dependencies: map[string]models.JobSpecDependency{},
+		}
+	}
+
+	t.Run("should return dependencies of outside jobs on jobs of namespaces", func(t *testing.T) {
+		// job-a is deleted, job-b of another namespace depends on it
+		jobA := newJobSpec("job-a")
+		jobB := newJobSpec("job-b")
+		jobB.Dependencies["job-a"] = models.JobSpecDependency{Type: models.JobSpecDependencyTypeIntra}
+		jobC := newJobSpec("job-c")
+
+		jobSpecRepo := new(mock.JobSpecRepository)
+		jobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA}, nil)
+		defer jobSpecRepo.AssertExpectations(t)
+
+		jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+		jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+		defer jobSpecRepoFac.AssertExpectations(t)
+
+		projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB, jobC}, nil)
+		projectJobSpecRepo.On("GetByName", "job-a").Return(jobA, namespaceSpec, nil)
+		defer projectJobSpecRepo.AssertExpectations(t)
+
+		projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+		projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+		defer projJobSpecRepoFac.AssertExpectations(t)
+
+		svc := job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+		edges, err := svc.GetIncomingDependencies(context.Background(), []models.NamespaceSpec{namespaceSpec},
+			[]models.ProjectSpec{projSpec})
+		assert.Nil(t, err)
+		assert.Equal(t, []models.JobGraphEdge{
+			{Upstream: "proj/job-a", Downstream: "proj/job-b", Type: models.JobSpecDependencyTypeIntra},
+		}, edges)
+	})
+	t.Run("should not look up projects if namespaces have no jobs", func(t *testing.T) {
+		jobSpecRepo := new(mock.JobSpecRepository)
+		jobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+		defer jobSpecRepo.AssertExpectations(t)
+
+		jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+		jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+		defer jobSpecRepoFac.AssertExpectations(t)
+
+		projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+		defer projJobSpecRepoFac.AssertExpectations(t)
+
+		svc := job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, nil, nil, nil, projJobSpecRepoFac, nil)
+		edges, err := svc.GetIncomingDependencies(context.Background(), []models.NamespaceSpec{namespaceSpec},
+			[]models.ProjectSpec{projSpec})
+		assert.Nil(t, err)
+		assert.Empty(t, edges)
+	})
+}
+
+func TestDeleteCompiled(t *testing.T) {
+	projSpec := models.ProjectSpec{
+		Name: "proj",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		Name:        "ns",
+		ProjectSpec: projSpec,
+	}
+
+	t.Run("should delete all compiled jobs of namespace", func(t *testing.T) {
+		jobRepo := new(mock.JobRepository)
+		jobRepo.On("ListNames", testMock.Anything, namespaceSpec).Return([]string{"job-a", "job-b"}, nil)
+		jobRepo.On("Delete", testMock.Anything, namespaceSpec, "job-a").Return(nil)
+		jobRepo.On("Delete", testMock.Anything, namespaceSpec, "job-b").Return(nil)
+		defer jobRepo.AssertExpectations(t)
+
+		jobRepoFac := new(mock.JobRepoFactory)
+		jobRepoFac.On("New", testMock.Anything, projSpec).Return(jobRepo, nil)
+		defer jobRepoFac.AssertExpectations(t)
+
+		svc := job.NewService(nil, jobRepoFac, nil, nil, nil, nil, nil, nil, nil)
+		err := svc.DeleteCompiled(context.Background(), namespaceSpec, nil)
+		assert.Nil(t, err)
+	})
+}
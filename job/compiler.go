@@ -84,9 +84,11 @@ func (com *Compiler) Compile(namespaceSpec models.NamespaceSpec, jobSpec models.
 	}
 
 	return models.Job{
-		Name:        jobSpec.Name,
-		Contents:    buf.Bytes(),
-		NamespaceID: namespaceSpec.ID.String(),
+		Name:           jobSpec.Name,
+		Contents:       buf.Bytes(),
+		NamespaceID:    namespaceSpec.ID.String(),
+		Priority:       jobSpec.Task.Priority,
+		PriorityReason: jobSpec.Task.PriorityReason,
 	}, nil
 }
 
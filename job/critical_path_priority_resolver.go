@@ -0,0 +1,361 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kushsharma/parallel"
+	log "github.com/odpf/optimus/core/logger"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+const (
+	// JobLabelTier declares importance of a job, one of JobTierCritical,
+	// JobTierHigh, JobTierStandard or JobTierLow
+	JobLabelTier = "tier"
+
+	JobTierCritical = "critical"
+	JobTierHigh     = "high"
+	JobTierStandard = "standard"
+	JobTierLow      = "low"
+
+	// DefaultJobRunDuration is assumed for jobs which didn't finish any
+	// run recently
+	DefaultJobRunDuration = 10 * time.Minute
+
+	// runDurationHistory is how far back finished runs are used to find
+	// typical run duration of a job
+	runDurationHistory   = 14 * 24 * time.Hour
+	runDurationBatchSize = 100
+
+	// RunDurationRefreshInterval is how often run durations of jobs are
+	// fetched from the scheduler with RefreshRunDurations
+	RunDurationRefreshInterval = time.Hour
+
+	// slaWeight multiplies weight of jobs declaring a sla_miss notification
+	slaWeight = 2
+)
+
+var jobTierWeights = map[string]int{
+	JobTierCritical: 8,
+	JobTierHigh:     4,
+	JobTierStandard: 2,
+	JobTierLow:      1,
+}
+
+// criticalPathPriorityResolver gives higher weights to jobs having more run
+// time ahead of them till an important job. Importance of a job comes from its
+// tier label and if it declares an SLA, while run time is the average duration
+// of its recent successful runs on the scheduler.
+// eg, consider [dag1(10m) <- dag2(1h) <- dag3(5m, tier critical)] [dag4(2h)]
+// dag1 has 1h15m of critical work ahead of it and gets the highest weight
+// followed by dag2, while dag4, despite being a longer root job, gets less
+// as it feeds nothing important.
+// Run durations are fetched from the scheduler in background by a single
+// server with RefreshRunDurations and stored for all servers, Resolve only
+// reads stored ones, so jobs not refreshed yet get DefaultJobRunDuration.
+// Schedulers not reporting duration of runs, like airflow 1 whose dag runs API
+// lacks end date, get DefaultJobRunDuration for all jobs and weights then only
+// depend on importance of jobs and the shape of the graph.
+// Note: it's crucial that dependencies of all Jobs are already resolved
+type criticalPathPriorityResolver struct {
+	scheduler             models.SchedulerUnit
+	projectRepoFac        ProjectRepoFactory
+	projectJobSpecRepoFac ProjectJobSpecRepoFactory
+	runDurationRepoFac    RunDurationRepoFactory
+	now                   func() time.Time
+}
+
+// RunDurationRepoFactory is used to store run durations of jobs of a project
+type RunDurationRepoFactory interface {
+	New(proj models.ProjectSpec) store.JobRunDurationRepository
+}
+
+// NewCriticalPathPriorityResolver creates an instance of criticalPathPriorityResolver
+// reading run history of jobs of projects using it from the scheduler
+func NewCriticalPathPriorityResolver(scheduler models.SchedulerUnit, projectRepoFac ProjectRepoFactory,
+	projectJobSpecRepoFac ProjectJobSpecRepoFactory, runDurationRepoFac RunDurationRepoFactory,
+	now func() time.Time) *criticalPathPriorityResolver {
+	return &criticalPathPriorityResolver{
+		scheduler:             scheduler,
+		projectRepoFac:        projectRepoFac,
+		projectJobSpecRepoFac: projectJobSpecRepoFac,
+		runDurationRepoFac:    runDurationRepoFac,
+		now:                   now,
+	}
+}
+
+// criticalPath is the longest run time from a job till a target job
+// of a given importance, inclusive of both
+type criticalPath struct {
+	found    bool
+	duration time.Duration
+	target   string
+}
+
+// Resolve takes jobSpecs and returns them with resolved priorities
+func (a *criticalPathPriorityResolver) Resolve(ctx context.Context, proj models.ProjectSpec, jobSpecs []models.JobSpec) ([]models.JobSpec, error) {
+	specs := map[string]models.JobSpec{}
+	for _, spec := range jobSpecs {
+		specs[spec.Name] = spec
+	}
+
+	// downstream jobs within the project
+	dependents := map[string][]string{}
+	for _, spec := range jobSpecs {
+		for depName, dep := range spec.Dependencies {
+			if dep.Project != nil && dep.Project.Name != proj.Name {
+				continue
+			}
+			if dep.Job != nil {
+				depName = dep.Job.Name
+			}
+			if _, ok := specs[depName]; ok {
+				dependents[depName] = append(dependents[depName], spec.Name)
+			}
+		}
+	}
+	for name := range dependents {
+		sort.Strings(dependents[name])
+	}
+
+	durations := a.runDurations(proj, jobSpecs)
+	importance := map[string]int{}
+	var levels []int
+	for _, spec := range jobSpecs {
+		importance[spec.Name] = jobImportance(spec)
+		levels = append(levels, importance[spec.Name])
+	}
+	levels = uniqueInts(levels)
+
+	// score of a job is the heaviest critical path to any target, where
+	// path to a more important target counts more
+	scores := map[string]float64{}
+	paths := map[string]criticalPath{}
+	for _, level := range levels {
+		memo := map[string]criticalPath{}
+		inPath := map[string]bool{}
+		var longest func(name string) (criticalPath, error)
+		longest = func(name string) (criticalPath, error) {
+			if path, ok := memo[name]; ok {
+				return path, nil
+			}
+			if inPath[name] {
+				return criticalPath{}, errors.Wrap(ErrCyclicDependency, name)
+			}
+			inPath[name] = true
+			defer delete(inPath, name)
+
+			best := criticalPath{}
+			if importance[name] == level {
+				best = criticalPath{found: true, target: name}
+			}
+			for _, dependent := range dependents[name] {
+				path, err := longest(dependent)
+				if err != nil {
+					return criticalPath{}, err
+				}
+				if path.found && (!best.found || path.duration > best.duration) {
+					best = path
+				}
+			}
+			if best.found {
+				best.duration += durations[name]
+			}
+			memo[name] = best
+			return best, nil
+		}
+
+		for _, spec := range jobSpecs {
+			path, err := longest(spec.Name)
+			if err != nil {
+				return nil, errors.Wrap(err, "error occurred while resolving priority")
+			}
+			if !path.found {
+				continue
+			}
+			if score := float64(level) * path.duration.Minutes(); score > scores[spec.Name] || !paths[spec.Name].found {
+				scores[spec.Name] = score
+				paths[spec.Name] = path
+			}
+		}
+	}
+
+	maxScore := 0.0
+	for _, score := range scores {
+		maxScore = math.Max(maxScore, score)
+	}
+	for idx, spec := range jobSpecs {
+		weight := MaxPriorityWeight
+		if maxScore > 0 {
+			weight = MinPriorityWeight + int(math.Round(float64(MaxPriorityWeight-MinPriorityWeight)*scores[spec.Name]/maxScore))
+		}
+		path := paths[spec.Name]
+		target := specs[path.target]
+
+		spec.Task.Priority = weight
+		spec.Task.PriorityReason = fmt.Sprintf("%s: %s of runs ahead till %s (%s)", PriorityResolverCriticalPath,
+			path.duration.Round(time.Second), path.target, describeImportance(target))
+		jobSpecs[idx] = spec
+	}
+	return jobSpecs, nil
+}
+
+// runDurations reads stored run durations of jobs, falling back to
+// DefaultJobRunDuration for jobs without one
+func (a *criticalPathPriorityResolver) runDurations(proj models.ProjectSpec, jobSpecs []models.JobSpec) map[string]time.Duration {
+	stored, err := a.runDurationRepoFac.New(proj).GetAll()
+	if err != nil {
+		log.W(errors.Wrapf(err, "failed to read run durations of project %s", proj.Name))
+	}
+
+	durations := map[string]time.Duration{}
+	for _, spec := range jobSpecs {
+		durations[spec.Name] = DefaultJobRunDuration
+		if duration, ok := stored[spec.Name]; ok {
+			durations[spec.Name] = duration
+		}
+	}
+	return durations
+}
+
+// RefreshRunDurations fetches run durations of jobs of every project using
+// critical path resolver from the scheduler and stores them
+func (a *criticalPathPriorityResolver) RefreshRunDurations(ctx context.Context) {
+	if a.scheduler == nil {
+		return
+	}
+	projects, err := a.projectRepoFac.New().GetAll()
+	if err != nil {
+		log.E(errors.Wrap(err, "failed to fetch projects to refresh run durations"))
+		return
+	}
+	for _, proj := range projects {
+		if proj.Config[models.ProjectPriorityResolver] != PriorityResolverCriticalPath {
+			continue
+		}
+		if err := a.refreshProjectRunDurations(ctx, proj); err != nil {
+			log.E(errors.Wrapf(err, "failed to refresh run durations of project %s", proj.Name))
+		}
+	}
+}
+
+// refreshProjectRunDurations averages duration of recent successful runs of
+// each job of project, jobs failing to be fetched keep their previous duration
+func (a *criticalPathPriorityResolver) refreshProjectRunDurations(ctx context.Context, proj models.ProjectSpec) error {
+	jobSpecs, err := a.projectJobSpecRepoFac.New(proj).GetAll()
+	if err != nil {
+		return errors.Wrap(err, "failed to fetch jobs")
+	}
+	repo := a.runDurationRepoFac.New(proj)
+	previous, err := repo.GetAll()
+	if err != nil {
+		return errors.Wrap(err, "failed to read previous run durations")
+	}
+
+	now := a.now()
+	startDate := now.Add(-runDurationHistory)
+	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
+	for _, spec := range jobSpecs {
+		runner.Add(func(jobName string) func() (interface{}, error) {
+			return func() (interface{}, error) {
+				runs, err := a.scheduler.GetDagRunStatus(ctx, proj, jobName, startDate, now, runDurationBatchSize)
+				if err != nil {
+					return jobRunDuration{name: jobName}, errors.Wrapf(err, "failed to fetch runs of %s", jobName)
+				}
+
+				var total time.Duration
+				var count int64
+				for _, run := range runs {
+					if run.State == models.JobStatusStateSuccess && run.Duration > 0 {
+						total += run.Duration
+						count++
+					}
+				}
+				if count == 0 {
+					return jobRunDuration{name: jobName, duration: DefaultJobRunDuration}, nil
+				}
+				return jobRunDuration{name: jobName, duration: time.Duration(int64(total) / count)}, nil
+			}
+		}(spec.Name))
+	}
+
+	durations := map[string]time.Duration{}
+	for _, state := range runner.Run() {
+		runDuration, ok := state.Val.(jobRunDuration)
+		if !ok {
+			continue
+		}
+		if state.Err != nil {
+			log.W(state.Err)
+			if duration, ok := previous[runDuration.name]; ok {
+				durations[runDuration.name] = duration
+			}
+			continue
+		}
+		durations[runDuration.name] = runDuration.duration
+	}
+	return repo.Save(durations)
+}
+
+type jobRunDuration struct {
+	name     string
+	duration time.Duration
+}
+
+// jobImportance combines tier and SLA of a job
+func jobImportance(spec models.JobSpec) int {
+	importance := jobTierWeights[jobTier(spec)]
+	if _, ok := jobSLA(spec); ok {
+		importance *= slaWeight
+	}
+	return importance
+}
+
+func jobTier(spec models.JobSpec) string {
+	tier := strings.ToLower(strings.TrimSpace(spec.Labels[JobLabelTier]))
+	if _, ok := jobTierWeights[tier]; !ok {
+		return JobTierStandard
+	}
+	return tier
+}
+
+// jobSLA is the duration of sla_miss notification declared by the job
+func jobSLA(spec models.JobSpec) (time.Duration, bool) {
+	for _, notify := range spec.Behavior.Notify {
+		if notify.On != models.JobEventTypeSLAMiss {
+			continue
+		}
+		if dur, err := time.ParseDuration(notify.Config["duration"]); err == nil {
+			return dur, true
+		}
+	}
+	return 0, false
+}
+
+func describeImportance(spec models.JobSpec) string {
+	description := "tier " + jobTier(spec)
+	if sla, ok := jobSLA(spec); ok {
+		description += ", sla " + sla.String()
+	}
+	return description
+}
+
+func uniqueInts(values []int) []int {
+	seen := map[int]bool{}
+	var unique []int
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	sort.Ints(unique)
+	return unique
+}
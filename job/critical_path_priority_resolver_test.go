@@ -0,0 +1,210 @@
+package job_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/core/logger"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCriticalPathPriorityResolver(t *testing.T) {
+	logger.InitWithWriter(logger.DEBUG, ioutil.Discard)
+	ctx := context.Background()
+	projSpec := models.ProjectSpec{
+		Name: "proj",
+	}
+
+	successfulRuns := func(durations ...time.Duration) []models.JobStatus {
+		var runs []models.JobStatus
+		for _, duration := range durations {
+			runs = append(runs, models.JobStatus{State: models.JobStatusStateSuccess, Duration: duration})
+		}
+		return runs
+	}
+	dependsOn := func(specs ...models.JobSpec) map[string]models.JobSpecDependency {
+		deps := map[string]models.JobSpecDependency{}
+		for i := range specs {
+			deps[specs[i].Name] = models.JobSpecDependency{Job: &specs[i], Project: &projSpec, Type: models.JobSpecDependencyTypeIntra}
+		}
+		return deps
+	}
+	weights := func(jobSpecs []models.JobSpec) map[string]int {
+		w := map[string]int{}
+		for _, spec := range jobSpecs {
+			w[spec.Name] = spec.Task.Priority
+		}
+		return w
+	}
+
+	storedDurations := func(durations map[string]time.Duration, err error) *mock.RunDurationRepoFactory {
+		repo := new(mock.JobRunDurationRepository)
+		repo.On("GetAll").Return(durations, err)
+		repoFac := new(mock.RunDurationRepoFactory)
+		repoFac.On("New", projSpec).Return(repo)
+		return repoFac
+	}
+
+	t.Run("should prioritise jobs with the longest run time ahead of important jobs", func(t *testing.T) {
+		// [dag1(10m) <- dag2(1h) <- dag3(5m, tier critical)] [dag4(2h)]
+		dag1 := models.JobSpec{Name: "dag1"}
+		dag2 := models.JobSpec{Name: "dag2", Dependencies: dependsOn(dag1)}
+		dag3 := models.JobSpec{Name: "dag3", Dependencies: dependsOn(dag2), Labels: map[string]string{
+			job.JobLabelTier: job.JobTierCritical,
+		}}
+		dag4 := models.JobSpec{Name: "dag4"}
+
+		runDurationRepoFac := storedDurations(map[string]time.Duration{
+			"dag1": 10 * time.Minute,
+			"dag2": time.Hour,
+			"dag3": 5 * time.Minute,
+			"dag4": 2 * time.Hour,
+		}, nil)
+		defer runDurationRepoFac.AssertExpectations(t)
+
+		resolver := job.NewCriticalPathPriorityResolver(nil, nil, nil, runDurationRepoFac, time.Now)
+		resolvedSpecs, err := resolver.Resolve(ctx, projSpec, []models.JobSpec{dag1, dag2, dag3, dag4})
+		assert.Nil(t, err)
+
+		w := weights(resolvedSpecs)
+		assert.Equal(t, job.MaxPriorityWeight, w["dag1"])
+		assert.Greater(t, w["dag2"], w["dag4"])
+		assert.Greater(t, w["dag4"], w["dag3"])
+		assert.GreaterOrEqual(t, w["dag3"], job.MinPriorityWeight)
+		assert.Equal(t, "critical_path: 1h15m0s of runs ahead till dag3 (tier critical)", resolvedSpecs[0].Task.PriorityReason)
+		assert.Equal(t, "critical_path: 2h0m0s of runs ahead till dag4 (tier standard)", resolvedSpecs[3].Task.PriorityReason)
+	})
+	t.Run("should weigh jobs declaring an sla over others and use default duration without stored ones", func(t *testing.T) {
+		dag1 := models.JobSpec{Name: "dag1"}
+		dag2 := models.JobSpec{Name: "dag2", Behavior: models.JobSpecBehavior{
+			Notify: []models.JobSpecNotifier{
+				{On: models.JobEventTypeSLAMiss, Config: map[string]string{"duration": "2h"}},
+			},
+		}}
+
+		runDurationRepoFac := storedDurations(nil, errors.New("database unreachable"))
+		defer runDurationRepoFac.AssertExpectations(t)
+
+		resolver := job.NewCriticalPathPriorityResolver(nil, nil, nil, runDurationRepoFac, time.Now)
+		resolvedSpecs, err := resolver.Resolve(ctx, projSpec, []models.JobSpec{dag1, dag2})
+		assert.Nil(t, err)
+
+		w := weights(resolvedSpecs)
+		assert.Equal(t, job.MaxPriorityWeight, w["dag2"])
+		assert.Equal(t, job.MaxPriorityWeight/2+1, w["dag1"])
+		assert.Equal(t, "critical_path: 10m0s of runs ahead till dag2 (tier standard, sla 2h0m0s)", resolvedSpecs[1].Task.PriorityReason)
+	})
+	t.Run("should fail for cyclic dependencies", func(t *testing.T) {
+		dag1 := models.JobSpec{Name: "dag1", Dependencies: map[string]models.JobSpecDependency{"dag2": {}}}
+		dag2 := models.JobSpec{Name: "dag2", Dependencies: map[string]models.JobSpecDependency{"dag1": {}}}
+
+		resolver := job.NewCriticalPathPriorityResolver(nil, nil, nil, storedDurations(map[string]time.Duration{}, nil), time.Now)
+		_, err := resolver.Resolve(ctx, projSpec, []models.JobSpec{dag1, dag2})
+		assert.True(t, errors.Is(err, job.ErrCyclicDependency))
+	})
+	t.Run("RefreshRunDurations", func(t *testing.T) {
+		t.Run("should store average duration of recent successful runs of projects using critical path", func(t *testing.T) {
+			criticalPathProj := models.ProjectSpec{
+				Name:   "proj",
+				Config: map[string]string{models.ProjectPriorityResolver: job.PriorityResolverCriticalPath},
+			}
+			depthProj := models.ProjectSpec{
+				Name: "other-proj",
+			}
+			now := time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)
+			startDate := now.Add(-14 * 24 * time.Hour)
+
+			projectRepo := new(mock.ProjectRepository)
+			projectRepo.On("GetAll").Return([]models.ProjectSpec{criticalPathProj, depthProj}, nil)
+			defer projectRepo.AssertExpectations(t)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			projectRepoFac.On("New").Return(projectRepo)
+			defer projectRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{{Name: "dag1"}, {Name: "dag2"}, {Name: "dag3"}}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+			projectJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projectJobSpecRepoFac.On("New", criticalPathProj).Return(projectJobSpecRepo)
+			defer projectJobSpecRepoFac.AssertExpectations(t)
+
+			scheduler := new(mock.Scheduler)
+			scheduler.On("GetDagRunStatus", ctx, criticalPathProj, "dag1", startDate, now, 100).
+				Return(append(successfulRuns(5*time.Minute, 15*time.Minute),
+					models.JobStatus{State: models.JobStatusStateFailed, Duration: time.Minute}), nil)
+			scheduler.On("GetDagRunStatus", ctx, criticalPathProj, "dag2", startDate, now, 100).
+				Return([]models.JobStatus{}, errors.New("scheduler unreachable"))
+			scheduler.On("GetDagRunStatus", ctx, criticalPathProj, "dag3", startDate, now, 100).
+				Return([]models.JobStatus{}, nil)
+			defer scheduler.AssertExpectations(t)
+
+			// dag2 keeps its previous duration as its runs can't be fetched
+			runDurationRepo := new(mock.JobRunDurationRepository)
+			runDurationRepo.On("GetAll").Return(map[string]time.Duration{"dag2": time.Hour, "removed": time.Hour}, nil)
+			runDurationRepo.On("Save", map[string]time.Duration{
+				"dag1": 10 * time.Minute,
+				"dag2": time.Hour,
+				"dag3": job.DefaultJobRunDuration,
+			}).Return(nil)
+			defer runDurationRepo.AssertExpectations(t)
+			runDurationRepoFac := new(mock.RunDurationRepoFactory)
+			runDurationRepoFac.On("New", criticalPathProj).Return(runDurationRepo)
+			defer runDurationRepoFac.AssertExpectations(t)
+
+			resolver := job.NewCriticalPathPriorityResolver(scheduler, projectRepoFac, projectJobSpecRepoFac, runDurationRepoFac,
+				func() time.Time { return now })
+			resolver.RefreshRunDurations(ctx)
+		})
+	})
+}
+
+func TestProjectPriorityResolver(t *testing.T) {
+	ctx := context.Background()
+	jobSpecs := []models.JobSpec{{Name: "dag1"}}
+
+	t.Run("should use resolver configured for the project", func(t *testing.T) {
+		projSpec := models.ProjectSpec{
+			Name:   "proj",
+			Config: map[string]string{models.ProjectPriorityResolver: job.PriorityResolverCriticalPath},
+		}
+		criticalPath := new(mock.PriorityResolver)
+		criticalPath.On("Resolve", ctx, projSpec, jobSpecs).Return(jobSpecs, nil)
+		defer criticalPath.AssertExpectations(t)
+
+		resolver := job.NewProjectPriorityResolver(map[string]job.PriorityResolver{
+			job.PriorityResolverDepth:        new(mock.PriorityResolver),
+			job.PriorityResolverCriticalPath: criticalPath,
+		})
+		_, err := resolver.Resolve(ctx, projSpec, jobSpecs)
+		assert.Nil(t, err)
+	})
+	t.Run("should use depth resolver when project doesn't configure one", func(t *testing.T) {
+		projSpec := models.ProjectSpec{
+			Name: "proj",
+		}
+		depth := new(mock.PriorityResolver)
+		depth.On("Resolve", ctx, projSpec, jobSpecs).Return(jobSpecs, nil)
+		defer depth.AssertExpectations(t)
+
+		resolver := job.NewProjectPriorityResolver(map[string]job.PriorityResolver{
+			job.PriorityResolverDepth: depth,
+		})
+		_, err := resolver.Resolve(ctx, projSpec, jobSpecs)
+		assert.Nil(t, err)
+	})
+	t.Run("should fail for unknown resolver", func(t *testing.T) {
+		projSpec := models.ProjectSpec{
+			Name:   "proj",
+			Config: map[string]string{models.ProjectPriorityResolver: "random"},
+		}
+		resolver := job.NewProjectPriorityResolver(map[string]job.PriorityResolver{})
+		_, err := resolver.Resolve(ctx, projSpec, jobSpecs)
+		assert.True(t, errors.Is(err, job.ErrUnknownPriorityResolver))
+	})
+}
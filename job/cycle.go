@@ -0,0 +1,176 @@
+package job
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+const (
+	// dependencySourceHook marks a hook depending on another hook of the same job
+	dependencySourceHook = "hook"
+)
+
+var (
+	// ErrCyclicDependency is returned when resolved dependencies of jobs
+	// or hooks form a cycle
+	ErrCyclicDependency = errors.New("cyclic dependency")
+)
+
+// dependencyEdge points from a node to the node it depends on
+type dependencyEdge struct {
+	from   string
+	to     string
+	source string
+}
+
+// dependencyCycle is a path of edges ending at the node it started from
+type dependencyCycle []dependencyEdge
+
+// String formats the cycle as a →(static) b →(inferred) a where an
+// arrow reads as "depends on"
+func (c dependencyCycle) String() string {
+	if len(c) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(c[0].from)
+	for _, edge := range c {
+		sb.WriteString(fmt.Sprintf(" →(%s) %s", edge.source, edge.to))
+	}
+	return sb.String()
+}
+
+// jobName is the job owning the first node of the cycle
+func (c dependencyCycle) jobName() string {
+	if len(c) == 0 {
+		return ""
+	}
+	return strings.SplitN(c[0].from, "/", 2)[0]
+}
+
+// involves is true if any node of the cycle belongs to one of the jobs
+func (c dependencyCycle) involves(jobNames map[string]bool) bool {
+	for _, edge := range c {
+		if jobNames[strings.SplitN(edge.from, "/", 2)[0]] {
+			return true
+		}
+	}
+	return false
+}
+
+// findDependencyCycles looks for cycles between dependency resolved jobs of a
+// project and between hooks of each job. Dependencies on jobs of other projects
+// are skipped as they can't close a cycle without a cross project deployment.
+func findDependencyCycles(projectName string, jobSpecs []models.JobSpec) []dependencyCycle {
+	var nodes []string
+	adjacency := map[string][]dependencyEdge{}
+	for _, spec := range jobSpecs {
+		nodes = append(nodes, spec.Name)
+		for depName, dep := range spec.Dependencies {
+			if dep.Type == models.JobSpecDependencyTypeInter || dep.Type == models.JobSpecDependencyTypeExtra ||
+				(dep.Project != nil && dep.Project.Name != projectName) {
+				continue
+			}
+			if dep.Job != nil {
+				depName = dep.Job.Name
+			}
+			source := dep.Source
+			if source == "" {
+				source = models.JobSpecDependencySourceStatic
+			}
+			adjacency[spec.Name] = append(adjacency[spec.Name], dependencyEdge{
+				from:   spec.Name,
+				to:     depName,
+				source: source.String(),
+			})
+		}
+
+		for _, hook := range spec.Hooks {
+			if hook.Unit == nil || hook.Unit.Base == nil {
+				continue
+			}
+			hookInfo := hook.Unit.Info()
+			hookID := hookNodeID(spec.Name, hookInfo.Name)
+			nodes = append(nodes, hookID)
+			for _, depHookName := range hookInfo.DependsOn {
+				if _, err := spec.GetHookByName(depHookName); err != nil {
+					// not used by this job
+					continue
+				}
+				adjacency[hookID] = append(adjacency[hookID], dependencyEdge{
+					from:   hookID,
+					to:     hookNodeID(spec.Name, depHookName),
+					source: dependencySourceHook,
+				})
+			}
+		}
+	}
+
+	// keep reported cycles stable across runs
+	sort.Strings(nodes)
+	for node := range adjacency {
+		edges := adjacency[node]
+		sort.Slice(edges, func(i, j int) bool {
+			return edges[i].to < edges[j].to
+		})
+	}
+
+	const (
+		unvisited = iota
+		inPath
+		visited
+	)
+	state := map[string]int{}
+	var path []dependencyEdge
+	var cycles []dependencyCycle
+
+	var visit func(node string)
+	visit = func(node string) {
+		state[node] = inPath
+		for _, edge := range adjacency[node] {
+			switch state[edge.to] {
+			case unvisited:
+				path = append(path, edge)
+				visit(edge.to)
+				path = path[:len(path)-1]
+			case inPath:
+				// walk back the current path till the node closing the cycle
+				start := len(path)
+				if edge.to != node {
+					for start > 0 && path[start-1].from != edge.to {
+						start--
+					}
+					start--
+				}
+				cycle := append(dependencyCycle{}, path[start:]...)
+				cycles = append(cycles, append(cycle, edge))
+			}
+		}
+		state[node] = visited
+	}
+	for _, node := range nodes {
+		if state[node] == unvisited {
+			visit(node)
+		}
+	}
+	return cycles
+}
+
+func cyclicDependencyError(cycles []dependencyCycle) error {
+	if len(cycles) == 0 {
+		return nil
+	}
+	var paths []string
+	for _, cycle := range cycles {
+		paths = append(paths, cycle.String())
+	}
+	return errors.Wrap(ErrCyclicDependency, strings.Join(paths, "; "))
+}
+
+func hookNodeID(jobName, hookName string) string {
+	return jobName + "/" + hookName
+}
@@ -0,0 +1,206 @@
+package job_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+func TestDependencyCycles(t *testing.T) {
+	ctx := context.Background()
+	dumpAssets := func(_ context.Context, jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
+		return jobSpec.Assets, nil
+	}
+
+	projSpec := models.ProjectSpec{
+		Name: "proj",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		Name:        "ns",
+		ProjectSpec: projSpec,
+	}
+
+	basePlugin := new(mock.BasePlugin)
+	basePlugin.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq"}, nil).Maybe()
+
+	newJobSpec := func(name, destination string, dependencies ...string) models.JobSpec {
+		depMod := new(mock.DependencyResolverMod)
+		depMod.On("GenerateDestination", testMock.Anything, testMock.Anything).
+			Return(&models.GenerateDestinationResponse{Destination: destination}, nil).Maybe()
+		depMod.On("GenerateDependencies", testMock.Anything, testMock.Anything).
+			Return(&models.GenerateDependenciesResponse{Dependencies: dependencies}, nil).Maybe()
+		return models.JobSpec{
+			Name: name,
+			Schedule: models.JobSpecSchedule{
+				StartDate: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				Interval:  "@daily",
+			},
+			Task: models.JobSpecTask{
+				Unit: &models.Plugin{Base: basePlugin, DependencyMod: depMod},
+			},
+			Dependencies: map[string]models.JobSpecDependency{},
+		}
+	}
+	newHook := func(name string, dependsOn ...string) models.JobSpecHook {
+		hookPlugin := new(mock.BasePlugin)
+		hookPlugin.On("PluginInfo").Return(&models.PluginInfoResponse{Name: name, DependsOn: dependsOn}, nil).Maybe()
+		return models.JobSpecHook{
+			Unit: &models.Plugin{Base: hookPlugin},
+		}
+	}
+
+	t.Run("Check", func(t *testing.T) {
+		t.Run("should report complete cycle path with source of each edge", func(t *testing.T) {
+			// job-a reads c, job-c reads b, job-b statically depends on job-a
+			jobA := newJobSpec("job-a", "proj.ds.a", "proj.ds.c")
+			jobB := newJobSpec("job-b", "proj.ds.b")
+			jobB.Dependencies["job-a"] = models.JobSpecDependency{Type: models.JobSpecDependencyTypeIntra}
+			jobC := newJobSpec("job-c", "proj.ds.c", "proj.ds.b")
+			// registered job-d is not part of the cycle
+			jobD := newJobSpec("job-d", "proj.ds.d", "proj.ds.a")
+
+			compiler := new(mock.Compiler)
+			compiler.On("Compile", namespaceSpec, testMock.Anything).Return(models.Job{}, nil)
+			defer compiler.AssertExpectations(t)
+
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobD}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			obs := new(checkFailureCollector)
+
+			service := job.NewService(jobSpecRepoFac, nil, compiler, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+			err := service.Check(context.Background(), namespaceSpec, []models.JobSpec{jobA, jobB, jobC}, obs)
+			assert.True(t, errors.Is(err, job.ErrCyclicDependency))
+			assert.Contains(t, err.Error(), "job-a →(inferred) job-c →(inferred) job-b →(static) job-a")
+
+			assert.Len(t, obs.failures, 1)
+			assert.Equal(t, "job-a", obs.failures[0].Name)
+			assert.Contains(t, obs.failures[0].Reason, "job-a →(inferred) job-c →(inferred) job-b →(static) job-a")
+		})
+		t.Run("should report cycle between hooks of a job", func(t *testing.T) {
+			jobA := newJobSpec("job-a", "proj.ds.a")
+			jobA.Hooks = []models.JobSpecHook{
+				newHook("transporter", "predator"),
+				newHook("predator", "transporter"),
+			}
+
+			compiler := new(mock.Compiler)
+			compiler.On("Compile", namespaceSpec, testMock.Anything).Return(models.Job{}, nil)
+			defer compiler.AssertExpectations(t)
+
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			service := job.NewService(jobSpecRepoFac, nil, compiler, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+			err := service.Check(context.Background(), namespaceSpec, []models.JobSpec{jobA}, nil)
+			assert.True(t, errors.Is(err, job.ErrCyclicDependency))
+			assert.Contains(t, err.Error(), "job-a/predator →(hook) job-a/transporter →(hook) job-a/predator")
+		})
+	})
+	t.Run("CheckDependencyCycles", func(t *testing.T) {
+		t.Run("should fail for deployment introducing a cycle with registered jobs", func(t *testing.T) {
+			// job-b in the namespace reads a, registered job-a reads b
+			jobA := newJobSpec("job-a", "proj.ds.a", "proj.ds.b")
+			jobB := newJobSpec("job-b", "proj.ds.b", "proj.ds.a")
+
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA}, nil)
+			projectJobSpecRepo.On("GetByDestination", "proj.ds.a").Return(jobA, projSpec, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			service := job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+			err := service.CheckDependencyCycles(ctx, namespaceSpec, []models.JobSpec{jobB})
+			assert.True(t, errors.Is(err, job.ErrCyclicDependency))
+			assert.Contains(t, err.Error(), "namespace ns: ")
+			assert.Contains(t, err.Error(), "job-a →(inferred) job-b →(inferred) job-a")
+		})
+		t.Run("should not fail for cycles between jobs of other namespaces", func(t *testing.T) {
+			jobA := newJobSpec("job-a", "proj.ds.a", "proj.ds.b")
+			jobB := newJobSpec("job-b", "proj.ds.b", "proj.ds.a")
+			jobC := newJobSpec("job-c", "proj.ds.c")
+
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB}, nil)
+			projectJobSpecRepo.On("GetByDestination", "proj.ds.a").Return(jobA, projSpec, nil)
+			projectJobSpecRepo.On("GetByDestination", "proj.ds.b").Return(jobB, projSpec, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			service := job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+			err := service.CheckDependencyCycles(ctx, namespaceSpec, []models.JobSpec{jobC})
+			assert.Nil(t, err)
+		})
+	})
+}
+
+// checkFailureCollector keeps check failures notified by a job service
+type checkFailureCollector struct {
+	mu       sync.Mutex
+	failures []*job.EventJobCheckFailed
+}
+
+func (c *checkFailureCollector) Notify(e progress.Event) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if failure, ok := e.(*job.EventJobCheckFailed); ok {
+		c.failures = append(c.failures, failure)
+	}
+}
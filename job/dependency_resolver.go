@@ -20,10 +20,10 @@ var (
 type dependencyResolver struct{}
 
 // Resolve resolves all kind of dependencies (inter/intra project, static deps) of a given JobSpec
-func (r *dependencyResolver) Resolve(projectSpec models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
+func (r *dependencyResolver) Resolve(ctx context.Context, projectSpec models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
 	jobSpec models.JobSpec, observer progress.Observer) (models.JobSpec, error) {
 	// resolve inter/intra dependencies inferred by optimus
-	jobSpec, err := r.resolveInferredDependencies(jobSpec, projectSpec, projectJobSpecRepo, observer)
+	jobSpec, err := r.resolveInferredDependencies(ctx, jobSpec, projectSpec, projectJobSpecRepo, observer)
 	if err != nil {
 		return models.JobSpec{}, err
 	}
@@ -43,12 +43,12 @@ func (r *dependencyResolver) Resolve(projectSpec models.ProjectSpec, projectJobS
 	return jobSpec, nil
 }
 
-func (r *dependencyResolver) resolveInferredDependencies(jobSpec models.JobSpec, projectSpec models.ProjectSpec,
+func (r *dependencyResolver) resolveInferredDependencies(ctx context.Context, jobSpec models.JobSpec, projectSpec models.ProjectSpec,
 	projectJobSpecRepo store.ProjectJobSpecRepository, observer progress.Observer) (models.JobSpec, error) {
 	// get destinations of dependencies, assets should be dependent on
 	var jobDependencies []string
 	if jobSpec.Task.Unit.DependencyMod != nil {
-		resp, err := jobSpec.Task.Unit.DependencyMod.GenerateDependencies(context.TODO(), models.GenerateDependenciesRequest{
+		resp, err := jobSpec.Task.Unit.DependencyMod.GenerateDependencies(ctx, models.GenerateDependenciesRequest{
 			Config:  models.PluginConfigs{}.FromJobSpec(jobSpec.Task.Config),
 			Assets:  models.PluginAssets{}.FromJobSpec(jobSpec.Assets),
 			Project: projectSpec,
@@ -65,6 +65,7 @@ func (r *dependencyResolver) resolveInferredDependencies(jobSpec models.JobSpec,
 		if err != nil {
 			if err == store.ErrResourceNotFound {
 				// should not fail for unknown dependency
+				unknownDependencies.WithLabelValues(projectSpec.Name).Inc()
 				r.notifyProgress(observer, &EventJobSpecUnknownDependencyUsed{Job: jobSpec.Name, Dependency: depDestination})
 				continue
 			}
@@ -72,8 +73,12 @@ func (r *dependencyResolver) resolveInferredDependencies(jobSpec models.JobSpec,
 		}
 
 		// determine the type of dependency
-		dep := models.JobSpecDependency{Job: &depSpec, Project: &depProj}
+		dep := models.JobSpecDependency{Job: &depSpec, Project: &depProj, Source: models.JobSpecDependencySourceInferred}
 		dep.Type = r.getJobSpecDependencyType(dep, projectSpec.Name)
+		if _, ok := jobSpec.Dependencies[depSpec.Name]; ok {
+			// explicitly defined as well
+			dep.Source = models.JobSpecDependencySourceStatic
+		}
 		jobSpec.Dependencies[depSpec.Name] = dep
 	}
 
@@ -99,6 +104,7 @@ func (r *dependencyResolver) resolveStaticDependencies(jobSpec models.JobSpec, p
 			}
 			depSpec.Job = &job
 			depSpec.Project = &projectSpec
+			depSpec.Source = models.JobSpecDependencySourceStatic
 			jobSpec.Dependencies[depName] = depSpec
 		}
 	}
@@ -104,8 +104,8 @@ func TestDependencyResolver(t *testing.T) {
 			}
 
 			// task dependencies
-			execUnit1.On("GenerateDependencies", context.TODO(), unitData).Return(&models.GenerateDependenciesResponse{Dependencies: []string{"project.dataset.table2_destination"}}, nil)
-			execUnit1.On("GenerateDependencies", context.TODO(), unitData2).Return(&models.GenerateDependenciesResponse{}, nil)
+			execUnit1.On("GenerateDependencies", context.Background(), unitData).Return(&models.GenerateDependenciesResponse{Dependencies: []string{"project.dataset.table2_destination"}}, nil)
+			execUnit1.On("GenerateDependencies", context.Background(), unitData2).Return(&models.GenerateDependenciesResponse{}, nil)
 
 			// hook dependency
 			hookUnit1.On("PluginInfo").Return(&models.PluginInfoResponse{
@@ -117,13 +117,13 @@ func TestDependencyResolver(t *testing.T) {
 			}, nil)
 
 			resolver := job.NewDependencyResolver()
-			resolvedJobSpec1, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 			assert.Nil(t, err)
-			resolvedJobSpec2, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec2, nil)
+			resolvedJobSpec2, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec2, nil)
 			assert.Nil(t, err)
 
 			assert.Equal(t, map[string]models.JobSpecDependency{
-				jobSpec2.Name: {Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra},
+				jobSpec2.Name: {Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra, Source: models.JobSpecDependencySourceInferred},
 			}, resolvedJobSpec1.Dependencies)
 			assert.Equal(t, map[string]models.JobSpecDependency{}, resolvedJobSpec2.Dependencies)
 			assert.Equal(t, []*models.JobSpecHook{&resolvedJobSpec1.Hooks[0]}, resolvedJobSpec1.Hooks[1].DependsOn)
@@ -203,19 +203,19 @@ func TestDependencyResolver(t *testing.T) {
 				Project: projectSpec,
 			}
 
-			execUnit.On("GenerateDependencies", context.TODO(), unitData).Return(&models.GenerateDependenciesResponse{
+			execUnit.On("GenerateDependencies", context.Background(), unitData).Return(&models.GenerateDependenciesResponse{
 				Dependencies: []string{"project.dataset.table2_destination"},
 			}, nil)
-			execUnit.On("GenerateDependencies", context.TODO(), unitData2).Return(&models.GenerateDependenciesResponse{}, nil)
+			execUnit.On("GenerateDependencies", context.Background(), unitData2).Return(&models.GenerateDependenciesResponse{}, nil)
 
 			resolver := job.NewDependencyResolver()
-			resolvedJobSpec1, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 			assert.Nil(t, err)
-			resolvedJobSpec2, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec2, nil)
+			resolvedJobSpec2, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec2, nil)
 			assert.Nil(t, err)
 
 			assert.Equal(t, map[string]models.JobSpecDependency{
-				jobSpec2.Name: {Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra},
+				jobSpec2.Name: {Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra, Source: models.JobSpecDependencySourceInferred},
 				jobSpec3.Name: {Job: &jobSpec3, Type: models.JobSpecDependencyTypeIntra},
 			}, resolvedJobSpec1.Dependencies)
 			assert.Equal(t, map[string]models.JobSpecDependency{}, resolvedJobSpec2.Dependencies)
@@ -273,7 +273,7 @@ func TestDependencyResolver(t *testing.T) {
 				&models.GenerateDependenciesResponse{Dependencies: []string{"project.dataset.table2_destination"}}, nil)
 
 			resolver := job.NewDependencyResolver()
-			resolvedJobSpec1, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 
 			assert.Error(t, errors.Wrapf(errors.New("random error"), job.UnknownRuntimeDependencyMessage,
 				"project.dataset.table2_destination", jobSpec1.Name),
@@ -312,7 +312,7 @@ func TestDependencyResolver(t *testing.T) {
 			execUnit.On("GenerateDependencies", context.Background(), unitData).Return(&models.GenerateDependenciesResponse{}, errors.New("random error"))
 
 			resolver := job.NewDependencyResolver()
-			resolvedJobSpec1, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 
 			assert.Equal(t, "random error", err.Error())
 			assert.Equal(t, models.JobSpec{}, resolvedJobSpec1)
@@ -351,7 +351,7 @@ func TestDependencyResolver(t *testing.T) {
 				Dependencies: []string{"project.dataset.table3_destination"}}, nil)
 
 			resolver := job.NewDependencyResolver()
-			_, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			_, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 			assert.Error(t, errors.Wrapf(errors.New("spec not found"), job.UnknownRuntimeDependencyMessage,
 				"project.dataset.table3_destination", jobSpec1.Name),
 				err.Error())
@@ -411,7 +411,7 @@ func TestDependencyResolver(t *testing.T) {
 			}, nil)
 
 			resolver := job.NewDependencyResolver()
-			_, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec2, nil)
+			_, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec2, nil)
 			assert.Equal(t, "unknown local dependency for job static_dep: spec not found", err.Error())
 		})
 
@@ -498,19 +498,75 @@ func TestDependencyResolver(t *testing.T) {
 			execUnit.On("GenerateDependencies", context.Background(), unitData2).Return(&models.GenerateDependenciesResponse{}, nil)
 
 			resolver := job.NewDependencyResolver()
-			resolvedJobSpec1, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 			assert.Nil(t, err)
-			resolvedJobSpec2, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec2, nil)
+			resolvedJobSpec2, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec2, nil)
 			assert.Nil(t, err)
 
 			assert.Nil(t, err)
 			assert.Equal(t, map[string]models.JobSpecDependency{
-				jobSpec2.Name: {Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra},
-				jobSpec3.Name: {Job: &jobSpec3, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra},
+				jobSpec2.Name: {Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra, Source: models.JobSpecDependencySourceInferred},
+				jobSpec3.Name: {Job: &jobSpec3, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra, Source: models.JobSpecDependencySourceStatic},
 			}, resolvedJobSpec1.Dependencies)
 			assert.Equal(t, map[string]models.JobSpecDependency{}, resolvedJobSpec2.Dependencies)
 		})
 
+		t.Run("it should tell dependencies defined in spec apart from inferred ones", func(t *testing.T) {
+			execUnit := new(mock.DependencyResolverMod)
+			defer execUnit.AssertExpectations(t)
+
+			jobSpec2 := models.JobSpec{
+				Version:      1,
+				Name:         "test2",
+				Owner:        "optimus",
+				Task:         models.JobSpecTask{Unit: &models.Plugin{DependencyMod: execUnit}},
+				Dependencies: make(map[string]models.JobSpecDependency),
+			}
+			jobSpec3 := models.JobSpec{
+				Version:      1,
+				Name:         "test3",
+				Owner:        "optimus",
+				Task:         models.JobSpecTask{Unit: &models.Plugin{DependencyMod: execUnit}},
+				Dependencies: make(map[string]models.JobSpecDependency),
+			}
+			jobSpec1 := models.JobSpec{
+				Version: 1,
+				Name:    "test1",
+				Owner:   "optimus",
+				Task: models.JobSpecTask{
+					Unit: &models.Plugin{DependencyMod: execUnit},
+					Config: models.JobSpecConfigs{
+						{
+							Name:  "foo",
+							Value: "bar",
+						},
+					},
+				},
+				// test3 is defined in spec and inferred from assets as well
+				Dependencies: map[string]models.JobSpecDependency{"test3": {Job: nil, Type: models.JobSpecDependencyTypeIntra}},
+			}
+
+			jobSpecRepository := new(mock.ProjectJobSpecRepository)
+			jobSpecRepository.On("GetByDestination", "project.dataset.table2_destination").Return(jobSpec2, projectSpec, nil)
+			jobSpecRepository.On("GetByDestination", "project.dataset.table3_destination").Return(jobSpec3, projectSpec, nil)
+			defer jobSpecRepository.AssertExpectations(t)
+
+			unitData := models.GenerateDependenciesRequest{
+				Config: models.PluginConfigs{}.FromJobSpec(jobSpec1.Task.Config), Assets: models.PluginAssets{}.FromJobSpec(jobSpec1.Assets),
+				Project: projectSpec,
+			}
+			execUnit.On("GenerateDependencies", context.Background(), unitData).Return(&models.GenerateDependenciesResponse{
+				Dependencies: []string{"project.dataset.table2_destination", "project.dataset.table3_destination"},
+			}, nil)
+
+			resolver := job.NewDependencyResolver()
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
+
+			assert.Nil(t, err)
+			assert.Equal(t, models.JobSpecDependencySourceInferred, resolvedJobSpec1.Dependencies[jobSpec2.Name].Source)
+			assert.Equal(t, models.JobSpecDependencySourceStatic, resolvedJobSpec1.Dependencies[jobSpec3.Name].Source)
+			assert.Equal(t, &jobSpec3, resolvedJobSpec1.Dependencies[jobSpec3.Name].Job)
+		})
 		t.Run("it should resolve any inter dependency", func(t *testing.T) {
 			externalProjectName := "an-external-data-project"
 			externalProjectSpec := models.ProjectSpec{
@@ -625,15 +681,15 @@ func TestDependencyResolver(t *testing.T) {
 			execUnit.On("GenerateDependencies", context.Background(), unitData2).Return(&models.GenerateDependenciesResponse{}, nil)
 
 			resolver := job.NewDependencyResolver()
-			resolvedJobSpec1, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec1, nil)
+			resolvedJobSpec1, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec1, nil)
 			assert.Nil(t, err)
-			resolvedJobSpec2, err := resolver.Resolve(projectSpec, jobSpecRepository, jobSpec2, nil)
+			resolvedJobSpec2, err := resolver.Resolve(context.Background(), projectSpec, jobSpecRepository, jobSpec2, nil)
 			assert.Nil(t, err)
 
 			assert.Nil(t, err)
-			assert.Equal(t, models.JobSpecDependency{Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra}, resolvedJobSpec1.Dependencies[jobSpec2.Name])
-			assert.Equal(t, models.JobSpecDependency{Job: &jobSpecExternal, Project: &externalProjectSpec, Type: models.JobSpecDependencyTypeInter}, resolvedJobSpec1.Dependencies[jobSpecExternal.Name])
-			assert.Equal(t, models.JobSpecDependency{Job: &jobSpec3, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra}, resolvedJobSpec1.Dependencies[jobSpec3.Name])
+			assert.Equal(t, models.JobSpecDependency{Job: &jobSpec2, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra, Source: models.JobSpecDependencySourceInferred}, resolvedJobSpec1.Dependencies[jobSpec2.Name])
+			assert.Equal(t, models.JobSpecDependency{Job: &jobSpecExternal, Project: &externalProjectSpec, Type: models.JobSpecDependencyTypeInter, Source: models.JobSpecDependencySourceInferred}, resolvedJobSpec1.Dependencies[jobSpecExternal.Name])
+			assert.Equal(t, models.JobSpecDependency{Job: &jobSpec3, Project: &projectSpec, Type: models.JobSpecDependencyTypeIntra, Source: models.JobSpecDependencySourceStatic}, resolvedJobSpec1.Dependencies[jobSpec3.Name])
 			assert.Equal(t, map[string]models.JobSpecDependency{}, resolvedJobSpec2.Dependencies)
 		})
 	})
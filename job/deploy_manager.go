@@ -0,0 +1,536 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/core/logger"
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/utils"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrDeployQueueFull signifies that deployments can't be accepted till
+	// queued ones are picked up by workers
+	ErrDeployQueueFull = errors.New("deployment queue is full")
+	// ErrDeployManagerClosed signifies that server is shutting down and
+	// doesn't accept deployments anymore
+	ErrDeployManagerClosed = errors.New("deployments are not accepted while shutting down")
+
+	// DeploymentAbandoned is recorded as message of deployments which were
+	// left unfinished by a server that stopped
+	DeploymentAbandoned = "deployment was abandoned, server stopped before it finished"
+
+	// abandonedDeploymentsLimit caps deployments marked failed at once
+	abandonedDeploymentsLimit = 1000
+
+	// deployments missing as many heartbeats in a row are abandoned
+	missedHeartbeats = 3
+
+	defaultHeartbeatInterval = 30 * time.Second
+
+	defaultProgressJobs     = 100
+	defaultProgressInterval = 10 * time.Second
+)
+
+type DeployManagerConfig struct {
+	NumWorkers int
+
+	// deployments taking longer are cancelled
+	WorkerTimeout time.Duration
+
+	// deployments waiting for a worker, more are rejected till queue drains
+	QueueSize int
+
+	// unfinished deployments of the server are marked alive this often,
+	// defaults to 30 seconds
+	HeartbeatInterval time.Duration
+
+	// outcome of jobs of a deployment in progress is saved after this many
+	// jobs are uploaded or this long, whichever comes first. Defaults to 100
+	// jobs and 10 seconds
+	ProgressJobs     int
+	ProgressInterval time.Duration
+}
+
+// DeployManager deploys job specifications of namespaces asynchronously,
+// deployments are tracked by their id
+type DeployManager interface {
+	// Deploy queues deployment of jobSpecs to namespace, jobs missing from
+	// jobSpecs are deleted. observer is notified of its progress and with
+	// EventDeploymentFinish once it ends
+	Deploy(context.Context, models.NamespaceSpec, []models.JobSpec, progress.Observer) (models.Deployment, error)
+	GetDeployment(context.Context, uuid.UUID) (models.Deployment, error)
+	// GetDeployments returns deployments matching filter, latest first
+	GetDeployments(context.Context, models.DeploymentFilter) ([]models.Deployment, error)
+}
+
+type deployRequest struct {
+	deployment models.Deployment
+	jobSpecs   []models.JobSpec
+	observer   progress.Observer
+}
+
+// deployManager carries out deployments with a fixed number of workers, status
+// of deployments is persisted so they can be looked up from any server
+type deployManager struct {
+	wg sync.WaitGroup
+
+	// guards closed, abandoned, requestQ, reserved, namespaceLocks and
+	// unfinished
+	mu     sync.Mutex
+	closed bool
+	// set once server stops waiting for queued deployments while closing,
+	// workers leave the rest to FailAbandonedDeployments
+	abandoned bool
+
+	config         DeployManagerConfig
+	jobService     models.JobService
+	deploymentRepo store.DeploymentRepository
+	auditEventRepo store.AuditEventRepository
+	locker         store.KeyLocker
+	uuidProvider   utils.UUIDProvider
+	now            func() time.Time
+
+	requestQ chan *deployRequest
+	// slots of requestQ held for deployments being saved
+	reserved int
+
+	// deployments of a namespace are carried out one at a time, by the
+	// server and among servers with locker
+	namespaceLocks map[uuid.UUID]*sync.Mutex
+
+	// queued and in progress deployments of this server, kept alive with
+	// heartbeats till they finish
+	unfinished    map[uuid.UUID]bool
+	stopHeartbeat chan struct{}
+	heartbeatDone chan struct{}
+}
+
+func (m *deployManager) Deploy(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec,
+	observer progress.Observer) (models.Deployment, error) {
+	id, err := m.uuidProvider.NewUUID()
+	if err != nil {
+		return models.Deployment{}, err
+	}
+	now := m.now()
+	deployment := models.Deployment{
+		ID:        id,
+		Namespace: namespace,
+		Status:    models.DeploymentStatusQueued,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return models.Deployment{}, ErrDeployManagerClosed
+	}
+	if len(m.requestQ)+m.reserved >= cap(m.requestQ) {
+		m.mu.Unlock()
+		return models.Deployment{}, ErrDeployQueueFull
+	}
+	// hold a slot of the queue while deployment is saved, so other
+	// deployments aren't kept waiting on the database
+	m.reserved++
+	m.mu.Unlock()
+
+	saveErr := m.deploymentRepo.Save(deployment)
+
+	m.mu.Lock()
+	m.reserved--
+	if saveErr != nil {
+		m.mu.Unlock()
+		return models.Deployment{}, errors.Wrap(saveErr, "failed to save deployment")
+	}
+	if m.closed {
+		m.mu.Unlock()
+		m.finish(deployment, nil, nil, ErrDeployManagerClosed)
+		return models.Deployment{}, ErrDeployManagerClosed
+	}
+	m.unfinished[deployment.ID] = true
+	// queue has room as slot was reserved and it is only sent to while
+	// holding the lock
+	m.requestQ <- &deployRequest{
+		deployment: deployment,
+		jobSpecs:   jobSpecs,
+		observer:   observer,
+	}
+	deployQueueDepth.Set(float64(len(m.requestQ)))
+	m.mu.Unlock()
+	return deployment, nil
+}
+
+func (m *deployManager) GetDeployment(ctx context.Context, id uuid.UUID) (models.Deployment, error) {
+	return m.deploymentRepo.GetByID(id)
+}
+
+func (m *deployManager) GetDeployments(ctx context.Context, filter models.DeploymentFilter) ([]models.Deployment, error) {
+	return m.deploymentRepo.GetAll(filter)
+}
+
+// start a worker goroutine that carries out queued deployments
+func (m *deployManager) spawnServiceWorker() {
+	defer m.wg.Done()
+
+	for req := range m.requestQ {
+		deployQueueDepth.Set(float64(len(m.requestQ)))
+		m.mu.Lock()
+		abandoned := m.abandoned
+		m.mu.Unlock()
+		if abandoned {
+			continue
+		}
+		m.process(req)
+	}
+}
+
+func (m *deployManager) process(req *deployRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), m.config.WorkerTimeout)
+	defer cancel()
+
+	deployment := req.deployment
+	unlock, err := m.lockNamespace(ctx, deployment.Namespace.ID)
+	if err != nil {
+		m.finish(deployment, nil, req.observer, err)
+		return
+	}
+	defer unlock()
+
+	startTime := time.Now()
+	deployment.Status = models.DeploymentStatusInProgress
+	deployment.UpdatedAt = m.now()
+	if err := m.deploymentRepo.Save(deployment); err != nil {
+		logger.E(errors.Wrapf(err, "failed to mark deployment %s in progress", deployment.ID))
+	}
+
+	// results are saved as jobs are uploaded, so progress can be followed
+	// from any server while deployment is in progress
+	results := &deploymentResultObserver{
+		saveJobs:     m.config.ProgressJobs,
+		saveInterval: m.config.ProgressInterval,
+		lastSavedAt:  time.Now(),
+		save: func(jobs []models.DeploymentJobResult) {
+			inProgress := deployment
+			inProgress.Jobs = jobs
+			inProgress.UpdatedAt = m.now()
+			if err := m.deploymentRepo.Save(inProgress); err != nil {
+				logger.E(errors.Wrapf(err, "failed to save progress of deployment %s", deployment.ID))
+			}
+		},
+	}
+	observers := new(progress.ObserverChain)
+	observers.Join(results)
+	if req.observer != nil {
+		observers.Join(req.observer)
+	}
+	err = m.deploy(ctx, deployment.Namespace, req.jobSpecs, observers)
+
+	jobs := results.Jobs()
+	if err == nil {
+		err = failedJobsError(jobs)
+	}
+	deployment = m.finish(deployment, jobs, observers, err)
+	logger.I(fmt.Sprintf("finished deployment %s with status %s in %s", deployment.ID, deployment.Status, time.Since(startTime)))
+}
+
+// finish records end state of deployment and notifies observer of it
+func (m *deployManager) finish(deployment models.Deployment, jobs []models.DeploymentJobResult,
+	observer progress.Observer, err error) models.Deployment {
+	deployment.Jobs = jobs
+	deployment.Status = models.DeploymentStatusSucceeded
+	if err != nil {
+		deployment.Status = models.DeploymentStatusFailed
+		deployment.Message = err.Error()
+	}
+	deployment.UpdatedAt = m.now()
+	if saveErr := m.deploymentRepo.Save(deployment); saveErr != nil {
+		logger.E(errors.Wrapf(saveErr, "failed to save status of deployment %s", deployment.ID))
+	}
+	m.settleAuditEvent(deployment)
+	m.mu.Lock()
+	delete(m.unfinished, deployment.ID)
+	m.mu.Unlock()
+	if observer != nil {
+		observer.Notify(&EventDeploymentFinish{Deployment: deployment})
+	}
+	return deployment
+}
+
+func (m *deployManager) deploy(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec,
+	observer progress.Observer) error {
+	// reject cyclic specs before they are saved, they would fail deployments
+	// of every namespace of the project afterwards
+	if err := m.jobService.CheckDependencyCycles(ctx, namespace, jobSpecs); err != nil {
+		return err
+	}
+
+	for _, jobSpec := range jobSpecs {
+		if err := m.jobService.Create(namespace, jobSpec); err != nil {
+			return errors.Wrapf(err, "failed to save %s", jobSpec.Name)
+		}
+	}
+
+	// delete specs not sent for deployment from internal repository
+	if err := m.jobService.KeepOnly(namespace, jobSpecs, observer); err != nil {
+		return errors.Wrap(err, "failed to delete jobs")
+	}
+
+	if err := m.jobService.Sync(ctx, namespace, observer); err != nil {
+		return errors.Wrap(err, "failed to sync jobs")
+	}
+	return nil
+}
+
+// failedJobsError names jobs which failed to be uploaded, a deployment fails
+// if any of its jobs did
+func failedJobsError(jobs []models.DeploymentJobResult) error {
+	var failed []string
+	for _, result := range jobs {
+		if !result.Success {
+			failed = append(failed, result.Name)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return errors.Errorf("failed to deploy %d of %d jobs: %s", len(failed), len(jobs), strings.Join(failed, ", "))
+}
+
+// lockNamespace waits till no other deployment of namespace is in progress,
+// on this server first so only one of its workers waits on the shared lock
+func (m *deployManager) lockNamespace(ctx context.Context, id uuid.UUID) (func(), error) {
+	m.mu.Lock()
+	lock, ok := m.namespaceLocks[id]
+	if !ok {
+		lock = &sync.Mutex{}
+		m.namespaceLocks[id] = lock
+	}
+	m.mu.Unlock()
+
+	lock.Lock()
+	unlockShared, err := m.locker.Lock(ctx, deploymentLockKey(id))
+	if err != nil {
+		lock.Unlock()
+		return nil, errors.Wrap(err, "failed to wait for other deployments of namespace")
+	}
+	return func() {
+		unlockShared()
+		lock.Unlock()
+	}, nil
+}
+
+// deploymentLockKey is held among servers while a namespace is deployed or
+// archived
+func deploymentLockKey(namespaceID uuid.UUID) string {
+	return "deployment:" + namespaceID.String()
+}
+
+// heartbeat keeps unfinished deployments of the server alive till stopped,
+// so they aren't taken as abandoned by FailAbandonedDeployments
+func (m *deployManager) heartbeat() {
+	defer close(m.heartbeatDone)
+	ticker := time.NewTicker(m.config.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-m.stopHeartbeat:
+			return
+		case <-ticker.C:
+		}
+
+		m.mu.Lock()
+		ids := make([]uuid.UUID, 0, len(m.unfinished))
+		for id := range m.unfinished {
+			ids = append(ids, id)
+		}
+		m.mu.Unlock()
+		if len(ids) == 0 {
+			continue
+		}
+		if err := m.deploymentRepo.Heartbeat(ids, m.now()); err != nil {
+			logger.E(errors.Wrap(err, "failed to send heartbeat of deployments"))
+		}
+	}
+}
+
+// FailAbandonedDeployments marks deployments that can't be running anymore as
+// failed, those are unfinished ones whose server stopped sending heartbeats
+func (m *deployManager) FailAbandonedDeployments() {
+	deployments, err := m.deploymentRepo.GetAll(models.DeploymentFilter{
+		Status:        models.DeploymentStatusUnfinished,
+		UpdatedBefore: m.now().Add(-time.Duration(missedHeartbeats) * m.config.HeartbeatInterval),
+		Limit:         abandonedDeploymentsLimit,
+	})
+	if err != nil {
+		logger.E(errors.Wrap(err, "failed to look up abandoned deployments"))
+		return
+	}
+	for _, deployment := range deployments {
+		deployment.Status = models.DeploymentStatusFailed
+		deployment.Message = DeploymentAbandoned
+		deployment.UpdatedAt = m.now()
+		if err := m.deploymentRepo.Save(deployment); err != nil {
+			logger.E(errors.Wrapf(err, "failed to mark deployment %s failed", deployment.ID))
+			continue
+		}
+		m.settleAuditEvent(deployment)
+	}
+}
+
+// settleAuditEvent records outcome of finished deployment in audit event of
+// the request which queued it
+func (m *deployManager) settleAuditEvent(deployment models.Deployment) {
+	outcome, errMsg := models.DeploymentAuditOutcome(deployment)
+	if err := m.auditEventRepo.UpdateDeploymentOutcome(deployment.ID, outcome, errMsg); err != nil {
+		logger.E(errors.Wrapf(err, "failed to record outcome of deployment %s in audit log", deployment.ID))
+	}
+}
+
+// Init starts deployment workers and their heartbeat, abandoned deployments
+// are failed separately with FailAbandonedDeployments by a single server
+func (m *deployManager) Init() {
+	logger.I("starting deployment workers")
+	for i := 0; i < m.config.NumWorkers; i++ {
+		m.wg.Add(1)
+		go m.spawnServiceWorker()
+	}
+	go m.heartbeat()
+}
+
+// Close stops accepting deployments and waits for queued ones to finish till
+// ctx is done. Deployments still unfinished then stop being kept alive, and
+// are failed by FailAbandonedDeployments of the leader.
+func (m *deployManager) Close(ctx context.Context) error {
+	m.mu.Lock()
+	closing := !m.closed
+	if closing {
+		m.closed = true
+		close(m.requestQ)
+	}
+	m.mu.Unlock()
+
+	finished := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(finished)
+	}()
+	var err error
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		m.mu.Lock()
+		m.abandoned = true
+		m.mu.Unlock()
+		err = errors.Wrap(ctx.Err(), "stopped waiting for unfinished deployments")
+	}
+
+	if closing {
+		close(m.stopHeartbeat)
+	}
+	<-m.heartbeatDone
+	return err
+}
+
+// NewDeployManager constructs a deploy manager and starts its workers, audit
+// events of requests queueing deployments are settled once they finish
+func NewDeployManager(jobService models.JobService, deploymentRepo store.DeploymentRepository,
+	auditEventRepo store.AuditEventRepository, locker store.KeyLocker, uuidProvider utils.UUIDProvider,
+	config DeployManagerConfig) *deployManager {
+	mgr := &deployManager{
+		config:         config,
+		jobService:     jobService,
+		deploymentRepo: deploymentRepo,
+		auditEventRepo: auditEventRepo,
+		locker:         locker,
+		uuidProvider:   uuidProvider,
+		now: func() time.Time {
+			return time.Now().UTC()
+		},
+		requestQ:       make(chan *deployRequest, config.QueueSize),
+		namespaceLocks: make(map[uuid.UUID]*sync.Mutex),
+		unfinished:     make(map[uuid.UUID]bool),
+		stopHeartbeat:  make(chan struct{}),
+		heartbeatDone:  make(chan struct{}),
+	}
+	if mgr.config.HeartbeatInterval <= 0 {
+		mgr.config.HeartbeatInterval = defaultHeartbeatInterval
+	}
+	if mgr.config.ProgressJobs <= 0 {
+		mgr.config.ProgressJobs = defaultProgressJobs
+	}
+	if mgr.config.ProgressInterval <= 0 {
+		mgr.config.ProgressInterval = defaultProgressInterval
+	}
+	mgr.Init()
+	return mgr
+}
+
+// deploymentResultObserver collects outcome of jobs uploaded during a
+// deployment, jobs are uploaded concurrently. Collected outcomes are handed
+// to save once saveJobs more jobs are uploaded or saveInterval passed since
+// the last save, one at a time so older ones don't overwrite newer
+type deploymentResultObserver struct {
+	mu   sync.Mutex
+	jobs []models.DeploymentJobResult
+
+	save         func([]models.DeploymentJobResult)
+	saveJobs     int
+	saveInterval time.Duration
+	lastSaved    int
+	lastSavedAt  time.Time
+}
+
+func (obs *deploymentResultObserver) Notify(e progress.Event) {
+	evt, ok := e.(*EventJobUpload)
+	if !ok {
+		return
+	}
+	result := models.DeploymentJobResult{
+		Name:    evt.Job.Name,
+		Success: evt.Err == nil,
+	}
+	if evt.Err != nil {
+		result.Message = evt.Err.Error()
+	}
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	obs.jobs = append(obs.jobs, result)
+	if obs.save == nil {
+		return
+	}
+	if len(obs.jobs)-obs.lastSaved < obs.saveJobs && time.Since(obs.lastSavedAt) < obs.saveInterval {
+		return
+	}
+	obs.save(append([]models.DeploymentJobResult(nil), obs.jobs...))
+	obs.lastSaved = len(obs.jobs)
+	obs.lastSavedAt = time.Now()
+}
+
+func (obs *deploymentResultObserver) Jobs() []models.DeploymentJobResult {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+	return obs.jobs
+}
+
+// EventDeploymentFinish signifies that a deployment has reached
+// an end state
+type EventDeploymentFinish struct {
+	Deployment models.Deployment
+}
+
+func (e *EventDeploymentFinish) String() string {
+	if e.Deployment.Status == models.DeploymentStatusFailed {
+		return fmt.Sprintf("deployment %s failed: %s", e.Deployment.ID, e.Deployment.Message)
+	}
+	return fmt.Sprintf("deployment %s %s", e.Deployment.ID, e.Deployment.Status)
+}
@@ -0,0 +1,422 @@
+package job_test
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/core/logger"
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+// finishObserver hands over deployment once it is finished
+type finishObserver struct {
+	finished chan models.Deployment
+}
+
+func (obs *finishObserver) Notify(e progress.Event) {
+	if evt, ok := e.(*job.EventDeploymentFinish); ok {
+		obs.finished <- evt.Deployment
+	}
+}
+
+func TestDeployManager(t *testing.T) {
+	ctx := context.Background()
+	logger.InitWithWriter(logger.DEBUG, ioutil.Discard)
+
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "a-data-project",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "dev-team-1",
+		ProjectSpec: projectSpec,
+	}
+	jobSpecs := []models.JobSpec{{Name: "job-a"}, {Name: "job-b"}}
+	deploymentID := uuid.Must(uuid.NewRandom())
+	withStatus := func(status string) interface{} {
+		return testMock.MatchedBy(func(d models.Deployment) bool {
+			return d.ID == deploymentID && d.Status == status
+		})
+	}
+
+	// outcome is asserted by tests of finished deployments
+	auditEventRepo := new(mock.AuditEventRepository)
+	auditEventRepo.On("UpdateDeploymentOutcome", testMock.Anything, testMock.Anything, testMock.Anything).Return(nil)
+
+	locker := new(mock.KeyLocker)
+	locker.On("Lock", testMock.Anything, "deployment:"+namespaceSpec.ID.String()).Return(func() {}, nil)
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Run("should carry out queued deployment and record outcome of its jobs", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			var uploadedSoFar []int
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusInProgress)).Run(func(args testMock.Arguments) {
+				uploadedSoFar = append(uploadedSoFar, len(args.Get(0).(models.Deployment).Jobs))
+			}).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusFailed)).Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			defer uuidProvider.AssertExpectations(t)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("Create", jobSpecs[0], namespaceSpec).Return(nil)
+			jobService.On("Create", jobSpecs[1], namespaceSpec).Return(nil)
+			jobService.On("CheckDependencyCycles", testMock.Anything, namespaceSpec, jobSpecs).Return(nil)
+			jobService.On("KeepOnly", namespaceSpec, jobSpecs).Return(nil)
+			jobService.On("Sync", testMock.Anything, namespaceSpec, testMock.Anything).Run(func(args testMock.Arguments) {
+				observer := args.Get(2).(progress.Observer)
+				observer.Notify(&job.EventJobUpload{Job: jobSpecs[0]})
+				observer.Notify(&job.EventJobUpload{Job: jobSpecs[1], Err: errors.New("failed to compile")})
+			}).Return(errors.New("failed to upload job-b"))
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:       1,
+				WorkerTimeout:    time.Minute,
+				QueueSize:        1,
+				ProgressJobs:     2,
+				ProgressInterval: time.Hour,
+			})
+			observer := &finishObserver{finished: make(chan models.Deployment, 1)}
+			deployment, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, observer)
+			assert.Nil(t, err)
+			assert.Equal(t, deploymentID, deployment.ID)
+			assert.Equal(t, models.DeploymentStatusQueued, deployment.Status)
+
+			finished := <-observer.finished
+			assert.Nil(t, manager.Close(ctx))
+			assert.Equal(t, models.DeploymentStatusFailed, finished.Status)
+			assert.Equal(t, "failed to sync jobs: failed to upload job-b", finished.Message)
+			assert.Equal(t, []models.DeploymentJobResult{
+				{Name: "job-a", Success: true},
+				{Name: "job-b", Message: "failed to compile"},
+			}, finished.Jobs)
+			// progress is saved once every two jobs
+			assert.Equal(t, []int{0, 2}, uploadedSoFar)
+		})
+		t.Run("should mark deployment succeeded if all jobs are deployed", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusInProgress)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusSucceeded)).Return(nil)
+
+			auditEventRepo := new(mock.AuditEventRepository)
+			defer auditEventRepo.AssertExpectations(t)
+			auditEventRepo.On("UpdateDeploymentOutcome", deploymentID, models.AuditOutcomeSuccess, "").Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("Create", jobSpecs[0], namespaceSpec).Return(nil)
+			jobService.On("CheckDependencyCycles", testMock.Anything, namespaceSpec, jobSpecs[:1]).Return(nil)
+			jobService.On("KeepOnly", namespaceSpec, jobSpecs[:1]).Return(nil)
+			jobService.On("Sync", testMock.Anything, namespaceSpec, testMock.Anything).Return(nil)
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			observer := &finishObserver{finished: make(chan models.Deployment, 1)}
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs[:1], observer)
+			assert.Nil(t, err)
+
+			finished := <-observer.finished
+			assert.Nil(t, manager.Close(ctx))
+			assert.Equal(t, models.DeploymentStatusSucceeded, finished.Status)
+			assert.Empty(t, finished.Message)
+		})
+		t.Run("should mark deployment failed if any job fails to be uploaded", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusInProgress)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusFailed)).Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("Create", jobSpecs[0], namespaceSpec).Return(nil)
+			jobService.On("Create", jobSpecs[1], namespaceSpec).Return(nil)
+			jobService.On("CheckDependencyCycles", testMock.Anything, namespaceSpec, jobSpecs).Return(nil)
+			jobService.On("KeepOnly", namespaceSpec, jobSpecs).Return(nil)
+			jobService.On("Sync", testMock.Anything, namespaceSpec, testMock.Anything).Run(func(args testMock.Arguments) {
+				observer := args.Get(2).(progress.Observer)
+				observer.Notify(&job.EventJobUpload{Job: jobSpecs[0]})
+				observer.Notify(&job.EventJobUpload{Job: jobSpecs[1], Err: errors.New("failed to compile")})
+			}).Return(nil)
+
+			auditEventRepo := new(mock.AuditEventRepository)
+			defer auditEventRepo.AssertExpectations(t)
+			auditEventRepo.On("UpdateDeploymentOutcome", deploymentID, models.AuditOutcomeFailed, "failed to deploy 1 of 2 jobs: job-b").Return(nil)
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			observer := &finishObserver{finished: make(chan models.Deployment, 1)}
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, observer)
+			assert.Nil(t, err)
+
+			finished := <-observer.finished
+			assert.Nil(t, manager.Close(ctx))
+			assert.Equal(t, models.DeploymentStatusFailed, finished.Status)
+			assert.Equal(t, "failed to deploy 1 of 2 jobs: job-b", finished.Message)
+		})
+		t.Run("should fail deployment introducing a cycle before saving its jobs", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusInProgress)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusFailed)).Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			// nothing is saved or synced
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("CheckDependencyCycles", testMock.Anything, namespaceSpec, jobSpecs).
+				Return(errors.Wrap(job.ErrCyclicDependency, "namespace dev-team-1: job-a →(inferred) job-b →(inferred) job-a"))
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			observer := &finishObserver{finished: make(chan models.Deployment, 1)}
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, observer)
+			assert.Nil(t, err)
+
+			finished := <-observer.finished
+			assert.Nil(t, manager.Close(ctx))
+			assert.Equal(t, models.DeploymentStatusFailed, finished.Status)
+			assert.Equal(t, "namespace dev-team-1: job-a →(inferred) job-b →(inferred) job-a: cyclic dependency", finished.Message)
+		})
+		t.Run("should fail deployment if other deployments of namespace can't be waited for", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusFailed)).Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			failingLocker := new(mock.KeyLocker)
+			defer failingLocker.AssertExpectations(t)
+			failingLocker.On("Lock", testMock.Anything, "deployment:"+namespaceSpec.ID.String()).Return(nil, errors.New("db is down"))
+
+			// nothing is deployed without the lock
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, failingLocker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			observer := &finishObserver{finished: make(chan models.Deployment, 1)}
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, observer)
+			assert.Nil(t, err)
+
+			finished := <-observer.finished
+			assert.Nil(t, manager.Close(ctx))
+			assert.Equal(t, models.DeploymentStatusFailed, finished.Status)
+			assert.Equal(t, "failed to wait for other deployments of namespace: db is down", finished.Message)
+		})
+		t.Run("should reject deployments if queue is full", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil).Once()
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			// without workers nothing is picked up from queue
+			manager := job.NewDeployManager(nil, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    0,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, nil)
+			assert.Nil(t, err)
+
+			_, err = manager.Deploy(ctx, namespaceSpec, jobSpecs, nil)
+			assert.Equal(t, job.ErrDeployQueueFull, err)
+		})
+		t.Run("should reject deployments once closed", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			manager := job.NewDeployManager(nil, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			assert.Nil(t, manager.Close(ctx))
+
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, nil)
+			assert.Equal(t, job.ErrDeployManagerClosed, err)
+		})
+		t.Run("should leave queued deployments unfinished once closing times out", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusInProgress)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusSucceeded)).Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			// first deployment is held in progress till closing times out
+			started := make(chan struct{})
+			release := make(chan struct{})
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("CheckDependencyCycles", testMock.Anything, namespaceSpec, []models.JobSpec(nil)).Return(nil)
+			jobService.On("KeepOnly", namespaceSpec, []models.JobSpec(nil)).Return(nil)
+			jobService.On("Sync", testMock.Anything, namespaceSpec, testMock.Anything).Run(func(args testMock.Arguments) {
+				close(started)
+				<-release
+			}).Return(nil)
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			_, err := manager.Deploy(ctx, namespaceSpec, nil, nil)
+			assert.Nil(t, err)
+			<-started
+			_, err = manager.Deploy(ctx, namespaceSpec, nil, nil)
+			assert.Nil(t, err)
+
+			closeCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+			defer cancel()
+			err = manager.Close(closeCtx)
+			assert.True(t, errors.Is(err, context.DeadlineExceeded))
+
+			// queued deployment isn't picked up once in progress one ends
+			close(release)
+			assert.Nil(t, manager.Close(ctx))
+			jobService.AssertNumberOfCalls(t, "KeepOnly", 1)
+		})
+		t.Run("should not queue deployment if it fails to be saved", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(errors.New("db is down"))
+
+			uuidProvider := new(mock.UUIDProvider)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			manager := job.NewDeployManager(nil, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:    1,
+				WorkerTimeout: time.Minute,
+				QueueSize:     1,
+			})
+			_, err := manager.Deploy(ctx, namespaceSpec, jobSpecs, nil)
+			assert.Equal(t, "failed to save deployment: db is down", err.Error())
+			assert.Nil(t, manager.Close(ctx))
+		})
+	})
+	t.Run("FailAbandonedDeployments", func(t *testing.T) {
+		t.Run("should mark deployments whose server stopped sending heartbeats as failed", func(t *testing.T) {
+			abandoned := models.Deployment{
+				ID:        deploymentID,
+				Namespace: namespaceSpec,
+				Status:    models.DeploymentStatusInProgress,
+				CreatedAt: time.Now().Add(-time.Hour),
+				UpdatedAt: time.Now().Add(-time.Hour),
+			}
+
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("GetAll", testMock.MatchedBy(func(filter models.DeploymentFilter) bool {
+				return assert.ObjectsAreEqual(models.DeploymentStatusUnfinished, filter.Status) &&
+					filter.CreatedBefore.IsZero() &&
+					filter.UpdatedBefore.Before(time.Now().Add(-3*time.Second+time.Millisecond))
+			})).Return([]models.Deployment{abandoned}, nil)
+			deploymentRepo.On("Save", testMock.MatchedBy(func(d models.Deployment) bool {
+				return d.ID == deploymentID && d.Status == models.DeploymentStatusFailed && d.Message == job.DeploymentAbandoned
+			})).Return(nil)
+
+			auditEventRepo := new(mock.AuditEventRepository)
+			defer auditEventRepo.AssertExpectations(t)
+			auditEventRepo.On("UpdateDeploymentOutcome", deploymentID, models.AuditOutcomeFailed, job.DeploymentAbandoned).Return(nil)
+
+			manager := job.NewDeployManager(nil, deploymentRepo, auditEventRepo, locker, nil, job.DeployManagerConfig{
+				NumWorkers:        1,
+				WorkerTimeout:     time.Minute,
+				QueueSize:         1,
+				HeartbeatInterval: time.Second,
+			})
+			manager.FailAbandonedDeployments()
+			assert.Nil(t, manager.Close(ctx))
+		})
+	})
+	t.Run("Heartbeat", func(t *testing.T) {
+		t.Run("should keep unfinished deployments alive till they finish", func(t *testing.T) {
+			deploymentRepo := new(mock.DeploymentRepository)
+			defer deploymentRepo.AssertExpectations(t)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusQueued)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusInProgress)).Return(nil)
+			deploymentRepo.On("Save", withStatus(models.DeploymentStatusSucceeded)).Return(nil)
+			heartbeats := make(chan struct{}, 1)
+			deploymentRepo.On("Heartbeat", []uuid.UUID{deploymentID}, testMock.Anything).Run(func(args testMock.Arguments) {
+				select {
+				case heartbeats <- struct{}{}:
+				default:
+				}
+			}).Return(nil)
+
+			uuidProvider := new(mock.UUIDProvider)
+			defer uuidProvider.AssertExpectations(t)
+			uuidProvider.On("NewUUID").Return(deploymentID, nil)
+
+			// deployment is held in progress till a heartbeat is sent
+			jobService := new(mock.JobService)
+			defer jobService.AssertExpectations(t)
+			jobService.On("CheckDependencyCycles", testMock.Anything, namespaceSpec, []models.JobSpec(nil)).Return(nil)
+			jobService.On("KeepOnly", namespaceSpec, []models.JobSpec(nil)).Return(nil)
+			jobService.On("Sync", testMock.Anything, namespaceSpec, testMock.Anything).Run(func(args testMock.Arguments) {
+				<-heartbeats
+			}).Return(nil)
+
+			manager := job.NewDeployManager(jobService, deploymentRepo, auditEventRepo, locker, uuidProvider, job.DeployManagerConfig{
+				NumWorkers:        1,
+				WorkerTimeout:     time.Minute,
+				QueueSize:         1,
+				HeartbeatInterval: 10 * time.Millisecond,
+			})
+			observer := &finishObserver{finished: make(chan models.Deployment, 1)}
+			_, err := manager.Deploy(ctx, namespaceSpec, nil, observer)
+			assert.Nil(t, err)
+
+			finished := <-observer.finished
+			assert.Equal(t, models.DeploymentStatusSucceeded, finished.Status)
+			assert.Nil(t, manager.Close(ctx))
+		})
+	})
+}
@@ -37,6 +37,7 @@ func (e *eventService) Register(ctx context.Context, namespace models.NamespaceS
 						Route:     route,
 					}); currErr != nil {
 						log.E(currErr)
+						notifyFailures.WithLabelValues(scheme).Inc()
 						err = multierror.Append(err, errors.Wrapf(currErr, "notifyChannel.Notify: %s", channel))
 					}
 				}
@@ -0,0 +1,312 @@
+package job
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// jobGraphIndexTTL is how long a resolved graph of a project is reused to
+// find its jobs depending on other projects, changes deployed meanwhile show
+// up once it expires
+const jobGraphIndexTTL = 10 * time.Minute
+
+// GetJobGraph resolves dependencies of all the jobs in a project and returns
+// them as a graph, jobs of provided projects depending on the project are included
+// as downstream consumers. If jobName is provided, graph is limited to the upstream
+// and downstream of the job up to depth levels across projects, depth <= 0
+// traverses all levels. Graphs of other projects are read from an index kept
+// by the service, they are resolved only when missing or expired.
+func (srv *Service) GetJobGraph(ctx context.Context, projectSpec models.ProjectSpec, projects []models.ProjectSpec,
+	jobName string, depth int) (models.JobGraph, error) {
+	graph, err := srv.resolveJobGraph(ctx, projectSpec)
+	if err != nil {
+		return models.JobGraph{}, err
+	}
+
+	rootID := models.JobGraphID(projectSpec.Name, jobName)
+	if jobName != "" {
+		found := false
+		for _, node := range graph.Nodes {
+			if node.ID == rootID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return models.JobGraph{}, errors.Wrap(ErrJobSpecNotFound, jobName)
+		}
+	}
+
+	// graphs of other projects give consumers of this project, and when a job
+	// is requested, upstreams of its jobs in other projects as well
+	for _, proj := range planProjects(projectSpec, projects)[1:] {
+		projGraph, ok := srv.graphIndex.get(proj.Name, srv.Now())
+		if !ok {
+			if projGraph, err = srv.resolveJobGraph(ctx, proj); err != nil {
+				return models.JobGraph{}, errors.Wrapf(err, "failed to resolve dependencies of project %s", proj.Name)
+			}
+		}
+		if jobName == "" {
+			projGraph = incomingJobGraph(projGraph, projectSpec.Name)
+		}
+		graph = mergeJobGraph(graph, projGraph)
+	}
+
+	if jobName == "" {
+		return graph, nil
+	}
+	return filterJobGraph(graph, rootID, depth), nil
+}
+
+// resolveJobGraph resolves dependencies of a project into a graph and indexes it
+func (srv *Service) resolveJobGraph(ctx context.Context, projectSpec models.ProjectSpec) (models.JobGraph, error) {
+	unknownDeps := new(unknownDependencyCollector)
+	jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, projectSpec, srv.projectJobSpecRepoFactory.New(projectSpec), unknownDeps)
+	if err != nil {
+		return models.JobGraph{}, err
+	}
+	graph := buildJobGraph(projectSpec, jobSpecs, unknownDeps.dependencies)
+	srv.graphIndex.put(projectSpec.Name, graph, srv.Now())
+	return graph, nil
+}
+
+// jobGraphIndex keeps the last resolved graph of each project
+type jobGraphIndex struct {
+	mu     sync.Mutex
+	graphs map[string]indexedJobGraph
+}
+
+type indexedJobGraph struct {
+	graph      models.JobGraph
+	resolvedAt time.Time
+}
+
+func (i *jobGraphIndex) get(projectName string, now time.Time) (models.JobGraph, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	indexed, ok := i.graphs[projectName]
+	if !ok || now.Sub(indexed.resolvedAt) > jobGraphIndexTTL {
+		return models.JobGraph{}, false
+	}
+	return indexed.graph, true
+}
+
+func (i *jobGraphIndex) put(projectName string, graph models.JobGraph, now time.Time) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.graphs == nil {
+		i.graphs = map[string]indexedJobGraph{}
+	}
+	i.graphs[projectName] = indexedJobGraph{graph: graph, resolvedAt: now}
+}
+
+// buildJobGraph converts dependency resolved job specs of a project into a graph
+func buildJobGraph(projectSpec models.ProjectSpec, jobSpecs []models.JobSpec, unknownDeps map[string][]string) models.JobGraph {
+	nodes := map[string]models.JobGraphNode{}
+	var edges []models.JobGraphEdge
+
+	for _, jobSpec := range jobSpecs {
+		jobID := models.JobGraphID(projectSpec.Name, jobSpec.Name)
+		nodes[jobID] = models.JobGraphNode{
+			ID:      jobID,
+			Name:    jobSpec.Name,
+			Project: projectSpec.Name,
+			Type:    models.JobGraphNodeTypeJob,
+		}
+
+		for depName, dep := range jobSpec.Dependencies {
+			depProject := projectSpec.Name
+			if dep.Project != nil {
+				depProject = dep.Project.Name
+			}
+			if dep.Job != nil {
+				depName = dep.Job.Name
+			}
+			depID := models.JobGraphID(depProject, depName)
+			if _, ok := nodes[depID]; !ok {
+				nodes[depID] = models.JobGraphNode{
+					ID:      depID,
+					Name:    depName,
+					Project: depProject,
+					Type:    models.JobGraphNodeTypeJob,
+				}
+			}
+
+			depType := dep.Type
+			if depType == "" {
+				depType = models.JobSpecDependencyTypeIntra
+			}
+			edges = append(edges, models.JobGraphEdge{
+				Upstream:   depID,
+				Downstream: jobID,
+				Type:       depType,
+			})
+		}
+
+		for _, destination := range unknownDeps[jobSpec.Name] {
+			if _, ok := nodes[destination]; !ok {
+				nodes[destination] = models.JobGraphNode{
+					ID:   destination,
+					Name: destination,
+					Type: models.JobGraphNodeTypeUnknown,
+				}
+			}
+			edges = append(edges, models.JobGraphEdge{
+				Upstream:   destination,
+				Downstream: jobID,
+				Type:       models.JobSpecDependencyTypeExtra,
+			})
+		}
+	}
+
+	graph := models.JobGraph{Edges: edges}
+	for _, node := range nodes {
+		graph.Nodes = append(graph.Nodes, node)
+	}
+	sortJobGraph(&graph)
+	return graph
+}
+
+// incomingJobGraph keeps only the edges of graph whose upstream is a job of
+// the provided project, along with the nodes on both ends
+func incomingJobGraph(graph models.JobGraph, projectName string) models.JobGraph {
+	nodes := map[string]models.JobGraphNode{}
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+
+	incoming := models.JobGraph{}
+	keep := map[string]bool{}
+	for _, edge := range graph.Edges {
+		if nodes[edge.Upstream].Project != projectName {
+			continue
+		}
+		incoming.Edges = append(incoming.Edges, edge)
+		keep[edge.Upstream] = true
+		keep[edge.Downstream] = true
+	}
+	for _, node := range graph.Nodes {
+		if keep[node.ID] {
+			incoming.Nodes = append(incoming.Nodes, node)
+		}
+	}
+	return incoming
+}
+
+// mergeJobGraph combines nodes and edges of both graphs, nodes are
+// deduplicated by ID
+func mergeJobGraph(graph, other models.JobGraph) models.JobGraph {
+	nodes := map[string]models.JobGraphNode{}
+	for _, node := range graph.Nodes {
+		nodes[node.ID] = node
+	}
+	edges := map[models.JobGraphEdge]bool{}
+	for _, edge := range graph.Edges {
+		edges[edge] = true
+	}
+
+	merged := models.JobGraph{Edges: graph.Edges}
+	for _, node := range other.Nodes {
+		if _, ok := nodes[node.ID]; !ok {
+			nodes[node.ID] = node
+		}
+	}
+	for _, edge := range other.Edges {
+		if !edges[edge] {
+			edges[edge] = true
+			merged.Edges = append(merged.Edges, edge)
+		}
+	}
+	for _, node := range nodes {
+		merged.Nodes = append(merged.Nodes, node)
+	}
+	sortJobGraph(&merged)
+	return merged
+}
+
+// filterJobGraph keeps only the nodes reachable from rootID in both
+// upstream and downstream direction within depth levels
+func filterJobGraph(graph models.JobGraph, rootID string, depth int) models.JobGraph {
+	upstreams := map[string][]string{}
+	downstreams := map[string][]string{}
+	for _, edge := range graph.Edges {
+		upstreams[edge.Downstream] = append(upstreams[edge.Downstream], edge.Upstream)
+		downstreams[edge.Upstream] = append(downstreams[edge.Upstream], edge.Downstream)
+	}
+
+	keep := map[string]bool{rootID: true}
+	traverseJobGraph(rootID, upstreams, depth, keep)
+	traverseJobGraph(rootID, downstreams, depth, keep)
+
+	filtered := models.JobGraph{}
+	for _, node := range graph.Nodes {
+		if keep[node.ID] {
+			filtered.Nodes = append(filtered.Nodes, node)
+		}
+	}
+	for _, edge := range graph.Edges {
+		if keep[edge.Upstream] && keep[edge.Downstream] {
+			filtered.Edges = append(filtered.Edges, edge)
+		}
+	}
+	return filtered
+}
+
+// traverseJobGraph runs a breadth first traversal from rootID following adjacency
+func traverseJobGraph(rootID string, adjacency map[string][]string, depth int, visited map[string]bool) {
+	seen := map[string]bool{rootID: true}
+	current := []string{rootID}
+	for level := 0; len(current) > 0 && (depth <= 0 || level < depth); level++ {
+		var next []string
+		for _, id := range current {
+			for _, adjacent := range adjacency[id] {
+				if seen[adjacent] {
+					continue
+				}
+				seen[adjacent] = true
+				visited[adjacent] = true
+				next = append(next, adjacent)
+			}
+		}
+		current = next
+	}
+}
+
+func sortJobGraph(graph *models.JobGraph) {
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Downstream == graph.Edges[j].Downstream {
+			return graph.Edges[i].Upstream < graph.Edges[j].Upstream
+		}
+		return graph.Edges[i].Downstream < graph.Edges[j].Downstream
+	})
+}
+
+// unknownDependencyCollector records destinations used by jobs that could not be
+// resolved to any registered job while resolving dependencies
+type unknownDependencyCollector struct {
+	mu           sync.Mutex
+	dependencies map[string][]string
+}
+
+func (c *unknownDependencyCollector) Notify(e progress.Event) {
+	evt, ok := e.(*EventJobSpecUnknownDependencyUsed)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.dependencies == nil {
+		c.dependencies = map[string][]string{}
+	}
+	c.dependencies[evt.Job] = append(c.dependencies[evt.Job], evt.Dependency)
+}
@@ -0,0 +1,164 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+func TestJobGraph(t *testing.T) {
+	dumpAssets := func(_ context.Context, jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
+		return jobSpec.Assets, nil
+	}
+
+	projSpec := models.ProjectSpec{
+		Name: "proj",
+	}
+	externalProjSpec := models.ProjectSpec{
+		Name: "external-proj",
+	}
+
+	// job-a <- job-b <- job-c <- job-d, external-proj/job-x <- job-b, unknown-table <- job-c,
+	// external-proj/job-w <- external-proj/job-x, job-d <- external-proj/job-y
+	jobA := models.JobSpec{Name: "job-a"}
+	jobB := models.JobSpec{Name: "job-b"}
+	jobC := models.JobSpec{Name: "job-c"}
+	jobD := models.JobSpec{Name: "job-d"}
+	jobW := models.JobSpec{Name: "job-w"}
+	jobX := models.JobSpec{Name: "job-x"}
+	jobY := models.JobSpec{Name: "job-y"}
+	resolvedSpecs := map[string]models.JobSpec{
+		jobA.Name: jobA,
+		jobB.Name: {Name: jobB.Name, Dependencies: map[string]models.JobSpecDependency{
+			jobA.Name: {Job: &jobA, Project: &projSpec, Type: models.JobSpecDependencyTypeIntra},
+			jobX.Name: {Job: &jobX, Project: &externalProjSpec, Type: models.JobSpecDependencyTypeInter},
+		}},
+		jobC.Name: {Name: jobC.Name, Dependencies: map[string]models.JobSpecDependency{
+			jobB.Name: {Job: &jobB, Project: &projSpec, Type: models.JobSpecDependencyTypeIntra},
+		}},
+		jobD.Name: {Name: jobD.Name, Dependencies: map[string]models.JobSpecDependency{
+			jobC.Name: {Job: &jobC, Project: &projSpec, Type: models.JobSpecDependencyTypeIntra},
+		}},
+		jobW.Name: jobW,
+		jobX.Name: {Name: jobX.Name, Dependencies: map[string]models.JobSpecDependency{
+			jobW.Name: {Job: &jobW, Project: &externalProjSpec, Type: models.JobSpecDependencyTypeIntra},
+		}},
+		jobY.Name: {Name: jobY.Name, Dependencies: map[string]models.JobSpecDependency{
+			jobD.Name: {Job: &jobD, Project: &projSpec, Type: models.JobSpecDependencyTypeInter},
+		}},
+	}
+
+	// external project is resolved only once by a service, its graph is
+	// indexed for later requests
+	setup := func(t *testing.T, resolvesExternal bool) *job.Service {
+		projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB, jobC, jobD}, nil)
+		t.Cleanup(func() { projectJobSpecRepo.AssertExpectations(t) })
+
+		externalProjectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		t.Cleanup(func() { externalProjectJobSpecRepo.AssertExpectations(t) })
+
+		projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+		projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+		t.Cleanup(func() { projJobSpecRepoFac.AssertExpectations(t) })
+
+		depenResolver := new(mock.DependencyResolver)
+		for _, spec := range []models.JobSpec{jobA, jobB, jobC, jobD} {
+			call := depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, spec, testMock.Anything).Return(resolvedSpecs[spec.Name], nil)
+			if spec.Name == jobC.Name {
+				call.Run(func(args testMock.Arguments) {
+					args.Get(4).(progress.Observer).Notify(&job.EventJobSpecUnknownDependencyUsed{
+						Job:        jobC.Name,
+						Dependency: "unknown-table",
+					})
+				})
+			}
+		}
+		t.Cleanup(func() { depenResolver.AssertExpectations(t) })
+		if resolvesExternal {
+			externalProjectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobW, jobX, jobY}, nil).Once()
+			projJobSpecRepoFac.On("New", externalProjSpec).Return(externalProjectJobSpecRepo).Once()
+			for _, spec := range []models.JobSpec{jobW, jobX, jobY} {
+				depenResolver.On("Resolve", testMock.Anything, externalProjSpec, externalProjectJobSpecRepo, spec, testMock.Anything).
+					Return(resolvedSpecs[spec.Name], nil).Once()
+			}
+		}
+
+		return job.NewService(nil, nil, nil, dumpAssets, depenResolver, nil, nil, projJobSpecRepoFac, nil)
+	}
+
+	projects := []models.ProjectSpec{projSpec, externalProjSpec}
+
+	t.Run("should return graph of all jobs in a project including external consumers and unknown dependencies", func(t *testing.T) {
+		svc := setup(t, true)
+
+		graph, err := svc.GetJobGraph(context.Background(), projSpec, projects, "", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []models.JobGraphNode{
+			{ID: "external-proj/job-x", Name: "job-x", Project: "external-proj", Type: models.JobGraphNodeTypeJob},
+			{ID: "external-proj/job-y", Name: "job-y", Project: "external-proj", Type: models.JobGraphNodeTypeJob},
+			{ID: "proj/job-a", Name: "job-a", Project: "proj", Type: models.JobGraphNodeTypeJob},
+			{ID: "proj/job-b", Name: "job-b", Project: "proj", Type: models.JobGraphNodeTypeJob},
+			{ID: "proj/job-c", Name: "job-c", Project: "proj", Type: models.JobGraphNodeTypeJob},
+			{ID: "proj/job-d", Name: "job-d", Project: "proj", Type: models.JobGraphNodeTypeJob},
+			{ID: "unknown-table", Name: "unknown-table", Type: models.JobGraphNodeTypeUnknown},
+		}, graph.Nodes)
+		assert.Equal(t, []models.JobGraphEdge{
+			{Upstream: "proj/job-d", Downstream: "external-proj/job-y", Type: models.JobSpecDependencyTypeInter},
+			{Upstream: "external-proj/job-x", Downstream: "proj/job-b", Type: models.JobSpecDependencyTypeInter},
+			{Upstream: "proj/job-a", Downstream: "proj/job-b", Type: models.JobSpecDependencyTypeIntra},
+			{Upstream: "proj/job-b", Downstream: "proj/job-c", Type: models.JobSpecDependencyTypeIntra},
+			{Upstream: "unknown-table", Downstream: "proj/job-c", Type: models.JobSpecDependencyTypeExtra},
+			{Upstream: "proj/job-c", Downstream: "proj/job-d", Type: models.JobSpecDependencyTypeIntra},
+		}, graph.Edges)
+	})
+	t.Run("should limit graph to upstream and downstream of a job within depth", func(t *testing.T) {
+		svc := setup(t, true)
+
+		graph, err := svc.GetJobGraph(context.Background(), projSpec, projects, jobC.Name, 1)
+		assert.Nil(t, err)
+
+		var nodeIDs []string
+		for _, node := range graph.Nodes {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+		assert.Equal(t, []string{"proj/job-b", "proj/job-c", "proj/job-d", "unknown-table"}, nodeIDs)
+		assert.Equal(t, 3, len(graph.Edges))
+	})
+	t.Run("should traverse upstream and downstream of a job across projects", func(t *testing.T) {
+		svc := setup(t, true)
+
+		graph, err := svc.GetJobGraph(context.Background(), projSpec, projects, jobB.Name, 0)
+		assert.Nil(t, err)
+
+		var nodeIDs []string
+		for _, node := range graph.Nodes {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+		assert.Equal(t, []string{"external-proj/job-w", "external-proj/job-x", "external-proj/job-y",
+			"proj/job-a", "proj/job-b", "proj/job-c", "proj/job-d"}, nodeIDs)
+	})
+	t.Run("should reuse indexed graph of other projects", func(t *testing.T) {
+		svc := setup(t, true)
+
+		first, err := svc.GetJobGraph(context.Background(), projSpec, projects, "", 0)
+		assert.Nil(t, err)
+		second, err := svc.GetJobGraph(context.Background(), projSpec, projects, "", 0)
+		assert.Nil(t, err)
+		assert.Equal(t, first, second)
+	})
+	t.Run("should return error if job is not found in the project", func(t *testing.T) {
+		svc := setup(t, false)
+
+		_, err := svc.GetJobGraph(context.Background(), projSpec, projects, "job-z", 0)
+		assert.True(t, errors.Is(err, job.ErrJobSpecNotFound))
+	})
+}
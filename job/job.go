@@ -7,5 +7,7 @@ type SpecRepository interface {
 	Save(models.JobSpec) error
 	GetByName(string) (models.JobSpec, error)
 	GetAll() ([]models.JobSpec, error)
+	// GetByFilter returns jobs matching filter, ordered by name
+	GetByFilter(models.JobSpecFilter) ([]models.JobSpec, error)
 	Delete(string) error
 }
@@ -0,0 +1,85 @@
+package job
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	metricResultSuccess = "success"
+	metricResultFailure = "failure"
+)
+
+var (
+	deployDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "optimus",
+		Subsystem: "job",
+		Name:      "deploy_duration_seconds",
+		Help:      "Time taken to resolve, compile and upload jobs of a namespace",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1200},
+	}, []string{"project", "result"})
+
+	deployedJobs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "optimus",
+		Subsystem: "job",
+		Name:      "deployed_jobs_total",
+		Help:      "Jobs compiled and uploaded to scheduler during deployments",
+	}, []string{"project", "result"})
+
+	namespaceJobs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "optimus",
+		Subsystem: "job",
+		Name:      "namespace_jobs",
+		Help:      "Jobs in a namespace as of its last deployment",
+	}, []string{"project", "namespace"})
+
+	deployQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "optimus",
+		Subsystem: "job",
+		Name:      "deploy_queue_depth",
+		Help:      "Deployments queued but not yet picked up by a worker",
+	})
+
+	dependencyResolutionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "optimus",
+		Subsystem: "job",
+		Name:      "dependency_resolution_duration_seconds",
+		Help:      "Time taken to resolve dependencies of all jobs in a project",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300},
+	}, []string{"project"})
+
+	unknownDependencies = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "optimus",
+		Subsystem: "job",
+		Name:      "unknown_dependencies_total",
+		Help:      "Dependencies of jobs which couldn't be resolved to any registered job",
+	}, []string{"project"})
+
+	replayQueueDepth = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "optimus",
+		Subsystem: "replay",
+		Name:      "queue_depth",
+		Help:      "Replay requests accepted but not yet dispatched to a worker",
+	})
+
+	replaysInFlight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "optimus",
+		Subsystem: "replay",
+		Name:      "in_flight",
+		Help:      "Replay requests being processed by workers",
+	})
+
+	replayOutcomes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "optimus",
+		Subsystem: "replay",
+		Name:      "requests_total",
+		Help:      "Replay requests processed by workers by outcome",
+	}, []string{"project", "result"})
+
+	notifyFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "optimus",
+		Subsystem: "notifier",
+		Name:      "send_failures_total",
+		Help:      "Job event notifications which failed to be sent",
+	}, []string{"notifier"})
+)
@@ -0,0 +1,574 @@
+package job
+
+import (
+	"context"
+	"reflect"
+	"sort"
+
+	"github.com/kushsharma/parallel"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+)
+
+// PlanDeployment computes the impact of replacing registered jobs of a namespace
+// with the provided job specs. Dependencies are resolved for the project of the
+// namespace and all the provided projects against the registered state, and again
+// as if the deployment was done for projects which can be impacted by it, nothing
+// is persisted.
+func (srv *Service) PlanDeployment(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec,
+	projects []models.ProjectSpec) (models.JobDeploymentPlan, error) {
+	registeredSpecs, err := srv.jobSpecRepoFactory.New(namespace).GetAll()
+	if err != nil {
+		return models.JobDeploymentPlan{}, errors.Wrapf(err, "failed to retrieve jobs")
+	}
+
+	overlay, err := newPlanOverlay(ctx, namespace, jobSpecs, registeredSpecs)
+	if err != nil {
+		return models.JobDeploymentPlan{}, err
+	}
+
+	plan := models.JobDeploymentPlan{}
+	registered := map[string]models.JobSpec{}
+	for _, spec := range registeredSpecs {
+		registered[spec.Name] = spec
+	}
+	for _, spec := range jobSpecs {
+		registeredSpec, ok := registered[spec.Name]
+		if !ok {
+			plan.Added = append(plan.Added, spec.Name)
+			continue
+		}
+		change, err := diffJobSpec(ctx, registeredSpec, spec, namespace.ProjectSpec)
+		if err != nil {
+			return models.JobDeploymentPlan{}, err
+		}
+		if len(change.Fields) > 0 {
+			plan.Changed = append(plan.Changed, change)
+		}
+	}
+	plan.Removed = overlay.removed
+
+	// resolve dependencies of every project before the deployment, only the
+	// planned project and the ones depending on the namespace are resolved again
+	// as if the deployment was done, others are left unchanged
+	plannedProjects := planProjects(namespace.ProjectSpec, projects)
+	before := newPlanGraph()
+	for _, proj := range plannedProjects {
+		if err := srv.resolveForPlan(ctx, proj, srv.projectJobSpecRepoFactory.New(proj), before); err != nil {
+			return models.JobDeploymentPlan{}, err
+		}
+	}
+	after := newPlanGraph()
+	for i, proj := range plannedProjects {
+		if i > 0 && !overlay.affects(proj.Name, before) {
+			after.copyProject(before, proj.Name)
+			continue
+		}
+		projectJobSpecRepo := overlay.wrap(proj, srv.projectJobSpecRepoFactory.New(proj))
+		if err := srv.resolveForPlan(ctx, proj, projectJobSpecRepo, after); err != nil {
+			return models.JobDeploymentPlan{}, err
+		}
+	}
+
+	plan.DependencyChanges = diffPlanGraph(before, after)
+
+	var changedJobIDs []string
+	for _, name := range plan.Added {
+		changedJobIDs = append(changedJobIDs, models.JobGraphID(namespace.ProjectSpec.Name, name))
+	}
+	for _, name := range plan.Removed {
+		changedJobIDs = append(changedJobIDs, models.JobGraphID(namespace.ProjectSpec.Name, name))
+	}
+	for _, change := range plan.Changed {
+		changedJobIDs = append(changedJobIDs, models.JobGraphID(namespace.ProjectSpec.Name, change.Name))
+	}
+	plan.Affected = affectedDownstreams(changedJobIDs, before, after)
+
+	sort.Strings(plan.Added)
+	sort.Strings(plan.Removed)
+	sort.Slice(plan.Changed, func(i, j int) bool {
+		return plan.Changed[i].Name < plan.Changed[j].Name
+	})
+	return plan, nil
+}
+
+// resolveForPlan resolves dependencies of all jobs of a project and records
+// them in the graph, failures are recorded per job instead of failing the plan
+func (srv *Service) resolveForPlan(ctx context.Context, proj models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
+	graph *planGraph) error {
+	registeredSpecs, err := projectJobSpecRepo.GetAll()
+	if err != nil {
+		return errors.Wrapf(err, "failed to retrieve jobs of project %s", proj.Name)
+	}
+	type resolution struct {
+		spec models.JobSpec
+		err  error
+	}
+	unknownDeps := new(unknownDependencyCollector)
+	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
+	for _, jobSpec := range registeredSpecs {
+		runner.Add(func(currentSpec models.JobSpec) func() (interface{}, error) {
+			return func() (interface{}, error) {
+				// specs can be resolved twice, keep the ones served by repository untouched
+				jobSpec := cloneJobSpecDependencies(currentSpec)
+				assets, err := srv.assetCompiler(ctx, currentSpec, srv.Now())
+				if err != nil {
+					return resolution{spec: currentSpec, err: errors.Wrap(err, "asset compilation")}, nil
+				}
+				jobSpec.Assets = assets
+
+				resolvedSpec, err := srv.dependencyResolver.Resolve(ctx, proj, projectJobSpecRepo, jobSpec, unknownDeps)
+				if err != nil {
+					return resolution{spec: currentSpec, err: err}, nil
+				}
+				return resolution{spec: resolvedSpec}, nil
+			}
+		}(jobSpec))
+	}
+
+	for _, state := range runner.Run() {
+		res := state.Val.(resolution)
+		jobID := models.JobGraphID(proj.Name, res.spec.Name)
+		graph.addJob(models.JobGraphNode{
+			ID:      jobID,
+			Name:    res.spec.Name,
+			Project: proj.Name,
+			Type:    models.JobGraphNodeTypeJob,
+		})
+		if res.err != nil {
+			graph.errs[jobID] = res.err
+			continue
+		}
+		for depName, dep := range res.spec.Dependencies {
+			depProject := proj.Name
+			if dep.Project != nil {
+				depProject = dep.Project.Name
+			}
+			if dep.Job != nil {
+				depName = dep.Job.Name
+			}
+			graph.addDependency(jobID, models.JobGraphNode{
+				ID:      models.JobGraphID(depProject, depName),
+				Name:    depName,
+				Project: depProject,
+				Type:    models.JobGraphNodeTypeJob,
+			})
+		}
+		for _, destination := range unknownDeps.dependencies[res.spec.Name] {
+			graph.unknown[jobID] = append(graph.unknown[jobID], destination)
+		}
+	}
+	return nil
+}
+
+// diffJobSpec lists the parts of a registered job spec modified by the new spec
+func diffJobSpec(ctx context.Context, registered, spec models.JobSpec, proj models.ProjectSpec) (models.JobSpecChange, error) {
+	change := models.JobSpecChange{Name: spec.Name}
+
+	oldDestination, err := jobSpecDestination(ctx, registered, proj)
+	if err != nil {
+		return change, errors.Wrapf(err, "failed to generate destination of %s", registered.Name)
+	}
+	newDestination, err := jobSpecDestination(ctx, spec, proj)
+	if err != nil {
+		return change, errors.Wrapf(err, "failed to generate destination of %s", spec.Name)
+	}
+	if oldDestination != newDestination {
+		change.Fields = append(change.Fields, "destination")
+		change.OldDestination = oldDestination
+		change.NewDestination = newDestination
+	}
+
+	if !sameSchedule(registered.Schedule, spec.Schedule) {
+		change.Fields = append(change.Fields, "schedule")
+	}
+	if registered.Task.Window != spec.Task.Window {
+		change.Fields = append(change.Fields, "window")
+	}
+	if pluginName(registered.Task.Unit) != pluginName(spec.Task.Unit) ||
+		!reflect.DeepEqual(configsToMap(registered.Task.Config), configsToMap(spec.Task.Config)) {
+		change.Fields = append(change.Fields, "task")
+	}
+	if !reflect.DeepEqual(registered.Assets.ToMap(), spec.Assets.ToMap()) {
+		change.Fields = append(change.Fields, "assets")
+	}
+	if !reflect.DeepEqual(registered.Behavior, spec.Behavior) {
+		change.Fields = append(change.Fields, "behavior")
+	}
+	if !sameDependencyNames(registered.Dependencies, spec.Dependencies) {
+		change.Fields = append(change.Fields, "dependencies")
+	}
+	if !sameHooks(registered.Hooks, spec.Hooks) {
+		change.Fields = append(change.Fields, "hooks")
+	}
+	return change, nil
+}
+
+func jobSpecDestination(ctx context.Context, spec models.JobSpec, proj models.ProjectSpec) (string, error) {
+	if spec.Task.Unit == nil || spec.Task.Unit.DependencyMod == nil {
+		return "", nil
+	}
+	resp, err := spec.Task.Unit.DependencyMod.GenerateDestination(ctx, models.GenerateDestinationRequest{
+		Config:  models.PluginConfigs{}.FromJobSpec(spec.Task.Config),
+		Assets:  models.PluginAssets{}.FromJobSpec(spec.Assets),
+		Project: proj,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.Destination, nil
+}
+
+func sameSchedule(a, b models.JobSpecSchedule) bool {
+	if a.Interval != b.Interval || !a.StartDate.Equal(b.StartDate) {
+		return false
+	}
+	if a.EndDate == nil || b.EndDate == nil {
+		return a.EndDate == nil && b.EndDate == nil
+	}
+	return a.EndDate.Equal(*b.EndDate)
+}
+
+func sameDependencyNames(a, b map[string]models.JobSpecDependency) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for name := range a {
+		if _, ok := b[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func sameHooks(a, b []models.JobSpecHook) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	hooks := map[string]map[string]string{}
+	for _, hook := range a {
+		hooks[pluginName(hook.Unit)] = configsToMap(hook.Config)
+	}
+	for _, hook := range b {
+		config, ok := hooks[pluginName(hook.Unit)]
+		if !ok || !reflect.DeepEqual(config, configsToMap(hook.Config)) {
+			return false
+		}
+	}
+	return true
+}
+
+func pluginName(unit *models.Plugin) string {
+	if unit == nil || unit.Base == nil {
+		return ""
+	}
+	return unit.Info().Name
+}
+
+func configsToMap(configs models.JobSpecConfigs) map[string]string {
+	mp := map[string]string{}
+	for _, item := range configs {
+		mp[item.Name] = item.Value
+	}
+	return mp
+}
+
+// planProjects returns the project of deployment followed by other projects
+// where downstream consumers should be looked for
+func planProjects(proj models.ProjectSpec, projects []models.ProjectSpec) []models.ProjectSpec {
+	planned := []models.ProjectSpec{proj}
+	for _, p := range projects {
+		if p.Name != proj.Name {
+			planned = append(planned, p)
+		}
+	}
+	return planned
+}
+
+// planOverlay replaces registered job specs of a namespace with the specs
+// being deployed when read through a wrapped ProjectJobSpecRepository
+type planOverlay struct {
+	namespace models.NamespaceSpec
+
+	specs        map[string]models.JobSpec
+	destinations map[string]string
+	// replaced are registered jobs of the namespace which are either
+	// overridden or removed by the deployment
+	replaced map[string]bool
+	removed  []string
+	// registered are graph IDs of jobs of the namespace before the deployment
+	registered map[string]bool
+}
+
+func newPlanOverlay(ctx context.Context, namespace models.NamespaceSpec, jobSpecs, registeredSpecs []models.JobSpec) (*planOverlay, error) {
+	overlay := &planOverlay{
+		namespace:    namespace,
+		specs:        map[string]models.JobSpec{},
+		destinations: map[string]string{},
+		replaced:     map[string]bool{},
+		registered:   map[string]bool{},
+	}
+
+	var specNames []string
+	for _, spec := range jobSpecs {
+		destination, err := jobSpecDestination(ctx, spec, namespace.ProjectSpec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to generate destination of %s", spec.Name)
+		}
+		if destination != "" {
+			overlay.destinations[destination] = spec.Name
+		}
+		overlay.specs[spec.Name] = spec
+		specNames = append(specNames, spec.Name)
+	}
+
+	var registeredNames []string
+	for _, spec := range registeredSpecs {
+		registeredNames = append(registeredNames, spec.Name)
+		overlay.registered[models.JobGraphID(namespace.ProjectSpec.Name, spec.Name)] = true
+		if _, ok := overlay.specs[spec.Name]; ok {
+			overlay.replaced[spec.Name] = true
+		}
+	}
+	overlay.removed = jobDeletionFilter(setSubstract(registeredNames, specNames))
+	for _, name := range overlay.removed {
+		overlay.replaced[name] = true
+	}
+	return overlay, nil
+}
+
+// affects reports if jobs of a project, as resolved in graph, depend on jobs of
+// the namespace or read a destination only produced after the deployment
+func (o *planOverlay) affects(projectName string, graph *planGraph) bool {
+	for jobID := range graph.jobs {
+		if graph.nodes[jobID].Project != projectName {
+			continue
+		}
+		for upstream := range graph.deps[jobID] {
+			if o.registered[upstream] {
+				return true
+			}
+		}
+		for _, destination := range graph.unknown[jobID] {
+			if _, ok := o.destinations[destination]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (o *planOverlay) wrap(proj models.ProjectSpec, repo store.ProjectJobSpecRepository) store.ProjectJobSpecRepository {
+	return &planJobSpecRepository{
+		ProjectJobSpecRepository: repo,
+		project:                  proj,
+		overlay:                  o,
+	}
+}
+
+// planJobSpecRepository serves job specs of a project as if the planned
+// deployment was already done
+type planJobSpecRepository struct {
+	store.ProjectJobSpecRepository
+	project models.ProjectSpec
+	overlay *planOverlay
+}
+
+func (repo *planJobSpecRepository) isPlannedProject() bool {
+	return repo.project.Name == repo.overlay.namespace.ProjectSpec.Name
+}
+
+func (repo *planJobSpecRepository) GetAll() ([]models.JobSpec, error) {
+	jobSpecs, err := repo.ProjectJobSpecRepository.GetAll()
+	if err != nil || !repo.isPlannedProject() {
+		return jobSpecs, err
+	}
+
+	var planned []models.JobSpec
+	for _, spec := range jobSpecs {
+		if !repo.overlay.replaced[spec.Name] {
+			planned = append(planned, spec)
+		}
+	}
+	for _, spec := range repo.overlay.specs {
+		planned = append(planned, spec)
+	}
+	return planned, nil
+}
+
+func (repo *planJobSpecRepository) GetByName(name string) (models.JobSpec, models.NamespaceSpec, error) {
+	if repo.isPlannedProject() {
+		if spec, ok := repo.overlay.specs[name]; ok {
+			return spec, repo.overlay.namespace, nil
+		}
+		if repo.overlay.replaced[name] {
+			return models.JobSpec{}, models.NamespaceSpec{}, store.ErrResourceNotFound
+		}
+	}
+	return repo.ProjectJobSpecRepository.GetByName(name)
+}
+
+func (repo *planJobSpecRepository) GetByDestination(destination string) (models.JobSpec, models.ProjectSpec, error) {
+	if name, ok := repo.overlay.destinations[destination]; ok {
+		return repo.overlay.specs[name], repo.overlay.namespace.ProjectSpec, nil
+	}
+
+	spec, proj, err := repo.ProjectJobSpecRepository.GetByDestination(destination)
+	if err != nil {
+		return spec, proj, err
+	}
+	if proj.Name == repo.overlay.namespace.ProjectSpec.Name && repo.overlay.replaced[spec.Name] {
+		// destination is no longer produced by the replaced job
+		return models.JobSpec{}, models.ProjectSpec{}, store.ErrResourceNotFound
+	}
+	return spec, proj, nil
+}
+
+// cloneJobSpecDependencies copies dependencies as resolver updates them in place
+func cloneJobSpecDependencies(spec models.JobSpec) models.JobSpec {
+	deps := map[string]models.JobSpecDependency{}
+	for name, dep := range spec.Dependencies {
+		deps[name] = dep
+	}
+	spec.Dependencies = deps
+	return spec
+}
+
+// planGraph holds resolved dependencies of jobs across projects
+type planGraph struct {
+	nodes   map[string]models.JobGraphNode
+	jobs    map[string]bool
+	deps    map[string]map[string]bool
+	unknown map[string][]string
+	errs    map[string]error
+}
+
+func newPlanGraph() *planGraph {
+	return &planGraph{
+		nodes:   map[string]models.JobGraphNode{},
+		jobs:    map[string]bool{},
+		deps:    map[string]map[string]bool{},
+		unknown: map[string][]string{},
+		errs:    map[string]error{},
+	}
+}
+
+func (g *planGraph) addJob(node models.JobGraphNode) {
+	g.nodes[node.ID] = node
+	g.jobs[node.ID] = true
+	if _, ok := g.deps[node.ID]; !ok {
+		g.deps[node.ID] = map[string]bool{}
+	}
+}
+
+func (g *planGraph) addDependency(jobID string, upstream models.JobGraphNode) {
+	if _, ok := g.nodes[upstream.ID]; !ok {
+		g.nodes[upstream.ID] = upstream
+	}
+	g.deps[jobID][upstream.ID] = true
+}
+
+// copyProject adds jobs of a project as resolved in another graph
+func (g *planGraph) copyProject(from *planGraph, projectName string) {
+	for jobID := range from.jobs {
+		if from.nodes[jobID].Project != projectName {
+			continue
+		}
+		g.addJob(from.nodes[jobID])
+		for upstream := range from.deps[jobID] {
+			g.addDependency(jobID, from.nodes[upstream])
+		}
+		if unknown, ok := from.unknown[jobID]; ok {
+			g.unknown[jobID] = unknown
+		}
+		if err, ok := from.errs[jobID]; ok {
+			g.errs[jobID] = err
+		}
+	}
+}
+
+// diffPlanGraph reports jobs whose resolved upstreams differ after the deployment,
+// jobs which won't exist anymore are skipped
+func diffPlanGraph(before, after *planGraph) []models.JobDependencyChange {
+	var changes []models.JobDependencyChange
+	for jobID := range after.jobs {
+		change := models.JobDependencyChange{Job: after.nodes[jobID]}
+		if err, ok := after.errs[jobID]; ok {
+			if _, failedBefore := before.errs[jobID]; !failedBefore {
+				change.Err = err
+			}
+		} else if _, failedBefore := before.errs[jobID]; !failedBefore {
+			for upstream := range after.deps[jobID] {
+				if !before.deps[jobID][upstream] {
+					change.Added = append(change.Added, upstream)
+				}
+			}
+			for upstream := range before.deps[jobID] {
+				if !after.deps[jobID][upstream] {
+					change.Removed = append(change.Removed, upstream)
+				}
+			}
+		}
+
+		knownBefore := map[string]bool{}
+		for _, destination := range before.unknown[jobID] {
+			knownBefore[destination] = true
+		}
+		for _, destination := range after.unknown[jobID] {
+			if !knownBefore[destination] {
+				change.Unknown = append(change.Unknown, destination)
+			}
+		}
+
+		if len(change.Added) == 0 && len(change.Removed) == 0 && len(change.Unknown) == 0 && change.Err == nil {
+			continue
+		}
+		sort.Strings(change.Added)
+		sort.Strings(change.Removed)
+		sort.Strings(change.Unknown)
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Job.ID < changes[j].Job.ID
+	})
+	return changes
+}
+
+// affectedDownstreams returns all the jobs which transitively depend on provided
+// jobs either before or after the deployment
+func affectedDownstreams(jobIDs []string, before, after *planGraph) []models.JobGraphNode {
+	downstreams := map[string][]string{}
+	nodes := map[string]models.JobGraphNode{}
+	for _, graph := range []*planGraph{before, after} {
+		for jobID, upstreams := range graph.deps {
+			nodes[jobID] = graph.nodes[jobID]
+			for upstream := range upstreams {
+				downstreams[upstream] = append(downstreams[upstream], jobID)
+			}
+		}
+	}
+
+	visited := map[string]bool{}
+	queue := append([]string{}, jobIDs...)
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, downstream := range downstreams[current] {
+			if visited[downstream] {
+				continue
+			}
+			visited[downstream] = true
+			queue = append(queue, downstream)
+		}
+	}
+
+	var affected []models.JobGraphNode
+	for jobID := range visited {
+		affected = append(affected, nodes[jobID])
+	}
+	sort.Slice(affected, func(i, j int) bool {
+		return affected[i].ID < affected[j].ID
+	})
+	return affected
+}
@@ -0,0 +1,207 @@
+package job_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+func TestPlanDeployment(t *testing.T) {
+	dumpAssets := func(_ context.Context, jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
+		return jobSpec.Assets, nil
+	}
+
+	projSpec := models.ProjectSpec{
+		Name: "proj",
+	}
+	externalProjSpec := models.ProjectSpec{
+		Name: "ext",
+	}
+	namespaceSpec := models.NamespaceSpec{
+		Name:        "ns",
+		ProjectSpec: projSpec,
+	}
+
+	basePlugin := new(mock.BasePlugin)
+	basePlugin.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq"}, nil).Maybe()
+
+	startDate := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	newJobSpec := func(name, interval, destination string, dependencies ...string) models.JobSpec {
+		depMod := new(mock.DependencyResolverMod)
+		depMod.On("GenerateDestination", testMock.Anything, testMock.Anything).
+			Return(&models.GenerateDestinationResponse{Destination: destination}, nil).Maybe()
+		depMod.On("GenerateDependencies", testMock.Anything, testMock.Anything).
+			Return(&models.GenerateDependenciesResponse{Dependencies: dependencies}, nil).Maybe()
+		return models.JobSpec{
+			Name: name,
+			Schedule: models.JobSpecSchedule{
+				StartDate: startDate,
+				Interval:  interval,
+			},
+			Task: models.JobSpecTask{
+				Unit: &models.Plugin{Base: basePlugin, DependencyMod: depMod},
+			},
+			Dependencies: map[string]models.JobSpecDependency{},
+		}
+	}
+
+	t.Run("should report changes of jobs, their dependencies and affected downstream", func(t *testing.T) {
+		// registered: job-a <- job-b <- job-c, job-b <- ext/job-x
+		jobA := newJobSpec("job-a", "@daily", "proj.ds.a")
+		jobB := newJobSpec("job-b", "@daily", "proj.ds.b", "proj.ds.a")
+		jobC := newJobSpec("job-c", "@daily", "proj.ds.c", "proj.ds.b")
+		jobX := newJobSpec("job-x", "@daily", "ext.ds.x", "proj.ds.b")
+
+		// deploying: job-b moves to a new destination on a new schedule,
+		// job-c is removed and job-d reads new destination of job-b
+		localJobA := newJobSpec("job-a", "@daily", "proj.ds.a")
+		localJobB := newJobSpec("job-b", "@hourly", "proj.ds.b2", "proj.ds.a")
+		localJobD := newJobSpec("job-d", "@daily", "proj.ds.d", "proj.ds.b2")
+
+		jobSpecRepo := new(mock.JobSpecRepository)
+		jobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB, jobC}, nil)
+		defer jobSpecRepo.AssertExpectations(t)
+
+		jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+		jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+		defer jobSpecRepoFac.AssertExpectations(t)
+
+		mockDestinations := func(repo *mock.ProjectJobSpecRepository) {
+			repo.On("GetByDestination", "proj.ds.a").Return(jobA, projSpec, nil).Maybe()
+			repo.On("GetByDestination", "proj.ds.b").Return(jobB, projSpec, nil).Maybe()
+		}
+		projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB, jobC}, nil)
+		mockDestinations(projectJobSpecRepo)
+		defer projectJobSpecRepo.AssertExpectations(t)
+
+		externalProjectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		externalProjectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobX}, nil)
+		mockDestinations(externalProjectJobSpecRepo)
+		defer externalProjectJobSpecRepo.AssertExpectations(t)
+
+		projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+		projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+		projJobSpecRepoFac.On("New", externalProjSpec).Return(externalProjectJobSpecRepo)
+		defer projJobSpecRepoFac.AssertExpectations(t)
+
+		svc := job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+		plan, err := svc.PlanDeployment(context.Background(), namespaceSpec, []models.JobSpec{localJobA, localJobB, localJobD},
+			[]models.ProjectSpec{projSpec, externalProjSpec})
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"job-d"}, plan.Added)
+		assert.Equal(t, []string{"job-c"}, plan.Removed)
+		assert.Equal(t, []models.JobSpecChange{
+			{
+				Name:           "job-b",
+				Fields:         []string{"destination", "schedule"},
+				OldDestination: "proj.ds.b",
+				NewDestination: "proj.ds.b2",
+			},
+		}, plan.Changed)
+		assert.Equal(t, []models.JobDependencyChange{
+			{
+				Job:     models.JobGraphNode{ID: "ext/job-x", Name: "job-x", Project: "ext", Type: models.JobGraphNodeTypeJob},
+				Removed: []string{"proj/job-b"},
+				Unknown: []string{"proj.ds.b"},
+			},
+			{
+				Job:   models.JobGraphNode{ID: "proj/job-d", Name: "job-d", Project: "proj", Type: models.JobGraphNodeTypeJob},
+				Added: []string{"proj/job-b"},
+			},
+		}, plan.DependencyChanges)
+
+		var affected []string
+		for _, node := range plan.Affected {
+			affected = append(affected, node.ID)
+		}
+		assert.Equal(t, []string{"ext/job-x", "proj/job-c", "proj/job-d"}, affected)
+	})
+	t.Run("should report broken static dependency of a removed job", func(t *testing.T) {
+		jobA := newJobSpec("job-a", "@daily", "proj.ds.a")
+		jobB := newJobSpec("job-b", "@daily", "proj.ds.b")
+		jobB.Dependencies["job-a"] = models.JobSpecDependency{}
+		jobC := newJobSpec("job-c", "@daily", "proj.ds.c")
+
+		jobSpecRepo := new(mock.JobSpecRepository)
+		jobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA}, nil)
+		defer jobSpecRepo.AssertExpectations(t)
+
+		jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+		jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+		defer jobSpecRepoFac.AssertExpectations(t)
+
+		// job-b belongs to another namespace of the same project
+		projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB}, nil)
+		projectJobSpecRepo.On("GetByName", "job-a").Return(jobA, namespaceSpec, nil)
+		defer projectJobSpecRepo.AssertExpectations(t)
+
+		projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+		projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+		defer projJobSpecRepoFac.AssertExpectations(t)
+
+		svc := job.NewService(jobSpecRepoFac, nil, nil, dumpAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+		plan, err := svc.PlanDeployment(context.Background(), namespaceSpec, []models.JobSpec{jobC}, nil)
+		assert.Nil(t, err)
+
+		assert.Equal(t, []string{"job-c"}, plan.Added)
+		assert.Equal(t, []string{"job-a"}, plan.Removed)
+		assert.Equal(t, 1, len(plan.DependencyChanges))
+		assert.Equal(t, "proj/job-b", plan.DependencyChanges[0].Job.ID)
+		assert.True(t, plan.DependencyChanges[0].Err != nil)
+		assert.Contains(t, plan.DependencyChanges[0].Err.Error(), store.ErrResourceNotFound.Error())
+		assert.Equal(t, []models.JobGraphNode{
+			{ID: "proj/job-b", Name: "job-b", Project: "proj", Type: models.JobGraphNodeTypeJob},
+		}, plan.Affected)
+	})
+	t.Run("should record asset compilation failures per job and skip resolving unaffected projects again", func(t *testing.T) {
+		jobA := newJobSpec("job-a", "@daily", "proj.ds.a")
+		jobB := newJobSpec("job-b", "@daily", "proj.ds.b")
+		jobY := newJobSpec("job-y", "@daily", "other.ds.y")
+
+		jobSpecRepo := new(mock.JobSpecRepository)
+		jobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA}, nil)
+		defer jobSpecRepo.AssertExpectations(t)
+
+		jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+		jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+		defer jobSpecRepoFac.AssertExpectations(t)
+
+		projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobA, jobB}, nil)
+		defer projectJobSpecRepo.AssertExpectations(t)
+
+		// other project doesn't depend on the namespace, it is resolved only once
+		otherProjectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+		otherProjectJobSpecRepo.On("GetAll").Return([]models.JobSpec{jobY}, nil).Once()
+		defer otherProjectJobSpecRepo.AssertExpectations(t)
+
+		projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+		projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+		projJobSpecRepoFac.On("New", externalProjSpec).Return(otherProjectJobSpecRepo).Once()
+		defer projJobSpecRepoFac.AssertExpectations(t)
+
+		failingAssets := func(_ context.Context, jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
+			if jobSpec.Name == "job-b" {
+				return models.JobAssets{}, errors.New("invalid macro")
+			}
+			return jobSpec.Assets, nil
+		}
+		svc := job.NewService(jobSpecRepoFac, nil, nil, failingAssets, job.NewDependencyResolver(), nil, nil, projJobSpecRepoFac, nil)
+		plan, err := svc.PlanDeployment(context.Background(), namespaceSpec, []models.JobSpec{jobA},
+			[]models.ProjectSpec{projSpec, externalProjSpec})
+		assert.Nil(t, err)
+		assert.Empty(t, plan.DependencyChanges)
+		assert.Empty(t, plan.Affected)
+	})
+}
@@ -1,6 +1,9 @@
 package job
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/odpf/optimus/core/tree"
 	"github.com/odpf/optimus/models"
 	"github.com/pkg/errors"
@@ -17,6 +20,13 @@ const (
 	// PriorityWeightGap - while giving weights to the DAG, what's the GAP
 	// do we want to consider. PriorityWeightGap = 1 means, weights will be 1, 2, 3 etc.
 	PriorityWeightGap = 10
+
+	// PriorityResolverDepth assigns weights by depth of jobs from roots
+	PriorityResolverDepth = "depth"
+
+	// PriorityResolverCriticalPath assigns weights by run duration of jobs
+	// ahead of critical and SLA bound downstream jobs
+	PriorityResolverCriticalPath = "critical_path"
 )
 
 var (
@@ -25,12 +35,42 @@ var (
 
 	// ErrPriorityNotFound is thrown when priority of a given spec is not found
 	ErrPriorityNotFound = errors.New("priority weight not found")
+
+	// ErrUnknownPriorityResolver is thrown when a project asks for a
+	// priority resolver which is not registered
+	ErrUnknownPriorityResolver = errors.New("unknown priority resolver")
 )
 
 // PriorityResolver defines an interface that represents getting
 // priority weight of Jobs based on their dependencies
 type PriorityResolver interface {
-	Resolve([]models.JobSpec) ([]models.JobSpec, error)
+	Resolve(context.Context, models.ProjectSpec, []models.JobSpec) ([]models.JobSpec, error)
+}
+
+// projectPriorityResolver delegates resolution to the resolver selected by
+// project config ProjectPriorityResolver, falling back to depth based weights
+type projectPriorityResolver struct {
+	resolvers map[string]PriorityResolver
+}
+
+// NewProjectPriorityResolver creates a PriorityResolver choosing one of the
+// provided resolvers, keyed by name, per project
+func NewProjectPriorityResolver(resolvers map[string]PriorityResolver) *projectPriorityResolver {
+	return &projectPriorityResolver{
+		resolvers: resolvers,
+	}
+}
+
+func (a *projectPriorityResolver) Resolve(ctx context.Context, proj models.ProjectSpec, jobSpecs []models.JobSpec) ([]models.JobSpec, error) {
+	name, ok := proj.Config[models.ProjectPriorityResolver]
+	if !ok || name == "" {
+		name = PriorityResolverDepth
+	}
+	resolver, ok := a.resolvers[name]
+	if !ok {
+		return nil, errors.Wrapf(ErrUnknownPriorityResolver, "%s for project %s", name, proj.Name)
+	}
+	return resolver.Resolve(ctx, proj, jobSpecs)
 }
 
 // priorityResolver runs a breadth first traversal on DAG/Job dependencies trees
@@ -51,7 +91,7 @@ func NewPriorityResolver() *priorityResolver {
 }
 
 // Resolve takes jobSpecs and returns them with resolved priorities
-func (a *priorityResolver) Resolve(jobSpecs []models.JobSpec) ([]models.JobSpec, error) {
+func (a *priorityResolver) Resolve(_ context.Context, _ models.ProjectSpec, jobSpecs []models.JobSpec) ([]models.JobSpec, error) {
 	if err := a.resolvePriorities(jobSpecs); err != nil {
 		return nil, errors.Wrap(err, "error occurred while resolving priority")
 	}
@@ -79,6 +119,8 @@ func (a *priorityResolver) resolvePriorities(jobSpecs []models.JobSpec) error {
 			return errors.Wrap(ErrPriorityNotFound, jobSpec.Name)
 		}
 		jobSpec.Task.Priority = priority
+		jobSpec.Task.PriorityReason = fmt.Sprintf("%s: %d level(s) below root jobs", PriorityResolverDepth,
+			(MaxPriorityWeight-priority)/PriorityWeightGap)
 		jobSpecs[idx] = jobSpec
 	}
 
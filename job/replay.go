@@ -16,9 +16,11 @@ const (
 	ReplayDateFormat = "2006-01-02"
 )
 
-func (srv *Service) populateRequestWithJobSpecs(replayRequest *models.ReplayWorkerRequest) error {
+// PopulateReplayRequest fills in dependency resolved job specs of the project
+// a replay is requested in
+func (srv *Service) PopulateReplayRequest(ctx context.Context, replayRequest *models.ReplayWorkerRequest) error {
 	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(replayRequest.Project)
-	jobSpecs, err := srv.GetDependencyResolvedSpecs(replayRequest.Project, projectJobSpecRepo, nil)
+	jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, replayRequest.Project, projectJobSpecRepo, nil)
 	if err != nil {
 		return err
 	}
@@ -30,8 +32,8 @@ func (srv *Service) populateRequestWithJobSpecs(replayRequest *models.ReplayWork
 	return nil
 }
 
-func (srv *Service) ReplayDryRun(replayRequest *models.ReplayWorkerRequest) (*tree.TreeNode, error) {
-	if err := srv.populateRequestWithJobSpecs(replayRequest); err != nil {
+func (srv *Service) ReplayDryRun(ctx context.Context, replayRequest *models.ReplayWorkerRequest) (*tree.TreeNode, error) {
+	if err := srv.PopulateReplayRequest(ctx, replayRequest); err != nil {
 		return nil, err
 	}
 
@@ -44,7 +46,7 @@ func (srv *Service) ReplayDryRun(replayRequest *models.ReplayWorkerRequest) (*tr
 }
 
 func (srv *Service) Replay(ctx context.Context, replayRequest *models.ReplayWorkerRequest) (string, error) {
-	if err := srv.populateRequestWithJobSpecs(replayRequest); err != nil {
+	if err := srv.PopulateReplayRequest(ctx, replayRequest); err != nil {
 		return "", err
 	}
 
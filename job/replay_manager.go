@@ -3,6 +3,7 @@ package job
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -23,6 +24,9 @@ var (
 	ErrConflictedJobRun = errors.New("conflicted job run found")
 	//ReplayRunTimeout signifies type of replay failure caused by timeout
 	ReplayRunTimeout = "long running replay timeout"
+	// ReplayPrepareFailed signifies type of replay failure caused by a request
+	// which couldn't be rebuilt for dispatch
+	ReplayPrepareFailed = "failed to prepare replay"
 	// TimestampLogFormat format of a timestamp will be used in logs
 	TimestampLogFormat = "2006-01-02T15:04:05+00:00"
 	// ReplayStatusToValidate signifies list of status to be used when checking active replays
@@ -33,44 +37,60 @@ type ReplayManagerConfig struct {
 	NumWorkers    int
 	WorkerTimeout time.Duration
 	RunTimeout    time.Duration
+	// DispatchInterval is how often accepted replays are looked up
+	DispatchInterval time.Duration
 }
 
 type ReplayManager interface {
-	Init()
 	Replay(context.Context, *models.ReplayWorkerRequest) (string, error)
 }
 
+// ReplayRequestPopulator fills in job specs of the project a replay request
+// is processed against
+type ReplayRequestPopulator interface {
+	PopulateReplayRequest(context.Context, *models.ReplayWorkerRequest) error
+}
+
 // Manager for replaying operation(s).
-// Offers an asynchronous interface to pipeline, with a fixed size request queue
-// Each replay request is handled by a replay worker and the number of parallel replay workers
-// can be provided through configuration.
+// Offers an asynchronous interface to pipeline, replays accepted by any server
+// are stored and dispatched to replay workers of a single server with Dispatch.
+// The number of parallel replay workers can be provided through configuration.
 type Manager struct {
-	// wait group to synchronise on workers
-	wg sync.WaitGroup
 	mu sync.Mutex
 
 	uuidProvider utils.UUIDProvider
 	config       ReplayManagerConfig
 
-	// request queue, used by workers
-	requestQ chan *models.ReplayWorkerRequest
 	// request map, used for verifying if a request is
-	// in queue without actually consuming it
+	// dispatched to a worker till it is processed
 	requestMap map[uuid.UUID]bool
 
 	//request worker
 	replayWorker ReplayWorker
 
 	replaySpecRepoFac ReplaySpecRepoFactory
+	projectRepoFac    ProjectRepoFactory
 	scheduler         models.SchedulerUnit
+
+	// serializes replays among servers, requests of a project are validated
+	// one at a time and replays of a job are processed one at a time
+	locker store.KeyLocker
 }
 
 // Replay a request asynchronously, returns a replay id that can
-// can be used to query its status
+// can be used to query its status. The request is stored as accepted
+// till it is dispatched to a worker.
 func (m *Manager) Replay(ctx context.Context, reqInput *models.ReplayWorkerRequest) (string, error) {
 	replaySpecRepo := m.replaySpecRepoFac.New(reqInput.Job)
 
-	err := m.validate(ctx, replaySpecRepo, reqInput)
+	// replays accepted by another server meanwhile would be missed by validation
+	unlock, err := m.locker.Lock(ctx, "replay-request:"+reqInput.Project.Name)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to wait for other replay requests of project")
+	}
+	defer unlock()
+
+	err = m.validate(ctx, replaySpecRepo, reqInput)
 	if err != nil {
 		return "", err
 	}
@@ -92,21 +112,7 @@ func (m *Manager) Replay(ctx context.Context, reqInput *models.ReplayWorkerReque
 	if err = replaySpecRepo.Insert(&replay); err != nil {
 		return "", err
 	}
-
-	// try sending the job request down the request queue
-	// if full return error indicating that we don't have capacity
-	// to process this request at the moment
-	select {
-	case m.requestQ <- reqInput:
-		m.mu.Lock()
-		//request pushed to worker
-		m.requestMap[reqInput.ID] = true
-		m.mu.Unlock()
-
-		return reqInput.ID.String(), nil
-	default:
-		return "", ErrRequestQueueFull
-	}
+	return reqInput.ID.String(), nil
 }
 
 func (m *Manager) validate(ctx context.Context, replaySpecRepo store.ReplaySpecRepository, reqInput *models.ReplayWorkerRequest) error {
@@ -220,46 +226,159 @@ func checkAnyConflictedRuns(activeNode *tree.TreeNode, reqNode *tree.TreeNode) e
 	return nil
 }
 
-// start a worker goroutine that runs the deployment pipeline in background
-func (m *Manager) spawnServiceWorker() {
-	defer m.wg.Done()
+// Dispatch hands replays accepted by any server to NumWorkers workers till
+// ctx is done. It is run by a single server, so only its workers hold
+// database connections for replays. Replays picked up by workers are
+// processed before it returns.
+func (m *Manager) Dispatch(ctx context.Context, populator ReplayRequestPopulator) {
+	logger.I("starting replay workers")
+	requestQ := make(chan *models.ReplayWorkerRequest)
+	var workers sync.WaitGroup
+	for i := 0; i < m.config.NumWorkers; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			m.spawnServiceWorker(requestQ)
+		}()
+	}
+	defer func() {
+		close(requestQ)
+		workers.Wait()
+	}()
+
+	ticker := time.NewTicker(m.config.DispatchInterval)
+	defer ticker.Stop()
+	for {
+		m.dispatchAccepted(ctx, populator, requestQ)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// dispatchAccepted sends accepted replays not yet dispatched down the
+// request queue, oldest first, as workers get free
+func (m *Manager) dispatchAccepted(ctx context.Context, populator ReplayRequestPopulator,
+	requestQ chan<- *models.ReplayWorkerRequest) {
+	replaySpecRepo := m.replaySpecRepoFac.New(models.JobSpec{})
+	acceptedReplaySpecs, err := replaySpecRepo.GetByStatus([]string{models.ReplayStatusAccepted})
+	if err != nil {
+		if !errors.Is(err, store.ErrResourceNotFound) {
+			logger.E(errors.Wrap(err, "failed to fetch accepted replays"))
+		}
+		return
+	}
+	sort.Slice(acceptedReplaySpecs, func(i, j int) bool {
+		return acceptedReplaySpecs[i].CreatedAt.Before(acceptedReplaySpecs[j].CreatedAt)
+	})
+
+	var waitingReplaySpecs []models.ReplaySpec
+	m.mu.Lock()
+	for _, replaySpec := range acceptedReplaySpecs {
+		if !m.requestMap[replaySpec.ID] {
+			waitingReplaySpecs = append(waitingReplaySpecs, replaySpec)
+		}
+	}
+	m.mu.Unlock()
+	replayQueueDepth.Set(float64(len(waitingReplaySpecs)))
+
+	for _, replaySpec := range waitingReplaySpecs {
+		reqInput, err := m.prepareRequest(ctx, populator, replaySpec)
+		if err != nil {
+			logger.E(errors.Wrapf(err, "failed to prepare replay %s", replaySpec.ID))
+			if updateStatusErr := replaySpecRepo.UpdateStatus(replaySpec.ID, models.ReplayStatusFailed, models.ReplayMessage{
+				Type:    ReplayPrepareFailed,
+				Message: err.Error(),
+			}); updateStatusErr != nil {
+				logger.E(errors.Wrapf(updateStatusErr, "failed to mark replay %s as failed", replaySpec.ID))
+			}
+			replayQueueDepth.Dec()
+			continue
+		}
+
+		m.mu.Lock()
+		m.requestMap[reqInput.ID] = true
+		m.mu.Unlock()
+		select {
+		case requestQ <- reqInput:
+			replayQueueDepth.Dec()
+		case <-ctx.Done():
+			m.mu.Lock()
+			delete(m.requestMap, reqInput.ID)
+			m.mu.Unlock()
+			return
+		}
+	}
+}
+
+// prepareRequest rebuilds the request of a stored replay
+func (m *Manager) prepareRequest(ctx context.Context, populator ReplayRequestPopulator,
+	replaySpec models.ReplaySpec) (*models.ReplayWorkerRequest, error) {
+	projectSpec, err := m.projectRepoFac.New().GetByName(replaySpec.Project.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch project %s", replaySpec.Project.Name)
+	}
+	reqInput := &models.ReplayWorkerRequest{
+		ID:      replaySpec.ID,
+		Job:     replaySpec.Job,
+		Start:   replaySpec.StartDate,
+		End:     replaySpec.EndDate,
+		Project: projectSpec,
+	}
+	if err := populator.PopulateReplayRequest(ctx, reqInput); err != nil {
+		return nil, err
+	}
+	return reqInput, nil
+}
 
-	for reqInput := range m.requestQ {
+// start a worker goroutine that runs the replay pipeline in background
+func (m *Manager) spawnServiceWorker(requestQ <-chan *models.ReplayWorkerRequest) {
+	for reqInput := range requestQ {
+		replaysInFlight.Inc()
 		logger.I("worker picked up the request for ", reqInput.Job.Name)
 		ctx, cancelCtx := context.WithTimeout(context.Background(), m.config.WorkerTimeout)
-		if err := m.replayWorker.Process(ctx, reqInput); err != nil {
+		result := metricResultSuccess
+		if err := m.process(ctx, reqInput); err != nil {
 			//do something about this error
 			logger.E(errors.Wrap(err, "worker failed to process"))
+			result = metricResultFailure
 			cancelCtx()
 		}
 		cancelCtx()
-	}
-}
+		replaysInFlight.Dec()
+		replayOutcomes.WithLabelValues(reqInput.Project.Name, result).Inc()
 
-//Close stops consuming any new request
-func (m *Manager) Close() error {
-	if m.requestQ != nil {
-		//stop accepting any more requests
-		close(m.requestQ)
+		m.mu.Lock()
+		delete(m.requestMap, reqInput.ID)
+		m.mu.Unlock()
 	}
-
-	//wait for request worker to finish
-	m.wg.Wait()
-
-	return nil
 }
 
-func (m *Manager) Init() {
-	m.shuttingDownTimedOutReplays()
+// process runs a replay once other replays of its job, e.g. ones cancelled by
+// a forced replay or still run by a previous leader, are done. Replays which
+// are no longer accepted meanwhile are skipped.
+func (m *Manager) process(ctx context.Context, reqInput *models.ReplayWorkerRequest) error {
+	unlock, err := m.locker.Lock(ctx, "replay:"+reqInput.Job.ID.String())
+	if err != nil {
+		return errors.Wrap(err, "failed to wait for other replays of job")
+	}
+	defer unlock()
 
-	logger.I("starting replay workers")
-	for i := 0; i < m.config.NumWorkers; i++ {
-		m.wg.Add(1)
-		go m.spawnServiceWorker()
+	replaySpec, err := m.replaySpecRepoFac.New(reqInput.Job).GetByID(reqInput.ID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to fetch replay %s", reqInput.ID)
+	}
+	if replaySpec.Status != models.ReplayStatusAccepted {
+		logger.I(fmt.Sprintf("skipping replay %s, it is %s", reqInput.ID, replaySpec.Status))
+		return nil
 	}
+	return m.replayWorker.Process(ctx, reqInput)
 }
 
-func (m *Manager) shuttingDownTimedOutReplays() {
+// FailTimedOutReplays marks replays running longer than run timeout as failed
+func (m *Manager) FailTimedOutReplays() {
 	replaySpecRepo := m.replaySpecRepoFac.New(models.JobSpec{})
 	runningReplaySpecs, err := replaySpecRepo.GetByStatus(ReplayStatusToValidate)
 	if err != nil {
@@ -279,17 +398,16 @@ func (m *Manager) shuttingDownTimedOutReplays() {
 }
 
 // NewManager constructs a new instance of Manager
-func NewManager(worker ReplayWorker, replaySpecRepoFac ReplaySpecRepoFactory, uuidProvider utils.UUIDProvider,
-	config ReplayManagerConfig, scheduler models.SchedulerUnit) *Manager {
-	mgr := &Manager{
+func NewManager(worker ReplayWorker, replaySpecRepoFac ReplaySpecRepoFactory, projectRepoFac ProjectRepoFactory,
+	locker store.KeyLocker, uuidProvider utils.UUIDProvider, config ReplayManagerConfig, scheduler models.SchedulerUnit) *Manager {
+	return &Manager{
 		replayWorker:      worker,
+		locker:            locker,
 		requestMap:        make(map[uuid.UUID]bool),
 		config:            config,
-		requestQ:          make(chan *models.ReplayWorkerRequest, 0),
 		replaySpecRepoFac: replaySpecRepoFac,
+		projectRepoFac:    projectRepoFac,
 		uuidProvider:      uuidProvider,
 		scheduler:         scheduler,
 	}
-	mgr.Init()
-	return mgr
 }
@@ -15,30 +15,157 @@ import (
 	"github.com/odpf/optimus/store"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
 )
 
 func TestReplayManager(t *testing.T) {
 	ctx := context.Background()
 	logger.InitWithWriter(logger.DEBUG, ioutil.Discard)
-	t.Run("Close", func(t *testing.T) {
+	t.Run("Dispatch", func(t *testing.T) {
 		replayManagerConfig := job.ReplayManagerConfig{
-			NumWorkers:    5,
-			WorkerTimeout: 1000,
+			NumWorkers:       1,
+			WorkerTimeout:    time.Minute,
+			DispatchInterval: time.Hour,
+		}
+		startDate, _ := time.Parse(job.ReplayDateFormat, "2020-08-22")
+		endDate, _ := time.Parse(job.ReplayDateFormat, "2020-08-26")
+		projectSpec := models.ProjectSpec{
+			Name: "project-name",
+		}
+		jobSpec := models.JobSpec{
+			ID:   uuid.Must(uuid.NewRandom()),
+			Name: "job-name",
+		}
+		jobSpecMap := map[string]models.JobSpec{
+			jobSpec.Name: jobSpec,
 		}
+		replaySpec := models.ReplaySpec{
+			ID:        uuid.Must(uuid.NewRandom()),
+			Job:       jobSpec,
+			Project:   models.ProjectSpec{Name: projectSpec.Name},
+			StartDate: startDate,
+			EndDate:   endDate,
+			Status:    models.ReplayStatusAccepted,
+		}
+		populate := func(args testMock.Arguments) {
+			args.Get(1).(*models.ReplayWorkerRequest).JobSpecMap = jobSpecMap
+		}
+		replayRequest := &models.ReplayWorkerRequest{
+			ID:         replaySpec.ID,
+			Job:        jobSpec,
+			Start:      startDate,
+			End:        endDate,
+			Project:    projectSpec,
+			JobSpecMap: jobSpecMap,
+		}
+
+		t.Run("should process accepted replays with workers till ctx is done", func(t *testing.T) {
+			dispatchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByStatus", []string{models.ReplayStatusAccepted}).Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("GetByID", replaySpec.ID).Return(replaySpec, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
+			replaySpecRepoFac.On("New", jobSpec).Return(replayRepository)
+
+			projectRepository := new(mock.ProjectRepository)
+			defer projectRepository.AssertExpectations(t)
+			projectRepository.On("GetByName", projectSpec.Name).Return(projectSpec, nil)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			defer projectRepoFac.AssertExpectations(t)
+			projectRepoFac.On("New").Return(projectRepository)
+
+			populator := new(mock.ReplayRequestPopulator)
+			defer populator.AssertExpectations(t)
+			populator.On("PopulateReplayRequest", dispatchCtx, testMock.Anything).Run(populate).Return(nil)
+
+			locker := new(mock.KeyLocker)
+			defer locker.AssertExpectations(t)
+			locker.On("Lock", testMock.Anything, "replay:"+jobSpec.ID.String()).Return(func() {}, nil)
+
+			replayWorker := new(mock.ReplayWorker)
+			defer replayWorker.AssertExpectations(t)
+			replayWorker.On("Process", testMock.Anything, replayRequest).Run(func(testMock.Arguments) {
+				cancel()
+			}).Return(nil)
+
+			replayManager := job.NewManager(replayWorker, replaySpecRepoFac, projectRepoFac, locker, nil, replayManagerConfig, nil)
+			replayManager.Dispatch(dispatchCtx, populator)
+		})
+		t.Run("should skip replays which are no longer accepted", func(t *testing.T) {
+			dispatchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			cancelledReplaySpec := replaySpec
+			cancelledReplaySpec.Status = models.ReplayStatusCancelled
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByStatus", []string{models.ReplayStatusAccepted}).Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("GetByID", replaySpec.ID).Run(func(testMock.Arguments) {
+				cancel()
+			}).Return(cancelledReplaySpec, nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
+			replaySpecRepoFac.On("New", jobSpec).Return(replayRepository)
+
+			projectRepository := new(mock.ProjectRepository)
+			defer projectRepository.AssertExpectations(t)
+			projectRepository.On("GetByName", projectSpec.Name).Return(projectSpec, nil)
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			defer projectRepoFac.AssertExpectations(t)
+			projectRepoFac.On("New").Return(projectRepository)
 
-		replayRepository := new(mock.ReplayRepository)
-		defer replayRepository.AssertExpectations(t)
-		replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, nil)
+			populator := new(mock.ReplayRequestPopulator)
+			defer populator.AssertExpectations(t)
+			populator.On("PopulateReplayRequest", dispatchCtx, testMock.Anything).Run(populate).Return(nil)
 
-		replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
-		defer replaySpecRepoFac.AssertExpectations(t)
-		replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
+			locker := new(mock.KeyLocker)
+			defer locker.AssertExpectations(t)
+			locker.On("Lock", testMock.Anything, "replay:"+jobSpec.ID.String()).Return(func() {}, nil)
 
-		manager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, nil)
-		err := manager.Close()
-		assert.Nil(t, err)
+			replayWorker := new(mock.ReplayWorker)
+			defer replayWorker.AssertExpectations(t)
+
+			replayManager := job.NewManager(replayWorker, replaySpecRepoFac, projectRepoFac, locker, nil, replayManagerConfig, nil)
+			replayManager.Dispatch(dispatchCtx, populator)
+		})
+		t.Run("should fail replays which can't be prepared", func(t *testing.T) {
+			dispatchCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			replayRepository := new(mock.ReplayRepository)
+			defer replayRepository.AssertExpectations(t)
+			replayRepository.On("GetByStatus", []string{models.ReplayStatusAccepted}).Return([]models.ReplaySpec{replaySpec}, nil)
+			replayRepository.On("UpdateStatus", replaySpec.ID, models.ReplayStatusFailed, models.ReplayMessage{
+				Type:    job.ReplayPrepareFailed,
+				Message: "failed to fetch project project-name: project not found",
+			}).Run(func(testMock.Arguments) {
+				cancel()
+			}).Return(nil)
+
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
+
+			projectRepository := new(mock.ProjectRepository)
+			defer projectRepository.AssertExpectations(t)
+			projectRepository.On("GetByName", projectSpec.Name).Return(models.ProjectSpec{}, errors.New("project not found"))
+			projectRepoFac := new(mock.ProjectRepoFactory)
+			defer projectRepoFac.AssertExpectations(t)
+			projectRepoFac.On("New").Return(projectRepository)
+
+			replayManager := job.NewManager(nil, replaySpecRepoFac, projectRepoFac, nil, nil, replayManagerConfig, nil)
+			replayManager.Dispatch(dispatchCtx, new(mock.ReplayRequestPopulator))
+		})
 	})
-	t.Run("Init", func(t *testing.T) {
+	t.Run("FailTimedOutReplays", func(t *testing.T) {
 		replayManagerConfig := job.ReplayManagerConfig{
 			NumWorkers:    0,
 			WorkerTimeout: 1000,
@@ -82,8 +209,8 @@ func TestReplayManager(t *testing.T) {
 			defer replaySpecRepoFac.AssertExpectations(t)
 			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, nil)
-			replayManager.Init()
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, nil, nil, replayManagerConfig, nil)
+			replayManager.FailTimedOutReplays()
 		})
 	})
 	t.Run("Replay", func(t *testing.T) {
@@ -122,15 +249,30 @@ func TestReplayManager(t *testing.T) {
 				jobSpec2.Name: jobSpec2,
 			},
 		}
+		locker := new(mock.KeyLocker)
+		locker.On("Lock", ctx, "replay-request:project-name").Return(func() {}, nil)
+
+		t.Run("should throw error if other replay requests of project can't be waited for", func(t *testing.T) {
+			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
+			defer replaySpecRepoFac.AssertExpectations(t)
+			replaySpecRepoFac.On("New", replayRequest.Job).Return(new(mock.ReplayRepository))
+
+			failingLocker := new(mock.KeyLocker)
+			defer failingLocker.AssertExpectations(t)
+			failingLocker.On("Lock", ctx, "replay-request:project-name").Return(nil, errors.New("db is down"))
+
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, failingLocker, nil, replayManagerConfig, nil)
+			_, err := replayManager.Replay(ctx, replayRequest)
+			assert.Equal(t, "failed to wait for other replay requests of project: db is down", err.Error())
+		})
 
 		t.Run("should throw error if uuid provider returns failure", func(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Twice()
+			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			uuidProvider := new(mock.UUIDProvider)
@@ -143,7 +285,7 @@ func TestReplayManager(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, uuidProvider, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, uuidProvider, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), errMessage)
@@ -151,12 +293,10 @@ func TestReplayManager(t *testing.T) {
 		t.Run("should throw an error if replay repo throws error", func(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			// worker init
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Twice()
+			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			uuidProvider := new(mock.UUIDProvider)
@@ -178,7 +318,7 @@ func TestReplayManager(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, uuidProvider, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, uuidProvider, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), errMessage)
@@ -186,20 +326,18 @@ func TestReplayManager(t *testing.T) {
 		t.Run("should throw an error if unable to fetch active replays", func(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 			errMessage := "error checking other replays"
 			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, errors.New(errMessage))
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			scheduler := new(mock.Scheduler)
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, nil, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), errMessage)
@@ -224,19 +362,17 @@ func TestReplayManager(t *testing.T) {
 
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return(activeReplaySpec, nil)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			scheduler := new(mock.Scheduler)
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, nil, replayManagerConfig, scheduler)
 
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, err, job.ErrConflictedJobRun)
@@ -256,12 +392,10 @@ func TestReplayManager(t *testing.T) {
 
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return(activeReplaySpec, nil)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			uuidProvider := new(mock.UUIDProvider)
@@ -283,7 +417,7 @@ func TestReplayManager(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, uuidProvider, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, uuidProvider, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, errMessage, err.Error())
 		})
@@ -303,12 +437,10 @@ func TestReplayManager(t *testing.T) {
 
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return(activeReplaySpec, nil)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			uuidProvider := new(mock.UUIDProvider)
@@ -331,18 +463,16 @@ func TestReplayManager(t *testing.T) {
 			defer scheduler.AssertExpectations(t)
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, uuidProvider, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, uuidProvider, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, errMessage, err.Error())
 		})
 		t.Run("should return error when unable to get status from scheduler", func(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			scheduler := new(mock.Scheduler)
@@ -350,7 +480,7 @@ func TestReplayManager(t *testing.T) {
 			errMessage := "unable to get status"
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return([]models.JobStatus{}, errors.New(errMessage))
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, nil, replayManagerConfig, scheduler)
 
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, errMessage, err.Error())
@@ -358,11 +488,9 @@ func TestReplayManager(t *testing.T) {
 		t.Run("should return error when same job and run in the running state is found", func(t *testing.T) {
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			scheduler := new(mock.Scheduler)
@@ -379,7 +507,7 @@ func TestReplayManager(t *testing.T) {
 			}
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return(jobStatus, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, nil, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, job.ErrConflictedJobRun, err)
 		})
@@ -403,12 +531,10 @@ func TestReplayManager(t *testing.T) {
 
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return(activeReplaySpec, nil)
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			scheduler := new(mock.Scheduler)
@@ -421,7 +547,7 @@ func TestReplayManager(t *testing.T) {
 			}
 			scheduler.On("GetDagRunStatus", ctx, replayRequest.Project, jobSpec.Name, startDate, reqBatchEndDate, reqBatchSize).Return(jobStatus, nil)
 
-			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, replayManagerConfig, scheduler)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, nil, replayManagerConfig, scheduler)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, job.ErrConflictedJobRun, err)
 		})
@@ -439,7 +565,6 @@ func TestReplayManager(t *testing.T) {
 
 			replayRepository := new(mock.ReplayRepository)
 			defer replayRepository.AssertExpectations(t)
-			replayRepository.On("GetByStatus", job.ReplayStatusToValidate).Return([]models.ReplaySpec{}, store.ErrResourceNotFound).Once()
 			replayRepository.On("GetByJobIDAndStatus", activeReplaySpec[0].Job.ID, job.ReplayStatusToValidate).Return(activeReplaySpec, nil)
 
 			cancelledReplayMessage := models.ReplayMessage{
@@ -450,7 +575,6 @@ func TestReplayManager(t *testing.T) {
 
 			replaySpecRepoFac := new(mock.ReplaySpecRepoFactory)
 			defer replaySpecRepoFac.AssertExpectations(t)
-			replaySpecRepoFac.On("New", models.JobSpec{}).Return(replayRepository)
 			replaySpecRepoFac.On("New", replayRequest.Job).Return(replayRepository)
 
 			uuidProvider := new(mock.UUIDProvider)
@@ -469,7 +593,7 @@ func TestReplayManager(t *testing.T) {
 			replayRepository.On("Insert", toInsertReplaySpec).Return(errors.New(errMessage))
 
 			replayRequest.Force = true
-			replayManager := job.NewManager(nil, replaySpecRepoFac, uuidProvider, replayManagerConfig, nil)
+			replayManager := job.NewManager(nil, replaySpecRepoFac, nil, locker, uuidProvider, replayManagerConfig, nil)
 			_, err := replayManager.Replay(ctx, replayRequest)
 			assert.Equal(t, errMessage, err.Error())
 		})
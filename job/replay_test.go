@@ -2,6 +2,7 @@ package job_test
 
 import (
 	"context"
+	testMock "github.com/stretchr/testify/mock"
 	"testing"
 	"time"
 
@@ -39,7 +40,7 @@ func getRuns(root *tree.TreeNode, countMap map[string][]time.Time) {
 func TestReplay(t *testing.T) {
 	ctx := context.TODO()
 	noDependency := map[string]models.JobSpecDependency{}
-	dumpAssets := func(jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
+	dumpAssets := func(_ context.Context, jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error) {
 		return jobSpec.Assets, nil
 	}
 	var (
@@ -114,7 +115,7 @@ func TestReplay(t *testing.T) {
 				End:     replayEnd,
 				Project: projSpec,
 			}
-			_, err := jobSvc.ReplayDryRun(replayRequest)
+			_, err := jobSvc.ReplayDryRun(context.Background(), replayRequest)
 
 			assert.NotNil(t, err)
 		})
@@ -130,12 +131,12 @@ func TestReplay(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(models.JobSpec{}, errors.New("error while fetching dag1"))
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(models.JobSpec{}, errors.New("error while fetching dag3"))
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(models.JobSpec{}, errors.New("error while fetching dag4"))
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(models.JobSpec{}, errors.New("error while fetching dag1"))
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(models.JobSpec{}, errors.New("error while fetching dag3"))
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(models.JobSpec{}, errors.New("error while fetching dag4"))
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			replayStart, _ := time.Parse(job.ReplayDateFormat, "2020-08-05")
@@ -148,7 +149,7 @@ func TestReplay(t *testing.T) {
 				End:     replayEnd,
 				Project: projSpec,
 			}
-			_, err := jobSvc.ReplayDryRun(replayRequest)
+			_, err := jobSvc.ReplayDryRun(context.Background(), replayRequest)
 
 			assert.NotNil(t, err)
 			merr := err.(*multierror.Error)
@@ -177,8 +178,8 @@ func TestReplay(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, cyclicDagSpec[0], nil).Return(cyclicDagSpec[0], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, cyclicDagSpec[1], nil).Return(cyclicDagSpec[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, cyclicDagSpec[0], nil).Return(cyclicDagSpec[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, cyclicDagSpec[1], nil).Return(cyclicDagSpec[1], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			replayStart, _ := time.Parse(job.ReplayDateFormat, "2020-08-05")
@@ -191,7 +192,7 @@ func TestReplay(t *testing.T) {
 				End:     replayEnd,
 				Project: projSpec,
 			}
-			_, err := jobSvc.ReplayDryRun(replayRequest)
+			_, err := jobSvc.ReplayDryRun(context.Background(), replayRequest)
 
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), "a cycle dependency encountered in the tree")
@@ -208,12 +209,12 @@ func TestReplay(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
@@ -229,7 +230,7 @@ func TestReplay(t *testing.T) {
 				Project: projSpec,
 			}
 
-			tree, err := jobSvc.ReplayDryRun(replayRequest)
+			tree, err := jobSvc.ReplayDryRun(context.Background(), replayRequest)
 
 			assert.Nil(t, err)
 			countMap := make(map[string][]time.Time)
@@ -260,12 +261,12 @@ func TestReplay(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
@@ -281,7 +282,7 @@ func TestReplay(t *testing.T) {
 				Project: projSpec,
 			}
 
-			tree, err := jobSvc.ReplayDryRun(replayRequest)
+			tree, err := jobSvc.ReplayDryRun(context.Background(), replayRequest)
 
 			assert.Nil(t, err)
 			countMap := make(map[string][]time.Time)
@@ -339,12 +340,12 @@ func TestReplay(t *testing.T) {
 			defer projJobSpecRepoFac.AssertExpectations(t)
 
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			replayStart, _ := time.Parse(job.ReplayDateFormat, "2020-08-05")
@@ -379,12 +380,12 @@ func TestReplay(t *testing.T) {
 			defer projJobSpecRepoFac.AssertExpectations(t)
 
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[0], nil).Return(dagSpec[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[1], nil).Return(dagSpec[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[2], nil).Return(dagSpec[2], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[3], nil).Return(dagSpec[3], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[4], nil).Return(dagSpec[4], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, dagSpec[5], nil).Return(dagSpec[5], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			replayStart, _ := time.Parse(job.ReplayDateFormat, "2020-08-05")
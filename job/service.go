@@ -13,7 +13,11 @@ import (
 	"github.com/odpf/optimus/meta"
 	"github.com/odpf/optimus/models"
 	"github.com/odpf/optimus/store"
+	"github.com/odpf/optimus/telemetry"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -24,11 +28,13 @@ const (
 	ConcurrentLimit        = 600
 )
 
-type AssetCompiler func(jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error)
+var tracer = otel.Tracer("github.com/odpf/optimus/job")
+
+type AssetCompiler func(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time) (models.JobAssets, error)
 
 // DependencyResolver compiles static and runtime dependencies
 type DependencyResolver interface {
-	Resolve(projectSpec models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
+	Resolve(ctx context.Context, projectSpec models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
 		jobSpec models.JobSpec, observer progress.Observer) (models.JobSpec, error)
 }
 
@@ -70,6 +76,10 @@ type Service struct {
 	projectJobSpecRepoFactory ProjectJobSpecRepoFactory
 	replayManager             ReplayManager
 
+	// resolved graphs of projects, used to find their jobs depending on
+	// other projects without resolving them on every graph request
+	graphIndex *jobGraphIndex
+
 	Now           func() time.Time
 	assetCompiler AssetCompiler
 }
@@ -109,16 +119,25 @@ func (srv *Service) GetAll(namespace models.NamespaceSpec) ([]models.JobSpec, er
 	return jobSpecs, nil
 }
 
+// GetByFilter reads job specs of a namespace matching filter
+func (srv *Service) GetByFilter(namespace models.NamespaceSpec, filter models.JobSpecFilter) ([]models.JobSpec, error) {
+	jobSpecs, err := srv.jobSpecRepoFactory.New(namespace).GetByFilter(filter)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve jobs")
+	}
+	return jobSpecs, nil
+}
+
 // Dump takes a jobSpec of a project, resolves dependencies, priorities and returns the compiled Job
-func (srv *Service) Dump(namespace models.NamespaceSpec, jobSpec models.JobSpec) (models.Job, error) {
+func (srv *Service) Dump(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec) (models.Job, error) {
 	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(namespace.ProjectSpec)
-	jobSpecs, err := srv.GetDependencyResolvedSpecs(namespace.ProjectSpec, projectJobSpecRepo, nil)
+	jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, namespace.ProjectSpec, projectJobSpecRepo, nil)
 	if err != nil {
 		return models.Job{}, err
 	}
 
 	// resolve priority of all jobSpecs
-	jobSpecs, err = srv.priorityResolver.Resolve(jobSpecs)
+	jobSpecs, err = srv.priorityResolver.Resolve(ctx, namespace.ProjectSpec, jobSpecs)
 	if err != nil {
 		return models.Job{}, err
 	}
@@ -143,10 +162,16 @@ func (srv *Service) Dump(namespace models.NamespaceSpec, jobSpec models.JobSpec)
 }
 
 // Check if job specifications are valid
-func (srv *Service) Check(namespace models.NamespaceSpec, jobSpecs []models.JobSpec, obs progress.Observer) (err error) {
+func (srv *Service) Check(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec, obs progress.Observer) (err error) {
+	// keep specs as provided to resolve dependencies against registered jobs
+	localSpecs := make([]models.JobSpec, len(jobSpecs))
+	for i, jSpec := range jobSpecs {
+		localSpecs[i] = cloneJobSpecDependencies(jSpec)
+	}
+
 	for i, jSpec := range jobSpecs {
 		// compile assets
-		if jobSpecs[i].Assets, err = srv.assetCompiler(jSpec, srv.Now()); err != nil {
+		if jobSpecs[i].Assets, err = srv.assetCompiler(ctx, jSpec, srv.Now()); err != nil {
 			return errors.Wrap(err, "asset compilation")
 		}
 
@@ -160,7 +185,7 @@ func (srv *Service) Check(namespace models.NamespaceSpec, jobSpecs []models.JobS
 			return func() (interface{}, error) {
 				// check dependencies
 				if currentSpec.Task.Unit.DependencyMod != nil {
-					if _, err := currentSpec.Task.Unit.DependencyMod.GenerateDependencies(context.TODO(), models.GenerateDependenciesRequest{
+					if _, err := currentSpec.Task.Unit.DependencyMod.GenerateDependencies(ctx, models.GenerateDependenciesRequest{
 						Config:  models.PluginConfigs{}.FromJobSpec(currentSpec.Task.Config),
 						Assets:  models.PluginAssets{}.FromJobSpec(currentSpec.Assets),
 						Project: namespace.ProjectSpec,
@@ -195,12 +220,64 @@ func (srv *Service) Check(namespace models.NamespaceSpec, jobSpecs []models.JobS
 			err = multierror.Append(err, result.Err)
 		}
 	}
-	return err
+	if err != nil {
+		return err
+	}
+	return srv.checkDeploymentCycles(ctx, namespace, localSpecs, obs)
+}
+
+// CheckDependencyCycles fails if deploying job specs in the namespace would
+// make them part of a dependency cycle, nothing is persisted
+func (srv *Service) CheckDependencyCycles(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec) error {
+	localSpecs := make([]models.JobSpec, len(jobSpecs))
+	for i, jSpec := range jobSpecs {
+		localSpecs[i] = cloneJobSpecDependencies(jSpec)
+	}
+	if err := srv.checkDeploymentCycles(ctx, namespace, localSpecs, nil); err != nil {
+		return errors.Wrapf(err, "namespace %s", namespace.Name)
+	}
+	return nil
+}
+
+// checkDeploymentCycles resolves dependencies of the project as if jobSpecs
+// were deployed in the namespace and fails if any of them is part of a cycle,
+// cycles between jobs of other namespaces are left to their own deployments
+func (srv *Service) checkDeploymentCycles(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec, obs progress.Observer) error {
+	registeredSpecs, err := srv.jobSpecRepoFactory.New(namespace).GetAll()
+	if err != nil && !errors.Is(err, store.ErrResourceNotFound) {
+		return errors.Wrapf(err, "failed to fetch specs for namespace %s", namespace.Name)
+	}
+	overlay, err := newPlanOverlay(ctx, namespace, jobSpecs, registeredSpecs)
+	if err != nil {
+		return err
+	}
+	projectJobSpecRepo := overlay.wrap(namespace.ProjectSpec, srv.projectJobSpecRepoFactory.New(namespace.ProjectSpec))
+	resolvedSpecs, err := srv.GetDependencyResolvedSpecs(ctx, namespace.ProjectSpec, projectJobSpecRepo, nil)
+	if err != nil {
+		return err
+	}
+
+	deployedNames := map[string]bool{}
+	for _, spec := range jobSpecs {
+		deployedNames[spec.Name] = true
+	}
+	var cycles []dependencyCycle
+	for _, cycle := range findDependencyCycles(namespace.ProjectSpec.Name, resolvedSpecs) {
+		if cycle.involves(deployedNames) {
+			cycles = append(cycles, cycle)
+		}
+	}
+	if obs != nil {
+		for _, cycle := range cycles {
+			obs.Notify(&EventJobCheckFailed{Name: cycle.jobName(), Reason: fmt.Sprintf("cyclic dependency: %s\n", cycle)})
+		}
+	}
+	return cyclicDependencyError(cycles)
 }
 
 // Delete deletes a job spec from all spec repos
 func (srv *Service) Delete(ctx context.Context, namespace models.NamespaceSpec, jobSpec models.JobSpec) error {
-	if err := srv.isJobDeletable(namespace.ProjectSpec, jobSpec); err != nil {
+	if err := srv.isJobDeletable(ctx, namespace.ProjectSpec, jobSpec); err != nil {
 		return err
 	}
 
@@ -219,15 +296,30 @@ func (srv *Service) Delete(ctx context.Context, namespace models.NamespaceSpec,
 // Sync fetches all the jobs that belong to a project, resolves its dependencies
 // assign proper priority weights, compiles it and uploads it to the destination
 // store
-func (srv *Service) Sync(ctx context.Context, namespace models.NamespaceSpec, progressObserver progress.Observer) error {
+func (srv *Service) Sync(ctx context.Context, namespace models.NamespaceSpec, progressObserver progress.Observer) (err error) {
+	ctx, span := tracer.Start(ctx, "Sync", trace.WithAttributes(
+		attribute.String("project", namespace.ProjectSpec.Name),
+		attribute.String("namespace", namespace.Name),
+	))
+	defer func(start time.Time) {
+		result := metricResultSuccess
+		if err != nil {
+			result = metricResultFailure
+		}
+		deployDuration.WithLabelValues(namespace.ProjectSpec.Name, result).Observe(time.Since(start).Seconds())
+		telemetry.EndSpan(span, err)
+	}(time.Now())
+
 	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(namespace.ProjectSpec)
-	jobSpecs, err := srv.GetDependencyResolvedSpecs(namespace.ProjectSpec, projectJobSpecRepo, progressObserver)
+	jobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, namespace.ProjectSpec, projectJobSpecRepo, progressObserver)
 	if err != nil {
 		return err
 	}
 	srv.notifyProgress(progressObserver, &EventJobSpecDependencyResolve{})
 
-	jobSpecs, err = srv.priorityResolver.Resolve(jobSpecs)
+	priorityCtx, prioritySpan := tracer.Start(ctx, "ResolvePriority")
+	jobSpecs, err = srv.priorityResolver.Resolve(priorityCtx, namespace.ProjectSpec, jobSpecs)
+	telemetry.EndSpan(prioritySpan, err)
 	if err != nil {
 		return err
 	}
@@ -247,7 +339,7 @@ func (srv *Service) Sync(ctx context.Context, namespace models.NamespaceSpec, pr
 		return err
 	}
 
-	if err = srv.publishMetadata(namespace, jobSpecs, progressObserver); err != nil {
+	if err = srv.publishMetadata(ctx, namespace, jobSpecs, progressObserver); err != nil {
 		return err
 	}
 
@@ -326,28 +418,32 @@ func (srv *Service) filterJobSpecForNamespace(jobSpecs []models.JobSpec, namespa
 	return filteredJobSpecs, nil
 }
 
-func (srv *Service) GetDependencyResolvedSpecs(proj models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
+func (srv *Service) GetDependencyResolvedSpecs(ctx context.Context, proj models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
 	progressObserver progress.Observer) (resolvedSpecs []models.JobSpec, resolvedErrors error) {
-	// fetch all jobs since dependency resolution happens for all jobs in a project, not just for a namespace
-	jobSpecs, err := projectJobSpecRepo.GetAll()
+	defer func(start time.Time) {
+		dependencyResolutionDuration.WithLabelValues(proj.Name).Observe(time.Since(start).Seconds())
+	}(time.Now())
+
+	jobSpecs, err := srv.fetchProjectSpecs(ctx, projectJobSpecRepo)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to retrieve jobs")
+		return nil, err
 	}
 	srv.notifyProgress(progressObserver, &EventJobSpecFetch{})
 
-	// compile assets first
-	for i, jSpec := range jobSpecs {
-		if jobSpecs[i].Assets, err = srv.assetCompiler(jSpec, srv.Now()); err != nil {
-			return nil, errors.Wrap(err, "asset compilation")
-		}
-	}
+	ctx, span := tracer.Start(ctx, "ResolveDependencies", trace.WithAttributes(
+		attribute.String("project", proj.Name),
+		attribute.Int("jobs", len(jobSpecs)),
+	))
+	defer func() {
+		telemetry.EndSpan(span, resolvedErrors)
+	}()
 
 	// resolve specs in parallel
 	runner := parallel.NewRunner(parallel.WithTicket(ConcurrentTicketPerSec), parallel.WithLimit(ConcurrentLimit))
 	for _, jobSpec := range jobSpecs {
 		runner.Add(func(currentSpec models.JobSpec) func() (interface{}, error) {
 			return func() (interface{}, error) {
-				resolvedSpec, err := srv.dependencyResolver.Resolve(proj, projectJobSpecRepo, currentSpec, progressObserver)
+				resolvedSpec, err := srv.dependencyResolver.Resolve(ctx, proj, projectJobSpecRepo, currentSpec, progressObserver)
 				if err != nil {
 					return nil, errors.Wrapf(err, "failed to resolve dependency for %s", currentSpec.Name)
 				}
@@ -367,6 +463,28 @@ func (srv *Service) GetDependencyResolvedSpecs(proj models.ProjectSpec, projectJ
 	return resolvedSpecs, resolvedErrors
 }
 
+// fetchProjectSpecs reads all jobs of a project with their assets compiled
+func (srv *Service) fetchProjectSpecs(ctx context.Context, projectJobSpecRepo store.ProjectJobSpecRepository) (jobSpecs []models.JobSpec, err error) {
+	ctx, span := tracer.Start(ctx, "FetchJobSpecs")
+	defer func() {
+		telemetry.EndSpan(span, err)
+	}()
+
+	// fetch all jobs since dependency resolution happens for all jobs in a project, not just for a namespace
+	jobSpecs, err = projectJobSpecRepo.GetAll()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to retrieve jobs")
+	}
+
+	// compile assets first
+	for i, jSpec := range jobSpecs {
+		if jobSpecs[i].Assets, err = srv.assetCompiler(ctx, jSpec, srv.Now()); err != nil {
+			return nil, errors.Wrap(err, "asset compilation")
+		}
+	}
+	return jobSpecs, nil
+}
+
 // uploadSpecs compiles a Job and uploads it to the destination store
 func (srv *Service) uploadSpecs(ctx context.Context, jobSpecs []models.JobSpec, jobRepo store.JobRepository,
 	namespace models.NamespaceSpec, progressObserver progress.Observer) error {
@@ -374,7 +492,10 @@ func (srv *Service) uploadSpecs(ctx context.Context, jobSpecs []models.JobSpec,
 	for _, jobSpec := range jobSpecs {
 		runner.Add(func(currentSpec models.JobSpec) func() (interface{}, error) {
 			return func() (interface{}, error) {
+				jobAttr := trace.WithAttributes(attribute.String("job", currentSpec.Name))
+				_, compileSpan := tracer.Start(ctx, "CompileJob", jobAttr)
 				compiledJob, err := srv.compiler.Compile(namespace, currentSpec)
+				telemetry.EndSpan(compileSpan, err)
 				if err != nil {
 					return nil, err
 				}
@@ -382,7 +503,10 @@ func (srv *Service) uploadSpecs(ctx context.Context, jobSpecs []models.JobSpec,
 					Name: currentSpec.Name,
 				})
 
-				if err = jobRepo.Save(ctx, compiledJob); err != nil {
+				uploadCtx, uploadSpan := tracer.Start(ctx, "UploadJob", jobAttr)
+				err = jobRepo.Save(uploadCtx, compiledJob)
+				telemetry.EndSpan(uploadSpan, err)
+				if err != nil {
 					return nil, err
 				}
 				return nil, nil
@@ -391,32 +515,38 @@ func (srv *Service) uploadSpecs(ctx context.Context, jobSpecs []models.JobSpec,
 	}
 
 	for runIdx, state := range runner.Run() {
+		result := metricResultSuccess
+		if state.Err != nil {
+			result = metricResultFailure
+		}
+		deployedJobs.WithLabelValues(namespace.ProjectSpec.Name, result).Inc()
 		srv.notifyProgress(progressObserver, &EventJobUpload{
 			Job: jobSpecs[runIdx],
 			Err: state.Err,
 		})
 	}
+	namespaceJobs.WithLabelValues(namespace.ProjectSpec.Name, namespace.Name).Set(float64(len(jobSpecs)))
 	return nil
 }
 
-func (srv *Service) publishMetadata(namespace models.NamespaceSpec, jobSpecs []models.JobSpec,
+func (srv *Service) publishMetadata(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec,
 	progressObserver progress.Observer) error {
 	if srv.metaSvcFactory == nil {
 		return nil
 	}
 
+	_, span := tracer.Start(ctx, "PublishMetadata")
 	metadataJobService := srv.metaSvcFactory.New()
-	if err := metadataJobService.Publish(namespace, jobSpecs, progressObserver); err != nil {
-		return err
-	}
-	return nil
+	err := metadataJobService.Publish(namespace, jobSpecs, progressObserver)
+	telemetry.EndSpan(span, err)
+	return err
 }
 
 // isJobDeletable determines if a given job is deletable or not
-func (srv *Service) isJobDeletable(projectSpec models.ProjectSpec, jobSpec models.JobSpec) error {
+func (srv *Service) isJobDeletable(ctx context.Context, projectSpec models.ProjectSpec, jobSpec models.JobSpec) error {
 	// check if this job spec is dependency of any other job spec
 	projectJobSpecRepo := srv.projectJobSpecRepoFactory.New(projectSpec)
-	depsResolvedJobSpecs, err := srv.GetDependencyResolvedSpecs(projectSpec, projectJobSpecRepo, nil)
+	depsResolvedJobSpecs, err := srv.GetDependencyResolvedSpecs(ctx, projectSpec, projectJobSpecRepo, nil)
 	if err != nil {
 		return err
 	}
@@ -496,6 +626,7 @@ func NewService(jobSpecRepoFactory SpecRepoFactory, jobRepoFact JobRepoFactory,
 		metaSvcFactory:            metaSvcFactory,
 		projectJobSpecRepoFactory: projectJobSpecRepoFactory,
 		replayManager:             replayManager,
+		graphIndex:                new(jobGraphIndex),
 
 		assetCompiler: assetCompiler,
 		Now:           time.Now,
@@ -18,7 +18,7 @@ import (
 func TestService(t *testing.T) {
 	ctx := context.Background()
 
-	dumpAssets := func(jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
+	dumpAssets := func(_ context.Context, jobSpec models.JobSpec, _ time.Time) (models.JobAssets, error) {
 		return jobSpec.Assets, nil
 	}
 
@@ -118,8 +118,28 @@ func TestService(t *testing.T) {
 			compiler.On("Compile", namespaceSpec, currentSpec).Return(models.Job{}, nil)
 			defer compiler.AssertExpectations(t)
 
-			service := job.NewService(nil, nil, compiler, dumpAssets, nil, nil, nil, nil, nil)
-			err := service.Check(namespaceSpec, []models.JobSpec{currentSpec}, nil)
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			depenResolver := new(mock.DependencyResolver)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, testMock.Anything, currentSpec, nil).Return(currentSpec, nil)
+			defer depenResolver.AssertExpectations(t)
+
+			service := job.NewService(jobSpecRepoFac, nil, compiler, dumpAssets, depenResolver, nil, nil, projJobSpecRepoFac, nil)
+			err := service.Check(context.Background(), namespaceSpec, []models.JobSpec{currentSpec}, nil)
 			assert.Nil(t, err)
 		})
 		t.Run("should check for successful dependency resolution for task that does support this mod", func(t *testing.T) {
@@ -138,7 +158,7 @@ func TestService(t *testing.T) {
 				},
 				Dependencies: map[string]models.JobSpecDependency{},
 			}
-			depMode.On("GenerateDependencies", context.TODO(), models.GenerateDependenciesRequest{
+			depMode.On("GenerateDependencies", context.Background(), models.GenerateDependenciesRequest{
 				Config:  models.PluginConfigs{}.FromJobSpec(currentSpec.Task.Config),
 				Assets:  models.PluginAssets{}.FromJobSpec(currentSpec.Assets),
 				Project: namespaceSpec.ProjectSpec,
@@ -146,13 +166,38 @@ func TestService(t *testing.T) {
 					DryRun: true,
 				},
 			}).Return(&models.GenerateDependenciesResponse{}, nil)
+			depMode.On("GenerateDestination", context.Background(), models.GenerateDestinationRequest{
+				Config:  models.PluginConfigs{}.FromJobSpec(currentSpec.Task.Config),
+				Assets:  models.PluginAssets{}.FromJobSpec(currentSpec.Assets),
+				Project: namespaceSpec.ProjectSpec,
+			}).Return(&models.GenerateDestinationResponse{}, nil)
 
 			compiler := new(mock.Compiler)
 			compiler.On("Compile", namespaceSpec, currentSpec).Return(models.Job{}, nil)
 			defer compiler.AssertExpectations(t)
 
-			service := job.NewService(nil, nil, compiler, dumpAssets, nil, nil, nil, nil, nil)
-			err := service.Check(namespaceSpec, []models.JobSpec{currentSpec}, nil)
+			jobSpecRepo := new(mock.JobSpecRepository)
+			jobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer jobSpecRepo.AssertExpectations(t)
+
+			jobSpecRepoFac := new(mock.JobSpecRepoFactory)
+			jobSpecRepoFac.On("New", namespaceSpec).Return(jobSpecRepo)
+			defer jobSpecRepoFac.AssertExpectations(t)
+
+			projectJobSpecRepo := new(mock.ProjectJobSpecRepository)
+			projectJobSpecRepo.On("GetAll").Return([]models.JobSpec{}, nil)
+			defer projectJobSpecRepo.AssertExpectations(t)
+
+			projJobSpecRepoFac := new(mock.ProjectJobSpecRepoFactory)
+			projJobSpecRepoFac.On("New", projSpec).Return(projectJobSpecRepo)
+			defer projJobSpecRepoFac.AssertExpectations(t)
+
+			depenResolver := new(mock.DependencyResolver)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, testMock.Anything, currentSpec, nil).Return(currentSpec, nil)
+			defer depenResolver.AssertExpectations(t)
+
+			service := job.NewService(jobSpecRepoFac, nil, compiler, dumpAssets, depenResolver, nil, nil, projJobSpecRepoFac, nil)
+			err := service.Check(context.Background(), namespaceSpec, []models.JobSpec{currentSpec}, nil)
 			assert.Nil(t, err)
 		})
 	})
@@ -234,21 +279,21 @@ func TestService(t *testing.T) {
 
 			// used to store compiled job specs
 			jobRepo := new(mock.JobRepository)
-			jobRepo.On("ListNames", ctx, namespaceSpec).Return([]string{"test"}, nil)
+			jobRepo.On("ListNames", testMock.Anything, namespaceSpec).Return([]string{"test"}, nil)
 			defer jobRepo.AssertExpectations(t)
 
 			jobRepoFac := new(mock.JobRepoFactory)
-			jobRepoFac.On("New", context.Background(), projSpec).Return(jobRepo, nil)
+			jobRepoFac.On("New", testMock.Anything, projSpec).Return(jobRepo, nil)
 			defer jobRepoFac.AssertExpectations(t)
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			// resolve priority
 			priorityResolver := new(mock.PriorityResolver)
-			priorityResolver.On("Resolve", jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
+			priorityResolver.On("Resolve", testMock.Anything, projSpec, jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
 			defer priorityResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
@@ -257,7 +302,7 @@ func TestService(t *testing.T) {
 			// compile to dag and save
 			for idx, compiledJob := range jobs {
 				compiler.On("Compile", namespaceSpec, jobSpecsAfterPriorityResolve[idx]).Return(compiledJob, nil)
-				jobRepo.On("Save", ctx, compiledJob).Return(nil)
+				jobRepo.On("Save", testMock.Anything, compiledJob).Return(nil)
 			}
 
 			svc := job.NewService(jobSpecRepoFac, jobRepoFac, compiler, dumpAssets, depenResolver, priorityResolver, nil, projJobSpecRepoFac, nil)
@@ -337,7 +382,7 @@ func TestService(t *testing.T) {
 
 			// used to store compiled job specs
 			jobRepo := new(mock.JobRepository)
-			jobRepo.On("ListNames", ctx, namespaceSpec).Return([]string{"test", "test2"}, nil)
+			jobRepo.On("ListNames", testMock.Anything, namespaceSpec).Return([]string{"test", "test2"}, nil)
 			defer jobRepo.AssertExpectations(t)
 
 			jobRepoFac := new(mock.JobRepoFactory)
@@ -346,35 +391,35 @@ func TestService(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			// resolve priority
 			priorityResolver := new(mock.PriorityResolver)
-			priorityResolver.On("Resolve", jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
+			priorityResolver.On("Resolve", testMock.Anything, projSpec, jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
 			defer priorityResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
 			defer compiler.AssertExpectations(t)
 
-			jobRepo.On("ListNames", ctx, namespaceSpec).Return([]string{"test", "test2"}, nil)
+			jobRepo.On("ListNames", testMock.Anything, namespaceSpec).Return([]string{"test", "test2"}, nil)
 
 			// resolve dependencies
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
 
 			// resolve priority
-			priorityResolver.On("Resolve", jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
-			jobRepoFac.On("New", context.Background(), projSpec).Return(jobRepo, nil)
+			priorityResolver.On("Resolve", testMock.Anything, projSpec, jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
+			jobRepoFac.On("New", testMock.Anything, projSpec).Return(jobRepo, nil)
 
 			// compile to dag and save the first one
 			compiler.On("Compile", namespaceSpec, jobSpecsAfterPriorityResolve[0]).Return(jobs[0], nil)
-			jobRepo.On("Save", ctx, jobs[0]).Return(nil)
+			jobRepo.On("Save", testMock.Anything, jobs[0]).Return(nil)
 
 			// fetch currently stored
 			projectJobSpecRepo.On("GetAll").Return(jobSpecsBase, nil)
 
 			// delete unwanted
-			jobRepo.On("Delete", ctx, namespaceSpec, jobs[1].Name).Return(nil)
+			jobRepo.On("Delete", testMock.Anything, namespaceSpec, jobs[1].Name).Return(nil)
 
 			svc := job.NewService(jobSpecRepoFac, jobRepoFac, compiler, dumpAssets, depenResolver, priorityResolver, nil, projJobSpecRepoFac, nil)
 			err := svc.Sync(ctx, namespaceSpec, nil)
@@ -419,8 +464,8 @@ func TestService(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(models.JobSpec{}, errors.New("error test"))
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[1], nil).Return(models.JobSpec{},
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(models.JobSpec{}, errors.New("error test"))
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[1], nil).Return(models.JobSpec{},
 				errors.New("error test-2"))
 			defer depenResolver.AssertExpectations(t)
 
@@ -498,21 +543,21 @@ func TestService(t *testing.T) {
 
 			// used to store compiled job specs
 			jobRepo := new(mock.JobRepository)
-			jobRepo.On("ListNames", ctx, namespaceSpec).Return([]string{"test"}, nil)
+			jobRepo.On("ListNames", testMock.Anything, namespaceSpec).Return([]string{"test"}, nil)
 			defer jobRepo.AssertExpectations(t)
 
 			jobRepoFac := new(mock.JobRepoFactory)
-			jobRepoFac.On("New", context.Background(), projSpec).Return(jobRepo, nil)
+			jobRepoFac.On("New", testMock.Anything, projSpec).Return(jobRepo, nil)
 			defer jobRepoFac.AssertExpectations(t)
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			// resolve priority
 			priorityResolver := new(mock.PriorityResolver)
-			priorityResolver.On("Resolve", jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
+			priorityResolver.On("Resolve", testMock.Anything, projSpec, jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
 			defer priorityResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
@@ -529,7 +574,7 @@ func TestService(t *testing.T) {
 			// compile to dag and save
 			for idx, compiledJob := range jobs {
 				compiler.On("Compile", namespaceSpec, jobSpecsAfterPriorityResolve[idx]).Return(compiledJob, nil)
-				jobRepo.On("Save", ctx, compiledJob).Return(nil)
+				jobRepo.On("Save", testMock.Anything, compiledJob).Return(nil)
 			}
 
 			svc := job.NewService(jobSpecRepoFac, jobRepoFac, compiler, dumpAssets, depenResolver, priorityResolver, metaSvcFact, projJobSpecRepoFac, nil)
@@ -695,12 +740,12 @@ func TestService(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			// resolve priority
 			priorityResolver := new(mock.PriorityResolver)
-			priorityResolver.On("Resolve", jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
+			priorityResolver.On("Resolve", context.Background(), projSpec, jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
 			defer priorityResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
@@ -712,7 +757,7 @@ func TestService(t *testing.T) {
 			}
 
 			svc := job.NewService(jobSpecRepoFac, jobRepoFac, compiler, dumpAssets, depenResolver, priorityResolver, nil, projJobSpecRepoFac, nil)
-			compiledJob, err := svc.Dump(namespaceSpec, jobSpecsBase[0])
+			compiledJob, err := svc.Dump(context.Background(), namespaceSpec, jobSpecsBase[0])
 			assert.Nil(t, err)
 			assert.Equal(t, "come string", string(compiledJob.Contents))
 			assert.Equal(t, "test", compiledJob.Name)
@@ -797,21 +842,21 @@ func TestService(t *testing.T) {
 
 			// used to store compiled job specs
 			jobRepo := new(mock.JobRepository)
-			jobRepo.On("ListNames", ctx, namespaceSpec).Return([]string{"test"}, nil)
+			jobRepo.On("ListNames", testMock.Anything, namespaceSpec).Return([]string{"test"}, nil)
 			defer jobRepo.AssertExpectations(t)
 
 			jobRepoFac := new(mock.JobRepoFactory)
-			jobRepoFac.On("New", context.Background(), projSpec).Return(jobRepo, nil)
+			jobRepoFac.On("New", testMock.Anything, projSpec).Return(jobRepo, nil)
 			defer jobRepoFac.AssertExpectations(t)
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			// resolve priority
 			priorityResolver := new(mock.PriorityResolver)
-			priorityResolver.On("Resolve", jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
+			priorityResolver.On("Resolve", testMock.Anything, projSpec, jobSpecsAfterDepenResolve).Return(jobSpecsAfterPriorityResolve, nil)
 			defer priorityResolver.AssertExpectations(t)
 
 			compiler := new(mock.Compiler)
@@ -820,7 +865,7 @@ func TestService(t *testing.T) {
 			// compile to dag and save
 			for idx, compiledJob := range jobs {
 				compiler.On("Compile", namespaceSpec, jobSpecsAfterPriorityResolve[idx]).Return(compiledJob, nil)
-				jobRepo.On("Save", ctx, compiledJob).Return(nil)
+				jobRepo.On("Save", testMock.Anything, compiledJob).Return(nil)
 			}
 
 			svc := job.NewService(jobSpecRepoFac, jobRepoFac, compiler, dumpAssets, depenResolver, priorityResolver, nil, projJobSpecRepoFac, nil)
@@ -901,8 +946,8 @@ func TestService(t *testing.T) {
 
 			// resolve dependencies
 			depenResolver := new(mock.DependencyResolver)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
-			depenResolver.On("Resolve", projSpec, projectJobSpecRepo, jobSpecsBase[1], nil).Return(jobSpecsAfterDepenResolve[1], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[0], nil).Return(jobSpecsAfterDepenResolve[0], nil)
+			depenResolver.On("Resolve", testMock.Anything, projSpec, projectJobSpecRepo, jobSpecsBase[1], nil).Return(jobSpecsAfterDepenResolve[1], nil)
 			defer depenResolver.AssertExpectations(t)
 
 			// resolve priority
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/segmentio/kafka-go"
 )
 
@@ -14,6 +16,13 @@ type KafkaWriter interface {
 	Stats() kafka.WriterStats
 }
 
+var publishFailures = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: "optimus",
+	Subsystem: "metadata",
+	Name:      "publish_failures_total",
+	Help:      "Job metadata messages which failed to be written to kafka",
+})
+
 // Writer will be used to write send data to kafka topic
 type Writer struct {
 	client           KafkaWriter
@@ -52,7 +61,9 @@ func (w *Writer) Flush() error {
 
 	if len(w.bufferedMessages) > 0 {
 		err = w.client.WriteMessages(context.Background(), w.bufferedMessages...)
-		if err == nil {
+		if err != nil {
+			publishFailures.Add(float64(len(w.bufferedMessages)))
+		} else {
 			w.bufferedMessages = make([]kafka.Message, 0)
 			fmt.Println("Published metadata for", len(w.bufferedMessages), "specs")
 		}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/odpf/optimus/meta"
 	"github.com/odpf/optimus/mock"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -59,4 +61,26 @@ func TestWriter(t *testing.T) {
 		err = writer.Write(key, msg)
 		assert.Nil(t, err)
 	})
+	t.Run("should count messages failed to be published", func(t *testing.T) {
+		key, msg := []byte("somekey"), []byte("somemessage")
+		kafkaWriter := &mock.MetaKafkaWriter{}
+		kafkaWriter.On("WriteMessages", context.Background(), []kafka.Message{{Key: key, Value: msg}}).Return(errors.New("broker unavailable"))
+		defer kafkaWriter.AssertExpectations(t)
+
+		before := publishFailures(t)
+		err := meta.NewWriter(kafkaWriter, 0).Write(key, msg)
+		assert.NotNil(t, err)
+		assert.Equal(t, before+1, publishFailures(t))
+	})
+}
+
+func publishFailures(t *testing.T) float64 {
+	families, err := prometheus.DefaultGatherer.Gather()
+	assert.Nil(t, err)
+	for _, family := range families {
+		if family.GetName() == "optimus_metadata_publish_failures_total" {
+			return family.GetMetric()[0].GetCounter().GetValue()
+		}
+	}
+	return 0
 }
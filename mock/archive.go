@@ -0,0 +1,45 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/job"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type ArchiveManager struct {
+	mock.Mock
+}
+
+func (am *ArchiveManager) ArchiveNamespace(ctx context.Context, namespace models.NamespaceSpec, opts job.ArchiveOptions,
+	obs progress.Observer) (time.Time, error) {
+	args := am.Called(ctx, namespace, opts, obs)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (am *ArchiveManager) ArchiveProject(ctx context.Context, project models.ProjectSpec, opts job.ArchiveOptions,
+	obs progress.Observer) (time.Time, error) {
+	args := am.Called(ctx, project, opts, obs)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (am *ArchiveManager) RestoreNamespace(ctx context.Context, project models.ProjectSpec, name string) (models.Deployment, error) {
+	args := am.Called(ctx, project, name)
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (am *ArchiveManager) RestoreProject(ctx context.Context, name string) ([]models.Deployment, error) {
+	args := am.Called(ctx, name)
+	return args.Get(0).([]models.Deployment), args.Error(1)
+}
+
+func (am *ArchiveManager) PurgeExpiredProject(name string) error {
+	return am.Called(name).Error(0)
+}
+
+func (am *ArchiveManager) PurgeExpiredNamespace(project models.ProjectSpec, name string) error {
+	return am.Called(project, name).Error(0)
+}
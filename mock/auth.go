@@ -0,0 +1,46 @@
+package mock
+
+import (
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type AuthTokenRepository struct {
+	mock.Mock
+}
+
+func (repo *AuthTokenRepository) Save(spec models.AuthToken) error {
+	return repo.Called(spec).Error(0)
+}
+
+func (repo *AuthTokenRepository) GetByHash(hash string) (models.AuthToken, error) {
+	args := repo.Called(hash)
+	return args.Get(0).(models.AuthToken), args.Error(1)
+}
+
+func (repo *AuthTokenRepository) GetAll() ([]models.AuthToken, error) {
+	args := repo.Called()
+	return args.Get(0).([]models.AuthToken), args.Error(1)
+}
+
+func (repo *AuthTokenRepository) Delete(name string) error {
+	return repo.Called(name).Error(0)
+}
+
+type AuditEventRepository struct {
+	mock.Mock
+}
+
+func (repo *AuditEventRepository) Save(event models.AuditEvent) error {
+	return repo.Called(event).Error(0)
+}
+
+func (repo *AuditEventRepository) UpdateDeploymentOutcome(deploymentID uuid.UUID, outcome, errMsg string) error {
+	return repo.Called(deploymentID, outcome, errMsg).Error(0)
+}
+
+func (repo *AuditEventRepository) GetAll(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	args := repo.Called(filter)
+	return args.Get(0).([]models.AuditEvent), args.Error(1)
+}
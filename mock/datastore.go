@@ -54,6 +54,36 @@ func (d *DatastoreTypeController) DefaultAssets() map[string]string {
 	return d.Called().Get(0).(map[string]string)
 }
 
+// DatastoreTypeDiffer is a DatastoreTypeController which can compare specs
+type DatastoreTypeDiffer struct {
+	DatastoreTypeController
+}
+
+func (d *DatastoreTypeDiffer) Diff(expected, actual models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	args := d.Called(expected, actual)
+	return args.Get(0).([]models.ResourceFieldDrift), args.Error(1)
+}
+
+// DatastoreTypeSchemaChecker is a DatastoreTypeController which can check schema changes
+type DatastoreTypeSchemaChecker struct {
+	DatastoreTypeController
+}
+
+func (d *DatastoreTypeSchemaChecker) CheckSchemaChange(current, desired models.ResourceSpec) ([]models.ResourceSchemaChange, error) {
+	args := d.Called(current, desired)
+	return args.Get(0).([]models.ResourceSchemaChange), args.Error(1)
+}
+
+// DatastoreTypeDependencyResolver is a DatastoreTypeController which can resolve dependencies
+type DatastoreTypeDependencyResolver struct {
+	DatastoreTypeController
+}
+
+func (d *DatastoreTypeDependencyResolver) Dependencies(spec models.ResourceSpec) ([]string, error) {
+	args := d.Called(spec)
+	return args.Get(0).([]string), args.Error(1)
+}
+
 type DatastoreTypeAdapter struct {
 	mock.Mock
 }
@@ -87,12 +117,17 @@ func (d *DatastoreService) GetAll(spec models.NamespaceSpec, datastoreName strin
 	return args.Get(0).([]models.ResourceSpec), args.Error(1)
 }
 
+func (d *DatastoreService) GetByFilter(spec models.NamespaceSpec, datastoreName string, filter models.ResourceSpecFilter) ([]models.ResourceSpec, error) {
+	args := d.Called(spec, datastoreName, filter)
+	return args.Get(0).([]models.ResourceSpec), args.Error(1)
+}
+
 func (d *DatastoreService) CreateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec, obs progress.Observer) error {
 	return d.Called(ctx, namespace, resourceSpecs, obs).Error(0)
 }
 
-func (d *DatastoreService) UpdateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec, obs progress.Observer) error {
-	return d.Called(ctx, namespace, resourceSpecs, obs).Error(0)
+func (d *DatastoreService) UpdateResource(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec, allowBreakingChanges bool, obs progress.Observer) error {
+	return d.Called(ctx, namespace, resourceSpecs, allowBreakingChanges, obs).Error(0)
 }
 
 func (d *DatastoreService) ReadResource(ctx context.Context, namespace models.NamespaceSpec, datastoreName, name string) (models.ResourceSpec, error) {
@@ -101,7 +136,17 @@ func (d *DatastoreService) ReadResource(ctx context.Context, namespace models.Na
 }
 
 func (d *DatastoreService) DeleteResource(ctx context.Context, namespace models.NamespaceSpec, datastoreName, name string) error {
-	return d.Called(ctx, namespace, datastoreName, name).Error(1)
+	return d.Called(ctx, namespace, datastoreName, name).Error(0)
+}
+
+func (d *DatastoreService) KeepOnly(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	resourceSpecs []models.ResourceSpec, confirmed []string, obs progress.Observer) error {
+	return d.Called(ctx, namespace, datastoreName, resourceSpecs, confirmed, obs).Error(0)
+}
+
+func (d *DatastoreService) DropResources(ctx context.Context, namespace models.NamespaceSpec, resourceSpecs []models.ResourceSpec,
+	obs progress.Observer) error {
+	return d.Called(ctx, namespace, resourceSpecs, obs).Error(0)
 }
 
 type SupportedDatastoreRepo struct {
@@ -148,6 +193,11 @@ func (r *ResourceSpecRepository) GetAll() ([]models.ResourceSpec, error) {
 	return args.Get(0).([]models.ResourceSpec), args.Error(1)
 }
 
+func (r *ResourceSpecRepository) GetByFilter(filter models.ResourceSpecFilter) ([]models.ResourceSpec, error) {
+	args := r.Called(filter)
+	return args.Get(0).([]models.ResourceSpec), args.Error(1)
+}
+
 func (r *ResourceSpecRepository) Delete(s string) error {
 	return r.Called(s).Error(0)
 }
@@ -173,3 +223,15 @@ func (r *ProjectResourceSpecRepository) GetAll() ([]models.ResourceSpec, error)
 	args := r.Called()
 	return args.Get(0).([]models.ResourceSpec), args.Error(1)
 }
+
+func (d *DatastoreService) PlanResource(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	resourceSpecs []models.ResourceSpec, prune bool) ([]models.ResourcePlan, error) {
+	args := d.Called(ctx, namespace, datastoreName, resourceSpecs, prune)
+	return args.Get(0).([]models.ResourcePlan), args.Error(1)
+}
+
+func (d *DatastoreService) DetectDrift(ctx context.Context, namespace models.NamespaceSpec, datastoreName string,
+	names []string) ([]models.ResourceDrift, error) {
+	args := d.Called(ctx, namespace, datastoreName, names)
+	return args.Get(0).([]models.ResourceDrift), args.Error(1)
+}
@@ -0,0 +1,52 @@
+package mock
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/odpf/optimus/core/progress"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/mock"
+)
+
+type DeploymentRepository struct {
+	mock.Mock
+}
+
+func (repo *DeploymentRepository) Save(deployment models.Deployment) error {
+	return repo.Called(deployment).Error(0)
+}
+
+func (repo *DeploymentRepository) GetByID(id uuid.UUID) (models.Deployment, error) {
+	args := repo.Called(id)
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (repo *DeploymentRepository) GetAll(filter models.DeploymentFilter) ([]models.Deployment, error) {
+	args := repo.Called(filter)
+	return args.Get(0).([]models.Deployment), args.Error(1)
+}
+
+func (repo *DeploymentRepository) Heartbeat(ids []uuid.UUID, at time.Time) error {
+	return repo.Called(ids, at).Error(0)
+}
+
+type DeployManager struct {
+	mock.Mock
+}
+
+func (dm *DeployManager) Deploy(ctx context.Context, namespace models.NamespaceSpec, jobSpecs []models.JobSpec, observer progress.Observer) (models.Deployment, error) {
+	args := dm.Called(ctx, namespace, jobSpecs, observer)
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (dm *DeployManager) GetDeployment(ctx context.Context, id uuid.UUID) (models.Deployment, error) {
+	args := dm.Called(ctx, id)
+	return args.Get(0).(models.Deployment), args.Error(1)
+}
+
+func (dm *DeployManager) GetDeployments(ctx context.Context, filter models.DeploymentFilter) ([]models.Deployment, error) {
+	args := dm.Called(ctx, filter)
+	return args.Get(0).([]models.Deployment), args.Error(1)
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"context"
 	"time"
 
 	"github.com/odpf/optimus/models"
@@ -42,13 +43,13 @@ type InstanceService struct {
 	mock.Mock
 }
 
-func (s *InstanceService) Compile(nsSpec models.NamespaceSpec, jobSpec models.JobSpec, instanceSpec models.InstanceSpec, runType models.InstanceType, runName string) (envMap map[string]string, fileMap map[string]string, err error) {
-	args := s.Called(nsSpec, jobSpec, instanceSpec, runType, runName)
+func (s *InstanceService) Compile(ctx context.Context, nsSpec models.NamespaceSpec, jobSpec models.JobSpec, instanceSpec models.InstanceSpec, runType models.InstanceType, runName string) (envMap map[string]string, fileMap map[string]string, err error) {
+	args := s.Called(ctx, nsSpec, jobSpec, instanceSpec, runType, runName)
 	return args.Get(0).(map[string]string), args.Get(1).(map[string]string), args.Error(2)
 }
 
-func (s *InstanceService) Register(jobSpec models.JobSpec, scheduledAt time.Time,
+func (s *InstanceService) Register(ctx context.Context, jobSpec models.JobSpec, scheduledAt time.Time,
 	taskType models.InstanceType) (models.InstanceSpec, error) {
-	args := s.Called(jobSpec, scheduledAt, taskType)
+	args := s.Called(ctx, jobSpec, scheduledAt, taskType)
 	return args.Get(0).(models.InstanceSpec), args.Error(1)
 }
@@ -2,6 +2,7 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/odpf/optimus/job"
 
@@ -90,6 +91,14 @@ func (repo *JobSpecRepository) GetAll() ([]models.JobSpec, error) {
 	return []models.JobSpec{}, args.Error(1)
 }
 
+func (repo *JobSpecRepository) GetByFilter(filter models.JobSpecFilter) ([]models.JobSpec, error) {
+	args := repo.Called(filter)
+	if args.Get(0) != nil {
+		return args.Get(0).([]models.JobSpec), args.Error(1)
+	}
+	return []models.JobSpec{}, args.Error(1)
+}
+
 func (repo *JobSpecRepository) GetByDestination(dest string) (models.JobSpec, models.ProjectSpec, error) {
 	args := repo.Called(dest)
 	if args.Get(0) != nil {
@@ -161,8 +170,8 @@ func (srv *JobService) GetByName(s string, spec models.NamespaceSpec) (models.Jo
 	return args.Get(0).(models.JobSpec), args.Error(1)
 }
 
-func (srv *JobService) Dump(spec2 models.NamespaceSpec, spec3 models.JobSpec) (models.Job, error) {
-	args := srv.Called(spec2, spec3)
+func (srv *JobService) Dump(ctx context.Context, spec2 models.NamespaceSpec, spec3 models.JobSpec) (models.Job, error) {
+	args := srv.Called(ctx, spec2, spec3)
 	return args.Get(0).(models.Job), args.Error(1)
 }
 
@@ -176,6 +185,11 @@ func (srv *JobService) GetAll(spec models.NamespaceSpec) ([]models.JobSpec, erro
 	return args.Get(0).([]models.JobSpec), args.Error(1)
 }
 
+func (srv *JobService) GetByFilter(spec models.NamespaceSpec, filter models.JobSpecFilter) ([]models.JobSpec, error) {
+	args := srv.Called(spec, filter)
+	return args.Get(0).([]models.JobSpec), args.Error(1)
+}
+
 func (srv *JobService) GetByNameForProject(s string, spec models.ProjectSpec) (models.JobSpec, models.NamespaceSpec, error) {
 	args := srv.Called(s, spec)
 	return args.Get(0).(models.JobSpec), args.Get(1).(models.NamespaceSpec), args.Error(2)
@@ -186,8 +200,13 @@ func (srv *JobService) Sync(ctx context.Context, spec models.NamespaceSpec, obse
 	return args.Error(0)
 }
 
-func (j *JobService) Check(namespaceSpec models.NamespaceSpec, specs []models.JobSpec, observer progress.Observer) error {
-	args := j.Called(namespaceSpec, specs, observer)
+func (j *JobService) Check(ctx context.Context, namespaceSpec models.NamespaceSpec, specs []models.JobSpec, observer progress.Observer) error {
+	args := j.Called(ctx, namespaceSpec, specs, observer)
+	return args.Error(0)
+}
+
+func (j *JobService) CheckDependencyCycles(ctx context.Context, namespaceSpec models.NamespaceSpec, specs []models.JobSpec) error {
+	args := j.Called(ctx, namespaceSpec, specs)
 	return args.Error(0)
 }
 
@@ -196,8 +215,8 @@ func (j *JobService) Delete(ctx context.Context, c models.NamespaceSpec, job mod
 	return args.Error(0)
 }
 
-func (j *JobService) ReplayDryRun(replayRequest *models.ReplayWorkerRequest) (*tree.TreeNode, error) {
-	args := j.Called(replayRequest)
+func (j *JobService) ReplayDryRun(ctx context.Context, replayRequest *models.ReplayWorkerRequest) (*tree.TreeNode, error) {
+	args := j.Called(ctx, replayRequest)
 	return args.Get(0).(*tree.TreeNode), args.Error(1)
 }
 
@@ -206,6 +225,26 @@ func (j *JobService) Replay(ctx context.Context, replayRequest *models.ReplayWor
 	return args.Get(0).(string), args.Error(1)
 }
 
+func (j *JobService) GetJobGraph(ctx context.Context, projectSpec models.ProjectSpec, projects []models.ProjectSpec, jobName string, depth int) (models.JobGraph, error) {
+	args := j.Called(ctx, projectSpec, projects, jobName, depth)
+	return args.Get(0).(models.JobGraph), args.Error(1)
+}
+
+func (j *JobService) PlanDeployment(ctx context.Context, namespaceSpec models.NamespaceSpec, jobSpecs []models.JobSpec, projects []models.ProjectSpec) (models.JobDeploymentPlan, error) {
+	args := j.Called(ctx, namespaceSpec, jobSpecs, projects)
+	return args.Get(0).(models.JobDeploymentPlan), args.Error(1)
+}
+
+func (j *JobService) GetIncomingDependencies(ctx context.Context, namespaces []models.NamespaceSpec,
+	projects []models.ProjectSpec) ([]models.JobGraphEdge, error) {
+	args := j.Called(ctx, namespaces, projects)
+	return args.Get(0).([]models.JobGraphEdge), args.Error(1)
+}
+
+func (j *JobService) DeleteCompiled(ctx context.Context, namespace models.NamespaceSpec, observer progress.Observer) error {
+	return j.Called(ctx, namespace, observer).Error(0)
+}
+
 type Compiler struct {
 	mock.Mock
 }
@@ -219,9 +258,9 @@ type DependencyResolver struct {
 	mock.Mock
 }
 
-func (srv *DependencyResolver) Resolve(projectSpec models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
+func (srv *DependencyResolver) Resolve(ctx context.Context, projectSpec models.ProjectSpec, projectJobSpecRepo store.ProjectJobSpecRepository,
 	jobSpec models.JobSpec, obs progress.Observer) (models.JobSpec, error) {
-	args := srv.Called(projectSpec, projectJobSpecRepo, jobSpec, obs)
+	args := srv.Called(ctx, projectSpec, projectJobSpecRepo, jobSpec, obs)
 	return args.Get(0).(models.JobSpec), args.Error(1)
 }
 
@@ -229,8 +268,8 @@ type PriorityResolver struct {
 	mock.Mock
 }
 
-func (srv *PriorityResolver) Resolve(jobSpecs []models.JobSpec) ([]models.JobSpec, error) {
-	args := srv.Called(jobSpecs)
+func (srv *PriorityResolver) Resolve(ctx context.Context, projectSpec models.ProjectSpec, jobSpecs []models.JobSpec) ([]models.JobSpec, error) {
+	args := srv.Called(ctx, projectSpec, jobSpecs)
 	return args.Get(0).([]models.JobSpec), args.Error(1)
 }
 
@@ -253,3 +292,27 @@ func (n *Notifier) Close() error {
 func (n *Notifier) Notify(ctx context.Context, attr models.NotifyAttrs) error {
 	return n.Called(ctx, attr).Error(0)
 }
+
+type RunDurationRepoFactory struct {
+	mock.Mock
+}
+
+func (fac *RunDurationRepoFactory) New(proj models.ProjectSpec) store.JobRunDurationRepository {
+	return fac.Called(proj).Get(0).(store.JobRunDurationRepository)
+}
+
+type JobRunDurationRepository struct {
+	mock.Mock
+}
+
+func (repo *JobRunDurationRepository) Save(durations map[string]time.Duration) error {
+	return repo.Called(durations).Error(0)
+}
+
+func (repo *JobRunDurationRepository) GetAll() (map[string]time.Duration, error) {
+	args := repo.Called()
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]time.Duration), args.Error(1)
+}
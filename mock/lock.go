@@ -0,0 +1,19 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type KeyLocker struct {
+	mock.Mock
+}
+
+func (l *KeyLocker) Lock(ctx context.Context, key string) (func(), error) {
+	args := l.Called(ctx, key)
+	if unlock, ok := args.Get(0).(func()); ok {
+		return unlock, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
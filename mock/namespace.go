@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/odpf/optimus/models"
 	"github.com/odpf/optimus/store"
 	"github.com/stretchr/testify/mock"
@@ -24,6 +26,28 @@ func (pr *NamespaceRepository) GetAll() ([]models.NamespaceSpec, error) {
 	return args.Get(0).([]models.NamespaceSpec), args.Error(1)
 }
 
+func (pr *NamespaceRepository) GetByFilter(filter models.NameFilter) ([]models.NamespaceSpec, error) {
+	args := pr.Called(filter)
+	return args.Get(0).([]models.NamespaceSpec), args.Error(1)
+}
+
+func (pr *NamespaceRepository) Archive(name string) error {
+	return pr.Called(name).Error(0)
+}
+
+func (pr *NamespaceRepository) GetArchivedAt(name string) (time.Time, error) {
+	args := pr.Called(name)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (pr *NamespaceRepository) Restore(name string) error {
+	return pr.Called(name).Error(0)
+}
+
+func (pr *NamespaceRepository) Purge(name string) error {
+	return pr.Called(name).Error(0)
+}
+
 type NamespaceRepoFactory struct {
 	mock.Mock
 }
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/odpf/optimus/core/progress"
 	"github.com/odpf/optimus/models"
 	"github.com/odpf/optimus/store"
@@ -25,6 +27,28 @@ func (pr *ProjectRepository) GetAll() ([]models.ProjectSpec, error) {
 	return args.Get(0).([]models.ProjectSpec), args.Error(1)
 }
 
+func (pr *ProjectRepository) GetByFilter(filter models.NameFilter) ([]models.ProjectSpec, error) {
+	args := pr.Called(filter)
+	return args.Get(0).([]models.ProjectSpec), args.Error(1)
+}
+
+func (pr *ProjectRepository) Archive(name string) error {
+	return pr.Called(name).Error(0)
+}
+
+func (pr *ProjectRepository) GetArchivedAt(name string) (time.Time, error) {
+	args := pr.Called(name)
+	return args.Get(0).(time.Time), args.Error(1)
+}
+
+func (pr *ProjectRepository) Restore(name string) error {
+	return pr.Called(name).Error(0)
+}
+
+func (pr *ProjectRepository) Purge(name string) error {
+	return pr.Called(name).Error(0)
+}
+
 type ProjectRepoFactory struct {
 	mock.Mock
 }
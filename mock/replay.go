@@ -53,11 +53,6 @@ func (rm *ReplayManager) Replay(ctx context.Context, reqInput *models.ReplayWork
 	return args.Get(0).(string), args.Error(1)
 }
 
-func (rm *ReplayManager) Init() {
-	rm.Called()
-	return
-}
-
 type ReplayWorker struct {
 	mock.Mock
 }
@@ -66,3 +61,11 @@ func (rm *ReplayWorker) Process(ctx context.Context, replayRequest *models.Repla
 	args := rm.Called(ctx, replayRequest)
 	return args.Error(0)
 }
+
+type ReplayRequestPopulator struct {
+	mock.Mock
+}
+
+func (p *ReplayRequestPopulator) PopulateReplayRequest(ctx context.Context, replayRequest *models.ReplayWorkerRequest) error {
+	return p.Called(ctx, replayRequest).Error(0)
+}
@@ -41,3 +41,37 @@ func (r *RuntimeService_DeployJobSpecificationServer) SendMsg(m interface{}) err
 func (r *RuntimeService_DeployJobSpecificationServer) RecvMsg(m interface{}) error {
 	panic("implement me")
 }
+
+type RuntimeService_DeployResourceSpecificationServer struct {
+	mock.Mock
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) Send(response *pb.DeployResourceSpecificationResponse) error {
+	args := r.Called(response)
+	return args.Error(0)
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) SetHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) SendHeader(md metadata.MD) error {
+	panic("implement me")
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) SetTrailer(md metadata.MD) {
+	panic("implement me")
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) Context() context.Context {
+	args := r.Called()
+	return args.Get(0).(context.Context)
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) SendMsg(m interface{}) error {
+	panic("implement me")
+}
+
+func (r *RuntimeService_DeployResourceSpecificationServer) RecvMsg(m interface{}) error {
+	panic("implement me")
+}
@@ -32,6 +32,10 @@ func (ms *Scheduler) Bootstrap(ctx context.Context, projectSpec models.ProjectSp
 	return ms.Called(ctx, projectSpec).Error(0)
 }
 
+func (ms *Scheduler) Ping(ctx context.Context, projSpec models.ProjectSpec) error {
+	return ms.Called(ctx, projSpec).Error(0)
+}
+
 func (ms *Scheduler) GetJobStatus(ctx context.Context, projSpec models.ProjectSpec, jobName string) ([]models.JobStatus, error) {
 	args := ms.Called(ctx, projSpec, jobName)
 	return args.Get(0).([]models.JobStatus), args.Error(1)
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// AuditActorAnonymous is recorded as actor of requests when
+	// authentication is disabled
+	AuditActorAnonymous = "anonymous"
+
+	// AuditOutcomeSuccess is recorded for requests that didn't fail, other
+	// outcomes are names of grpc status codes, e.g. NotFound
+	AuditOutcomeSuccess = "OK"
+	// AuditOutcomeQueued is recorded for requests which queued a deployment
+	// till it finishes, it is then settled as OK or failed
+	AuditOutcomeQueued = "queued"
+	AuditOutcomeFailed = "failed"
+
+	// AuditDefaultLimit caps number of events listed unless a limit is given
+	AuditDefaultLimit = 100
+)
+
+// AuditEvent records a mutating request made to optimus, events are never
+// deleted once saved and only outcome of queued ones is updated
+type AuditEvent struct {
+	ID uuid.UUID
+
+	// principal making the request
+	Actor string
+
+	ProjectName   string
+	NamespaceName string
+
+	// rpc method, e.g. DeleteJobSpecification
+	Operation string
+
+	// names of jobs, resources, secrets or projects in the request
+	Targets []string
+
+	// keyed hash of the request, identical requests have identical digests
+	RequestDigest string
+
+	Outcome string
+	Error   string
+
+	// deployment queued by the request, if any
+	DeploymentID uuid.UUID
+
+	CreatedAt time.Time
+}
+
+// AuditFilter narrows down listed audit events, empty fields match all
+type AuditFilter struct {
+	ProjectName   string
+	NamespaceName string
+	Actor         string
+	Operation     string
+
+	// events created in [Since, Until)
+	Since time.Time
+	Until time.Time
+
+	Limit int
+}
+
+// DeploymentAuditOutcome is the outcome of request which queued deployment,
+// it stays queued till deployment finishes
+func DeploymentAuditOutcome(deployment Deployment) (outcome string, errMsg string) {
+	switch deployment.Status {
+	case DeploymentStatusSucceeded:
+		return AuditOutcomeSuccess, ""
+	case DeploymentStatusFailed:
+		return AuditOutcomeFailed, deployment.Message
+	}
+	return AuditOutcomeQueued, ""
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+const (
+	// AuthRoleViewer can read specs and status of jobs and resources
+	AuthRoleViewer AuthRole = "viewer"
+	// AuthRoleDeployer can additionally deploy, delete and replay specs
+	AuthRoleDeployer AuthRole = "deployer"
+	// AuthRoleAdmin can additionally register projects, namespaces and secrets
+	AuthRoleAdmin AuthRole = "admin"
+
+	// AuthAnyProject in a role binding grants the role in all projects
+	AuthAnyProject = "*"
+)
+
+var (
+	ErrUnauthenticated = errors.New("credentials are missing or invalid")
+	ErrUnauthorized    = errors.New("permission denied")
+
+	authRoleRanks = map[AuthRole]int{
+		AuthRoleViewer:   1,
+		AuthRoleDeployer: 2,
+		AuthRoleAdmin:    3,
+	}
+)
+
+type AuthRole string
+
+func (r AuthRole) String() string {
+	return string(r)
+}
+
+// Includes is true if permissions of role r are a superset of the other
+func (r AuthRole) Includes(other AuthRole) bool {
+	rank, ok := authRoleRanks[r]
+	return ok && rank >= authRoleRanks[other]
+}
+
+// AuthRoleBinding grants a role in a project, limited to a namespace of
+// the project unless Namespace is empty
+type AuthRoleBinding struct {
+	Project   string   `json:"project"`
+	Namespace string   `json:"namespace,omitempty"`
+	Role      AuthRole `json:"role"`
+}
+
+// String formats binding as <project>[/<namespace>]:<role>
+func (b AuthRoleBinding) String() string {
+	scope := b.Project
+	if b.Namespace != "" {
+		scope = fmt.Sprintf("%s/%s", b.Project, b.Namespace)
+	}
+	return fmt.Sprintf("%s:%s", scope, b.Role)
+}
+
+// ParseAuthRoleBinding reads a binding formatted as <project>[/<namespace>]:<role>
+// e.g. "*:viewer", "sales:deployer", "sales/reporting:admin"
+func ParseAuthRoleBinding(s string) (AuthRoleBinding, error) {
+	sep := strings.LastIndex(s, ":")
+	if sep <= 0 {
+		return AuthRoleBinding{}, errors.Errorf("invalid role binding %q, expected <project>[/<namespace>]:<role>", s)
+	}
+	binding := AuthRoleBinding{
+		Project: s[:sep],
+		Role:    AuthRole(strings.ToLower(s[sep+1:])),
+	}
+	if _, ok := authRoleRanks[binding.Role]; !ok {
+		return AuthRoleBinding{}, errors.Errorf("invalid role %q in binding %q", binding.Role, s)
+	}
+	if parts := strings.SplitN(binding.Project, "/", 2); len(parts) == 2 {
+		binding.Project, binding.Namespace = parts[0], parts[1]
+	}
+	if binding.Project == "" {
+		return AuthRoleBinding{}, errors.Errorf("project missing in role binding %q", s)
+	}
+	return binding, nil
+}
+
+// AuthPrincipal is the authenticated caller of optimus APIs
+type AuthPrincipal struct {
+	Name     string
+	Bindings []AuthRoleBinding
+}
+
+// HasRole is true if principal is granted the role in namespace of project.
+// An empty project matches bindings of any project, whereas an empty namespace
+// only matches bindings granting the role in whole project
+func (p AuthPrincipal) HasRole(project, namespace string, role AuthRole) bool {
+	for _, binding := range p.Bindings {
+		if !binding.Role.Includes(role) {
+			continue
+		}
+		if project != "" && binding.Project != AuthAnyProject && binding.Project != project {
+			continue
+		}
+		if binding.Namespace != "" && binding.Namespace != namespace {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// HasRoleInProject is true if principal is granted the role in project or in
+// any of its namespaces
+func (p AuthPrincipal) HasRoleInProject(project string, role AuthRole) bool {
+	for _, binding := range p.Bindings {
+		if !binding.Role.Includes(role) {
+			continue
+		}
+		if binding.Project == AuthAnyProject || binding.Project == project {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthToken is a static API token, only hash of the token is stored
+type AuthToken struct {
+	ID       uuid.UUID
+	Name     string
+	Hash     string
+	Bindings []AuthRoleBinding
+
+	// ExpiresAt is zero for tokens which never expire
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (t AuthToken) Expired(now time.Time) bool {
+	return !t.ExpiresAt.IsZero() && now.After(t.ExpiresAt)
+}
@@ -0,0 +1,57 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthRoleBinding(t *testing.T) {
+	t.Run("should parse role bindings", func(t *testing.T) {
+		cases := map[string]models.AuthRoleBinding{
+			"*:viewer":              {Project: models.AuthAnyProject, Role: models.AuthRoleViewer},
+			"sales:Deployer":        {Project: "sales", Role: models.AuthRoleDeployer},
+			"sales/reporting:admin": {Project: "sales", Namespace: "reporting", Role: models.AuthRoleAdmin},
+		}
+		for s, expected := range cases {
+			binding, err := models.ParseAuthRoleBinding(s)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, binding)
+		}
+		assert.Equal(t, "sales/reporting:admin", cases["sales/reporting:admin"].String())
+	})
+	t.Run("should fail to parse invalid role bindings", func(t *testing.T) {
+		for _, s := range []string{"sales", ":viewer", "sales:owner", "/reporting:viewer"} {
+			_, err := models.ParseAuthRoleBinding(s)
+			assert.NotNil(t, err, s)
+		}
+	})
+}
+
+func TestAuthPrincipal(t *testing.T) {
+	principal := models.AuthPrincipal{
+		Name: "token:ci",
+		Bindings: []models.AuthRoleBinding{
+			{Project: "sales", Role: models.AuthRoleDeployer},
+			{Project: "finance", Namespace: "reporting", Role: models.AuthRoleAdmin},
+			{Project: models.AuthAnyProject, Role: models.AuthRoleViewer},
+		},
+	}
+
+	t.Run("should grant roles included in bindings", func(t *testing.T) {
+		assert.True(t, principal.HasRole("sales", "", models.AuthRoleDeployer))
+		assert.True(t, principal.HasRole("sales", "reporting", models.AuthRoleViewer))
+		assert.True(t, principal.HasRole("finance", "reporting", models.AuthRoleAdmin))
+		assert.True(t, principal.HasRole("marketing", "", models.AuthRoleViewer))
+		assert.True(t, principal.HasRole("", "", models.AuthRoleDeployer))
+	})
+	t.Run("should not grant roles outside bindings", func(t *testing.T) {
+		assert.False(t, principal.HasRole("sales", "", models.AuthRoleAdmin))
+		assert.False(t, principal.HasRole("marketing", "", models.AuthRoleDeployer))
+		assert.False(t, principal.HasRole("finance", "", models.AuthRoleDeployer))
+		assert.False(t, principal.HasRole("finance", "payments", models.AuthRoleDeployer))
+		assert.False(t, principal.HasRole("", "", models.AuthRoleAdmin))
+		assert.False(t, models.AuthPrincipal{}.HasRole("", "", models.AuthRoleViewer))
+	})
+}
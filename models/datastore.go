@@ -11,10 +11,13 @@ import (
 )
 
 const (
-	ResourceTypeTable         ResourceType = "table"
-	ResourceTypeDataset       ResourceType = "dataset"
-	ResourceTypeView          ResourceType = "view"
-	ResourceTypeExternalTable ResourceType = "external_table"
+	ResourceTypeTable            ResourceType = "table"
+	ResourceTypeDataset          ResourceType = "dataset"
+	ResourceTypeView             ResourceType = "view"
+	ResourceTypeExternalTable    ResourceType = "external_table"
+	ResourceTypeMaterializedView ResourceType = "materialized_view"
+	ResourceTypeRoutine          ResourceType = "routine"
+	ResourceTypeSchema           ResourceType = "schema"
 )
 
 type ResourceType string
@@ -64,6 +67,12 @@ type Datastorer interface {
 	DeleteResource(context.Context, DeleteResourceRequest) error
 }
 
+// DatastoreImporter can be implemented by a Datastorer which can generate
+// specs of resources already existing in datastore
+type DatastoreImporter interface {
+	ImportResources(context.Context, ImportResourcesRequest) ([]ResourceSpec, error)
+}
+
 type DatastoreTypeController interface {
 	Adapter() DatastoreSpecAdapter
 	Validator() DatastoreSpecValidator
@@ -83,6 +92,27 @@ type DatastoreSpecAdapter interface {
 	FromProtobuf([]byte) (ResourceSpec, error)
 }
 
+// DatastoreSpecDiffer can be implemented by a DatastoreTypeController to
+// compare a resource spec with the one read back from datastore, reporting
+// every field where actual differs from expected
+type DatastoreSpecDiffer interface {
+	Diff(expected, actual ResourceSpec) ([]ResourceFieldDrift, error)
+}
+
+// DatastoreSchemaChecker can be implemented by a DatastoreTypeController to
+// classify changes a desired spec makes to the schema of current resource
+// read back from datastore, before the resource is updated
+type DatastoreSchemaChecker interface {
+	CheckSchemaChange(current, desired ResourceSpec) ([]ResourceSchemaChange, error)
+}
+
+// DatastoreDependencyResolver can be implemented by a DatastoreTypeController
+// to list names of resources a resource depends on, which need to be deployed
+// before it
+type DatastoreDependencyResolver interface {
+	Dependencies(spec ResourceSpec) ([]string, error)
+}
+
 // DatastoreSpecValidator verifies if resource is as expected, in case of validation
 // failure, return with non nil error
 type DatastoreSpecValidator func(spec ResourceSpec) error
@@ -116,11 +146,114 @@ type DeleteResourceRequest struct {
 	Project  ProjectSpec
 }
 
+type ImportResourcesRequest struct {
+	// Secret used to access the datastore
+	Secret string
+
+	// Parent resource imported along with its children, e.g. a bigquery dataset
+	Parent string
+	// Pattern of children names to import, all are imported if empty
+	Pattern string
+}
+
+// ResourceFieldDrift is a field of resource whose value in datastore
+// differs from the stored spec
+type ResourceFieldDrift struct {
+	Field    string
+	Expected string
+	Actual   string
+}
+
+// ResourceDrift is the result of comparing a stored resource spec with
+// the live resource in datastore
+type ResourceDrift struct {
+	Spec ResourceSpec
+
+	// Missing is true if resource doesn't exist in datastore
+	Missing bool
+	Fields  []ResourceFieldDrift
+
+	// Err if the resource couldn't be compared
+	Err error
+}
+
+func (d ResourceDrift) Drifted() bool {
+	return d.Missing || len(d.Fields) > 0
+}
+
+type ResourcePlanAction string
+
+const (
+	ResourcePlanCreate ResourcePlanAction = "create"
+	ResourcePlanUpdate ResourcePlanAction = "update"
+	ResourcePlanNoop   ResourcePlanAction = "no-op"
+	ResourcePlanDelete ResourcePlanAction = "delete"
+	// ResourcePlanUnsupported is planned for existing resources of a datastore
+	// which can't compare specs, deploying updates them without knowing how
+	ResourcePlanUnsupported ResourcePlanAction = "unsupported"
+)
+
+// ResourcePlan is what deploying a resource spec would do to the resource
+// in datastore
+type ResourcePlan struct {
+	Spec   ResourceSpec
+	Action ResourcePlanAction
+
+	// Fields of resource which would change on update, where expected is
+	// the desired value
+	Fields        []ResourceFieldDrift
+	SchemaChanges []ResourceSchemaChange
+
+	// Err if the resource couldn't be planned
+	Err error
+}
+
+type SchemaChangeKind string
+
+const (
+	// SchemaChangeAdditive adds to schema without touching existing data,
+	// e.g. a nullable column
+	SchemaChangeAdditive SchemaChangeKind = "additive"
+	// SchemaChangeCompatible modifies schema keeping existing data and readers
+	// intact, e.g. description of a column
+	SchemaChangeCompatible SchemaChangeKind = "compatible"
+	// SchemaChangeBreaking is rejected by datastore or silently breaks readers,
+	// e.g. dropping a column
+	SchemaChangeBreaking SchemaChangeKind = "breaking"
+)
+
+// ResourceSchemaChange is a field of resource schema changed by a spec
+type ResourceSchemaChange struct {
+	Field string
+	Kind  SchemaChangeKind
+	From  string
+	To    string
+}
+
+func (c ResourceSchemaChange) String() string {
+	return fmt.Sprintf("%s %s: %q -> %q", c.Kind, c.Field, c.From, c.To)
+}
+
 var (
 	DatastoreRegistry = &supportedDatastore{
 		data: map[string]Datastorer{},
 	}
 	ErrUnsupportedDatastore = errors.New("unsupported datastore requested")
+	// ErrResourceNotFoundInDatastore is returned by a datastore reading a resource
+	// which doesn't exist
+	ErrResourceNotFoundInDatastore = errors.New("resource not found in datastore")
+	// ErrBreakingSchemaChange is returned when an update with breaking schema
+	// changes isn't explicitly allowed
+	ErrBreakingSchemaChange = errors.New("breaking schema change")
+	// ErrResourceCompareUnsupported is returned by a datastore which can't compare
+	// resource specs or check their schema changes, e.g. one served by a plugin
+	ErrResourceCompareUnsupported = errors.New("comparing resources is not supported by datastore")
+	// ErrUpstreamResourceFailed is returned for resources skipped during a
+	// deployment as a resource they depend on failed
+	ErrUpstreamResourceFailed = errors.New("upstream resource failed")
+	// ErrCyclicResourceDependency is returned for resources which depend on
+	// each other
+	ErrCyclicResourceDependency = errors.New("cyclic resource dependency")
 )
 
 type DatastoreRepo interface {
@@ -165,9 +298,28 @@ func (s *supportedDatastore) Add(newUnit Datastorer) error {
 type DatastoreService interface {
 	// does not really fetch resource metadata, just the user provided spec
 	GetAll(namespace NamespaceSpec, datastoreName string) ([]ResourceSpec, error)
+	// GetByFilter returns user provided specs matching filter, ordered by name
+	GetByFilter(namespace NamespaceSpec, datastoreName string, filter ResourceSpecFilter) ([]ResourceSpec, error)
 
 	CreateResource(ctx context.Context, namespace NamespaceSpec, resourceSpecs []ResourceSpec, obs progress.Observer) error
-	UpdateResource(ctx context.Context, namespace NamespaceSpec, resourceSpecs []ResourceSpec, obs progress.Observer) error
+	// UpdateResource fails updating resources with breaking schema changes
+	// unless allowBreakingChanges is set
+	UpdateResource(ctx context.Context, namespace NamespaceSpec, resourceSpecs []ResourceSpec, allowBreakingChanges bool,
+		obs progress.Observer) error
 	ReadResource(ctx context.Context, namespace NamespaceSpec, datastoreName, name string) (ResourceSpec, error)
 	DeleteResource(ctx context.Context, namespace NamespaceSpec, datastoreName, name string) error
+	// KeepOnly deletes resources missing from resourceSpecs which are confirmed
+	KeepOnly(ctx context.Context, namespace NamespaceSpec, datastoreName string, resourceSpecs []ResourceSpec,
+		confirmed []string, obs progress.Observer) error
+	// DropResources deletes resources from their datastores except protected
+	// ones, specs are left as they are
+	DropResources(ctx context.Context, namespace NamespaceSpec, resourceSpecs []ResourceSpec, obs progress.Observer) error
+	// PlanResource classifies what deploying resourceSpecs would do to each resource
+	// without changing anything, resources missing from resourceSpecs are planned
+	// for deletion if prune is set
+	PlanResource(ctx context.Context, namespace NamespaceSpec, datastoreName string, resourceSpecs []ResourceSpec,
+		prune bool) ([]ResourcePlan, error)
+	// DetectDrift compares stored resource specs with live resources of datastore,
+	// all resources of namespace are compared if names are not provided
+	DetectDrift(ctx context.Context, namespace NamespaceSpec, datastoreName string, names []string) ([]ResourceDrift, error)
 }
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DeploymentStatusQueued deployment is waiting for a worker
+	DeploymentStatusQueued     = "queued"
+	DeploymentStatusInProgress = "inprogress"
+	// DeploymentStatusSucceeded all jobs are deployed
+	DeploymentStatusSucceeded = "succeeded" // end state
+	// DeploymentStatusFailed some or all jobs failed to be deployed
+	DeploymentStatusFailed = "failed" // end state
+
+	// DeploymentDefaultLimit caps number of deployments listed unless a
+	// limit is given
+	DeploymentDefaultLimit = 20
+)
+
+// DeploymentStatusUnfinished lists statuses of deployments yet to finish
+var DeploymentStatusUnfinished = []string{DeploymentStatusQueued, DeploymentStatusInProgress}
+
+// Deployment tracks job specifications of a namespace being deployed
+// asynchronously by the server
+type Deployment struct {
+	ID        uuid.UUID
+	Namespace NamespaceSpec
+
+	Status string
+
+	// error deployment failed with
+	Message string
+
+	// outcome of jobs uploaded to scheduler so far
+	Jobs []DeploymentJobResult
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Finished reports if deployment has reached an end state
+func (d Deployment) Finished() bool {
+	return d.Status == DeploymentStatusSucceeded || d.Status == DeploymentStatusFailed
+}
+
+type DeploymentJobResult struct {
+	Name    string
+	Success bool
+	Message string
+}
+
+// DeploymentFilter narrows down listed deployments, empty fields match all
+type DeploymentFilter struct {
+	ProjectID   uuid.UUID
+	NamespaceID uuid.UUID
+	Status      []string
+
+	// deployments created before
+	CreatedBefore time.Time
+	// deployments last updated before, server carrying out a deployment
+	// keeps updating it till it is finished
+	UpdatedBefore time.Time
+
+	Limit int
+}
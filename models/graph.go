@@ -0,0 +1,42 @@
+package models
+
+const (
+	// JobGraphNodeTypeJob is a job registered in optimus
+	JobGraphNodeTypeJob JobGraphNodeType = "job"
+	// JobGraphNodeTypeUnknown is a destination used by a job which
+	// isn't produced by any registered job
+	JobGraphNodeTypeUnknown JobGraphNodeType = "unknown"
+)
+
+type JobGraphNodeType string
+
+func (j JobGraphNodeType) String() string {
+	return string(j)
+}
+
+// JobGraph represents resolved dependencies between jobs as a directed graph,
+// edges point from an upstream to its downstream
+type JobGraph struct {
+	Nodes []JobGraphNode
+	Edges []JobGraphEdge
+}
+
+type JobGraphNode struct {
+	// ID is unique within a graph, jobs are identified by project and name,
+	// unknown dependencies by their destination
+	ID      string
+	Name    string
+	Project string
+	Type    JobGraphNodeType
+}
+
+type JobGraphEdge struct {
+	Upstream   string
+	Downstream string
+	Type       JobSpecDependencyType
+}
+
+// JobGraphID builds the node identifier of a job within a JobGraph
+func JobGraphID(projectName, jobName string) string {
+	return projectName + "/" + jobName
+}
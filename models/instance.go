@@ -1,6 +1,7 @@
 package models
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -75,8 +76,8 @@ func (j *InstanceSpec) DataToJSON() ([]byte, error) {
 }
 
 type InstanceService interface {
-	Register(jobSpec JobSpec, scheduledAt time.Time, taskType InstanceType) (InstanceSpec, error)
-	Compile(namespaceSpec NamespaceSpec, jobSpec JobSpec, instanceSpec InstanceSpec,
+	Register(ctx context.Context, jobSpec JobSpec, scheduledAt time.Time, taskType InstanceType) (InstanceSpec, error)
+	Compile(ctx context.Context, namespaceSpec NamespaceSpec, jobSpec JobSpec, instanceSpec InstanceSpec,
 		runType InstanceType, runName string) (envMap map[string]string, fileMap map[string]string, err error)
 }
 
@@ -39,8 +39,18 @@ const (
 	// outside optimus
 	JobSpecDependencyTypeExtra JobSpecDependencyType = "extra"
 
+	// JobSpecDependencySourceStatic is a dependency explicitly defined in job spec
+	JobSpecDependencySourceStatic JobSpecDependencySource = "static"
+	// JobSpecDependencySourceInferred is a dependency on the job producing
+	// a destination used by the job
+	JobSpecDependencySourceInferred JobSpecDependencySource = "inferred"
+
 	JobEventTypeSLAMiss JobEventType = "sla_miss"
 	JobEventTypeFailure JobEventType = "failure"
+
+	// JobEventTypeResourceDrift is not raised by jobs, resource drift
+	// notifications reuse job notification channels
+	JobEventTypeResourceDrift JobEventType = "resource_drift"
 )
 
 // JobSpec represents a job
@@ -111,6 +121,10 @@ type JobSpecTask struct {
 	Config   JobSpecConfigs
 	Window   JobSpecTaskWindow
 	Priority int
+
+	// PriorityReason explains how Priority was computed, set only by
+	// priority resolvers
+	PriorityReason string
 }
 
 // using array to keep order, map would be more performant
@@ -287,10 +301,18 @@ func (j JobSpecDependencyType) String() string {
 	return string(j)
 }
 
+type JobSpecDependencySource string
+
+func (j JobSpecDependencySource) String() string {
+	return string(j)
+}
+
 type JobSpecDependency struct {
 	Project *ProjectSpec
 	Job     *JobSpec
 	Type    JobSpecDependencyType
+	// Source is how the dependency was found, set only while resolving
+	Source JobSpecDependencySource
 }
 
 // JobService provides a high-level operations on DAGs
@@ -300,11 +322,14 @@ type JobService interface {
 	// GetByName fetches a Job by name for a specific namespace
 	GetByName(string, NamespaceSpec) (JobSpec, error)
 	// Dump returns the compiled Job
-	Dump(NamespaceSpec, JobSpec) (Job, error)
+	Dump(context.Context, NamespaceSpec, JobSpec) (Job, error)
 	// KeepOnly deletes all jobs except the ones provided for a namespace
 	KeepOnly(NamespaceSpec, []JobSpec, progress.Observer) error
 	// GetAll reads all job specifications of the given namespace
 	GetAll(NamespaceSpec) ([]JobSpec, error)
+	// GetByFilter reads job specifications of the given namespace matching
+	// filter, ordered by name
+	GetByFilter(NamespaceSpec, JobSpecFilter) ([]JobSpec, error)
 	// Delete deletes a job spec from all repos
 	Delete(context.Context, NamespaceSpec, JobSpec) error
 
@@ -312,11 +337,26 @@ type JobService interface {
 	// GetByNameForProject fetches a Job by name for a specific project
 	GetByNameForProject(string, ProjectSpec) (JobSpec, NamespaceSpec, error)
 	Sync(context.Context, NamespaceSpec, progress.Observer) error
-	Check(NamespaceSpec, []JobSpec, progress.Observer) error
+	Check(context.Context, NamespaceSpec, []JobSpec, progress.Observer) error
+	// CheckDependencyCycles fails if job specs would be part of a dependency
+	// cycle once deployed in the namespace
+	CheckDependencyCycles(context.Context, NamespaceSpec, []JobSpec) error
 	// ReplayDryRun returns the execution tree of jobSpec and its dependencies between start and endDate
-	ReplayDryRun(*ReplayWorkerRequest) (*tree.TreeNode, error)
+	ReplayDryRun(context.Context, *ReplayWorkerRequest) (*tree.TreeNode, error)
 	// Replay replays the jobSpec and its dependencies between start and endDate
 	Replay(context.Context, *ReplayWorkerRequest) (string, error)
+	// GetJobGraph returns the resolved dependency graph of jobs in a project along with
+	// their consumers in provided projects, optionally limited to the upstream and
+	// downstream of a job
+	GetJobGraph(context.Context, ProjectSpec, []ProjectSpec, string, int) (JobGraph, error)
+	// PlanDeployment reports the impact of deploying job specs of a namespace
+	// without persisting them, downstream consumers are looked up in provided projects
+	PlanDeployment(context.Context, NamespaceSpec, []JobSpec, []ProjectSpec) (JobDeploymentPlan, error)
+	// GetIncomingDependencies returns dependencies of jobs outside of namespaces,
+	// looked up in provided projects, on jobs of namespaces
+	GetIncomingDependencies(context.Context, []NamespaceSpec, []ProjectSpec) ([]JobGraphEdge, error)
+	// DeleteCompiled removes compiled jobs of a namespace from scheduler
+	DeleteCompiled(context.Context, NamespaceSpec, progress.Observer) error
 }
 
 // JobCompiler takes template file of a scheduler and after applying
@@ -331,6 +371,10 @@ type Job struct {
 	Name        string
 	NamespaceID string
 	Contents    []byte
+
+	// Priority and PriorityReason of the job spec used for compilation
+	Priority       int
+	PriorityReason string
 }
 
 type JobEventType string
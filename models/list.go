@@ -0,0 +1,78 @@
+package models
+
+import "strings"
+
+const (
+	// PageDefaultSize is number of items listed in a page unless a page
+	// size is given
+	PageDefaultSize = 100
+	// PageMaxSize caps number of items listed in a page
+	PageMaxSize = 1000
+)
+
+// Page selects a window of items ordered by name
+type Page struct {
+	// list items whose name sorts after this one, from the first if empty
+	After string
+
+	// maximum items listed, all if 0
+	Limit int
+}
+
+// NameFilter narrows down listed projects or namespaces, empty fields
+// match all
+type NameFilter struct {
+	NamePrefix string
+
+	Page
+}
+
+// JobSpecFilter narrows down listed job specifications, empty fields
+// match all
+type JobSpecFilter struct {
+	NamePrefix  string
+	Owner       string
+	TaskName    string
+	Destination string
+
+	// jobs having all of these labels
+	Labels map[string]string
+
+	// top level fields of listed specs that are read, e.g. assets, all if
+	// empty
+	Fields []string
+
+	Page
+}
+
+// ResourceSpecFilter narrows down listed resource specifications, empty
+// fields match all
+type ResourceSpecFilter struct {
+	NamePrefix string
+	Type       ResourceType
+
+	// resources having all of these labels
+	Labels map[string]string
+
+	// top level fields of listed specs that are read, e.g. assets, all if
+	// empty
+	Fields []string
+
+	Page
+}
+
+// Matches tells if spec passes the filter, regardless of page
+func (f ResourceSpecFilter) Matches(spec ResourceSpec) bool {
+	if !strings.HasPrefix(spec.Name, f.NamePrefix) {
+		return false
+	}
+	if f.Type != "" && f.Type != spec.Type {
+		return false
+	}
+	for key, value := range f.Labels {
+		if actual, ok := spec.Labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
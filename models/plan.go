@@ -0,0 +1,36 @@
+package models
+
+// JobDeploymentPlan is the impact of deploying job specifications of a
+// namespace, computed against the registered state without persisting anything
+type JobDeploymentPlan struct {
+	// Added are jobs which are not registered yet
+	Added []string
+	// Removed are registered jobs missing from the deployment
+	Removed []string
+	// Changed are registered jobs with a different specification
+	Changed []JobSpecChange
+	// DependencyChanges are jobs, possibly of other projects, whose resolved
+	// upstreams differ after the deployment
+	DependencyChanges []JobDependencyChange
+	// Affected are downstream consumers of added, removed or changed jobs
+	Affected []JobGraphNode
+}
+
+type JobSpecChange struct {
+	Name string
+	// Fields are the parts of the specification that differ
+	Fields         []string
+	OldDestination string
+	NewDestination string
+}
+
+type JobDependencyChange struct {
+	Job JobGraphNode
+	// Added and Removed are graph ids of upstream jobs
+	Added   []string
+	Removed []string
+	// Unknown are destinations which will no longer be produced by any job
+	Unknown []string
+	// Err is set if dependencies can't be resolved after the deployment
+	Err error
+}
@@ -15,6 +15,7 @@ const (
 	// plugin modes are optional and implemented as needed
 	ModTypeCLI                PluginMod = "cli"
 	ModTypeDependencyResolver PluginMod = "dependencyresolver"
+	ModTypeDatastore          PluginMod = "datastore"
 
 	HookTypePre  HookType = "pre"
 	HookTypePost HookType = "post"
@@ -25,6 +26,10 @@ var (
 	// plugin types
 	PluginTypeTask = PluginType(InstanceTypeTask.String())
 	PluginTypeHook = PluginType(InstanceTypeHook.String())
+
+	// PluginTypeDatastore plugins only provide a datastore, they are not
+	// scheduled for execution
+	PluginTypeDatastore = PluginType("datastore")
 )
 
 type PluginType string
@@ -75,6 +80,13 @@ type PluginInfoResponse struct {
 	HookType HookType
 }
 
+// DatastoreMod needs to be implemented by plugins shipping a datastore, it gets
+// registered in DatastoreRegistry along with the datastores built in optimus
+type DatastoreMod interface {
+	BasePlugin
+	Datastorer
+}
+
 // CommandLineMod needs to be implemented by plugins to interact with optimus CLI
 type CommandLineMod interface {
 	BasePlugin
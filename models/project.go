@@ -15,6 +15,14 @@ const (
 	ProjectStoragePathKey = "STORAGE_PATH"
 	ProjectSchedulerHost  = "SCHEDULER_HOST"
 
+	// ProjectPriorityResolver selects how priority weights of jobs are
+	// computed, e.g. depth, critical_path
+	ProjectPriorityResolver = "PRIORITY_RESOLVER"
+
+	// ProjectResourceDriftNotify lists comma separated channels notified
+	// when live resources drift from their specs, e.g. slack://#data-alerts
+	ProjectResourceDriftNotify = "RESOURCE_DRIFT_NOTIFY"
+
 	// Secret used for uploading prepared scheduler specifications to cloud
 	// e.g. for gcs it will be base64 encoded service account for the bucket
 	ProjectSecretStorageKey = "STORAGE"
@@ -41,6 +49,8 @@ type ProjectSpec struct {
 	// suggested are gcs/s3 or similar object store
 	// - ProjectSchedulerHost: host url to connect with the scheduler used by
 	// the tenant
+	// - ProjectPriorityResolver: strategy used to assign priority weights
+	// - ProjectResourceDriftNotify: channels notified about resource drift
 	Config map[string]string
 
 	// Secret contains key value pair for project level credentials and gets
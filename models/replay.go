@@ -34,6 +34,7 @@ type ReplayWorkerRequest struct {
 type ReplaySpec struct {
 	ID        uuid.UUID
 	Job       JobSpec
+	Project   ProjectSpec
 	StartDate time.Time
 	EndDate   time.Time
 	Status    string
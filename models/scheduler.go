@@ -36,6 +36,9 @@ type SchedulerUnit interface {
 	// this can be used to do adhoc commands for initialization of scheduler
 	Bootstrap(context.Context, ProjectSpec) error
 
+	// Ping fails if scheduler used by project can't be reached
+	Ping(ctx context.Context, projSpec ProjectSpec) error
+
 	// GetJobStatus should return the current and previous status of job
 	GetJobStatus(ctx context.Context, projSpec ProjectSpec, jobName string) ([]JobStatus, error)
 
@@ -56,4 +59,7 @@ func (j JobStatusState) String() string {
 type JobStatus struct {
 	ScheduledAt time.Time
 	State       JobStatusState
+
+	// Duration is how long the run took, zero when it is not finished
+	Duration time.Duration
 }
@@ -35,6 +35,8 @@ func (m *GRPCClient) PluginInfo() (*models.PluginInfoResponse, error) {
 		ptype = models.PluginTypeTask
 	case pbp.PluginType_PluginType_HOOK:
 		ptype = models.PluginTypeHook
+	case pbp.PluginType_PluginType_DATASTORE:
+		ptype = models.PluginTypeDatastore
 	default:
 		return nil, fmt.Errorf("plugin is of unknown type: %q", resp.GetPluginType().String())
 	}
@@ -46,6 +48,8 @@ func (m *GRPCClient) PluginInfo() (*models.PluginInfoResponse, error) {
 			mtype = append(mtype, models.ModTypeCLI)
 		case pbp.PluginMod_PluginMod_DEPENDENCYRESOLVER:
 			mtype = append(mtype, models.ModTypeDependencyResolver)
+		case pbp.PluginMod_PluginMod_DATASTORE:
+			mtype = append(mtype, models.ModTypeDatastore)
 		default:
 			return nil, fmt.Errorf("plugin mod is of unknown type: %q", mod.String())
 		}
@@ -32,7 +32,7 @@ func (p *Connector) GRPCServer(broker *hplugin.GRPCBroker, s *grpc.Server) error
 
 func (p *Connector) GRPCClient(ctx context.Context, broker *hplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
 	return &GRPCClient{
-		Client: pbp.NewBaseClient(c),
+		Client: pbp.NewBaseClient(NewInstrumentedConn(c)),
 	}, nil
 }
 
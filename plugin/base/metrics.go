@@ -0,0 +1,45 @@
+package base
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var rpcDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "optimus",
+	Subsystem: "plugin",
+	Name:      "rpc_duration_seconds",
+	Help:      "Latency of calls made to plugins by grpc method and status code",
+	Buckets:   []float64{0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 15, 30},
+}, []string{"method", "code"})
+
+var traceInterceptor = otelgrpc.UnaryClientInterceptor()
+
+// instrumentedConn records latency and traces of calls made over a plugin
+// connection
+type instrumentedConn struct {
+	*grpc.ClientConn
+}
+
+func (c *instrumentedConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	start := time.Now()
+	err := traceInterceptor(ctx, method, args, reply, c.ClientConn, invoke, opts...)
+	rpcDuration.WithLabelValues(method, status.Code(err).String()).Observe(time.Since(start).Seconds())
+	return err
+}
+
+func invoke(ctx context.Context, method string, args, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+	return cc.Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewInstrumentedConn wraps connection to a plugin so clients of its mods
+// report latency of every call and trace it as part of the caller's span
+func NewInstrumentedConn(conn *grpc.ClientConn) grpc.ClientConnInterface {
+	return &instrumentedConn{ClientConn: conn}
+}
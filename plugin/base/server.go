@@ -27,6 +27,8 @@ func (s *GRPCServer) PluginInfo(ctx context.Context, req *pbp.PluginInfoRequest)
 	switch n.PluginType {
 	case models.PluginTypeTask:
 		ptype = pbp.PluginType_PluginType_TASK
+	case models.PluginTypeDatastore:
+		ptype = pbp.PluginType_PluginType_DATASTORE
 	}
 
 	var mtype []pbp.PluginMod
@@ -36,6 +38,8 @@ func (s *GRPCServer) PluginInfo(ctx context.Context, req *pbp.PluginInfoRequest)
 			mtype = append(mtype, pbp.PluginMod_PluginMod_CLI)
 		case models.ModTypeDependencyResolver:
 			mtype = append(mtype, pbp.PluginMod_PluginMod_DEPENDENCYRESOLVER)
+		case models.ModTypeDatastore:
+			mtype = append(mtype, pbp.PluginMod_PluginMod_DATASTORE)
 		default:
 			return nil, fmt.Errorf("plugin mod is of unknown type: %s", mod)
 		}
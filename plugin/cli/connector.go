@@ -32,10 +32,11 @@ func (p *Connector) GRPCServer(broker *hplugin.GRPCBroker, s *grpc.Server) error
 }
 
 func (p *Connector) GRPCClient(ctx context.Context, broker *hplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	conn := base.NewInstrumentedConn(c)
 	return &GRPCClient{
-		client: pbp.NewCLIModClient(c),
+		client: pbp.NewCLIModClient(conn),
 		baseClient: &base.GRPCClient{
-			Client: pbp.NewBaseClient(c),
+			Client: pbp.NewBaseClient(conn),
 			Logger: p.logger,
 		},
 	}, nil
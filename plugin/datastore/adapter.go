@@ -0,0 +1,68 @@
+package datastore
+
+import (
+	"strings"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// AdaptResourceToProto converts a resource read by core from a datastore
+// plugin, whose spec is kept as a protobuf struct opaque to core
+func AdaptResourceToProto(spec models.ResourceSpec) (*pb.ResourceSpecification, error) {
+	var protoSpec *structpb.Struct
+	if spec.Spec != nil {
+		var ok bool
+		if protoSpec, ok = spec.Spec.(*structpb.Struct); !ok {
+			return nil, errors.Errorf("spec of resource %s is not a protobuf struct", spec.Name)
+		}
+	}
+	return &pb.ResourceSpecification{
+		Version: int32(spec.Version),
+		Name:    spec.Name,
+		Type:    spec.Type.String(),
+		Spec:    protoSpec,
+		Assets:  spec.Assets,
+		Labels:  spec.Labels,
+	}, nil
+}
+
+func AdaptResourceFromProto(res *pb.ResourceSpecification, store models.Datastorer) models.ResourceSpec {
+	var spec interface{}
+	if res.Spec != nil {
+		spec = res.Spec
+	}
+	return models.ResourceSpec{
+		Version:   int(res.Version),
+		Name:      res.Name,
+		Type:      models.ResourceType(res.Type),
+		Datastore: store,
+		Spec:      spec,
+		Assets:    res.Assets,
+		Labels:    res.Labels,
+	}
+}
+
+// secretName of the datastore, it is the only project secret sent to plugin
+func secretName(datastoreName string) string {
+	return "DATASTORE_" + strings.ToUpper(datastoreName)
+}
+
+// toStatusErr keeps resource not found errors of plugin recognisable to core
+func toStatusErr(err error) error {
+	if errors.Is(err, models.ErrResourceNotFoundInDatastore) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func fromStatusErr(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return errors.Wrap(models.ErrResourceNotFoundInDatastore, status.Convert(err).Message())
+	}
+	return err
+}
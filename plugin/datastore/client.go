@@ -0,0 +1,209 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	pbp "github.com/odpf/optimus/api/proto/odpf/optimus/plugins"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/plugin/base"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCClient will be used by core to talk over grpc with plugins, it is
+// registered as a datastore once its info is loaded
+type GRPCClient struct {
+	client             pbp.DatastoreModClient
+	projectSpecAdapter ProjectSpecAdapter
+
+	baseClient *base.GRPCClient
+
+	// datastore info cached on Init
+	name        string
+	description string
+	types       map[models.ResourceType]models.DatastoreTypeController
+}
+
+func (m *GRPCClient) PluginInfo() (*models.PluginInfoResponse, error) {
+	return m.baseClient.PluginInfo()
+}
+
+// Init loads name and resource types of datastore from plugin
+func (m *GRPCClient) Init(ctx context.Context) error {
+	resp, err := m.client.DatastoreInfo(ctx, &pbp.DatastoreInfoRequest{})
+	if err != nil {
+		return err
+	}
+	m.name = resp.Name
+	m.description = resp.Description
+	m.types = map[models.ResourceType]models.DatastoreTypeController{}
+	for _, resourceType := range resp.Types {
+		m.types[models.ResourceType(resourceType.Type)] = &typeController{
+			client:        m,
+			resourceType:  models.ResourceType(resourceType.Type),
+			defaultAssets: resourceType.DefaultAssets,
+		}
+	}
+	return nil
+}
+
+func (m *GRPCClient) Name() string {
+	return m.name
+}
+
+func (m *GRPCClient) Description() string {
+	return m.description
+}
+
+func (m *GRPCClient) Types() map[models.ResourceType]models.DatastoreTypeController {
+	return m.types
+}
+
+func (m *GRPCClient) CreateResource(ctx context.Context, request models.CreateResourceRequest) error {
+	_, err := m.resourceCall(ctx, m.client.CreateResource, request.Resource, request.Project)
+	return err
+}
+
+func (m *GRPCClient) UpdateResource(ctx context.Context, request models.UpdateResourceRequest) error {
+	_, err := m.resourceCall(ctx, m.client.UpdateResource, request.Resource, request.Project)
+	return err
+}
+
+func (m *GRPCClient) ReadResource(ctx context.Context, request models.ReadResourceRequest) (models.ReadResourceResponse, error) {
+	resp, err := m.resourceCall(ctx, m.client.ReadResource, request.Resource, request.Project)
+	if err != nil {
+		return models.ReadResourceResponse{}, err
+	}
+	return models.ReadResourceResponse{
+		Resource: AdaptResourceFromProto(resp.Resource, m),
+	}, nil
+}
+
+func (m *GRPCClient) DeleteResource(ctx context.Context, request models.DeleteResourceRequest) error {
+	_, err := m.resourceCall(ctx, m.client.DeleteResource, request.Resource, request.Project)
+	return err
+}
+
+type resourceRPC func(context.Context, *pbp.DatastoreResourceRequest, ...grpc.CallOption) (*pbp.DatastoreResourceResponse, error)
+
+func (m *GRPCClient) resourceCall(ctx context.Context, call resourceRPC, resource models.ResourceSpec,
+	project models.ProjectSpec) (*pbp.DatastoreResourceResponse, error) {
+	resourceProto, err := AdaptResourceToProto(resource)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := call(ctx, &pbp.DatastoreResourceRequest{
+		Resource: resourceProto,
+		Project:  m.projectSpecAdapter.ToProjectProtoWithSecrets(m.datastoreProject(project)),
+	})
+	if err != nil {
+		return nil, fromStatusErr(err)
+	}
+	return resp, nil
+}
+
+// datastoreProject strips secrets of project not meant for this datastore
+func (m *GRPCClient) datastoreProject(project models.ProjectSpec) models.ProjectSpec {
+	var secrets models.ProjectSecrets
+	for _, s := range project.Secret {
+		if s.Name == secretName(m.name) {
+			secrets = append(secrets, s)
+		}
+	}
+	project.Secret = secrets
+	return project
+}
+
+type typeController struct {
+	client        *GRPCClient
+	resourceType  models.ResourceType
+	defaultAssets map[string]string
+}
+
+func (t *typeController) Adapter() models.DatastoreSpecAdapter {
+	return &specAdapter{client: t.client, resourceType: t.resourceType}
+}
+
+func (t *typeController) Validator() models.DatastoreSpecValidator {
+	return func(spec models.ResourceSpec) error {
+		resourceProto, err := AdaptResourceToProto(spec)
+		if err != nil {
+			return err
+		}
+		_, err = t.client.client.ValidateResource(context.Background(), &pbp.ValidateResourceRequest{
+			Resource: resourceProto,
+		})
+		if err != nil {
+			// report reason of failure as returned by plugin
+			return errors.New(status.Convert(err).Message())
+		}
+		return nil
+	}
+}
+
+func (t *typeController) DefaultAssets() map[string]string {
+	return t.defaultAssets
+}
+
+// Diff can't be answered by plugins, resources are reported as not comparable
+// instead of being silently left out of plans and drift detection
+func (t *typeController) Diff(_, _ models.ResourceSpec) ([]models.ResourceFieldDrift, error) {
+	return nil, errors.Wrap(models.ErrResourceCompareUnsupported, t.client.name)
+}
+
+// CheckSchemaChange can't be answered by plugins, updates are only applied
+// when breaking changes are allowed
+func (t *typeController) CheckSchemaChange(_, _ models.ResourceSpec) ([]models.ResourceSchemaChange, error) {
+	return nil, errors.Wrap(models.ErrResourceCompareUnsupported, t.client.name)
+}
+
+// specAdapter serializes specs to yaml in plugin, protobuf encoding of
+// resources is done in core as spec is already a protobuf struct
+type specAdapter struct {
+	client       *GRPCClient
+	resourceType models.ResourceType
+}
+
+func (s *specAdapter) ToYaml(spec models.ResourceSpec) ([]byte, error) {
+	resourceProto, err := AdaptResourceToProto(spec)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.client.ResourceToYaml(context.Background(), &pbp.ResourceToYamlRequest{
+		Resource: resourceProto,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Yaml, nil
+}
+
+func (s *specAdapter) FromYaml(b []byte) (models.ResourceSpec, error) {
+	resp, err := s.client.client.ResourceFromYaml(context.Background(), &pbp.ResourceFromYamlRequest{
+		Type: s.resourceType.String(),
+		Yaml: b,
+	})
+	if err != nil {
+		return models.ResourceSpec{}, err
+	}
+	return AdaptResourceFromProto(resp.Resource, s.client), nil
+}
+
+func (s *specAdapter) ToProtobuf(spec models.ResourceSpec) ([]byte, error) {
+	resourceProto, err := AdaptResourceToProto(spec)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resourceProto)
+}
+
+func (s *specAdapter) FromProtobuf(b []byte) (models.ResourceSpec, error) {
+	resourceProto := &pb.ResourceSpecification{}
+	if err := proto.Unmarshal(b, resourceProto); err != nil {
+		return models.ResourceSpec{}, err
+	}
+	return AdaptResourceFromProto(resourceProto, s.client), nil
+}
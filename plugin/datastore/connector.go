@@ -0,0 +1,95 @@
+package datastore
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	"github.com/odpf/optimus/plugin/base"
+	"github.com/odpf/optimus/plugin/cli"
+	"github.com/odpf/optimus/plugin/dependencyresolver"
+
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+
+	v1 "github.com/odpf/optimus/api/handler/v1"
+
+	"github.com/odpf/optimus/models"
+
+	hplugin "github.com/hashicorp/go-plugin"
+	pbp "github.com/odpf/optimus/api/proto/odpf/optimus/plugins"
+	"google.golang.org/grpc"
+)
+
+var _ hplugin.GRPCPlugin = &Connector{}
+
+type ProjectSpecAdapter interface {
+	FromProjectProtoWithSecrets(*pb.ProjectSpecification) models.ProjectSpec
+	ToProjectProtoWithSecrets(models.ProjectSpec) *pb.ProjectSpecification
+}
+
+type Connector struct {
+	hplugin.NetRPCUnsupportedPlugin
+	hplugin.GRPCPlugin
+
+	impl               models.DatastoreMod
+	projectSpecAdapter ProjectSpecAdapter
+
+	logger hclog.Logger
+}
+
+func (p *Connector) GRPCServer(broker *hplugin.GRPCBroker, s *grpc.Server) error {
+	pbp.RegisterDatastoreModServer(s, &GRPCServer{
+		Impl:               p.impl,
+		projectSpecAdapter: p.projectSpecAdapter,
+	})
+	return nil
+}
+
+func (p *Connector) GRPCClient(ctx context.Context, broker *hplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	conn := base.NewInstrumentedConn(c)
+	return &GRPCClient{
+		client:             pbp.NewDatastoreModClient(conn),
+		projectSpecAdapter: p.projectSpecAdapter,
+		baseClient: &base.GRPCClient{
+			Client: pbp.NewBaseClient(conn),
+			Logger: p.logger,
+		},
+	}, nil
+}
+
+func NewPlugin(impl models.DatastoreMod, logger hclog.Logger) *Connector {
+	return &Connector{
+		impl:               impl,
+		projectSpecAdapter: v1.NewAdapter(nil, nil),
+		logger:             logger,
+	}
+}
+
+func NewPluginClient(logger hclog.Logger) *Connector {
+	return &Connector{
+		projectSpecAdapter: v1.NewAdapter(nil, nil),
+		logger:             logger,
+	}
+}
+
+// Serve starts the plugin with datastore mod, along with cli and dependency
+// resolver mods if the datastore implements them too
+func Serve(t models.DatastoreMod, logger hclog.Logger) {
+	mp := map[string]plugin.Plugin{
+		models.PluginTypeBase:            base.NewPlugin(t, logger),
+		models.ModTypeDatastore.String(): NewPlugin(t, logger),
+	}
+	if cliPlugin, ok := t.(models.CommandLineMod); ok {
+		mp[models.ModTypeCLI.String()] = cli.NewPlugin(cliPlugin, logger)
+	}
+	if drPlugin, ok := t.(models.DependencyResolverMod); ok {
+		mp[models.ModTypeDependencyResolver.String()] = dependencyresolver.NewPlugin(drPlugin, logger)
+	}
+
+	hplugin.Serve(&hplugin.ServeConfig{
+		HandshakeConfig: base.Handshake,
+		Plugins:         mp,
+		GRPCServer:      plugin.DefaultGRPCServer,
+		Logger:          logger,
+	})
+}
@@ -0,0 +1,125 @@
+package datastore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	hplugin "github.com/hashicorp/go-plugin"
+	"github.com/odpf/optimus/ext/datastore/postgres"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/plugin/datastore"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// pluginDatastore serves the postgres datastore as a plugin, reading a
+// missing resource and recording secrets it received
+type pluginDatastore struct {
+	*postgres.Postgres
+	secrets models.ProjectSecrets
+}
+
+func (p *pluginDatastore) PluginInfo() (*models.PluginInfoResponse, error) {
+	return &models.PluginInfoResponse{
+		Name:          "pg",
+		PluginType:    models.PluginTypeDatastore,
+		PluginMods:    []models.PluginMod{models.ModTypeDatastore},
+		PluginVersion: "0.1",
+	}, nil
+}
+
+func (p *pluginDatastore) Name() string {
+	return "pg"
+}
+
+func (p *pluginDatastore) ReadResource(ctx context.Context, request models.ReadResourceRequest) (models.ReadResourceResponse, error) {
+	p.secrets = request.Project.Secret
+	return models.ReadResourceResponse{}, errors.Wrapf(models.ErrResourceNotFoundInDatastore, "table %s", request.Resource.Name)
+}
+
+func TestDatastorePlugin(t *testing.T) {
+	impl := &pluginDatastore{Postgres: postgres.This}
+	client, server := hplugin.TestPluginGRPCConn(t, map[string]hplugin.Plugin{
+		models.ModTypeDatastore.String(): datastore.NewPlugin(impl, hclog.NewNullLogger()),
+	})
+	defer client.Close()
+	defer server.Stop()
+
+	raw, err := client.Dispense(models.ModTypeDatastore.String())
+	assert.Nil(t, err)
+	dsClient := raw.(*datastore.GRPCClient)
+	assert.Nil(t, dsClient.Init(context.Background()))
+
+	tableYaml := `version: 1
+name: sales.orders
+type: table
+spec:
+  columns:
+  - name: id
+    type: bigint
+`
+
+	t.Run("should load datastore info from plugin", func(t *testing.T) {
+		assert.Equal(t, "pg", dsClient.Name())
+		assert.Equal(t, len(postgres.This.Types()), len(dsClient.Types()))
+		assert.Contains(t, dsClient.Types(), models.ResourceTypeTable)
+	})
+	t.Run("should convert resource between yaml and protobuf through plugin", func(t *testing.T) {
+		adapter := dsClient.Types()[models.ResourceTypeTable].Adapter()
+		resource, err := adapter.FromYaml([]byte(tableYaml))
+		assert.Nil(t, err)
+		assert.Equal(t, "sales.orders", resource.Name)
+		assert.Equal(t, dsClient, resource.Datastore)
+		assert.IsType(t, &structpb.Struct{}, resource.Spec)
+
+		raw, err := adapter.ToProtobuf(resource)
+		assert.Nil(t, err)
+		decoded, err := adapter.FromProtobuf(raw)
+		assert.Nil(t, err)
+		assert.Equal(t, resource.Name, decoded.Name)
+
+		b, err := adapter.ToYaml(decoded)
+		assert.Nil(t, err)
+		roundTrip, err := adapter.FromYaml(b)
+		assert.Nil(t, err)
+		assert.Equal(t, resource.Spec.(*structpb.Struct).AsMap(), roundTrip.Spec.(*structpb.Struct).AsMap())
+	})
+	t.Run("should validate resource in plugin", func(t *testing.T) {
+		typeController := dsClient.Types()[models.ResourceTypeTable]
+		resource, err := typeController.Adapter().FromYaml([]byte(tableYaml))
+		assert.Nil(t, err)
+		assert.Nil(t, typeController.Validator()(resource))
+
+		resource.Name = "orders"
+		assert.Equal(t, "invalid resource name orders", typeController.Validator()(resource).Error())
+	})
+	t.Run("should report comparing resources as unsupported", func(t *testing.T) {
+		typeController := dsClient.Types()[models.ResourceTypeTable]
+		resource, err := typeController.Adapter().FromYaml([]byte(tableYaml))
+		assert.Nil(t, err)
+
+		_, err = typeController.(models.DatastoreSpecDiffer).Diff(resource, resource)
+		assert.True(t, errors.Is(err, models.ErrResourceCompareUnsupported))
+		_, err = typeController.(models.DatastoreSchemaChecker).CheckSchemaChange(resource, resource)
+		assert.True(t, errors.Is(err, models.ErrResourceCompareUnsupported))
+	})
+	t.Run("should send only datastore secret and map not found errors", func(t *testing.T) {
+		resource, err := dsClient.Types()[models.ResourceTypeTable].Adapter().FromYaml([]byte(tableYaml))
+		assert.Nil(t, err)
+
+		_, err = dsClient.ReadResource(context.Background(), models.ReadResourceRequest{
+			Resource: resource,
+			Project: models.ProjectSpec{
+				Name: "proj",
+				Secret: models.ProjectSecrets{
+					{Name: "DATASTORE_PG", Value: "postgres://localhost"},
+					{Name: "DATASTORE_BIGQUERY", Value: "key"},
+				},
+			},
+		})
+		assert.True(t, errors.Is(err, models.ErrResourceNotFoundInDatastore))
+		assert.Equal(t, models.ProjectSecrets{{Name: "DATASTORE_PG", Value: "postgres://localhost"}}, impl.secrets)
+	})
+}
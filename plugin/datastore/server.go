@@ -0,0 +1,185 @@
+package datastore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/odpf/optimus/api/proto/odpf/optimus"
+	pbp "github.com/odpf/optimus/api/proto/odpf/optimus/plugins"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+// GRPCServer will be used by plugins this is working as proto adapter
+type GRPCServer struct {
+	// This is the real implementation coming from plugin
+	Impl models.DatastoreMod
+
+	projectSpecAdapter ProjectSpecAdapter
+	pbp.UnimplementedDatastoreModServer
+}
+
+func (s *GRPCServer) DatastoreInfo(ctx context.Context, req *pbp.DatastoreInfoRequest) (*pbp.DatastoreInfoResponse, error) {
+	var types []*pbp.DatastoreResourceType
+	for resourceType, typeController := range s.Impl.Types() {
+		types = append(types, &pbp.DatastoreResourceType{
+			Type:          resourceType.String(),
+			DefaultAssets: typeController.DefaultAssets(),
+		})
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i].Type < types[j].Type
+	})
+	return &pbp.DatastoreInfoResponse{
+		Name:        s.Impl.Name(),
+		Description: s.Impl.Description(),
+		Types:       types,
+	}, nil
+}
+
+func (s *GRPCServer) ResourceToYaml(ctx context.Context, req *pbp.ResourceToYamlRequest) (*pbp.ResourceToYamlResponse, error) {
+	typeController, err := s.typeController(req.Resource.GetType())
+	if err != nil {
+		return nil, err
+	}
+	spec, err := s.resourceFromProto(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	b, err := typeController.Adapter().ToYaml(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &pbp.ResourceToYamlResponse{Yaml: b}, nil
+}
+
+func (s *GRPCServer) ResourceFromYaml(ctx context.Context, req *pbp.ResourceFromYamlRequest) (*pbp.ResourceFromYamlResponse, error) {
+	typeController, err := s.typeController(req.Type)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := typeController.Adapter().FromYaml(req.Yaml)
+	if err != nil {
+		return nil, err
+	}
+	resourceProto, err := s.resourceToProto(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &pbp.ResourceFromYamlResponse{Resource: resourceProto}, nil
+}
+
+func (s *GRPCServer) ValidateResource(ctx context.Context, req *pbp.ValidateResourceRequest) (*pbp.ValidateResourceResponse, error) {
+	typeController, err := s.typeController(req.Resource.GetType())
+	if err != nil {
+		return nil, err
+	}
+	spec, err := s.resourceFromProto(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if err := typeController.Validator()(spec); err != nil {
+		return nil, err
+	}
+	return &pbp.ValidateResourceResponse{}, nil
+}
+
+func (s *GRPCServer) CreateResource(ctx context.Context, req *pbp.DatastoreResourceRequest) (*pbp.DatastoreResourceResponse, error) {
+	spec, err := s.resourceFromProto(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Impl.CreateResource(ctx, models.CreateResourceRequest{
+		Resource: spec,
+		Project:  s.projectSpecAdapter.FromProjectProtoWithSecrets(req.Project),
+	}); err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &pbp.DatastoreResourceResponse{}, nil
+}
+
+func (s *GRPCServer) UpdateResource(ctx context.Context, req *pbp.DatastoreResourceRequest) (*pbp.DatastoreResourceResponse, error) {
+	spec, err := s.resourceFromProto(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Impl.UpdateResource(ctx, models.UpdateResourceRequest{
+		Resource: spec,
+		Project:  s.projectSpecAdapter.FromProjectProtoWithSecrets(req.Project),
+	}); err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &pbp.DatastoreResourceResponse{}, nil
+}
+
+func (s *GRPCServer) ReadResource(ctx context.Context, req *pbp.DatastoreResourceRequest) (*pbp.DatastoreResourceResponse, error) {
+	spec, err := s.resourceFromProto(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.Impl.ReadResource(ctx, models.ReadResourceRequest{
+		Resource: spec,
+		Project:  s.projectSpecAdapter.FromProjectProtoWithSecrets(req.Project),
+	})
+	if err != nil {
+		return nil, toStatusErr(err)
+	}
+	resourceProto, err := s.resourceToProto(resp.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return &pbp.DatastoreResourceResponse{Resource: resourceProto}, nil
+}
+
+func (s *GRPCServer) DeleteResource(ctx context.Context, req *pbp.DatastoreResourceRequest) (*pbp.DatastoreResourceResponse, error) {
+	spec, err := s.resourceFromProto(req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Impl.DeleteResource(ctx, models.DeleteResourceRequest{
+		Resource: spec,
+		Project:  s.projectSpecAdapter.FromProjectProtoWithSecrets(req.Project),
+	}); err != nil {
+		return nil, toStatusErr(err)
+	}
+	return &pbp.DatastoreResourceResponse{}, nil
+}
+
+func (s *GRPCServer) typeController(resourceType string) (models.DatastoreTypeController, error) {
+	typeController, ok := s.Impl.Types()[models.ResourceType(resourceType)]
+	if !ok {
+		return nil, errors.Errorf("unsupported type %s for datastore %s", resourceType, s.Impl.Name())
+	}
+	return typeController, nil
+}
+
+// resourceFromProto converts resource to the spec of plugin datastore using
+// its own protobuf adapter
+func (s *GRPCServer) resourceFromProto(res *pb.ResourceSpecification) (models.ResourceSpec, error) {
+	typeController, err := s.typeController(res.GetType())
+	if err != nil {
+		return models.ResourceSpec{}, err
+	}
+	b, err := proto.Marshal(res)
+	if err != nil {
+		return models.ResourceSpec{}, err
+	}
+	return typeController.Adapter().FromProtobuf(b)
+}
+
+func (s *GRPCServer) resourceToProto(spec models.ResourceSpec) (*pb.ResourceSpecification, error) {
+	typeController, err := s.typeController(spec.Type.String())
+	if err != nil {
+		return nil, err
+	}
+	b, err := typeController.Adapter().ToProtobuf(spec)
+	if err != nil {
+		return nil, err
+	}
+	resourceProto := &pb.ResourceSpecification{}
+	if err := proto.Unmarshal(b, resourceProto); err != nil {
+		return nil, err
+	}
+	return resourceProto, nil
+}
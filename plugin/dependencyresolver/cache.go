@@ -0,0 +1,141 @@
+package dependencyresolver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	cacheMethodDestination  = "generate_destination"
+	cacheMethodDependencies = "generate_dependencies"
+
+	cacheResultHit  = "hit"
+	cacheResultMiss = "miss"
+)
+
+var cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "optimus",
+	Subsystem: "plugin_dependency_cache",
+	Name:      "requests_total",
+	Help:      "Dependency resolver mod calls served from cache(hit) or plugin(miss)",
+}, []string{"plugin", "method", "result"})
+
+// CachedClient memoizes results of a dependency resolver mod. Results are keyed
+// by a hash of task config, compiled assets, project config and plugin version
+// so jobs whose inputs didn't change are not sent to the plugin again.
+type CachedClient struct {
+	models.DependencyResolverMod
+
+	pluginName    string
+	pluginVersion string
+	cache         *lru.Cache
+}
+
+func (c *CachedClient) GenerateDestination(ctx context.Context, request models.GenerateDestinationRequest) (*models.GenerateDestinationResponse, error) {
+	key, err := c.key(cacheMethodDestination, request.Config, request.Assets, request.Project, request.PluginOptions)
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := c.cache.Get(key); ok {
+		cacheRequests.WithLabelValues(c.pluginName, cacheMethodDestination, cacheResultHit).Inc()
+		return &models.GenerateDestinationResponse{
+			Destination: cached.(string),
+		}, nil
+	}
+	cacheRequests.WithLabelValues(c.pluginName, cacheMethodDestination, cacheResultMiss).Inc()
+
+	resp, err := c.DependencyResolverMod.GenerateDestination(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, resp.Destination)
+	return resp, nil
+}
+
+func (c *CachedClient) GenerateDependencies(ctx context.Context, request models.GenerateDependenciesRequest) (*models.GenerateDependenciesResponse, error) {
+	key, err := c.key(cacheMethodDependencies, request.Config, request.Assets, request.Project, request.PluginOptions)
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := c.cache.Get(key); ok {
+		cacheRequests.WithLabelValues(c.pluginName, cacheMethodDependencies, cacheResultHit).Inc()
+		return &models.GenerateDependenciesResponse{
+			Dependencies: append([]string(nil), cached.([]string)...),
+		}, nil
+	}
+	cacheRequests.WithLabelValues(c.pluginName, cacheMethodDependencies, cacheResultMiss).Inc()
+
+	resp, err := c.DependencyResolverMod.GenerateDependencies(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	c.cache.Add(key, append([]string(nil), resp.Dependencies...))
+	return resp, nil
+}
+
+// key hashes everything that can change the result of a call, configs and
+// assets are sorted as their order carries no meaning
+func (c *CachedClient) key(method string, config models.PluginConfigs, assets models.PluginAssets,
+	project models.ProjectSpec, options models.PluginOptions) (string, error) {
+	sortedConfig := append(models.PluginConfigs{}, config...)
+	sort.Slice(sortedConfig, func(i, j int) bool {
+		return sortedConfig[i].Name < sortedConfig[j].Name
+	})
+	sortedAssets := append(models.PluginAssets{}, assets...)
+	sort.Slice(sortedAssets, func(i, j int) bool {
+		return sortedAssets[i].Name < sortedAssets[j].Name
+	})
+
+	raw, err := json.Marshal(struct {
+		Method        string
+		PluginName    string
+		PluginVersion string
+		Config        models.PluginConfigs
+		Assets        models.PluginAssets
+		ProjectName   string
+		ProjectConfig map[string]string
+		DryRun        bool
+	}{
+		Method:        method,
+		PluginName:    c.pluginName,
+		PluginVersion: c.pluginVersion,
+		Config:        sortedConfig,
+		Assets:        sortedAssets,
+		ProjectName:   project.Name,
+		ProjectConfig: project.Config,
+		DryRun:        options.DryRun,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to build cache key")
+	}
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// NewCachedClient wraps a dependency resolver mod with an in memory LRU cache
+// holding at most size results
+func NewCachedClient(mod models.DependencyResolverMod, size int) (*CachedClient, error) {
+	info, err := mod.PluginInfo()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read plugin info")
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &CachedClient{
+		DependencyResolverMod: mod,
+		pluginName:            info.Name,
+		pluginVersion:         info.PluginVersion,
+		cache:                 cache,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package dependencyresolver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/odpf/optimus/mock"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/plugin/dependencyresolver"
+	"github.com/stretchr/testify/assert"
+	testMock "github.com/stretchr/testify/mock"
+)
+
+func TestCachedClient(t *testing.T) {
+	ctx := context.Background()
+	projectSpec := models.ProjectSpec{
+		Name: "proj",
+		Config: map[string]string{
+			"bucket": "gs://some_folder",
+		},
+	}
+	request := models.GenerateDependenciesRequest{
+		Config: models.PluginConfigs{
+			{Name: "DATASET", Value: "playground"},
+			{Name: "TABLE", Value: "table1"},
+		},
+		Assets: models.PluginAssets{
+			{Name: "query.sql", Value: "select * from proj.playground.table0"},
+			{Name: "extra.sql", Value: ""},
+		},
+		Project: projectSpec,
+	}
+
+	newMod := func(version string) *mock.DependencyResolverMod {
+		depMod := new(mock.DependencyResolverMod)
+		depMod.On("PluginInfo").Return(&models.PluginInfoResponse{Name: "bq2bq", PluginVersion: version}, nil)
+		return depMod
+	}
+
+	t.Run("should serve dependencies of unchanged inputs from cache", func(t *testing.T) {
+		depMod := newMod("1.0.0")
+		depMod.On("GenerateDependencies", ctx, request).Return(&models.GenerateDependenciesResponse{
+			Dependencies: []string{"proj.playground.table0"},
+		}, nil).Once()
+		defer depMod.AssertExpectations(t)
+
+		cached, err := dependencyresolver.NewCachedClient(depMod, 10)
+		assert.Nil(t, err)
+
+		resp, err := cached.GenerateDependencies(ctx, request)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"proj.playground.table0"}, resp.Dependencies)
+
+		// order of assets and configs doesn't change the result
+		reordered := request
+		reordered.Assets = models.PluginAssets{request.Assets[1], request.Assets[0]}
+		reordered.Config = models.PluginConfigs{request.Config[1], request.Config[0]}
+		resp, err = cached.GenerateDependencies(ctx, reordered)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"proj.playground.table0"}, resp.Dependencies)
+	})
+	t.Run("should call plugin again if assets change", func(t *testing.T) {
+		changed := request
+		changed.Assets = models.PluginAssets{
+			{Name: "query.sql", Value: "select * from proj.playground.table2"},
+		}
+
+		depMod := newMod("1.0.0")
+		depMod.On("GenerateDependencies", ctx, request).Return(&models.GenerateDependenciesResponse{
+			Dependencies: []string{"proj.playground.table0"},
+		}, nil).Once()
+		depMod.On("GenerateDependencies", ctx, changed).Return(&models.GenerateDependenciesResponse{
+			Dependencies: []string{"proj.playground.table2"},
+		}, nil).Once()
+		defer depMod.AssertExpectations(t)
+
+		cached, err := dependencyresolver.NewCachedClient(depMod, 10)
+		assert.Nil(t, err)
+
+		_, err = cached.GenerateDependencies(ctx, request)
+		assert.Nil(t, err)
+		resp, err := cached.GenerateDependencies(ctx, changed)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"proj.playground.table2"}, resp.Dependencies)
+	})
+	t.Run("should cache destination separately from dependencies and not cache failures", func(t *testing.T) {
+		destRequest := models.GenerateDestinationRequest{
+			Config:  request.Config,
+			Assets:  request.Assets,
+			Project: projectSpec,
+		}
+
+		depMod := newMod("1.0.0")
+		depMod.On("GenerateDestination", ctx, destRequest).Return(&models.GenerateDestinationResponse{}, assert.AnError).Once()
+		depMod.On("GenerateDestination", ctx, destRequest).Return(&models.GenerateDestinationResponse{
+			Destination: "proj.playground.table1",
+		}, nil).Once()
+		depMod.On("GenerateDependencies", ctx, testMock.Anything).Return(&models.GenerateDependenciesResponse{
+			Dependencies: []string{"proj.playground.table0"},
+		}, nil).Once()
+		defer depMod.AssertExpectations(t)
+
+		cached, err := dependencyresolver.NewCachedClient(depMod, 10)
+		assert.Nil(t, err)
+
+		_, err = cached.GenerateDestination(ctx, destRequest)
+		assert.Equal(t, assert.AnError, err)
+		for i := 0; i < 2; i++ {
+			resp, err := cached.GenerateDestination(ctx, destRequest)
+			assert.Nil(t, err)
+			assert.Equal(t, "proj.playground.table1", resp.Destination)
+		}
+		_, err = cached.GenerateDependencies(ctx, request)
+		assert.Nil(t, err)
+	})
+}
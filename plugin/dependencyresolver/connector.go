@@ -46,11 +46,12 @@ func (p *Connector) GRPCServer(broker *hplugin.GRPCBroker, s *grpc.Server) error
 }
 
 func (p *Connector) GRPCClient(ctx context.Context, broker *hplugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	conn := base.NewInstrumentedConn(c)
 	return &GRPCClient{
-		client:             pbp.NewDependencyResolverModClient(c),
+		client:             pbp.NewDependencyResolverModClient(conn),
 		projectSpecAdapter: p.projectSpecAdapter,
 		baseClient: &base.GRPCClient{
-			Client: pbp.NewBaseClient(c),
+			Client: pbp.NewBaseClient(conn),
 			Logger: p.logger,
 		},
 	}, nil
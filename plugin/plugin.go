@@ -1,14 +1,17 @@
 package plugin
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 
+	"github.com/odpf/optimus/plugin/datastore"
 	"github.com/odpf/optimus/plugin/dependencyresolver"
 
 	"github.com/odpf/optimus/plugin/cli"
@@ -23,6 +26,9 @@ import (
 	"github.com/hashicorp/go-plugin"
 )
 
+// processes of plugins loaded by Initialize keyed by plugin name
+var processes = map[string]*plugin.Client{}
+
 func Initialize(pluginLogger hclog.Logger) error {
 	discoveredPlugins, err := DiscoverPlugins(pluginLogger)
 	if err != nil {
@@ -35,6 +41,7 @@ func Initialize(pluginLogger hclog.Logger) error {
 		models.PluginTypeBase:                     base.NewPluginClient(pluginLogger),
 		models.ModTypeCLI.String():                cli.NewPluginClient(pluginLogger),
 		models.ModTypeDependencyResolver.String(): dependencyresolver.NewPluginClient(pluginLogger),
+		models.ModTypeDatastore.String():          datastore.NewPluginClient(pluginLogger),
 	}
 
 	for _, pluginPath := range discoveredPlugins {
@@ -70,6 +77,7 @@ func Initialize(pluginLogger hclog.Logger) error {
 			return errors.Wrapf(err, "failed to read plugin info: %s", pluginPath)
 		}
 		pluginLogger.Debug("plugin connection established: ", baseInfo.Name)
+		processes[baseInfo.Name] = pluginClient
 
 		if modSupported(baseInfo.PluginMods, models.ModTypeCLI) {
 			// create a client with cli mod
@@ -91,8 +99,27 @@ func Initialize(pluginLogger hclog.Logger) error {
 			}
 		}
 
-		if err := models.PluginRegistry.Add(baseClient, cliClient, drClient); err != nil {
-			return errors.Wrapf(err, "PluginRegistry.Add: %s", pluginPath)
+		if modSupported(baseInfo.PluginMods, models.ModTypeDatastore) {
+			// create a client with datastore mod
+			rawMod, err := rpcClient.Dispense(models.ModTypeDatastore.String())
+			if err != nil {
+				return errors.Wrapf(err, "rpcClient.Dispense: %s", pluginPath)
+			}
+			dsClient := rawMod.(*datastore.GRPCClient)
+			if err := dsClient.Init(context.Background()); err != nil {
+				return errors.Wrapf(err, "failed to read datastore info: %s", pluginPath)
+			}
+			if err := models.DatastoreRegistry.Add(dsClient); err != nil {
+				return errors.Wrapf(err, "DatastoreRegistry.Add: %s", pluginPath)
+			}
+			pluginLogger.Debug(fmt.Sprintf("%s mod found for: %s", models.ModTypeDatastore, baseInfo.Name))
+		}
+
+		// plugins only shipping a datastore are not executed as tasks or hooks
+		if baseInfo.PluginType != models.PluginTypeDatastore {
+			if err := models.PluginRegistry.Add(baseClient, cliClient, drClient); err != nil {
+				return errors.Wrapf(err, "PluginRegistry.Add: %s", pluginPath)
+			}
 		}
 		pluginLogger.Debug("plugin ready: ", baseInfo.Name)
 	}
@@ -100,6 +127,29 @@ func Initialize(pluginLogger hclog.Logger) error {
 	return nil
 }
 
+// CheckProcesses fails if process of any loaded plugin has exited, plugins
+// are only launched at startup so an exited one stays unavailable
+func CheckProcesses() error {
+	var exited []string
+	for name, pluginClient := range processes {
+		if pluginClient.Exited() {
+			exited = append(exited, name)
+		}
+	}
+	if len(exited) > 0 {
+		sort.Strings(exited)
+		return errors.Errorf("plugin processes exited: %s", strings.Join(exited, ", "))
+	}
+	return nil
+}
+
+// Kill stops processes of loaded plugins, plugins can't be called afterwards
+func Kill() {
+	for _, pluginClient := range processes {
+		pluginClient.Kill()
+	}
+}
+
 func modSupported(mods []models.PluginMod, mod models.PluginMod) bool {
 	for _, m := range mods {
 		if m == mod {
@@ -216,6 +266,8 @@ func Serve(f Factory) {
 
 func servePlugin(plugin interface{}, logger hclog.Logger) {
 	switch p := plugin.(type) {
+	case models.DatastoreMod:
+		datastore.Serve(p, logger)
 	case models.DependencyResolverMod:
 		if cliPlugin, ok := plugin.(models.CommandLineMod); ok {
 			dependencyresolver.ServeWithCLI(p, cliPlugin, logger)
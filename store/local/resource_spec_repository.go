@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/spf13/afero"
@@ -113,6 +114,28 @@ func (repo *resourceRepository) GetAll() ([]models.ResourceSpec, error) {
 	return resourceSpecs, nil
 }
 
+// GetByFilter returns resources matching filter, ordered by name
+func (repo *resourceRepository) GetByFilter(filter models.ResourceSpecFilter) ([]models.ResourceSpec, error) {
+	resourceSpecs, err := repo.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(resourceSpecs, func(i, j int) bool {
+		return resourceSpecs[i].Name < resourceSpecs[j].Name
+	})
+
+	var matched []models.ResourceSpec
+	for _, spec := range resourceSpecs {
+		if filter.Limit > 0 && len(matched) == filter.Limit {
+			break
+		}
+		if spec.Name > filter.After && filter.Matches(spec) {
+			matched = append(matched, spec)
+		}
+	}
+	return matched, nil
+}
+
 // GetByName returns a job requested by the name
 func (repo *resourceRepository) GetByName(jobName string) (models.ResourceSpec, error) {
 	if strings.TrimSpace(jobName) == "" {
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"hash/fnv"
+	"sync"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// LeaderLockKey identifies the advisory lock held by the leader among
+// optimus servers sharing a database
+const LeaderLockKey int64 = 0x6f7074696d7573
+
+// AdvisoryLock is a session level postgres advisory lock. Postgres releases
+// it as soon as the connection holding it is closed, so a server that dies
+// or loses its database can't keep holding it. A held lock takes up one of
+// the open connections of the pool.
+type AdvisoryLock struct {
+	db  *sql.DB
+	key int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+// TryAcquire takes the lock without waiting, true if it is held once it
+// returns
+func (l *AdvisoryLock) TryAcquire(ctx context.Context) (bool, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn != nil {
+		return true, nil
+	}
+
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get a connection for advisory lock")
+	}
+	var acquired bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, errors.Wrap(err, "failed to take advisory lock")
+	}
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Check fails if the lock isn't held anymore. Lock is held as long as the
+// session that took it is alive, so it is enough to reach the session.
+func (l *AdvisoryLock) Check(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return errors.New("advisory lock is not held")
+	}
+	if err := l.conn.PingContext(ctx); err != nil {
+		l.closeConn()
+		return errors.Wrap(err, "advisory lock is lost")
+	}
+	return nil
+}
+
+// Release gives up the lock if it is held
+func (l *AdvisoryLock) Release(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conn == nil {
+		return nil
+	}
+	_, err := l.conn.ExecContext(ctx, "SELECT pg_advisory_unlock($1)", l.key)
+	// lock is released along with the session even if unlock failed
+	l.closeConn()
+	if err != nil {
+		return errors.Wrap(err, "failed to release advisory lock")
+	}
+	return nil
+}
+
+func (l *AdvisoryLock) closeConn() {
+	discardConn(l.conn)
+	l.conn = nil
+}
+
+// discardConn closes conn without returning it to the pool, so a lock
+// that failed to be released can't outlive it
+func discardConn(conn *sql.Conn) {
+	conn.Raw(func(driverConn interface{}) error {
+		return driver.ErrBadConn
+	})
+	conn.Close()
+}
+
+func NewAdvisoryLock(db *gorm.DB, key int64) *AdvisoryLock {
+	return &AdvisoryLock{
+		db:  db.DB(),
+		key: key,
+	}
+}
+
+// KeyedAdvisoryLocker takes session level advisory locks on arbitrary keys,
+// a key is hashed into the lock id. Each held lock takes up one of the open
+// connections of the pool till it is released.
+type KeyedAdvisoryLocker struct {
+	db *sql.DB
+}
+
+func (l *KeyedAdvisoryLocker) Lock(ctx context.Context, key string) (func(), error) {
+	id := advisoryLockID(key)
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get a connection for advisory lock of %s", key)
+	}
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", id); err != nil {
+		// a cancelled wait may leave the lock taken once it is granted
+		discardConn(conn)
+		return nil, errors.Wrapf(err, "failed to take advisory lock of %s", key)
+	}
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", id); err != nil {
+			discardConn(conn)
+			return
+		}
+		conn.Close()
+	}, nil
+}
+
+func advisoryLockID(key string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(key))
+	return int64(h.Sum64())
+}
+
+func NewKeyedAdvisoryLocker(db *gorm.DB) *KeyedAdvisoryLocker {
+	return &KeyedAdvisoryLocker{
+		db: db.DB(),
+	}
+}
@@ -0,0 +1,62 @@
+package postgres
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+)
+
+// archiveTime is truncated to the precision of postgres timestamps, rows
+// archived together are restored by matching their deletion time
+func archiveTime() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+// archiveRows soft deletes live rows of each model matching query
+func archiveRows(tx *gorm.DB, archivedAt time.Time, rowModels []interface{}, query string, args ...interface{}) error {
+	for _, rowModel := range rowModels {
+		if err := tx.Model(rowModel).Where(query, args...).UpdateColumn("deleted_at", archivedAt).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreRows undoes archiveRows of rows archived at archivedAt, rows
+// deleted on their own before are left deleted
+func restoreRows(tx *gorm.DB, archivedAt time.Time, rowModels []interface{}, query string, args ...interface{}) error {
+	for _, rowModel := range rowModels {
+		if err := tx.Unscoped().Model(rowModel).Where(query, args...).Where("deleted_at = ?", archivedAt).
+			UpdateColumn("deleted_at", gorm.Expr("NULL")).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archivedWithNamespace matches rows of table archived along with their
+// namespace, restoring the namespace or its project brings them back
+func archivedWithNamespace(table string) string {
+	return fmt.Sprintf("%[1]s.deleted_at IS NOT NULL AND "+
+		"%[1]s.deleted_at = (SELECT deleted_at FROM namespace WHERE namespace.id = %[1]s.namespace_id)", table)
+}
+
+// purgeJobsAndResources permanently deletes jobs, their runs and replays,
+// resources and deployments whose column matches id, archived or not
+func purgeJobsAndResources(tx *gorm.DB, column string, id uuid.UUID) error {
+	statements := []string{
+		"DELETE FROM instance WHERE job_id IN (SELECT id FROM job WHERE %s = ?)",
+		"DELETE FROM replay WHERE job_id IN (SELECT id FROM job WHERE %s = ?)",
+		"DELETE FROM job WHERE %s = ?",
+		"DELETE FROM resource WHERE %s = ?",
+		"DELETE FROM deployment WHERE %s = ?",
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(fmt.Sprintf(statement, column), id).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
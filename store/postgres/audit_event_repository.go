@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+)
+
+type AuditEvent struct {
+	ID            uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v4()"`
+	Actor         string    `gorm:"not null"`
+	ProjectName   string
+	NamespaceName string
+	Operation     string         `gorm:"not null"`
+	Targets       datatypes.JSON `gorm:"not null"`
+	RequestDigest string         `gorm:"not null"`
+	Outcome       string         `gorm:"not null"`
+	Error         string
+	DeploymentID  *uuid.UUID `gorm:"type:uuid"`
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+}
+
+func (e AuditEvent) FromSpec(spec models.AuditEvent) (AuditEvent, error) {
+	targets := spec.Targets
+	if targets == nil {
+		targets = []string{}
+	}
+	rawTargets, err := json.Marshal(targets)
+	if err != nil {
+		return AuditEvent{}, err
+	}
+	var deploymentID *uuid.UUID
+	if spec.DeploymentID != uuid.Nil {
+		deploymentID = &spec.DeploymentID
+	}
+	return AuditEvent{
+		ID:            spec.ID,
+		Actor:         spec.Actor,
+		ProjectName:   spec.ProjectName,
+		NamespaceName: spec.NamespaceName,
+		Operation:     spec.Operation,
+		Targets:       rawTargets,
+		RequestDigest: spec.RequestDigest,
+		Outcome:       spec.Outcome,
+		Error:         spec.Error,
+		DeploymentID:  deploymentID,
+		CreatedAt:     spec.CreatedAt,
+	}, nil
+}
+
+func (e AuditEvent) ToSpec() (models.AuditEvent, error) {
+	var targets []string
+	if err := json.Unmarshal(e.Targets, &targets); err != nil {
+		return models.AuditEvent{}, errors.Wrapf(err, "failed to read targets of audit event %s", e.ID)
+	}
+	var deploymentID uuid.UUID
+	if e.DeploymentID != nil {
+		deploymentID = *e.DeploymentID
+	}
+	return models.AuditEvent{
+		ID:            e.ID,
+		Actor:         e.Actor,
+		ProjectName:   e.ProjectName,
+		NamespaceName: e.NamespaceName,
+		Operation:     e.Operation,
+		Targets:       targets,
+		RequestDigest: e.RequestDigest,
+		Outcome:       e.Outcome,
+		Error:         e.Error,
+		DeploymentID:  deploymentID,
+		CreatedAt:     e.CreatedAt,
+	}, nil
+}
+
+type auditEventRepository struct {
+	db *gorm.DB
+}
+
+func (repo *auditEventRepository) Save(spec models.AuditEvent) error {
+	if spec.Actor == "" {
+		return errors.New("actor cannot be empty")
+	}
+	if spec.Operation == "" {
+		return errors.New("operation cannot be empty")
+	}
+	e, err := AuditEvent{}.FromSpec(spec)
+	if err != nil {
+		return err
+	}
+	if e.ID == uuid.Nil {
+		e.ID = uuid.New()
+	}
+	return repo.db.Create(&e).Error
+}
+
+// UpdateDeploymentOutcome settles outcome of events recorded as queued for
+// deployment, settled events are left as they are
+func (repo *auditEventRepository) UpdateDeploymentOutcome(deploymentID uuid.UUID, outcome, errMsg string) error {
+	return repo.db.Model(&AuditEvent{}).
+		Where("deployment_id = ? AND outcome = ?", deploymentID, models.AuditOutcomeQueued).
+		Updates(map[string]interface{}{"outcome": outcome, "error": errMsg}).Error
+}
+
+func (repo *auditEventRepository) GetAll(filter models.AuditFilter) ([]models.AuditEvent, error) {
+	query := repo.db
+	if filter.ProjectName != "" {
+		query = query.Where("project_name = ?", filter.ProjectName)
+	}
+	if filter.NamespaceName != "" {
+		query = query.Where("namespace_name = ?", filter.NamespaceName)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Operation != "" {
+		query = query.Where("operation = ?", filter.Operation)
+	}
+	if !filter.Since.IsZero() {
+		query = query.Where("created_at >= ?", filter.Since)
+	}
+	if !filter.Until.IsZero() {
+		query = query.Where("created_at < ?", filter.Until)
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = models.AuditDefaultLimit
+	}
+
+	specs := []models.AuditEvent{}
+	events := []AuditEvent{}
+	if err := query.Order("created_at DESC").Limit(limit).Find(&events).Error; err != nil {
+		return specs, err
+	}
+	for _, e := range events {
+		adapted, err := e.ToSpec()
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapted)
+	}
+	return specs, nil
+}
+
+func NewAuditEventRepository(db *gorm.DB) *auditEventRepository {
+	return &auditEventRepository{
+		db: db,
+	}
+}
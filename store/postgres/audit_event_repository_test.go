@@ -0,0 +1,129 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventRepository(t *testing.T) {
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+		return dbConn
+	}
+
+	createdAt := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	deployEvent := models.AuditEvent{
+		Actor:         "token:ci",
+		ProjectName:   "t-optimus",
+		NamespaceName: "reporting",
+		Operation:     "DeployJobSpecification",
+		Targets:       []string{"job-a", "job-b"},
+		RequestDigest: "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		Outcome:       models.AuditOutcomeSuccess,
+		CreatedAt:     createdAt,
+	}
+	secretEvent := models.AuditEvent{
+		Actor:         "user:jane@example.io",
+		ProjectName:   "t-optimus",
+		Operation:     "RegisterSecret",
+		Targets:       []string{"STORAGE"},
+		RequestDigest: "a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e",
+		Outcome:       "PermissionDenied",
+		Error:         "permission denied",
+		CreatedAt:     createdAt.Add(time.Hour),
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewAuditEventRepository(db)
+
+		assert.Nil(t, repo.Save(deployEvent))
+		assert.NotNil(t, repo.Save(models.AuditEvent{Operation: "RegisterSecret"}))
+
+		// events are append only
+		assert.NotNil(t, db.Exec("UPDATE audit_event SET actor = 'someone'").Error)
+		assert.NotNil(t, db.Exec("DELETE FROM audit_event").Error)
+	})
+	t.Run("UpdateDeploymentOutcome", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewAuditEventRepository(db)
+		queuedEvent := deployEvent
+		queuedEvent.Outcome = models.AuditOutcomeQueued
+		queuedEvent.DeploymentID = uuid.New()
+		assert.Nil(t, repo.Save(queuedEvent))
+		assert.Nil(t, repo.Save(secretEvent))
+
+		assert.Nil(t, repo.UpdateDeploymentOutcome(queuedEvent.DeploymentID, models.AuditOutcomeFailed, "failed to deploy 1 of 2 jobs"))
+		// settled outcome stays as it is
+		assert.Nil(t, repo.UpdateDeploymentOutcome(queuedEvent.DeploymentID, models.AuditOutcomeSuccess, ""))
+
+		events, err := repo.GetAll(models.AuditFilter{Operation: "DeployJobSpecification"})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, queuedEvent.DeploymentID, events[0].DeploymentID)
+		assert.Equal(t, models.AuditOutcomeFailed, events[0].Outcome)
+		assert.Equal(t, "failed to deploy 1 of 2 jobs", events[0].Error)
+
+		// only outcome of queued events can be updated
+		assert.NotNil(t, db.Exec("UPDATE audit_event SET actor = 'someone' WHERE deployment_id IS NOT NULL").Error)
+		assert.NotNil(t, db.Exec("UPDATE audit_event SET outcome = 'OK' WHERE operation = 'RegisterSecret'").Error)
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewAuditEventRepository(db)
+		assert.Nil(t, repo.Save(deployEvent))
+		assert.Nil(t, repo.Save(secretEvent))
+
+		events, err := repo.GetAll(models.AuditFilter{ProjectName: "t-optimus"})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(events))
+		assert.Equal(t, "RegisterSecret", events[0].Operation)
+		assert.Equal(t, []string{"job-a", "job-b"}, events[1].Targets)
+
+		events, err = repo.GetAll(models.AuditFilter{ProjectName: "t-optimus", Actor: "token:ci"})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, "reporting", events[0].NamespaceName)
+
+		events, err = repo.GetAll(models.AuditFilter{Since: createdAt.Add(time.Minute)})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(events))
+		assert.Equal(t, "permission denied", events[0].Error)
+
+		events, err = repo.GetAll(models.AuditFilter{Operation: "DeleteJobSpecification"})
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(events))
+
+		events, err = repo.GetAll(models.AuditFilter{Limit: 1})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(events))
+	})
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+)
+
+type AuthToken struct {
+	ID   uuid.UUID `gorm:"primary_key;type:uuid;default:uuid_generate_v4()"`
+	Name string    `gorm:"not null"`
+	Hash string    `gorm:"not null"`
+
+	Bindings  datatypes.JSON `gorm:"not null"`
+	ExpiresAt *time.Time
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (t AuthToken) FromSpec(spec models.AuthToken) (AuthToken, error) {
+	bindings, err := json.Marshal(spec.Bindings)
+	if err != nil {
+		return AuthToken{}, err
+	}
+	var expiresAt *time.Time
+	if !spec.ExpiresAt.IsZero() {
+		expiry := spec.ExpiresAt.UTC()
+		expiresAt = &expiry
+	}
+	return AuthToken{
+		ID:        spec.ID,
+		Name:      spec.Name,
+		Hash:      spec.Hash,
+		Bindings:  bindings,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (t AuthToken) ToSpec() (models.AuthToken, error) {
+	var bindings []models.AuthRoleBinding
+	if err := json.Unmarshal(t.Bindings, &bindings); err != nil {
+		return models.AuthToken{}, errors.Wrapf(err, "failed to read role bindings of token %s", t.Name)
+	}
+	var expiresAt time.Time
+	if t.ExpiresAt != nil {
+		expiresAt = *t.ExpiresAt
+	}
+	return models.AuthToken{
+		ID:        t.ID,
+		Name:      t.Name,
+		Hash:      t.Hash,
+		Bindings:  bindings,
+		ExpiresAt: expiresAt,
+		CreatedAt: t.CreatedAt,
+	}, nil
+}
+
+type authTokenRepository struct {
+	db *gorm.DB
+}
+
+func (repo *authTokenRepository) Save(spec models.AuthToken) error {
+	if spec.Name == "" {
+		return errors.New("name cannot be empty")
+	}
+	if spec.Hash == "" {
+		return errors.New("hash cannot be empty")
+	}
+	t, err := AuthToken{}.FromSpec(spec)
+	if err != nil {
+		return err
+	}
+	if t.ID == uuid.Nil {
+		t.ID = uuid.New()
+	}
+	return repo.db.Create(&t).Error
+}
+
+func (repo *authTokenRepository) GetByHash(hash string) (models.AuthToken, error) {
+	var t AuthToken
+	if err := repo.db.Where("hash = ?", hash).Find(&t).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.AuthToken{}, store.ErrResourceNotFound
+		}
+		return models.AuthToken{}, err
+	}
+	return t.ToSpec()
+}
+
+func (repo *authTokenRepository) GetAll() ([]models.AuthToken, error) {
+	specs := []models.AuthToken{}
+	tokens := []AuthToken{}
+	if err := repo.db.Order("name").Find(&tokens).Error; err != nil {
+		return specs, err
+	}
+	for _, t := range tokens {
+		adapted, err := t.ToSpec()
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapted)
+	}
+	return specs, nil
+}
+
+func (repo *authTokenRepository) Delete(name string) error {
+	result := repo.db.Where("name = ?", name).Delete(&AuthToken{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return store.ErrResourceNotFound
+	}
+	return nil
+}
+
+func NewAuthTokenRepository(db *gorm.DB) *authTokenRepository {
+	return &authTokenRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,93 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthTokenRepository(t *testing.T) {
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+		return dbConn
+	}
+
+	expiry := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ciToken := models.AuthToken{
+		Name:      "ci",
+		Hash:      "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		Bindings:  []models.AuthRoleBinding{{Project: "t-optimus", Role: models.AuthRoleDeployer}},
+		ExpiresAt: expiry,
+	}
+	adminToken := models.AuthToken{
+		Name:     "admin",
+		Hash:     "a591a6d40bf420404a011733cfb7b190d62c65bf0bcda32b57b277d9ad9f146e",
+		Bindings: []models.AuthRoleBinding{{Project: models.AuthAnyProject, Role: models.AuthRoleAdmin}},
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewAuthTokenRepository(db)
+
+		assert.Nil(t, repo.Save(ciToken))
+		assert.NotNil(t, repo.Save(ciToken))
+		assert.NotNil(t, repo.Save(models.AuthToken{Name: "empty"}))
+
+		checkModel, err := repo.GetByHash(ciToken.Hash)
+		assert.Nil(t, err)
+		assert.Equal(t, "ci", checkModel.Name)
+		assert.Equal(t, ciToken.Bindings, checkModel.Bindings)
+		assert.True(t, expiry.Equal(checkModel.ExpiresAt))
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewAuthTokenRepository(db)
+
+		assert.Nil(t, repo.Save(ciToken))
+		assert.Nil(t, repo.Save(adminToken))
+
+		tokens, err := repo.GetAll()
+		assert.Nil(t, err)
+		assert.Len(t, tokens, 2)
+		assert.Equal(t, "admin", tokens[0].Name)
+		assert.True(t, tokens[0].ExpiresAt.IsZero())
+	})
+	t.Run("Delete", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewAuthTokenRepository(db)
+
+		assert.Nil(t, repo.Save(ciToken))
+		assert.Nil(t, repo.Delete(ciToken.Name))
+		assert.Equal(t, store.ErrResourceNotFound, repo.Delete(ciToken.Name))
+
+		_, err := repo.GetByHash(ciToken.Hash)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
+}
@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+)
+
+type Deployment struct {
+	ID uuid.UUID `gorm:"primary_key;type:uuid"`
+
+	ProjectID   uuid.UUID `gorm:"not null"`
+	NamespaceID uuid.UUID `gorm:"not null"`
+	Namespace   Namespace `gorm:"foreignKey:NamespaceID"`
+
+	Status  string `gorm:"not null"`
+	Message string
+	Jobs    datatypes.JSON `gorm:"not null"`
+
+	CreatedAt time.Time `gorm:"not null" json:"created_at"`
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+func (d Deployment) FromSpec(spec models.Deployment) (Deployment, error) {
+	jobs := spec.Jobs
+	if jobs == nil {
+		jobs = []models.DeploymentJobResult{}
+	}
+	rawJobs, err := json.Marshal(jobs)
+	if err != nil {
+		return Deployment{}, err
+	}
+	return Deployment{
+		ID:          spec.ID,
+		ProjectID:   spec.Namespace.ProjectSpec.ID,
+		NamespaceID: spec.Namespace.ID,
+		Status:      spec.Status,
+		Message:     spec.Message,
+		Jobs:        rawJobs,
+		CreatedAt:   spec.CreatedAt,
+		UpdatedAt:   spec.UpdatedAt,
+	}, nil
+}
+
+func (d Deployment) ToSpec() (models.Deployment, error) {
+	var jobs []models.DeploymentJobResult
+	if err := json.Unmarshal(d.Jobs, &jobs); err != nil {
+		return models.Deployment{}, errors.Wrapf(err, "failed to read jobs of deployment %s", d.ID)
+	}
+	projectSpec, err := d.Namespace.Project.ToSpec()
+	if err != nil {
+		return models.Deployment{}, err
+	}
+	namespaceSpec, err := d.Namespace.ToSpec(projectSpec)
+	if err != nil {
+		return models.Deployment{}, err
+	}
+	return models.Deployment{
+		ID:        d.ID,
+		Namespace: namespaceSpec,
+		Status:    d.Status,
+		Message:   d.Message,
+		Jobs:      jobs,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}, nil
+}
+
+type deploymentRepository struct {
+	db *gorm.DB
+}
+
+func (repo *deploymentRepository) Save(spec models.Deployment) error {
+	if spec.ID == uuid.Nil {
+		return errors.New("deployment id cannot be empty")
+	}
+	d, err := Deployment{}.FromSpec(spec)
+	if err != nil {
+		return err
+	}
+	// namespace and project are only referred to, never changed
+	return repo.db.Set("gorm:save_associations", false).Save(&d).Error
+}
+
+func (repo *deploymentRepository) GetByID(id uuid.UUID) (models.Deployment, error) {
+	var d Deployment
+	if err := repo.db.Preload("Namespace").Preload("Namespace.Project").Where("id = ?", id).Find(&d).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return models.Deployment{}, store.ErrResourceNotFound
+		}
+		return models.Deployment{}, err
+	}
+	return d.ToSpec()
+}
+
+func (repo *deploymentRepository) GetAll(filter models.DeploymentFilter) ([]models.Deployment, error) {
+	query := repo.db.Preload("Namespace").Preload("Namespace.Project")
+	if filter.ProjectID != uuid.Nil {
+		query = query.Where("project_id = ?", filter.ProjectID)
+	}
+	if filter.NamespaceID != uuid.Nil {
+		query = query.Where("namespace_id = ?", filter.NamespaceID)
+	}
+	if len(filter.Status) > 0 {
+		query = query.Where("status in (?)", filter.Status)
+	}
+	if !filter.CreatedBefore.IsZero() {
+		query = query.Where("created_at < ?", filter.CreatedBefore)
+	}
+	if !filter.UpdatedBefore.IsZero() {
+		query = query.Where("updated_at < ?", filter.UpdatedBefore)
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = models.DeploymentDefaultLimit
+	}
+
+	specs := []models.Deployment{}
+	deployments := []Deployment{}
+	if err := query.Order("created_at DESC").Limit(limit).Find(&deployments).Error; err != nil {
+		return specs, err
+	}
+	for _, d := range deployments {
+		adapted, err := d.ToSpec()
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapted)
+	}
+	return specs, nil
+}
+
+func (repo *deploymentRepository) Heartbeat(ids []uuid.UUID, at time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return repo.db.Model(&Deployment{}).Where("id IN (?) AND status IN (?)", ids, models.DeploymentStatusUnfinished).
+		UpdateColumn("updated_at", at).Error
+}
+
+func NewDeploymentRepository(db *gorm.DB) *deploymentRepository {
+	return &deploymentRepository{
+		db: db,
+	}
+}
@@ -0,0 +1,188 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploymentRepository(t *testing.T) {
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+		return dbConn
+	}
+
+	hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+	projectSpec := models.ProjectSpec{
+		ID:   uuid.Must(uuid.NewRandom()),
+		Name: "t-optimus",
+		Config: map[string]string{
+			"bucket": "gs://some_folder",
+		},
+	}
+	namespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "reporting",
+		Config:      map[string]string{},
+		ProjectSpec: projectSpec,
+	}
+	otherNamespaceSpec := models.NamespaceSpec{
+		ID:          uuid.Must(uuid.NewRandom()),
+		Name:        "billing",
+		Config:      map[string]string{},
+		ProjectSpec: projectSpec,
+	}
+	setup := func(db *gorm.DB) {
+		assert.Nil(t, NewProjectRepository(db, hash).Save(projectSpec))
+		namespaceRepo := NewNamespaceRepository(db, projectSpec, hash)
+		assert.Nil(t, namespaceRepo.Save(namespaceSpec))
+		assert.Nil(t, namespaceRepo.Save(otherNamespaceSpec))
+	}
+
+	createdAt := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	queued := models.Deployment{
+		ID:        uuid.Must(uuid.NewRandom()),
+		Namespace: namespaceSpec,
+		Status:    models.DeploymentStatusQueued,
+		CreatedAt: createdAt,
+	}
+	failed := models.Deployment{
+		ID:        uuid.Must(uuid.NewRandom()),
+		Namespace: otherNamespaceSpec,
+		Status:    models.DeploymentStatusFailed,
+		Message:   "failed to sync jobs",
+		Jobs: []models.DeploymentJobResult{
+			{Name: "job-a", Success: true},
+			{Name: "job-b", Message: "failed to compile"},
+		},
+		CreatedAt: createdAt.Add(time.Hour),
+	}
+
+	t.Run("Save", func(t *testing.T) {
+		t.Run("should insert deployment if it doesn't exist and update it otherwise", func(t *testing.T) {
+			db := DBSetup()
+			defer db.Close()
+			setup(db)
+			repo := NewDeploymentRepository(db)
+
+			assert.Nil(t, repo.Save(queued))
+
+			finished := queued
+			finished.Status = models.DeploymentStatusSucceeded
+			finished.Jobs = []models.DeploymentJobResult{{Name: "job-a", Success: true}}
+			assert.Nil(t, repo.Save(finished))
+
+			deployment, err := repo.GetByID(queued.ID)
+			assert.Nil(t, err)
+			assert.Equal(t, models.DeploymentStatusSucceeded, deployment.Status)
+			assert.Equal(t, finished.Jobs, deployment.Jobs)
+			assert.Equal(t, "reporting", deployment.Namespace.Name)
+			assert.Equal(t, "t-optimus", deployment.Namespace.ProjectSpec.Name)
+			assert.True(t, createdAt.Equal(deployment.CreatedAt))
+		})
+		t.Run("should fail if deployment has no id", func(t *testing.T) {
+			db := DBSetup()
+			defer db.Close()
+			repo := NewDeploymentRepository(db)
+
+			assert.NotNil(t, repo.Save(models.Deployment{Namespace: namespaceSpec}))
+		})
+	})
+	t.Run("GetByID", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		repo := NewDeploymentRepository(db)
+
+		_, err := repo.GetByID(uuid.Must(uuid.NewRandom()))
+		assert.Equal(t, store.ErrResourceNotFound, err)
+	})
+	t.Run("GetAll", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		setup(db)
+		repo := NewDeploymentRepository(db)
+		assert.Nil(t, repo.Save(queued))
+		assert.Nil(t, repo.Save(failed))
+
+		deployments, err := repo.GetAll(models.DeploymentFilter{ProjectID: projectSpec.ID})
+		assert.Nil(t, err)
+		assert.Len(t, deployments, 2)
+		assert.Equal(t, failed.ID, deployments[0].ID)
+		assert.Equal(t, failed.Jobs, deployments[0].Jobs)
+		assert.Equal(t, queued.ID, deployments[1].ID)
+
+		deployments, err = repo.GetAll(models.DeploymentFilter{ProjectID: projectSpec.ID, NamespaceID: namespaceSpec.ID})
+		assert.Nil(t, err)
+		assert.Len(t, deployments, 1)
+		assert.Equal(t, queued.ID, deployments[0].ID)
+
+		deployments, err = repo.GetAll(models.DeploymentFilter{
+			Status:        models.DeploymentStatusUnfinished,
+			CreatedBefore: createdAt.Add(time.Minute),
+		})
+		assert.Nil(t, err)
+		assert.Len(t, deployments, 1)
+		assert.Equal(t, queued.ID, deployments[0].ID)
+
+		deployments, err = repo.GetAll(models.DeploymentFilter{ProjectID: projectSpec.ID, Limit: 1})
+		assert.Nil(t, err)
+		assert.Len(t, deployments, 1)
+	})
+	t.Run("Heartbeat", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		setup(db)
+		repo := NewDeploymentRepository(db)
+		assert.Nil(t, repo.Save(queued))
+		assert.Nil(t, repo.Save(failed))
+
+		beatAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+		assert.Nil(t, repo.Heartbeat([]uuid.UUID{queued.ID, failed.ID}, beatAt))
+
+		deployment, err := repo.GetByID(queued.ID)
+		assert.Nil(t, err)
+		assert.True(t, beatAt.Equal(deployment.UpdatedAt))
+		// finished deployments are left as they are
+		deployment, err = repo.GetByID(failed.ID)
+		assert.Nil(t, err)
+		assert.False(t, beatAt.Equal(deployment.UpdatedAt))
+
+		deployments, err := repo.GetAll(models.DeploymentFilter{
+			Status:        models.DeploymentStatusUnfinished,
+			UpdatedBefore: beatAt,
+		})
+		assert.Nil(t, err)
+		assert.Len(t, deployments, 0)
+		deployments, err = repo.GetAll(models.DeploymentFilter{
+			Status:        models.DeploymentStatusUnfinished,
+			UpdatedBefore: beatAt.Add(time.Second),
+		})
+		assert.Nil(t, err)
+		assert.Len(t, deployments, 1)
+	})
+}
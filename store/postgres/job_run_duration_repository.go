@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+	"gorm.io/datatypes"
+)
+
+// JobRunDuration holds run durations of all jobs of a project, in
+// milliseconds keyed by job name
+type JobRunDuration struct {
+	ProjectID uuid.UUID      `gorm:"primary_key;type:uuid"`
+	Durations datatypes.JSON `gorm:"not null"`
+
+	UpdatedAt time.Time `gorm:"not null" json:"updated_at"`
+}
+
+type jobRunDurationRepository struct {
+	db      *gorm.DB
+	project models.ProjectSpec
+}
+
+func (repo *jobRunDurationRepository) Save(durations map[string]time.Duration) error {
+	millis := map[string]int64{}
+	for name, duration := range durations {
+		millis[name] = duration.Milliseconds()
+	}
+	rawDurations, err := json.Marshal(millis)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize run durations")
+	}
+	return repo.db.Save(&JobRunDuration{
+		ProjectID: repo.project.ID,
+		Durations: rawDurations,
+	}).Error
+}
+
+func (repo *jobRunDurationRepository) GetAll() (map[string]time.Duration, error) {
+	durations := map[string]time.Duration{}
+	var r JobRunDuration
+	if err := repo.db.Where("project_id = ?", repo.project.ID).Find(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return durations, nil
+		}
+		return nil, err
+	}
+
+	var millis map[string]int64
+	if err := json.Unmarshal(r.Durations, &millis); err != nil {
+		return nil, errors.Wrapf(err, "failed to read run durations of project %s", repo.project.Name)
+	}
+	for name, ms := range millis {
+		durations[name] = time.Duration(ms) * time.Millisecond
+	}
+	return durations, nil
+}
+
+func NewJobRunDurationRepository(db *gorm.DB, project models.ProjectSpec) *jobRunDurationRepository {
+	return &jobRunDurationRepository{
+		db:      db,
+		project: project,
+	}
+}
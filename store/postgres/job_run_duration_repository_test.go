@@ -0,0 +1,68 @@
+// +build !unit_test
+
+package postgres
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobRunDurationRepository(t *testing.T) {
+	DBSetup := func() *gorm.DB {
+		dbURL, ok := os.LookupEnv("TEST_OPTIMUS_DB_URL")
+		if !ok {
+			panic("unable to find TEST_OPTIMUS_DB_URL env var")
+		}
+		dbConn, err := Connect(dbURL, 1, 1)
+		if err != nil {
+			panic(err)
+		}
+		m, err := NewHTTPFSMigrator(dbURL)
+		if err != nil {
+			panic(err)
+		}
+		if err := m.Drop(); err != nil {
+			panic(err)
+		}
+		if err := Migrate(dbURL); err != nil {
+			panic(err)
+		}
+		return dbConn
+	}
+
+	hash, _ := models.NewApplicationSecret("32charshtesthashtesthashtesthash")
+	projectSpec := models.ProjectSpec{
+		ID:     uuid.Must(uuid.NewRandom()),
+		Name:   "t-optimus",
+		Config: map[string]string{},
+	}
+
+	t.Run("should return no durations if none are saved", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		assert.Nil(t, NewProjectRepository(db, hash).Save(projectSpec))
+
+		durations, err := NewJobRunDurationRepository(db, projectSpec).GetAll()
+		assert.Nil(t, err)
+		assert.Empty(t, durations)
+	})
+	t.Run("should replace saved durations of project", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		assert.Nil(t, NewProjectRepository(db, hash).Save(projectSpec))
+		repo := NewJobRunDurationRepository(db, projectSpec)
+
+		assert.Nil(t, repo.Save(map[string]time.Duration{"job-a": time.Hour, "job-b": time.Minute}))
+		assert.Nil(t, repo.Save(map[string]time.Duration{"job-a": 90 * time.Second}))
+
+		durations, err := repo.GetAll()
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]time.Duration{"job-a": 90 * time.Second}, durations)
+	})
+}
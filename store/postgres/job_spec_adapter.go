@@ -160,8 +160,10 @@ func (adapt JobSpecAdapter) ToSpec(conf Job) (models.JobSpec, error) {
 	//prep assets
 	jobAssets := []models.JobSpecAsset{}
 	assetsRaw := []JobAsset{}
-	if err := json.Unmarshal(conf.Assets, &assetsRaw); err != nil {
-		return models.JobSpec{}, err
+	if conf.Assets != nil {
+		if err := json.Unmarshal(conf.Assets, &assetsRaw); err != nil {
+			return models.JobSpec{}, err
+		}
 	}
 	for _, asset := range assetsRaw {
 		jobAssets = append(jobAssets, asset.ToSpec())
@@ -170,8 +172,10 @@ func (adapt JobSpecAdapter) ToSpec(conf Job) (models.JobSpec, error) {
 	//prep hooks
 	jobHooks := []models.JobSpecHook{}
 	hooksRaw := []JobHook{}
-	if err := json.Unmarshal(conf.Hooks, &hooksRaw); err != nil {
-		return models.JobSpec{}, err
+	if conf.Hooks != nil {
+		if err := json.Unmarshal(conf.Hooks, &hooksRaw); err != nil {
+			return models.JobSpec{}, err
+		}
 	}
 	for _, hook := range hooksRaw {
 		hookSpec, err := hook.ToSpec(adapt.pluginRepo)
@@ -157,10 +157,21 @@ func (repo *JobSpecRepository) Delete(name string) error {
 	return repo.db.Where("namespace_id = ? AND name = ?", repo.namespace.ID, name).Delete(&Job{}).Error
 }
 
+// HardDelete permanently deletes job of the project deleted earlier, a job
+// archived with its namespace is kept for restore and its name stays taken
 func (repo *JobSpecRepository) HardDelete(name string) error {
+	var archived int
+	if err := repo.db.Unscoped().Model(&Job{}).Where("project_id = ? AND name = ?", repo.namespace.ProjectSpec.ID, name).
+		Where(archivedWithNamespace("job")).Count(&archived).Error; err != nil {
+		return errors.Wrap(err, "failed to check archived jobs")
+	}
+	if archived > 0 {
+		return errors.Wrapf(store.ErrResourceArchived, "job %s belongs to an archived namespace", name)
+	}
+
 	//find the base job
 	var r Job
-	if err := repo.db.Unscoped().Where("project_id = ? AND name = ?", repo.namespace.ProjectSpec.ID, name).Find(&r).Error; err == gorm.ErrRecordNotFound {
+	if err := repo.db.Unscoped().Where("project_id = ? AND name = ? AND deleted_at IS NOT NULL", repo.namespace.ProjectSpec.ID, name).Find(&r).Error; err == gorm.ErrRecordNotFound {
 		// no job exists, inserting for the first time
 		return nil
 	} else if err != nil {
@@ -190,6 +201,45 @@ func (repo *JobSpecRepository) GetAll() ([]models.JobSpec, error) {
 	return specs, nil
 }
 
+// jobLazyColumns are bulky columns of listed jobs read only when their
+// field is selected
+var jobLazyColumns = map[string]string{
+	"assets": "assets",
+	"hooks":  "hooks",
+}
+
+func (repo *JobSpecRepository) GetByFilter(filter models.JobSpecFilter) ([]models.JobSpec, error) {
+	specs := []models.JobSpec{}
+	jobs := []Job{}
+	query := withNamePrefix(repo.db.Where("namespace_id = ?", repo.namespace.ID), filter.NamePrefix)
+	if filter.Owner != "" {
+		query = query.Where("owner = ?", filter.Owner)
+	}
+	if filter.TaskName != "" {
+		query = query.Where("task_name = ?", filter.TaskName)
+	}
+	if filter.Destination != "" {
+		query = query.Where("destination = ?", filter.Destination)
+	}
+	query, err := withLabels(query, filter.Labels)
+	if err != nil {
+		return specs, err
+	}
+	query = withFields(query, &Job{}, filter.Fields, jobLazyColumns)
+	if err := withPage(query, filter.Page).Find(&jobs).Error; err != nil {
+		return specs, err
+	}
+
+	for _, job := range jobs {
+		adapt, err := repo.adapter.ToSpec(job)
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapt)
+	}
+	return specs, nil
+}
+
 func NewJobSpecRepository(db *gorm.DB, namespace models.NamespaceSpec, projectJobSpecRepo store.ProjectJobSpecRepository, adapter *JobSpecAdapter) *JobSpecRepository {
 	return &JobSpecRepository{
 		db:                 db,
@@ -0,0 +1,71 @@
+package postgres
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/odpf/optimus/models"
+	"github.com/pkg/errors"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// withNamePrefix narrows down query to rows whose name starts with prefix
+func withNamePrefix(db *gorm.DB, prefix string) *gorm.DB {
+	if prefix == "" {
+		return db
+	}
+	return db.Where("name LIKE ?", likeEscaper.Replace(prefix)+"%")
+}
+
+// withLabels narrows down query to rows whose labels contain all of labels
+func withLabels(db *gorm.DB, labels map[string]string) (*gorm.DB, error) {
+	if len(labels) == 0 {
+		return db, nil
+	}
+	rawLabels, err := json.Marshal(labels)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize labels")
+	}
+	return db.Where("labels @> ?::jsonb", string(rawLabels)), nil
+}
+
+// withFields reads only columns of model needed for fields if any are given,
+// lazy columns, e.g. assets, are left out unless their field is among them
+func withFields(db *gorm.DB, model interface{}, fields []string, lazyColumns map[string]string) *gorm.DB {
+	if len(fields) == 0 {
+		return db
+	}
+	skipped := map[string]bool{}
+	for _, column := range lazyColumns {
+		skipped[column] = true
+	}
+	for _, field := range fields {
+		delete(skipped, lazyColumns[field])
+	}
+	if len(skipped) == 0 {
+		return db
+	}
+
+	var columns []string
+	for _, field := range db.NewScope(model).Fields() {
+		if field.IsNormal && !field.IsIgnored && !skipped[field.DBName] {
+			columns = append(columns, field.DBName)
+		}
+	}
+	return db.Select(columns)
+}
+
+// withPage orders query by name and narrows it down to page, names are unique
+// within the listed scope so a page always starts where the previous ended
+func withPage(db *gorm.DB, page models.Page) *gorm.DB {
+	db = db.Order("name ASC")
+	if page.After != "" {
+		db = db.Where("name > ?", page.After)
+	}
+	if page.Limit > 0 {
+		db = db.Limit(page.Limit)
+	}
+	return db
+}
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS auth_token (
+   id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+   name VARCHAR(100) NOT NULL UNIQUE,
+   hash VARCHAR(64) NOT NULL UNIQUE,
+   bindings JSONB NOT NULL,
+   expires_at TIMESTAMP WITH TIME ZONE,
+
+   created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+   updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS audit_event (
+   id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+   actor VARCHAR(255) NOT NULL,
+   project_name VARCHAR(100),
+   namespace_name VARCHAR(100),
+   operation VARCHAR(100) NOT NULL,
+   targets JSONB NOT NULL,
+   request_digest VARCHAR(64) NOT NULL,
+   outcome VARCHAR(50) NOT NULL,
+   error TEXT,
+
+   created_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS audit_event_project_name_created_at_idx ON audit_event (project_name, created_at DESC);
+
+-- audit events are append only
+CREATE OR REPLACE FUNCTION audit_event_immutable() RETURNS TRIGGER AS $$
+BEGIN
+   RAISE EXCEPTION 'audit events can not be modified';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_event_immutable
+   BEFORE UPDATE OR DELETE ON audit_event
+   FOR EACH ROW EXECUTE PROCEDURE audit_event_immutable();
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS deployment (
+   id UUID PRIMARY KEY NOT NULL,
+   project_id UUID NOT NULL REFERENCES project (id),
+   namespace_id UUID NOT NULL REFERENCES namespace (id),
+   status VARCHAR(30) NOT NULL,
+   message TEXT,
+   jobs JSONB NOT NULL,
+
+   created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+   updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS deployment_project_id_created_at_idx ON deployment (project_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS deployment_status_idx ON deployment (status);
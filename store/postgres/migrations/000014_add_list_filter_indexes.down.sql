@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS job_namespace_id_name_idx;
+DROP INDEX IF EXISTS job_labels_idx;
+DROP INDEX IF EXISTS resource_namespace_id_datastore_name_idx;
+DROP INDEX IF EXISTS resource_labels_idx;
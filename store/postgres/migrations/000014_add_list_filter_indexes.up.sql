@@ -0,0 +1,4 @@
+CREATE INDEX IF NOT EXISTS job_namespace_id_name_idx ON job (namespace_id, name);
+CREATE INDEX IF NOT EXISTS job_labels_idx ON job USING GIN (labels jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS resource_namespace_id_datastore_name_idx ON resource (namespace_id, datastore, name);
+CREATE INDEX IF NOT EXISTS resource_labels_idx ON resource USING GIN (labels jsonb_path_ops);
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS job_run_duration (
+   project_id UUID PRIMARY KEY NOT NULL REFERENCES project (id),
+   durations JSONB NOT NULL,
+
+   updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
@@ -0,0 +1,8 @@
+CREATE OR REPLACE FUNCTION audit_event_immutable() RETURNS TRIGGER AS $$
+BEGIN
+   RAISE EXCEPTION 'audit events can not be modified';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP INDEX IF EXISTS audit_event_deployment_id_idx;
+ALTER TABLE audit_event DROP COLUMN IF EXISTS deployment_id;
@@ -0,0 +1,18 @@
+ALTER TABLE audit_event ADD COLUMN IF NOT EXISTS deployment_id UUID;
+
+CREATE INDEX IF NOT EXISTS audit_event_deployment_id_idx ON audit_event (deployment_id) WHERE deployment_id IS NOT NULL;
+
+-- audit events are append only, except outcome of requests which queued a
+-- deployment is settled once it finishes
+CREATE OR REPLACE FUNCTION audit_event_immutable() RETURNS TRIGGER AS $$
+BEGIN
+   IF TG_OP = 'UPDATE' AND OLD.outcome = 'queued' AND
+      (NEW.id, NEW.actor, NEW.project_name, NEW.namespace_name, NEW.operation, NEW.targets,
+       NEW.request_digest, NEW.deployment_id, NEW.created_at) IS NOT DISTINCT FROM
+      (OLD.id, OLD.actor, OLD.project_name, OLD.namespace_name, OLD.operation, OLD.targets,
+       OLD.request_digest, OLD.deployment_id, OLD.created_at) THEN
+      RETURN NEW;
+   END IF;
+   RAISE EXCEPTION 'audit events can not be modified';
+END;
+$$ LANGUAGE plpgsql;
@@ -148,6 +148,92 @@ func (repo *namespaceRepository) GetAll() ([]models.NamespaceSpec, error) {
 	return specs, nil
 }
 
+func (repo *namespaceRepository) GetByFilter(filter models.NameFilter) ([]models.NamespaceSpec, error) {
+	specs := []models.NamespaceSpec{}
+	namespaces := []Namespace{}
+	query := repo.db.Preload("Project").Preload("Project.Secrets").Where("project_id = ?", repo.project.ID)
+	query = withPage(withNamePrefix(query, filter.NamePrefix), filter.Page)
+	if err := query.Find(&namespaces).Error; err != nil {
+		return specs, err
+	}
+
+	for _, namespace := range namespaces {
+		adapt, err := namespace.ToSpecWithProjectSecrets(repo.hash)
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapt)
+	}
+	return specs, nil
+}
+
+// Archive soft deletes namespace along with its jobs and resources
+func (repo *namespaceRepository) Archive(name string) error {
+	var r Namespace
+	if err := repo.db.Where("name = ? AND project_id = ?", name, repo.project.ID).Find(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return store.ErrResourceNotFound
+		}
+		return err
+	}
+	archivedAt := archiveTime()
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := archiveRows(tx, archivedAt, []interface{}{&Job{}, &Resource{}}, "namespace_id = ?", r.ID); err != nil {
+			return err
+		}
+		return archiveRows(tx, archivedAt, []interface{}{&Namespace{}}, "id = ?", r.ID)
+	})
+}
+
+func (repo *namespaceRepository) getArchived(name string) (Namespace, error) {
+	var r Namespace
+	if err := repo.db.Unscoped().Where("name = ? AND project_id = ? AND deleted_at IS NOT NULL", name, repo.project.ID).
+		Find(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Namespace{}, store.ErrResourceNotFound
+		}
+		return Namespace{}, err
+	}
+	return r, nil
+}
+
+func (repo *namespaceRepository) GetArchivedAt(name string) (time.Time, error) {
+	r, err := repo.getArchived(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *r.DeletedAt, nil
+}
+
+// Restore brings back an archived namespace with jobs and resources archived
+// along with it
+func (repo *namespaceRepository) Restore(name string) error {
+	r, err := repo.getArchived(name)
+	if err != nil {
+		return err
+	}
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := restoreRows(tx, *r.DeletedAt, []interface{}{&Job{}, &Resource{}}, "namespace_id = ?", r.ID); err != nil {
+			return err
+		}
+		return restoreRows(tx, *r.DeletedAt, []interface{}{&Namespace{}}, "id = ?", r.ID)
+	})
+}
+
+// Purge permanently deletes an archived namespace and everything in it
+func (repo *namespaceRepository) Purge(name string) error {
+	r, err := repo.getArchived(name)
+	if err != nil {
+		return err
+	}
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := purgeJobsAndResources(tx, "namespace_id", r.ID); err != nil {
+			return err
+		}
+		return tx.Exec("DELETE FROM namespace WHERE id = ?", r.ID).Error
+	})
+}
+
 func NewNamespaceRepository(db *gorm.DB, project models.ProjectSpec, hash models.ApplicationKey) *namespaceRepository {
 	return &namespaceRepository{
 		db:      db,
@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -220,4 +221,73 @@ func TestNamespaceRepository(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(checkModel))
 	})
+	t.Run("GetByFilter", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+
+		projRepo := NewProjectRepository(db, hash)
+		err := projRepo.Save(projectSpec)
+		assert.Nil(t, err)
+
+		repo := NewNamespaceRepository(db, projectSpec, hash)
+		for _, namespace := range []models.NamespaceSpec{namespaceSpecs[0], namespaceSpecs[2], namespaceSpecs[3]} {
+			assert.Nil(t, repo.Insert(namespace))
+		}
+
+		firstPage, err := repo.GetByFilter(models.NameFilter{
+			NamePrefix: "t-",
+			Page:       models.Page{Limit: 1},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(firstPage))
+		assert.Equal(t, "t-optimus", firstPage[0].Name)
+
+		nextPage, err := repo.GetByFilter(models.NameFilter{
+			NamePrefix: "t-",
+			Page:       models.Page{After: firstPage[0].Name, Limit: 1},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(nextPage))
+		assert.Equal(t, "t-optimus-2", nextPage[0].Name)
+	})
+	t.Run("Archive", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+
+		projRepo := NewProjectRepository(db, hash)
+		err := projRepo.Save(projectSpec)
+		assert.Nil(t, err)
+
+		repo := NewNamespaceRepository(db, projectSpec, hash)
+		err = repo.Insert(namespaceSpecs[0])
+		assert.Nil(t, err)
+
+		_, err = repo.GetArchivedAt(namespaceSpecs[0].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+
+		err = repo.Archive(namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		_, err = repo.GetByName(namespaceSpecs[0].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+		archivedAt, err := repo.GetArchivedAt(namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		assert.False(t, archivedAt.IsZero())
+
+		err = repo.Restore(namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		checkModel, err := repo.GetByName(namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		assert.Equal(t, namespaceSpecs[0].ID, checkModel.ID)
+
+		err = repo.Archive(namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		err = repo.Purge(namespaceSpecs[0].Name)
+		assert.Nil(t, err)
+		_, err = repo.GetArchivedAt(namespaceSpecs[0].Name)
+		assert.Equal(t, store.ErrResourceNotFound, err)
+
+		// name is free to register again once purged
+		err = repo.Insert(namespaceSpecs[0])
+		assert.Nil(t, err)
+	})
 }
@@ -140,6 +140,102 @@ func (repo *ProjectRepository) GetAll() ([]models.ProjectSpec, error) {
 	return specs, nil
 }
 
+func (repo *ProjectRepository) GetByFilter(filter models.NameFilter) ([]models.ProjectSpec, error) {
+	specs := []models.ProjectSpec{}
+	projs := []Project{}
+	query := withPage(withNamePrefix(repo.db.Preload("Secrets"), filter.NamePrefix), filter.Page)
+	if err := query.Find(&projs).Error; err != nil {
+		return specs, err
+	}
+	for _, proj := range projs {
+		adapt, err := proj.ToSpecWithSecrets(repo.hash)
+		if err != nil {
+			return specs, err
+		}
+		specs = append(specs, adapt)
+	}
+	return specs, nil
+}
+
+// Archive soft deletes project along with its namespaces, jobs, resources
+// and secrets
+func (repo *ProjectRepository) Archive(name string) error {
+	var r Project
+	if err := repo.db.Where("name = ?", name).Find(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return store.ErrResourceNotFound
+		}
+		return err
+	}
+	archivedAt := archiveTime()
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := archiveRows(tx, archivedAt, []interface{}{&Job{}, &Resource{}, &Namespace{}, &Secret{}},
+			"project_id = ?", r.ID); err != nil {
+			return err
+		}
+		return archiveRows(tx, archivedAt, []interface{}{&Project{}}, "id = ?", r.ID)
+	})
+}
+
+func (repo *ProjectRepository) getArchived(name string) (Project, error) {
+	var r Project
+	if err := repo.db.Unscoped().Where("name = ? AND deleted_at IS NOT NULL", name).Find(&r).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return Project{}, store.ErrResourceNotFound
+		}
+		return Project{}, err
+	}
+	return r, nil
+}
+
+func (repo *ProjectRepository) GetArchivedAt(name string) (time.Time, error) {
+	r, err := repo.getArchived(name)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return *r.DeletedAt, nil
+}
+
+// Restore brings back an archived project with everything archived along
+// with it, namespaces archived on their own stay archived
+func (repo *ProjectRepository) Restore(name string) error {
+	r, err := repo.getArchived(name)
+	if err != nil {
+		return err
+	}
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := restoreRows(tx, *r.DeletedAt, []interface{}{&Job{}, &Resource{}, &Namespace{}, &Secret{}},
+			"project_id = ?", r.ID); err != nil {
+			return err
+		}
+		return restoreRows(tx, *r.DeletedAt, []interface{}{&Project{}}, "id = ?", r.ID)
+	})
+}
+
+// Purge permanently deletes an archived project and everything in it
+func (repo *ProjectRepository) Purge(name string) error {
+	r, err := repo.getArchived(name)
+	if err != nil {
+		return err
+	}
+	return repo.db.Transaction(func(tx *gorm.DB) error {
+		if err := purgeJobsAndResources(tx, "project_id", r.ID); err != nil {
+			return err
+		}
+		for _, statement := range []string{
+			"DELETE FROM secret WHERE project_id = ?",
+			"DELETE FROM job_run_duration WHERE project_id = ?",
+			"DELETE FROM namespace WHERE project_id = ?",
+			"DELETE FROM project WHERE id = ?",
+		} {
+			if err := tx.Exec(statement, r.ID).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func NewProjectRepository(db *gorm.DB, hash models.ApplicationKey) *ProjectRepository {
 	return &ProjectRepository{
 		db:   db,
@@ -207,6 +207,7 @@ func TestReplayRepository(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, jobConfigs[0].ID, replays[0].Job.ID)
 			assert.Equal(t, jobConfigs[2].ID, replays[1].Job.ID)
+			assert.Equal(t, projectSpec.Name, replays[0].Project.Name)
 		})
 	})
 
@@ -113,8 +113,10 @@ func (r Resource) ToSpec(ds models.Datastorer) (models.ResourceSpec, error) {
 	}
 
 	var assets map[string]string
-	if err := json.Unmarshal(r.Assets, &assets); err != nil {
-		return models.ResourceSpec{}, err
+	if r.Assets != nil {
+		if err := json.Unmarshal(r.Assets, &assets); err != nil {
+			return models.ResourceSpec{}, err
+		}
 	}
 	var labels map[string]string
 	if err := json.Unmarshal(r.Labels, &labels); err != nil {
@@ -266,12 +268,55 @@ func (repo *resourceSpecRepository) GetAll() ([]models.ResourceSpec, error) {
 	return specs, nil
 }
 
+// resourceLazyColumns are bulky columns of listed resources read only when
+// their field is selected
+var resourceLazyColumns = map[string]string{
+	"assets": "assets",
+}
+
+func (repo *resourceSpecRepository) GetByFilter(filter models.ResourceSpecFilter) ([]models.ResourceSpec, error) {
+	specs := []models.ResourceSpec{}
+	resources := []Resource{}
+	query := repo.db.Where("namespace_id = ? AND datastore = ?", repo.namespace.ID, repo.datastore.Name())
+	query = withNamePrefix(query, filter.NamePrefix)
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type.String())
+	}
+	query, err := withLabels(query, filter.Labels)
+	if err != nil {
+		return specs, err
+	}
+	query = withFields(query, &Resource{}, filter.Fields, resourceLazyColumns)
+	if err := withPage(query, filter.Page).Find(&resources).Error; err != nil {
+		return specs, err
+	}
+	for _, r := range resources {
+		adapted, err := r.ToSpec(repo.datastore)
+		if err != nil {
+			return specs, errors.Wrap(err, "failed to adapt resource")
+		}
+		specs = append(specs, adapted)
+	}
+	return specs, nil
+}
+
 func (repo *resourceSpecRepository) Delete(name string) error {
 	return repo.db.Where("namespace_id = ? AND datastore = ? AND name = ? ", repo.namespace.ID, repo.datastore.Name(), name).Delete(&Resource{}).Error
 }
 
+// HardDelete permanently deletes resource of the project deleted earlier, a
+// resource archived with its namespace is kept for restore and its name
+// stays taken
 func (repo *resourceSpecRepository) HardDelete(name string) error {
-	return repo.db.Unscoped().Where("namespace_id = ? AND datastore = ? AND name = ? ", repo.namespace.ID, repo.datastore.Name(), name).Delete(&Resource{}).Error
+	var archived int
+	if err := repo.db.Unscoped().Model(&Resource{}).Where("project_id = ? AND datastore = ? AND name = ?", repo.namespace.ProjectSpec.ID, repo.datastore.Name(), name).
+		Where(archivedWithNamespace("resource")).Count(&archived).Error; err != nil {
+		return errors.Wrap(err, "failed to check archived resources")
+	}
+	if archived > 0 {
+		return errors.Wrapf(store.ErrResourceArchived, "resource %s belongs to an archived namespace", name)
+	}
+	return repo.db.Unscoped().Where("project_id = ? AND datastore = ? AND name = ? AND deleted_at IS NOT NULL", repo.namespace.ProjectSpec.ID, repo.datastore.Name(), name).Delete(&Resource{}).Error
 }
 
 func NewResourceSpecRepository(db *gorm.DB, namespace models.NamespaceSpec, ds models.Datastorer, projectResourceSpecRepo store.ProjectResourceSpecRepository) *resourceSpecRepository {
@@ -11,6 +11,8 @@ import (
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	"github.com/odpf/optimus/models"
+	"github.com/odpf/optimus/store"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -133,6 +135,41 @@ func TestResourceSpecRepository(t *testing.T) {
 		assert.Equal(t, "proj.datas.test", checkModel.Name)
 	})
 
+	t.Run("Insert with name of resource in archived namespace", func(t *testing.T) {
+		db := DBSetup()
+		defer db.Close()
+		testModel := testConfigs[2]
+
+		namespaceRepo := NewNamespaceRepository(db, projectSpec, hash)
+		assert.Nil(t, namespaceRepo.Insert(namespaceSpec))
+		assert.Nil(t, namespaceRepo.Insert(namespaceSpec2))
+
+		projectResourceSpecRepo := NewProjectResourceSpecRepository(db, projectSpec, datastorer)
+		resourceSpecNamespace1 := NewResourceSpecRepository(db, namespaceSpec, datastorer, projectResourceSpecRepo)
+		resourceSpecNamespace2 := NewResourceSpecRepository(db, namespaceSpec2, datastorer, projectResourceSpecRepo)
+
+		err := resourceSpecNamespace1.Insert(testModel)
+		assert.Nil(t, err)
+		err = namespaceRepo.Archive(namespaceSpec.Name)
+		assert.Nil(t, err)
+
+		// archived resource is kept for restore, name is taken till purge
+		err = resourceSpecNamespace2.Insert(testModel)
+		assert.True(t, errors.Is(err, store.ErrResourceArchived))
+
+		err = namespaceRepo.Restore(namespaceSpec.Name)
+		assert.Nil(t, err)
+		checkModel, err := resourceSpecNamespace1.GetByName(testModel.Name)
+		assert.Nil(t, err)
+		assert.Equal(t, testModel.ID, checkModel.ID)
+
+		// resource deleted on its own isn't restored, name is free again
+		err = resourceSpecNamespace1.Delete(testModel.Name)
+		assert.Nil(t, err)
+		err = resourceSpecNamespace2.Insert(testModel)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Upsert", func(t *testing.T) {
 		t.Run("insert different resource should insert two", func(t *testing.T) {
 			db := DBSetup()
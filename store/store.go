@@ -13,6 +13,9 @@ import (
 
 var (
 	ErrResourceNotFound = errors.New("resource not found")
+	// ErrResourceArchived is returned when name is taken by a resource of an
+	// archived namespace or project, it's free again once that is purged
+	ErrResourceArchived = errors.New("resource is archived")
 )
 
 // ProjectJobSpecRepository represents a storage interface for Job specifications at a project level
@@ -22,11 +25,31 @@ type ProjectJobSpecRepository interface {
 	GetByDestination(string) (models.JobSpec, models.ProjectSpec, error)
 }
 
+// JobRunDurationRepository stores typical run durations of jobs of a project
+type JobRunDurationRepository interface {
+	// Save replaces run durations of jobs of the project
+	Save(durations map[string]time.Duration) error
+	// GetAll returns run durations of jobs of the project, empty if none
+	// are saved yet
+	GetAll() (map[string]time.Duration, error)
+}
+
 // ProjectRepository represents a storage interface for registered projects
 type ProjectRepository interface {
 	Save(models.ProjectSpec) error
 	GetByName(string) (models.ProjectSpec, error)
 	GetAll() ([]models.ProjectSpec, error)
+	// GetByFilter returns projects matching filter, ordered by name
+	GetByFilter(models.NameFilter) ([]models.ProjectSpec, error)
+	// Archive soft deletes a project with everything in it
+	Archive(string) error
+	// GetArchivedAt returns when a project was archived, ErrResourceNotFound
+	// if it isn't archived
+	GetArchivedAt(string) (time.Time, error)
+	// Restore brings back an archived project
+	Restore(string) error
+	// Purge permanently deletes an archived project
+	Purge(string) error
 }
 
 // ProjectSecretRepository stores secrets attached to projects
@@ -36,11 +59,56 @@ type ProjectSecretRepository interface {
 	GetAll() ([]models.ProjectSecretItem, error)
 }
 
+// AuthTokenRepository stores static API tokens used to authenticate callers
+type AuthTokenRepository interface {
+	Save(models.AuthToken) error
+	GetByHash(string) (models.AuthToken, error)
+	GetAll() ([]models.AuthToken, error)
+	Delete(name string) error
+}
+
+// AuditEventRepository is an append only log of mutating requests
+type AuditEventRepository interface {
+	Save(models.AuditEvent) error
+	// UpdateDeploymentOutcome settles outcome of queued events of deployment
+	UpdateDeploymentOutcome(deploymentID uuid.UUID, outcome, errMsg string) error
+	// GetAll returns events matching filter, latest first
+	GetAll(models.AuditFilter) ([]models.AuditEvent, error)
+}
+
+// DeploymentRepository stores status of job deployments
+type DeploymentRepository interface {
+	// Save inserts deployment or updates it if it exists
+	Save(models.Deployment) error
+	GetByID(uuid.UUID) (models.Deployment, error)
+	// GetAll returns deployments matching filter, latest first
+	GetAll(models.DeploymentFilter) ([]models.Deployment, error)
+	// Heartbeat sets last update time of deployments which are unfinished
+	Heartbeat([]uuid.UUID, time.Time) error
+}
+
+// KeyLocker serializes work on a key among servers sharing a store
+type KeyLocker interface {
+	// Lock waits till lock of key is taken or ctx is done, unlock releases it
+	Lock(ctx context.Context, key string) (unlock func(), err error)
+}
+
 // NamespaceRepository represents a storage interface for registered namespaces
 type NamespaceRepository interface {
 	Save(models.NamespaceSpec) error
 	GetByName(string) (models.NamespaceSpec, error)
 	GetAll() ([]models.NamespaceSpec, error)
+	// GetByFilter returns namespaces matching filter, ordered by name
+	GetByFilter(models.NameFilter) ([]models.NamespaceSpec, error)
+	// Archive soft deletes a namespace with its jobs and resources
+	Archive(string) error
+	// GetArchivedAt returns when a namespace was archived, ErrResourceNotFound
+	// if it isn't archived
+	GetArchivedAt(string) (time.Time, error)
+	// Restore brings back an archived namespace
+	Restore(string) error
+	// Purge permanently deletes an archived namespace
+	Purge(string) error
 }
 
 // JobRepository represents a storage interface for compiled specifications for
@@ -74,6 +142,8 @@ type ResourceSpecRepository interface {
 	Save(models.ResourceSpec) error
 	GetByName(string) (models.ResourceSpec, error)
 	GetAll() ([]models.ResourceSpec, error)
+	// GetByFilter returns resources matching filter, ordered by name
+	GetByFilter(models.ResourceSpecFilter) ([]models.ResourceSpec, error)
 	Delete(string) error
 }
 
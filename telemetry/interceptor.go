@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	grpctags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// TraceIDKey is the trailer trace id of failed requests is returned in,
+	// grpc gateway sends it as Grpc-Trailer-X-Trace-Id header
+	TraceIDKey = "x-trace-id"
+
+	// traceIDTag adds trace id to request logs
+	traceIDTag = "trace.id"
+)
+
+// UnaryServerInterceptor returns trace id of failed requests to callers, it
+// needs to run after requests are traced
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		traceID := TraceID(ctx)
+		if traceID != "" {
+			grpctags.Extract(ctx).Set(traceIDTag, traceID)
+		}
+		resp, err := handler(ctx, req)
+		if err != nil && traceID != "" {
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(TraceIDKey, traceID))
+		}
+		return resp, err
+	}
+}
+
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		traceID := TraceID(ss.Context())
+		if traceID != "" {
+			grpctags.Extract(ss.Context()).Set(traceIDTag, traceID)
+		}
+		err := handler(srv, ss)
+		if err != nil && traceID != "" {
+			ss.SetTrailer(metadata.Pairs(TraceIDKey, traceID))
+		}
+		return err
+	}
+}
+
+// UnaryClientInterceptor appends trace id returned by server to errors, so
+// failures reported by cli can be looked up in traces
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var trailer metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Trailer(&trailer))...)
+		return withTraceID(err, trailer)
+	}
+}
+
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+		streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &tracedClientStream{ClientStream: stream}, nil
+	}
+}
+
+// tracedClientStream appends trace id to the error stream ends with
+type tracedClientStream struct {
+	grpc.ClientStream
+}
+
+func (s *tracedClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err == nil || err == io.EOF {
+		return err
+	}
+	return withTraceID(err, s.ClientStream.Trailer())
+}
+
+func withTraceID(err error, trailer metadata.MD) error {
+	if err == nil {
+		return nil
+	}
+	ids := trailer.Get(TraceIDKey)
+	if len(ids) == 0 {
+		return err
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return errors.Wrapf(err, "trace id %s", ids[0])
+	}
+	proto := st.Proto()
+	proto.Message = fmt.Sprintf("%s (trace id: %s)", proto.Message, ids[0])
+	return status.ErrorProto(proto)
+}
@@ -0,0 +1,62 @@
+package telemetry_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/odpf/optimus/telemetry"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestInterceptor(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		otelgrpc.UnaryServerInterceptor(otelgrpc.WithTracerProvider(provider)),
+		telemetry.UnaryServerInterceptor(),
+	))
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("optimus", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	go server.Serve(listener)
+	defer server.Stop()
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(),
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithUnaryInterceptor(telemetry.UnaryClientInterceptor()),
+	)
+	assert.Nil(t, err)
+	defer conn.Close()
+	client := healthpb.NewHealthClient(conn)
+
+	t.Run("should return trace id of failed requests", func(t *testing.T) {
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		spans := recorder.Ended()
+		traceID := spans[len(spans)-1].SpanContext().TraceID().String()
+		assert.Equal(t, "unknown service (trace id: "+traceID+")", status.Convert(err).Message())
+	})
+	t.Run("should not return trace id of successful requests", func(t *testing.T) {
+		var trailer metadata.MD
+		resp, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "optimus"}, grpc.Trailer(&trailer))
+		assert.Nil(t, err)
+		assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+		assert.Empty(t, trailer.Get(telemetry.TraceIDKey))
+	})
+}